@@ -0,0 +1,188 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Converts from radians to degrees.
+ * @param radians an angle in radians
+ * @return the angle in degrees
+ */
+func AngleToDegrees(radians float64) float64 {
+	return (radians * 180) / math.Pi
+}
+
+/**
+ * Converts from degrees to radians.
+ *
+ * @param angleDegrees an angle in degrees
+ * @return the angle in radians
+ */
+func AngleToRadians(angleDegrees float64) float64 {
+	return (angleDegrees * math.Pi) / 180.0
+}
+
+/**
+ * Returns the angle of the vector from p0 to p1,
+ * relative to the positive X-axis.
+ * The angle is normalized to be in the range [ -Pi, Pi ].
+ *
+ * @param p0 the initial point of the vector
+ * @param p1 the terminal point of the vector
+ * @return the normalized angle (in radians) that p0-p1 makes with the positive x-axis.
+ */
+func AngleOf(p0 *geom.Coordinate, p1 *geom.Coordinate) float64 {
+	dx := p1.X - p0.X
+	dy := p1.Y - p0.Y
+	return math.Atan2(dy, dx)
+}
+
+/**
+ * Returns the unoriented smallest angle between two vectors.
+ * The computed angle will be in the range [0, Pi).
+ *
+ * @param tip1 the tip of one vector
+ * @param tail the tail of each vector
+ * @param tip2 the tip of the other vector
+ * @return the angle between tail-tip1 and tail-tip2
+ */
+func AngleBetween(tip1 *geom.Coordinate, tail *geom.Coordinate, tip2 *geom.Coordinate) float64 {
+	a1 := AngleOf(tail, tip1)
+	a2 := AngleOf(tail, tip2)
+
+	return AngleDiff(a1, a2)
+}
+
+/**
+ * Returns the oriented smallest angle between two vectors.
+ * The computed angle will be in the range (-Pi, Pi].
+ * A positive result corresponds to a counterclockwise
+ * (CCW) rotation
+ * from v1 to v2;
+ * a negative result corresponds to a clockwise (CW) rotation;
+ * a zero result corresponds to no rotation.
+ *
+ * @param tip1 the tip of v1
+ * @param tail the tail of each vector
+ * @param tip2 the tip of v2
+ * @return the angle between v1 and v2, relative to v1
+ */
+func AngleBetweenOriented(tip1 *geom.Coordinate, tail *geom.Coordinate, tip2 *geom.Coordinate) float64 {
+	a1 := AngleOf(tail, tip1)
+	a2 := AngleOf(tail, tip2)
+	angDel := a2 - a1
+
+	// normalize, maintaining orientation
+	if angDel <= -math.Pi {
+		return angDel + constants.ANGLE_PI_TIMES_2
+	}
+	if angDel > math.Pi {
+		return angDel - constants.ANGLE_PI_TIMES_2
+	}
+	return angDel
+}
+
+/**
+ * Computes the interior angle between two segments of a ring. The ring is
+ * assumed to be oriented in a clockwise direction. The computed angle will be
+ * in the range [0, 2Pi]
+ *
+ * @param p0
+ *          a point of the ring
+ * @param p1
+ *          the next point of the ring
+ * @param p2
+ *          the next point of the ring
+ * @return the interior angle based at <code>p1</code>
+ */
+func AngleInterior(p0 *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate) float64 {
+	anglePrev := AngleOf(p1, p0)
+	angleNext := AngleOf(p1, p2)
+	return AngleNormalizePositive(angleNext - anglePrev)
+}
+
+/**
+ * Computes the normalized value of an angle, which is the
+ * equivalent angle in the range ( -Pi, Pi ].
+ *
+ * @param angle the angle to normalize
+ * @return an equivalent angle in the range (-Pi, Pi]
+ */
+func AngleNormalize(angle float64) float64 {
+	for angle > math.Pi {
+		angle -= constants.ANGLE_PI_TIMES_2
+	}
+	for angle <= -math.Pi {
+		angle += constants.ANGLE_PI_TIMES_2
+	}
+	return angle
+}
+
+/**
+ * Computes the normalized positive value of an angle, which is the
+ * equivalent angle in the range [ 0, 2*Pi ).
+ * E.g.:
+ * <ul>
+ * <li>normalizePositive(0.0) = 0.0
+ * <li>normalizePositive(-PI) = PI
+ * <li>normalizePositive(-2PI) = 0.0
+ * <li>normalizePositive(-3PI) = PI
+ * <li>normalizePositive(-4PI) = 0
+ * <li>normalizePositive(PI) = PI
+ * <li>normalizePositive(2PI) = 0.0
+ * <li>normalizePositive(3PI) = PI
+ * <li>normalizePositive(4PI) = 0.0
+ * </ul>
+ *
+ * @param angle the angle to normalize, in radians
+ * @return an equivalent positive angle
+ */
+func AngleNormalizePositive(angle float64) float64 {
+	if angle < 0.0 {
+		for angle < 0.0 {
+			angle += constants.ANGLE_PI_TIMES_2
+		}
+		// in case round-off error bumps the value over
+		if angle >= constants.ANGLE_PI_TIMES_2 {
+			angle = 0.0
+		}
+	} else {
+		for angle >= constants.ANGLE_PI_TIMES_2 {
+			angle -= constants.ANGLE_PI_TIMES_2
+		}
+		// in case round-off error bumps the value under
+		if angle < 0.0 {
+			angle = 0.0
+		}
+	}
+	return angle
+}
+
+/**
+ * Computes the unoriented smallest difference between two angles.
+ * The angles are assumed to be normalized to the range [-Pi, Pi].
+ * The result will be in the range [0, Pi].
+ *
+ * @param ang1 the angle of one vector (in [-Pi, Pi] )
+ * @param ang2 the angle of the other vector (in range [-Pi, Pi] )
+ * @return the angle (in radians) between the two vectors (in range [0, Pi] )
+ */
+func AngleDiff(ang1 float64, ang2 float64) float64 {
+	var delAngle float64
+
+	if ang1 < ang2 {
+		delAngle = ang2 - ang1
+	} else {
+		delAngle = ang1 - ang2
+	}
+
+	if delAngle > math.Pi {
+		delAngle = constants.ANGLE_PI_TIMES_2 - delAngle
+	}
+
+	return delAngle
+}
@@ -0,0 +1,46 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the area for a ring.
+ *
+ * @param ring the coordinates forming the ring
+ * @return the area of the ring
+ */
+func AreaOfRing(ring []geom.Coordinate) float64 {
+	return math.Abs(AreaOfRingSigned(ring))
+}
+
+/**
+ * Computes the signed area for a ring. The signed area is positive if the
+ * ring is oriented CW, and negative if it is oriented CCW.
+ * <p>
+ * The implementation uses the "Shoelace" formula.
+ *
+ * @param ring
+ *          the coordinates forming the ring
+ * @return the signed area of the ring
+ */
+func AreaOfRingSigned(ring []geom.Coordinate) float64 {
+	if len(ring) < 3 {
+		return 0.0
+	}
+	sum := 0.0
+	/**
+	 * Based on the Shoelace formula.
+	 * http://en.wikipedia.org/wiki/Shoelace_formula
+	 */
+	x0 := ring[0].X
+	for i := 1; i < len(ring)-1; i++ {
+		x := ring[i].X - x0
+		y1 := ring[i+1].Y
+		y2 := ring[i-1].Y
+		sum += x * (y2 - y1)
+	}
+	return sum / 2.0
+}
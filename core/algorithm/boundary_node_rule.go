@@ -0,0 +1,71 @@
+package geos
+
+/**
+ * A rule which determines whether node points
+ * which are in boundaries of lineal geometry components
+ * are in the boundary of the parent geometry collection.
+ * The SFS specification states that there are two possible
+ * boundary rules, the Mod-2 rule and the EndPoint rule,
+ * but other rules are possible.
+ * <p>
+ * A rule is given the number of component boundaries
+ * (i.e. line endpoints) which touch a node,
+ * and reports whether the node lies in the boundary
+ * of the geometry.
+ * <p>
+ * {@link BoundaryNodeRuleMod2} is the rule used by the OGC SFS.
+ */
+type BoundaryNodeRule func(boundaryCount int) bool
+
+/**
+ * The Mod-2 Boundary Node Rule (which is the rule specified in the OGC SFS).
+ * A point on a linear geometry is in the boundary if
+ * it is the endpoint of an odd number of components.
+ * In particular, the endpoints of closed lines are not in the boundary.
+ *
+ * @param boundaryCount the number of component boundaries that this point occurs in
+ * @return true if point is a boundary point
+ */
+func BoundaryNodeRuleMod2(boundaryCount int) bool {
+	// the "Mod-2 Rule"
+	return boundaryCount%2 == 1
+}
+
+/**
+ * The Endpoint Boundary Node Rule.
+ * A point on a linear geometry is in the boundary
+ * if it is the endpoint of any component,
+ * including the endpoints of closed lines.
+ * This is the rule used by many GIS systems for linear networks.
+ *
+ * @param boundaryCount the number of component boundaries that this point occurs in
+ * @return true if point is a boundary point
+ */
+func BoundaryNodeRuleEndPoint(boundaryCount int) bool {
+	return boundaryCount > 0
+}
+
+/**
+ * The MultiValent Endpoint Boundary Node Rule.
+ * A point on a linear geometry is in the boundary
+ * if it is the endpoint of more than one component.
+ *
+ * @param boundaryCount the number of component boundaries that this point occurs in
+ * @return true if point is a boundary point
+ */
+func BoundaryNodeRuleMultiValentEndPoint(boundaryCount int) bool {
+	return boundaryCount > 1
+}
+
+/**
+ * The Monovalent Endpoint Boundary Node Rule.
+ * A point on a linear geometry is in the boundary
+ * only if it is the endpoint of exactly one component.
+ * This is the rule used by the ESRI SDE spatial data model.
+ *
+ * @param boundaryCount the number of component boundaries that this point occurs in
+ * @return true if point is a boundary point
+ */
+func BoundaryNodeRuleMonoValentEndPoint(boundaryCount int) bool {
+	return boundaryCount == 1
+}
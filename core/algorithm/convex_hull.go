@@ -0,0 +1,448 @@
+package geos
+
+import (
+	"sort"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the convex hull of a set of points.
+ * The convex hull is the smallest convex region
+ * which contains all the points.
+ * <p>
+ * Uses the Graham Scan algorithm,
+ * with the robust orientation predicate.
+ * The input is first reduced by discarding the points
+ * which lie inside the octagon formed by
+ * the extremal points in the axis and diagonal directions,
+ * since these cannot be vertices of the hull.
+ * <p>
+ * The hull is returned as an array of coordinates,
+ * whose length indicates the type of the result:
+ * <ul>
+ * <li>an empty array if the input is empty
+ * <li>a single coordinate (a Point) if the input has one distinct point
+ * <li>two coordinates (a LineString) if the input points are collinear
+ * <li>a closed clockwise ring of at least 4 coordinates
+ * (the shell of a Polygon) otherwise
+ * </ul>
+ * Collinear points on the hull boundary are not included in the result.
+ */
+type ConvexHull struct {
+	inputPts []geom.Coordinate
+}
+
+/**
+ * Creates a new instance which will compute the convex hull
+ * of an array of points.
+ *
+ * @param pts the points to compute the hull of
+ */
+func NewConvexHull(pts []geom.Coordinate) *ConvexHull {
+	ch := new(ConvexHull)
+	ch.inputPts = pts
+	return ch
+}
+
+/**
+ * Creates a new instance which will compute the convex hull
+ * of the vertices of a set of lines.
+ *
+ * @param lines the lines to compute the hull of
+ */
+func NewConvexHullFromLines(lines [][]geom.Coordinate) *ConvexHull {
+	pts := make([]geom.Coordinate, 0)
+	for _, line := range lines {
+		pts = append(pts, line...)
+	}
+	return NewConvexHull(pts)
+}
+
+/**
+ * Creates a new instance which will compute the convex hull
+ * of the vertices of a set of polygons.
+ *
+ * @param polygons the polygons, each as a shell followed by its holes
+ */
+func NewConvexHullFromPolygons(polygons [][][]geom.Coordinate) *ConvexHull {
+	pts := make([]geom.Coordinate, 0)
+	for _, poly := range polygons {
+		for _, ring := range poly {
+			pts = append(pts, ring...)
+		}
+	}
+	return NewConvexHull(pts)
+}
+
+/**
+ * Computes the convex hull of the points of an array.
+ *
+ * @param pts the points to compute the hull of
+ * @return the coordinates of the hull
+ */
+func ConvexHullOfPoints(pts []geom.Coordinate) []geom.Coordinate {
+	return NewConvexHull(pts).GetConvexHull()
+}
+
+/**
+ * Returns the convex hull of the input points.
+ * The result is an empty array, a single point,
+ * the two endpoints of a line,
+ * or a closed clockwise ring,
+ * according to the number of distinct input points
+ * and whether they are collinear.
+ *
+ * @return the coordinates of the convex hull
+ */
+func (ch *ConvexHull) GetConvexHull() []geom.Coordinate {
+	fewPts := ch.extractFewUniquePoints()
+	if fewPts != nil {
+		return fewPts
+	}
+
+	// use heuristic to reduce points, if large
+	reducedPts := ch.reduce(ch.inputPts)
+
+	// sort points for Graham scan.
+	sortedPts := convexHullPreSort(reducedPts)
+
+	// Use Graham scan to find convex hull.
+	cH := convexHullGrahamScan(sortedPts)
+
+	return convexHullLineOrPolygon(cH)
+}
+
+/**
+ * Returns the unique input points if there are at most two of them,
+ * or nil if there are more.
+ */
+func (ch *ConvexHull) extractFewUniquePoints() []geom.Coordinate {
+	uniquePts := make([]geom.Coordinate, 0, 2)
+	for i := range ch.inputPts {
+		pt := &ch.inputPts[i]
+		isUnique := true
+		for j := range uniquePts {
+			if uniquePts[j].Equals2D(pt) {
+				isUnique = false
+				break
+			}
+		}
+		if !isUnique {
+			continue
+		}
+		if len(uniquePts) == 2 {
+			return nil
+		}
+		uniquePts = append(uniquePts, *pt)
+	}
+	return uniquePts
+}
+
+/**
+ * Uses a heuristic to reduce the number of points scanned
+ * to compute the hull.
+ * The heuristic is to find a polygon guaranteed to
+ * be in (or on) the hull, and eliminate all points inside it.
+ * A quadrilateral defined by the extremal points
+ * in the four orthogonal directions
+ * can be used, but even more inclusive is
+ * to use an octilateral defined by the points in the 8 cardinal directions.
+ * <p>
+ * Note that even if the method used to determine the polygon vertices
+ * is not 100% robust, this does not affect the robustness of the convex hull.
+ * <p>
+ * To satisfy the requirements of the Graham Scan algorithm,
+ * the returned array has at least 3 entries.
+ * <p>
+ * This has the side effect of making the reduced points unique,
+ * as required by the convex hull algorithm used.
+ *
+ * @param inputPts the points to reduce
+ * @return the reduced list of points (at least 3)
+ */
+func (ch *ConvexHull) reduce(inputPts []geom.Coordinate) []geom.Coordinate {
+	polyPts := convexHullInnerOctolateralRing(inputPts)
+
+	// unable to compute interior polygon for some reason
+	if polyPts == nil {
+		return inputPts
+	}
+
+	type pointKey struct {
+		x, y float64
+	}
+	reducedSet := make(map[pointKey]bool)
+	reducedPts := make([]geom.Coordinate, 0)
+	add := func(pt *geom.Coordinate) {
+		key := pointKey{pt.X, pt.Y}
+		if reducedSet[key] {
+			return
+		}
+		reducedSet[key] = true
+		reducedPts = append(reducedPts, *pt)
+	}
+
+	// add points defining polygon
+	for i := range polyPts {
+		add(&polyPts[i])
+	}
+	/**
+	 * Add all unique points not in the interior poly.
+	 * CGAlgorithms.isPointInRing is not defined for points actually on the ring,
+	 * but this doesn't matter since the points of the interior polygon
+	 * are forced to be in the reduced set.
+	 */
+	for i := range inputPts {
+		if !PointLocationIsInRing(&inputPts[i], polyPts) {
+			add(&inputPts[i])
+		}
+	}
+	// ensure that computed array has at least 3 points (not necessarily unique)
+	for len(reducedPts) < 3 {
+		reducedPts = append(reducedPts, reducedPts[0])
+	}
+	return reducedPts
+}
+
+/**
+ * Computes the ring formed by the extremal points
+ * in the axis and diagonal directions,
+ * or nil if they are collinear.
+ */
+func convexHullInnerOctolateralRing(inputPts []geom.Coordinate) []geom.Coordinate {
+	octPts := convexHullInnerOctolateralPts(inputPts)
+	coordList := geom.DefaultCoordinateList()
+	coordList.AddAll(octPts, false)
+
+	// points must all lie in a line
+	if len(coordList.ToCoordinateArray()) < 3 {
+		return nil
+	}
+	coordList.CloseRing()
+	return coordList.ToCoordinateArray()
+}
+
+/**
+ * Computes the extremal points of an array of points
+ * in the 8 cardinal directions.
+ *
+ * @param inputPts an array of points
+ * @return the extremal points, in clockwise order starting from the west
+ */
+func convexHullInnerOctolateralPts(inputPts []geom.Coordinate) []geom.Coordinate {
+	pts := make([]geom.Coordinate, 8)
+	for j := range pts {
+		pts[j] = inputPts[0]
+	}
+	for i := 1; i < len(inputPts); i++ {
+		p := inputPts[i]
+		if p.X < pts[0].X {
+			pts[0] = p
+		}
+		if p.X-p.Y < pts[1].X-pts[1].Y {
+			pts[1] = p
+		}
+		if p.Y > pts[2].Y {
+			pts[2] = p
+		}
+		if p.X+p.Y > pts[3].X+pts[3].Y {
+			pts[3] = p
+		}
+		if p.X > pts[4].X {
+			pts[4] = p
+		}
+		if p.X-p.Y > pts[5].X-pts[5].Y {
+			pts[5] = p
+		}
+		if p.Y < pts[6].Y {
+			pts[6] = p
+		}
+		if p.X+p.Y < pts[7].X+pts[7].Y {
+			pts[7] = p
+		}
+	}
+	return pts
+}
+
+/**
+ * Sorts the points radially around the lowest point,
+ * which is placed first.
+ */
+func convexHullPreSort(pts []geom.Coordinate) []geom.Coordinate {
+	sorted := make([]geom.Coordinate, len(pts))
+	copy(sorted, pts)
+
+	// find the lowest point in the set. If two or more points have
+	// the same minimum y coordinate choose the one with the minimu x.
+	// This focal point is put in array location pts[0].
+	for i := 1; i < len(sorted); i++ {
+		if (sorted[i].Y < sorted[0].Y) || ((sorted[i].Y == sorted[0].Y) && (sorted[i].X < sorted[0].X)) {
+			sorted[0], sorted[i] = sorted[i], sorted[0]
+		}
+	}
+
+	// sort the points radially around the focal point.
+	origin := sorted[0]
+	rest := sorted[1:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		return convexHullPolarCompare(&origin, &rest[i], &rest[j]) < 0
+	})
+	return sorted
+}
+
+/**
+ * Given two points p and q compare them with respect to their radial
+ * ordering about point o.
+ * First checks radial ordering using a CCW orientation.
+ * If the points are collinear, the comparison is based
+ * on their distance to the origin.
+ * <p>
+ * p &lt; q iff
+ * <ul>
+ * <li>ang(o-p) &lt; ang(o-q) (e.g. o-p-q is CCW)
+ * <li>or ang(o-p) == ang(o-q) &amp;&amp; dist(o,p) &lt; dist(o,q)
+ * </ul>
+ *
+ * @param o the origin
+ * @param p a point
+ * @param q another point
+ * @return -1, 0 or 1 depending on whether p is less than,
+ * equal to or greater than q
+ */
+func convexHullPolarCompare(o *geom.Coordinate, p *geom.Coordinate, q *geom.Coordinate) int {
+	orient := OrientationIndex(o, p, q)
+	if orient == constants.ORIENTATION_COUNTERCLOCKWISE {
+		return -1
+	}
+	if orient == constants.ORIENTATION_CLOCKWISE {
+		return 1
+	}
+
+	/**
+	 * The points are collinear,
+	 * so compare based on distance from the origin.
+	 * The points p and q are >= to the origin,
+	 * so they lie in the closed half-plane above the origin.
+	 * If they are not in a horizontal line,
+	 * the Y ordinate can be tested to determine distance.
+	 * This is more robust than computing the distance explicitly.
+	 */
+	if p.Y > q.Y {
+		return 1
+	}
+	if p.Y < q.Y {
+		return -1
+	}
+
+	/**
+	 * The points lie in a horizontal line, which should also contain the origin
+	 * (since they are collinear).
+	 * Also, they must be above the origin.
+	 * Use the X ordinate to determine distance.
+	 */
+	if p.X > q.X {
+		return 1
+	}
+	if p.X < q.X {
+		return -1
+	}
+	// Assert: p = q
+	return 0
+}
+
+/**
+ * Uses the Graham Scan algorithm to compute the convex hull vertices.
+ *
+ * @param c a list of points, with at least 3 entries
+ * @return a closed ring of the convex hull points
+ */
+func convexHullGrahamScan(c []geom.Coordinate) []geom.Coordinate {
+	ps := make([]geom.Coordinate, 0, len(c)+1)
+	ps = append(ps, c[0], c[1], c[2])
+	for i := 3; i < len(c); i++ {
+		p := ps[len(ps)-1]
+		ps = ps[:len(ps)-1]
+		// check for empty stack to guard against robustness problems
+		for len(ps) > 0 && OrientationIndex(&ps[len(ps)-1], &p, &c[i]) == constants.ORIENTATION_CLOCKWISE {
+			p = ps[len(ps)-1]
+			ps = ps[:len(ps)-1]
+		}
+		ps = append(ps, p, c[i])
+	}
+	ps = append(ps, c[0])
+	return ps
+}
+
+/**
+ * Returns the hull as a line if the points are collinear,
+ * or otherwise as a clockwise ring with no collinear vertices.
+ *
+ * @param coordinates the vertices of a linear ring, which may or may not be
+ * flattened (i.e. vertices collinear)
+ * @return the 2 endpoints of a line if the vertices are collinear;
+ * otherwise, a ring with unnecessary (collinear) vertices removed
+ */
+func convexHullLineOrPolygon(coordinates []geom.Coordinate) []geom.Coordinate {
+	coordinates = convexHullCleanRing(coordinates)
+	if len(coordinates) == 3 {
+		return []geom.Coordinate{coordinates[0], coordinates[1]}
+	}
+	//-- the scan produces a counter-clockwise ring
+	geom.ReverseCoordinates(coordinates)
+	return coordinates
+}
+
+/**
+ * Cleans a list of points by removing interior collinear vertices.
+ *
+ * @param original the vertices of a linear ring, which may or may not be
+ * flattened (i.e. vertices collinear)
+ * @return the coordinates with unnecessary (collinear) vertices removed
+ */
+func convexHullCleanRing(original []geom.Coordinate) []geom.Coordinate {
+	cleanedRing := make([]geom.Coordinate, 0, len(original))
+	var previousDistinctCoordinate *geom.Coordinate
+	for i := 0; i <= len(original)-2; i++ {
+		currentCoordinate := &original[i]
+		nextCoordinate := &original[i+1]
+		if currentCoordinate.Equals2D(nextCoordinate) {
+			continue
+		}
+		if previousDistinctCoordinate != nil && convexHullIsBetween(previousDistinctCoordinate, currentCoordinate, nextCoordinate) {
+			continue
+		}
+		cleanedRing = append(cleanedRing, *currentCoordinate)
+		previousDistinctCoordinate = currentCoordinate
+	}
+	cleanedRing = append(cleanedRing, original[len(original)-1])
+	return cleanedRing
+}
+
+/**
+ * @return whether the three coordinates are collinear and c2 lies between
+ * c1 and c3 inclusive
+ */
+func convexHullIsBetween(c1 *geom.Coordinate, c2 *geom.Coordinate, c3 *geom.Coordinate) bool {
+	if OrientationIndex(c1, c2, c3) != constants.ORIENTATION_COLLINEAR {
+		return false
+	}
+	if c1.X != c3.X {
+		if c1.X <= c2.X && c2.X <= c3.X {
+			return true
+		}
+		if c3.X <= c2.X && c2.X <= c1.X {
+			return true
+		}
+	}
+	if c1.Y != c3.Y {
+		if c1.Y <= c2.Y && c2.Y <= c3.Y {
+			return true
+		}
+		if c3.Y <= c2.Y && c2.Y <= c1.Y {
+			return true
+		}
+	}
+	return false
+}
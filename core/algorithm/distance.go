@@ -0,0 +1,194 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the distance from a point p to a line segment AB
+ *
+ * Note: NON-ROBUST!
+ *
+ * @param p
+ *          the point to compute the distance for
+ * @param A
+ *          one point of the line
+ * @param B
+ *          another point of the line (must be different to A)
+ * @return the distance from p to line segment AB
+ */
+func DistancePointToSegment(p *geom.Coordinate, A *geom.Coordinate, B *geom.Coordinate) float64 {
+	// if start = end, then just compute distance to one of the endpoints
+	if A.X == B.X && A.Y == B.Y {
+		return p.Distance(A)
+	}
+
+	// otherwise use comp.graphics.algorithms Frequently Asked Questions method
+	/*
+	 * (1) r = AC dot AB
+	 *         ---------
+	 *         ||AB||^2
+	 *
+	 * r has the following meaning:
+	 *   r=0 P = A
+	 *   r=1 P = B
+	 *   r<0 P is on the backward extension of AB
+	 *   r>1 P is on the forward extension of AB
+	 *   0<r<1 P is interior to AB
+	 */
+
+	len2 := (B.X-A.X)*(B.X-A.X) + (B.Y-A.Y)*(B.Y-A.Y)
+	r := ((p.X-A.X)*(B.X-A.X) + (p.Y-A.Y)*(B.Y-A.Y)) / len2
+
+	if r <= 0.0 {
+		return p.Distance(A)
+	}
+	if r >= 1.0 {
+		return p.Distance(B)
+	}
+
+	/*
+	 * (2) s = (Ay-Cy)(Bx-Ax)-(Ax-Cx)(By-Ay)
+	 *         -----------------------------
+	 *                    L^2
+	 *
+	 * Then the distance from C to P = |s|*L.
+	 *
+	 * This is the same calculation as {@link #DistancePointToLinePerpendicular}.
+	 * Unrolled here for performance.
+	 */
+	s := ((A.Y-p.Y)*(B.X-A.X) - (A.X-p.X)*(B.Y-A.Y)) / len2
+	return math.Abs(s) * math.Sqrt(len2)
+}
+
+/**
+ * Computes the perpendicular distance from a point p to the (infinite) line
+ * containing the points AB
+ *
+ * @param p
+ *          the point to compute the distance for
+ * @param A
+ *          one point of the line
+ * @param B
+ *          another point of the line (must be different to A)
+ * @return the distance from p to line AB
+ */
+func DistancePointToLinePerpendicular(p *geom.Coordinate, A *geom.Coordinate, B *geom.Coordinate) float64 {
+	// use comp.graphics.algorithms Frequently Asked Questions method
+	/*
+	 * (2) s = (Ay-Cy)(Bx-Ax)-(Ax-Cx)(By-Ay)
+	 *         -----------------------------
+	 *                    L^2
+	 *
+	 * Then the distance from C to P = |s|*L.
+	 */
+	len2 := (B.X-A.X)*(B.X-A.X) + (B.Y-A.Y)*(B.Y-A.Y)
+	s := ((A.Y-p.Y)*(B.X-A.X) - (A.X-p.X)*(B.Y-A.Y)) / len2
+
+	return math.Abs(s) * math.Sqrt(len2)
+}
+
+/**
+ * Computes the distance from a point to a sequence of line segments.
+ *
+ * @param p
+ *          a point
+ * @param line
+ *          a sequence of contiguous line segments defined by their vertices
+ * @return the minimum distance between the point and the line segments,
+ *         or +Inf if the sequence is empty
+ */
+func DistancePointToSegmentString(p *geom.Coordinate, line []geom.Coordinate) float64 {
+	if len(line) == 0 {
+		return math.Inf(1)
+	}
+	minDistance := p.Distance(&line[0])
+	for i := 0; i < len(line)-1; i++ {
+		dist := DistancePointToSegment(p, &line[i], &line[i+1])
+		if dist < minDistance {
+			minDistance = dist
+		}
+	}
+	return minDistance
+}
+
+/**
+ * Computes the distance from a line segment AB to a line segment CD
+ *
+ * Note: NON-ROBUST!
+ *
+ * @param A
+ *          a point of one line
+ * @param B
+ *          the second point of (must be different to A)
+ * @param C
+ *          one point of the line
+ * @param D
+ *          another point of the line (must be different to A)
+ */
+func DistanceSegmentToSegment(A *geom.Coordinate, B *geom.Coordinate, C *geom.Coordinate, D *geom.Coordinate) float64 {
+	// check for zero-length segments
+	if A.Equals2D(B) {
+		return DistancePointToSegment(A, C, D)
+	}
+	if C.Equals2D(D) {
+		return DistancePointToSegment(D, A, B)
+	}
+
+	// AB and CD are line segments
+	/*
+	 * from comp.graphics.algo
+	 *
+	 * Solving the above for r and s yields
+	 *
+	 *     (Ay-Cy)(Dx-Cx)-(Ax-Cx)(Dy-Cy)
+	 * r = ----------------------------- (eqn 1)
+	 *     (Bx-Ax)(Dy-Cy)-(By-Ay)(Dx-Cx)
+	 *
+	 *     (Ay-Cy)(Bx-Ax)-(Ax-Cx)(By-Ay)
+	 * s = ----------------------------- (eqn 2)
+	 *     (Bx-Ax)(Dy-Cy)-(By-Ay)(Dx-Cx)
+	 *
+	 * Let P be the position vector of the
+	 * intersection point, then
+	 *   P=A+r(B-A) or
+	 *   Px=Ax+r(Bx-Ax)
+	 *   Py=Ay+r(By-Ay)
+	 * By examining the values of r & s, you can also determine some other limiting
+	 * conditions:
+	 *   If 0<=r<=1 & 0<=s<=1, intersection exists
+	 *      r<0 or r>1 or s<0 or s>1 line segments do not intersect
+	 *   If the denominator in eqn 1 is zero, AB & CD are parallel
+	 *   If the numerator in eqn 1 is also zero, AB & CD are collinear.
+	 */
+
+	noIntersection := false
+	if !geom.EnvelopeIntersectsSegments(A, B, C, D) {
+		noIntersection = true
+	} else {
+		denom := (B.X-A.X)*(D.Y-C.Y) - (B.Y-A.Y)*(D.X-C.X)
+
+		if denom == 0 {
+			noIntersection = true
+		} else {
+			r_num := (A.Y-C.Y)*(D.X-C.X) - (A.X-C.X)*(D.Y-C.Y)
+			s_num := (A.Y-C.Y)*(B.X-A.X) - (A.X-C.X)*(B.Y-A.Y)
+
+			s := s_num / denom
+			r := r_num / denom
+
+			if (r < 0) || (r > 1) || (s < 0) || (s > 1) {
+				noIntersection = true
+			}
+		}
+	}
+	if noIntersection {
+		return math.Min(
+			math.Min(DistancePointToSegment(A, C, D), DistancePointToSegment(B, C, D)),
+			math.Min(DistancePointToSegment(C, A, B), DistancePointToSegment(D, A, B)))
+	}
+	// segments intersect
+	return 0.0
+}
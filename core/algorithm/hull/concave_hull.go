@@ -0,0 +1,476 @@
+package geos
+
+import (
+	"cmp"
+	"container/heap"
+	"errors"
+	"math"
+	"slices"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	triangulate "github.com/UltimateThread/geos-go/core/triangulate"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Constructs a concave hull of a set of points.
+ * A concave hull is a concave or convex polygon containing all the input points,
+ * whose vertices are a subset of the vertices in the input.
+ * A given set of points has a sequence of hulls,
+ * of increasing concaveness, determined by a numeric target parameter.
+ * <p>
+ * The concave hull is constructed by removing border triangles
+ * of the Delaunay Triangulation of the points,
+ * as long as their "size" is larger than the target criterion.
+ * <p>
+ * The target criteria are:
+ * <ul>
+ * <li><b>Maximum Edge Length</b> - the length of the longest edge between hull vertices is
+ * no larger than this value
+ * <li><b>Maximum Edge Length Ratio</b> - determine the Maximum Edge Length
+ * as a fraction of the difference between the longest and shortest edge lengths
+ * in the Delaunay Triangulation.
+ * This normalizes the <b>Maximum Edge Length</b> to be scale-free.
+ * A value of 1 produces the convex hull; a value of 0 produces maximum concaveness.
+ * </ul>
+ * The preferred criterion is the <b>Maximum Edge Length Ratio</b>, since it is
+ * scale-free and local (so that no assumption needs to be made about the
+ * total amount of concaveness present).
+ * Other length criteria can be used by setting the Maximum Edge Length directly.
+ * For example, use a length relative to the longest edge length
+ * in the Minimum Spanning Tree of the point set.
+ * Or, use a length derived from the {@link #ConcaveHullUniformGridEdgeLength(pts)} value.
+ * <p>
+ * The computed hull is always a single connected polygon.
+ * This constraint may cause the concave hull to fail to meet the target criteria.
+ * <p>
+ * Optionally the concave hull can be allowed to contain holes
+ * by calling {@link #SetHolesAllowed(bool)}.
+ * <p>
+ * The hull is returned as a polygon, with a clockwise shell
+ * followed by any counter-clockwise holes.
+ * If the input points do not span an area the convex hull of the points
+ * is returned as a single component: an empty list, a point or a line.
+ */
+type ConcaveHull struct {
+	inputPts           []geom.Coordinate
+	maxEdgeLengthRatio float64
+	isHolesAllowed     bool
+	maxSizeInHull      float64
+
+	triList []*tri.Tri
+	isInTri map[*tri.Tri]bool
+	triSize map[*tri.Tri]float64
+}
+
+/**
+ * Computes the approximate edge length of
+ * a uniform square grid having the same number of
+ * points as a set of points and the same area as its convex hull.
+ * This value can be used to determine a suitable length threshold value
+ * for computing a concave hull.
+ * A value from 2 to 4 times the uniform grid length
+ * seems to produce reasonable results.
+ *
+ * @param pts a set of points
+ * @return the approximate uniform grid length
+ */
+func ConcaveHullUniformGridEdgeLength(pts []geom.Coordinate) float64 {
+	if len(pts) == 0 {
+		return 0
+	}
+	hull := algorithm.ConvexHullOfPoints(pts)
+	areaCH := 0.0
+	if len(hull) >= 4 {
+		areaCH = algorithm.AreaOfRing(hull)
+	}
+	return math.Sqrt(areaCH / float64(len(pts)))
+}
+
+/**
+ * Computes the concave hull of the vertices in a geometry
+ * using the target criterion of maximum edge length.
+ *
+ * @param pts the input points
+ * @param maxLength the target maximum edge length
+ * @param isHolesAllowed whether holes are allowed in the result
+ * @return the concave hull
+ */
+func ConcaveHullByLength(pts []geom.Coordinate, maxLength float64, isHolesAllowed bool) ([][]geom.Coordinate, error) {
+	hull := NewConcaveHull(pts)
+	if err := hull.SetMaximumEdgeLength(maxLength); err != nil {
+		return nil, err
+	}
+	hull.SetHolesAllowed(isHolesAllowed)
+	return hull.GetHull()
+}
+
+/**
+ * Computes the concave hull of the vertices in a geometry
+ * using the target criterion of maximum edge length ratio.
+ * The edge length ratio is a fraction of the length difference
+ * between the longest and shortest edges
+ * in the Delaunay Triangulation of the input points.
+ *
+ * @param pts the input points
+ * @param lengthRatio the target edge length factor
+ * @param isHolesAllowed whether holes are allowed in the result
+ * @return the concave hull
+ */
+func ConcaveHullByLengthRatio(pts []geom.Coordinate, lengthRatio float64, isHolesAllowed bool) ([][]geom.Coordinate, error) {
+	hull := NewConcaveHull(pts)
+	if err := hull.SetMaximumEdgeLengthRatio(lengthRatio); err != nil {
+		return nil, err
+	}
+	hull.SetHolesAllowed(isHolesAllowed)
+	return hull.GetHull()
+}
+
+/**
+ * Creates a new instance for a given set of points.
+ *
+ * @param pts the input points
+ */
+func NewConcaveHull(pts []geom.Coordinate) *ConcaveHull {
+	hull := new(ConcaveHull)
+	hull.inputPts = pts
+	hull.maxEdgeLengthRatio = -1
+	return hull
+}
+
+/**
+ * Sets the target maximum edge length for the concave hull.
+ * The length value must be zero or greater.
+ * <ul>
+ * <li>The value 0.0 produces the concave hull of smallest area
+ * that is still connected.
+ * <li>Larger values produce less concave results.
+ * A value equal or greater than the longest Delaunay Triangulation edge length
+ * produces the convex hull.
+ * </ul>
+ * The {@link #ConcaveHullUniformGridEdgeLength(pts)} value may be used as
+ * the basis for estimating an appropriate target maximum edge length.
+ *
+ * @param edgeLength a non-negative length
+ * @return an error if the length is negative
+ */
+func (hull *ConcaveHull) SetMaximumEdgeLength(edgeLength float64) error {
+	if edgeLength < 0 {
+		return errors.New("edge length must be non-negative")
+	}
+	hull.maxSizeInHull = edgeLength
+	hull.maxEdgeLengthRatio = -1
+	return nil
+}
+
+/**
+ * Sets the target maximum edge length ratio for the concave hull.
+ * The edge length ratio is a fraction of the difference
+ * between the longest and shortest edge lengths
+ * in the Delaunay Triangulation of the input points.
+ * It is a value in the range 0 to 1.
+ * <ul>
+ * <li>The value 0.0 produces a concave hull of minimum area
+ * that is still connected.
+ * <li>The value 1.0 produces the convex hull.
+ * </ul>
+ *
+ * @param edgeLengthRatio a length factor value between 0 and 1
+ * @return an error if the ratio is out of range
+ */
+func (hull *ConcaveHull) SetMaximumEdgeLengthRatio(edgeLengthRatio float64) error {
+	if edgeLengthRatio < 0 || edgeLengthRatio > 1 {
+		return errors.New("edge length ratio must be in range [0,1]")
+	}
+	hull.maxEdgeLengthRatio = edgeLengthRatio
+	return nil
+}
+
+/**
+ * Sets whether holes are allowed in the concave hull polygon.
+ *
+ * @param isHolesAllowed true if holes are allowed in the result
+ */
+func (hull *ConcaveHull) SetHolesAllowed(isHolesAllowed bool) {
+	hull.isHolesAllowed = isHolesAllowed
+}
+
+/**
+ * Gets the computed concave hull.
+ *
+ * @return the concave hull, as a shell followed by holes
+ */
+func (hull *ConcaveHull) GetHull() ([][]geom.Coordinate, error) {
+	if len(hull.inputPts) == 0 {
+		return [][]geom.Coordinate{}, nil
+	}
+	triList, err := concaveHullCreateDelaunayTriangulation(hull.inputPts)
+	if err != nil {
+		return nil, err
+	}
+	if hull.maxEdgeLengthRatio >= 0 {
+		hull.maxSizeInHull = concaveHullComputeTargetEdgeLength(triList, hull.maxEdgeLengthRatio)
+	}
+	if len(triList) == 0 {
+		convexHull := algorithm.ConvexHullOfPoints(hull.inputPts)
+		if len(convexHull) == 0 {
+			return [][]geom.Coordinate{}, nil
+		}
+		return [][]geom.Coordinate{convexHull}, nil
+	}
+
+	hull.triList = triList
+	hull.isInTri = make(map[*tri.Tri]bool, len(triList))
+	hull.triSize = make(map[*tri.Tri]float64, len(triList))
+	for _, t := range triList {
+		hull.isInTri[t] = true
+		hull.triSize[t] = hullTriLengthOfLongestEdge(t)
+	}
+	hull.computeHull()
+
+	hullTris := make([]*tri.Tri, 0, len(triList))
+	for _, t := range triList {
+		if hull.isInTri[t] {
+			hullTris = append(hullTris, t)
+		}
+	}
+	polys, err := hullCoverageUnion(hullAddTriEdges(nil, hullTris))
+	if err != nil {
+		return nil, err
+	}
+	if len(polys) == 0 {
+		return [][]geom.Coordinate{}, nil
+	}
+	return polys[0], nil
+}
+
+/**
+ * Computes the Delaunay Triangulation of the unique input points,
+ * as a list of linked clockwise triangles.
+ */
+func concaveHullCreateDelaunayTriangulation(pts []geom.Coordinate) ([]*tri.Tri, error) {
+	builder := triangulate.NewDelaunayTriangulationBuilder()
+	builder.SetSites(pts)
+	subdiv, err := builder.GetSubdivision()
+	if err != nil {
+		return nil, err
+	}
+
+	triList := make([]*tri.Tri, 0)
+	subdiv.VisitTriangles(func(triEdges []*quadedge.QuadEdge) {
+		p0 := triEdges[0].Orig().GetCoordinate()
+		p1 := triEdges[1].Orig().GetCoordinate()
+		p2 := triEdges[2].Orig().GetCoordinate()
+		if triEdges[0].Orig().IsCCW(triEdges[1].Orig(), triEdges[2].Orig()) {
+			triList = append(triList, tri.NewTri(p0, p2, p1))
+		} else {
+			triList = append(triList, tri.NewTri(p0, p1, p2))
+		}
+	}, false)
+	tri.TriangulationBuilderBuild(triList)
+	return triList, nil
+}
+
+func concaveHullComputeTargetEdgeLength(triList []*tri.Tri, edgeLengthRatio float64) float64 {
+	if edgeLengthRatio == 0 {
+		return 0
+	}
+	maxEdgeLen := -1.0
+	minEdgeLen := -1.0
+	for _, t := range triList {
+		for i := 0; i < 3; i++ {
+			length := t.GetEdgeLength(i)
+			if length > maxEdgeLen {
+				maxEdgeLen = length
+			}
+			if minEdgeLen < 0 || length < minEdgeLen {
+				minEdgeLen = length
+			}
+		}
+	}
+	//-- if ratio = 1 ensure all edges are included
+	if edgeLengthRatio == 1 {
+		return 2 * maxEdgeLen
+	}
+	return edgeLengthRatio*(maxEdgeLen-minEdgeLen) + minEdgeLen
+}
+
+func (hull *ConcaveHull) computeHull() {
+	hull.computeHullBorder()
+	if hull.isHolesAllowed {
+		hull.computeHullHoles()
+	}
+}
+
+/**
+ * Removes border triangles in order of decreasing size,
+ * until the largest border triangle is within the size threshold.
+ */
+func (hull *ConcaveHull) computeHullBorder() {
+	queue := make(hullTriQueue, 0)
+	for _, t := range hull.triList {
+		hull.addBorderTri(t, &queue)
+	}
+	// process tris in order of decreasing size (edge length)
+	for queue.Len() > 0 {
+		entry := heap.Pop(&queue).(hullTriEntry)
+		t := entry.tri
+		if !hull.isQueued(entry) {
+			continue
+		}
+		if hull.isInHull(t) {
+			break
+		}
+		if hull.isRemovableBorder(t) {
+			hull.removeTri(t, &queue)
+		}
+	}
+}
+
+/**
+ * Tests whether a queue entry is current,
+ * i.e. the triangle has not been removed or resized since it was queued.
+ */
+func (hull *ConcaveHull) isQueued(entry hullTriEntry) bool {
+	return hull.isInTri[entry.tri] && hull.triSize[entry.tri] == entry.size
+}
+
+/**
+ * Removes a triangle from the hull,
+ * and queues the adjacent triangles which become border triangles.
+ */
+func (hull *ConcaveHull) removeTri(t *tri.Tri, queue *hullTriQueue) {
+	//-- the non-null adjacents are now on the border
+	adj0 := t.GetAdjacent(0)
+	adj1 := t.GetAdjacent(1)
+	adj2 := t.GetAdjacent(2)
+
+	t.Remove()
+	hull.isInTri[t] = false
+
+	//-- add border adjacents to queue
+	hull.addBorderTri(adj0, queue)
+	hull.addBorderTri(adj1, queue)
+	hull.addBorderTri(adj2, queue)
+}
+
+func (hull *ConcaveHull) addBorderTri(t *tri.Tri, queue *hullTriQueue) {
+	if t == nil {
+		return
+	}
+	if t.NumAdjacent() != 2 {
+		return
+	}
+	size := hullTriLengthOfBoundary(t)
+	hull.triSize[t] = size
+	heap.Push(queue, hullTriEntry{t, size, t.GetArea()})
+}
+
+func (hull *ConcaveHull) isInHull(t *tri.Tri) bool {
+	return hull.triSize[t] < hull.maxSizeInHull
+}
+
+func (hull *ConcaveHull) computeHullHoles() {
+	candidateHoles := hull.findCandidateHoles()
+	// remove tris in order of decreasing size (edge length)
+	for _, t := range candidateHoles {
+		if !hull.isInTri[t] || t.IsBorder() || hullTriHasBoundaryTouch(t) {
+			continue
+		}
+		hull.removeHole(t)
+	}
+}
+
+/**
+ * Finds tris which may be the start of holes.
+ * Only tris which have a long enough edge and which do not touch the current hull
+ * boundary are included.
+ * This avoids the risk of disconnecting the result polygon.
+ * The list is sorted in decreasing order of size.
+ */
+func (hull *ConcaveHull) findCandidateHoles() []*tri.Tri {
+	candidateHoles := make([]*tri.Tri, 0)
+	for _, t := range hull.triList {
+		if !hull.isInTri[t] {
+			continue
+		}
+		//-- tris below the size threshold are in the hull, so NOT in a hole
+		if hull.triSize[t] < hull.maxSizeInHull {
+			continue
+		}
+		isTouchingBoundary := t.IsBorder() || hullTriHasBoundaryTouch(t)
+		if !isTouchingBoundary {
+			candidateHoles = append(candidateHoles, t)
+		}
+	}
+	// sort by size - longest edge length first
+	slices.SortStableFunc(candidateHoles, func(a *tri.Tri, b *tri.Tri) int {
+		sizeA := hull.triSize[a]
+		sizeB := hull.triSize[b]
+		if sizeA == sizeB {
+			return -cmp.Compare(a.GetArea(), b.GetArea())
+		}
+		return -cmp.Compare(sizeA, sizeB)
+	})
+	return candidateHoles
+}
+
+/**
+ * Erodes a hole starting at a given triangle,
+ * and eroding all adjacent triangles with boundary edge length above target.
+ */
+func (hull *ConcaveHull) removeHole(triHole *tri.Tri) {
+	queue := make(hullTriQueue, 0)
+	heap.Push(&queue, hullTriEntry{triHole, hull.triSize[triHole], triHole.GetArea()})
+
+	for queue.Len() > 0 {
+		entry := heap.Pop(&queue).(hullTriEntry)
+		t := entry.tri
+		if !hull.isQueued(entry) {
+			continue
+		}
+		if t != triHole && hull.isInHull(t) {
+			break
+		}
+		if t == triHole || hull.isRemovableHole(t) {
+			hull.removeTri(t, &queue)
+		}
+	}
+}
+
+func (hull *ConcaveHull) isRemovableBorder(t *tri.Tri) bool {
+	/**
+	 * Tri must have exactly 2 adjacent tris (i.e. a single boundary edge).
+	 * If it it has only 0 or 1 adjacent then removal would remove a vertex.
+	 * If it has 3 adjacent then it is not on border.
+	 */
+	if t.NumAdjacent() != 2 {
+		return false
+	}
+	/**
+	 * The tri cannot be removed if it is connecting, because
+	 * this would create more than one result polygon.
+	 */
+	return !hullTriIsConnecting(t)
+}
+
+func (hull *ConcaveHull) isRemovableHole(t *tri.Tri) bool {
+	/**
+	 * Tri must have exactly 2 adjacent tris (i.e. a single boundary edge).
+	 * If it it has only 0 or 1 adjacent then removal would remove a vertex.
+	 * If it has 3 adjacent then it is not connected to hole.
+	 */
+	if t.NumAdjacent() != 2 {
+		return false
+	}
+	/**
+	 * Ensure removal does not disconnect hull area.
+	 * This is a fast check which ensure holes and boundary
+	 * do not touch at single points.
+	 * (But it is slightly over-strict, since it prevents
+	 * any touching holes.)
+	 */
+	return !hullTriHasBoundaryTouch(t)
+}
@@ -0,0 +1,638 @@
+package geos
+
+import (
+	"errors"
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+const concaveHullOfPolygons_FRAME_EXPAND_FACTOR = 4
+
+const concaveHullOfPolygons_NOT_SPECIFIED = -1
+
+const concaveHullOfPolygons_NOT_FOUND = -1
+
+/**
+ * Constructs a concave hull of a set of polygons, respecting
+ * the polygons as constraints.
+ * A concave hull is a concave or convex polygon containing all the input polygons,
+ * whose vertices are a subset of the vertices in the input.
+ * A given set of polygons has a sequence of hulls of increasing concaveness,
+ * determined by a numeric target parameter.
+ * The computed hull "fills the gap" between the polygons,
+ * and does not intersect their interior.
+ * <p>
+ * The concave hull is constructed by removing the longest outer edges
+ * of the Constrained Delaunay Triangulation of the space between the polygons,
+ * until the target criterion parameter is reached.
+ * <p>
+ * The target criteria are:
+ * <ul>
+ * <li><b>Maximum Edge Length</b> - the length of the longest edge between the polygons is no larger
+ * than this value.
+ * <li><b>Maximum Edge Length Ratio</b> - determine the Maximum Edge Length
+ * as a fraction of the difference between the longest and shortest edge lengths
+ * between the polygons.
+ * This normalizes the <b>Maximum Edge Length</b> to be scale-free.
+ * A value of 1 produces the convex hull; a value of 0 produces the original polygons.
+ * </ul>
+ * The preferred criterion is the <b>Maximum Edge Length Ratio</b>, since it is
+ * scale-free and local (so that no assumption needs to be made about the
+ * total amount of concaveness present).
+ * <p>
+ * Optionally the concave hull can be allowed to contain holes, via {@link #SetHolesAllowed(bool)}.
+ * <p>
+ * The hull can be specified as being "tight", which means it follows the outer boundaries
+ * of the input polygons.
+ * <p>
+ * The input polygons must form a valid multipolygon
+ * (i.e. they must be non-overlapping, and touch only at points).
+ * The result is a list of polygons, each a clockwise shell
+ * followed by counter-clockwise holes.
+ */
+type ConcaveHullOfPolygons struct {
+	inputPolygons      [][][]geom.Coordinate
+	maxEdgeLength      float64
+	maxEdgeLengthRatio float64
+	isHolesAllowed     bool
+	isTight            bool
+
+	polygonRings    [][]geom.Coordinate
+	polygonRingPts  []map[hullNodeKey]bool
+	polygonRingEnvs []*geom.Envelope
+
+	tris         []*tri.Tri
+	isHullTri    map[*tri.Tri]bool
+	borderTriQue []*tri.Tri
+	/**
+	 * Records the edge index of the longest border edge for border tris,
+	 * so it can be tested for length and possible removal.
+	 */
+	borderEdgeMap map[*tri.Tri]int
+}
+
+/**
+ * Computes a concave hull of set of polygons
+ * using the target criterion of maximum edge length.
+ *
+ * @param polygons the input polygons
+ * @param maxLength the target maximum edge length
+ * @param isTight true if the hull should follow the outer boundaries of the input polygons
+ * @param isHolesAllowed true if the concave hull may contain holes
+ * @return the concave hull
+ */
+func ConcaveHullOfPolygonsByLength(polygons [][][]geom.Coordinate, maxLength float64, isTight bool, isHolesAllowed bool) ([][][]geom.Coordinate, error) {
+	hull := NewConcaveHullOfPolygons(polygons)
+	if err := hull.SetMaximumEdgeLength(maxLength); err != nil {
+		return nil, err
+	}
+	hull.SetHolesAllowed(isHolesAllowed)
+	hull.SetTight(isTight)
+	return hull.GetHull()
+}
+
+/**
+ * Computes a concave hull of set of polygons
+ * using the target criterion of maximum edge length ratio.
+ *
+ * @param polygons the input polygons
+ * @param lengthRatio the target maximum edge length ratio
+ * @param isTight true if the hull should follow the outer boundaries of the input polygons
+ * @param isHolesAllowed true if the concave hull may contain holes
+ * @return the concave hull
+ */
+func ConcaveHullOfPolygonsByLengthRatio(polygons [][][]geom.Coordinate, lengthRatio float64, isTight bool, isHolesAllowed bool) ([][][]geom.Coordinate, error) {
+	hull := NewConcaveHullOfPolygons(polygons)
+	if err := hull.SetMaximumEdgeLengthRatio(lengthRatio); err != nil {
+		return nil, err
+	}
+	hull.SetHolesAllowed(isHolesAllowed)
+	hull.SetTight(isTight)
+	return hull.GetHull()
+}
+
+/**
+ * Computes a concave fill area between a set of polygons,
+ * using the target criterion of maximum edge length.
+ *
+ * @param polygons the input polygons
+ * @param maxLength the target maximum edge length
+ * @return the concave fill
+ */
+func ConcaveHullOfPolygonsFillByLength(polygons [][][]geom.Coordinate, maxLength float64) ([][][]geom.Coordinate, error) {
+	hull := NewConcaveHullOfPolygons(polygons)
+	if err := hull.SetMaximumEdgeLength(maxLength); err != nil {
+		return nil, err
+	}
+	return hull.GetFill()
+}
+
+/**
+ * Computes a concave fill area between a set of polygons,
+ * using the target criterion of maximum edge length ratio.
+ *
+ * @param polygons the input polygons
+ * @param lengthRatio the target maximum edge length ratio
+ * @return the concave fill
+ */
+func ConcaveHullOfPolygonsFillByLengthRatio(polygons [][][]geom.Coordinate, lengthRatio float64) ([][][]geom.Coordinate, error) {
+	hull := NewConcaveHullOfPolygons(polygons)
+	if err := hull.SetMaximumEdgeLengthRatio(lengthRatio); err != nil {
+		return nil, err
+	}
+	return hull.GetFill()
+}
+
+/**
+ * Creates a new instance for a given set of polygons.
+ *
+ * @param polygons the input polygons, each a shell followed by holes
+ */
+func NewConcaveHullOfPolygons(polygons [][][]geom.Coordinate) *ConcaveHullOfPolygons {
+	hull := new(ConcaveHullOfPolygons)
+	hull.inputPolygons = make([][][]geom.Coordinate, 0, len(polygons))
+	for _, poly := range polygons {
+		if len(poly) == 0 || len(poly[0]) == 0 {
+			continue
+		}
+		hull.inputPolygons = append(hull.inputPolygons, poly)
+	}
+	hull.maxEdgeLengthRatio = concaveHullOfPolygons_NOT_SPECIFIED
+	return hull
+}
+
+/**
+ * Sets the target maximum edge length for the concave hull.
+ * The length value must be zero or greater.
+ * <ul>
+ * <li>The value 0.0 produces the input polygons.
+ * <li>Larger values produce less concave results.
+ * Above a certain large value the result is the convex hull of the input.
+ * </ul>
+ *
+ * @param edgeLength a non-negative length
+ * @return an error if the length is negative
+ */
+func (hull *ConcaveHullOfPolygons) SetMaximumEdgeLength(edgeLength float64) error {
+	if edgeLength < 0 {
+		return errors.New("edge length must be non-negative")
+	}
+	hull.maxEdgeLength = edgeLength
+	hull.maxEdgeLengthRatio = concaveHullOfPolygons_NOT_SPECIFIED
+	return nil
+}
+
+/**
+ * Sets the target maximum edge length ratio for the concave hull.
+ * The edge length ratio is a fraction of the difference
+ * between the longest and shortest edge lengths
+ * in the Delaunay Triangulation of the area between the input polygons.
+ * It is a value in the range 0 to 1.
+ * <ul>
+ * <li>The value 0.0 produces the original input polygons.
+ * <li>The value 1.0 produces the convex hull.
+ * </ul>
+ *
+ * @param edgeLengthRatio a length ratio value between 0 and 1
+ * @return an error if the ratio is out of range
+ */
+func (hull *ConcaveHullOfPolygons) SetMaximumEdgeLengthRatio(edgeLengthRatio float64) error {
+	if edgeLengthRatio < 0 || edgeLengthRatio > 1 {
+		return errors.New("edge length ratio must be in range [0,1]")
+	}
+	hull.maxEdgeLengthRatio = edgeLengthRatio
+	return nil
+}
+
+/**
+ * Sets whether holes are allowed in the concave hull polygon.
+ *
+ * @param isHolesAllowed true if holes are allowed in the result
+ */
+func (hull *ConcaveHullOfPolygons) SetHolesAllowed(isHolesAllowed bool) {
+	hull.isHolesAllowed = isHolesAllowed
+}
+
+/**
+ * Sets whether the boundary of the hull polygon is kept
+ * tight to the outer edges of the input polygons.
+ *
+ * @param isTight true if the boundary is kept tight
+ */
+func (hull *ConcaveHullOfPolygons) SetTight(isTight bool) {
+	hull.isTight = isTight
+}
+
+/**
+ * Gets the computed concave hull.
+ *
+ * @return the concave hull
+ */
+func (hull *ConcaveHullOfPolygons) GetHull() ([][][]geom.Coordinate, error) {
+	if len(hull.inputPolygons) == 0 {
+		return [][][]geom.Coordinate{}, nil
+	}
+	if err := hull.buildHullTris(); err != nil {
+		return nil, err
+	}
+	return hull.createHullGeometry(true)
+}
+
+/**
+ * Gets the concave fill, which is the area between the input polygons,
+ * subject to the concaveness control parameter.
+ *
+ * @return the concave fill
+ */
+func (hull *ConcaveHullOfPolygons) GetFill() ([][][]geom.Coordinate, error) {
+	hull.isTight = true
+	if len(hull.inputPolygons) == 0 {
+		return [][][]geom.Coordinate{}, nil
+	}
+	if err := hull.buildHullTris(); err != nil {
+		return nil, err
+	}
+	return hull.createHullGeometry(false)
+}
+
+func (hull *ConcaveHullOfPolygons) buildHullTris() error {
+	hull.extractShellRings()
+	frame := hull.createFrame()
+	tris, err := polygon.ConstrainedDelaunayTriangulatorTriangulatePolygon(frame)
+	if err != nil {
+		return err
+	}
+
+	framePts := frame[0]
+	if hull.maxEdgeLengthRatio >= 0 {
+		hull.maxEdgeLength = concaveHullOfPolygonsComputeTargetEdgeLength(tris, framePts, hull.maxEdgeLengthRatio)
+	}
+
+	hull.removeFrameCornerTris(tris, framePts)
+
+	hull.removeBorderTris()
+	if hull.isHolesAllowed {
+		hull.removeHoleTris()
+	}
+	return nil
+}
+
+func concaveHullOfPolygonsComputeTargetEdgeLength(triList []*tri.Tri, frameCorners []geom.Coordinate, edgeLengthRatio float64) float64 {
+	if edgeLengthRatio == 0 {
+		return 0
+	}
+	maxEdgeLen := -1.0
+	minEdgeLen := -1.0
+	for _, t := range triList {
+		//-- don't include frame triangles
+		if concaveHullOfPolygonsIsFrameTri(t, frameCorners) {
+			continue
+		}
+		for i := 0; i < 3; i++ {
+			//-- constraint edges are not used to determine ratio
+			if !t.HasAdjacent(i) {
+				continue
+			}
+			length := t.GetEdgeLength(i)
+			if length > maxEdgeLen {
+				maxEdgeLen = length
+			}
+			if minEdgeLen < 0 || length < minEdgeLen {
+				minEdgeLen = length
+			}
+		}
+	}
+	//-- if ratio = 1 ensure all edges are included
+	if edgeLengthRatio == 1 {
+		return 2 * maxEdgeLen
+	}
+	return edgeLengthRatio*(maxEdgeLen-minEdgeLen) + minEdgeLen
+}
+
+func concaveHullOfPolygonsIsFrameTri(t *tri.Tri, frameCorners []geom.Coordinate) bool {
+	index := concaveHullOfPolygonsVertexIndex(t, frameCorners)
+	return index >= 0
+}
+
+func (hull *ConcaveHullOfPolygons) removeFrameCornerTris(tris []*tri.Tri, frameCorners []geom.Coordinate) {
+	hull.tris = tris
+	hull.isHullTri = make(map[*tri.Tri]bool, len(tris))
+	hull.borderTriQue = make([]*tri.Tri, 0)
+	hull.borderEdgeMap = make(map[*tri.Tri]int)
+	for _, t := range tris {
+		index := concaveHullOfPolygonsVertexIndex(t, frameCorners)
+		isFrameTri := index != concaveHullOfPolygons_NOT_FOUND
+		if isFrameTri {
+			/**
+			 * Frame tris are adjacent to at most one border tri,
+			 * which is opposite the frame corner vertex.
+			 * Or, the opposite tri may be another frame tri,
+			 * which is not added as a border tri.
+			 */
+			oppIndex := tri.TriOppEdge(index)
+			oppTri := t.GetAdjacent(oppIndex)
+			isBorderTri := oppTri != nil && !concaveHullOfPolygonsIsFrameTri(oppTri, frameCorners)
+			if isBorderTri {
+				hull.addBorderTri(t, oppIndex)
+			}
+			t.Remove()
+		} else {
+			hull.isHullTri[t] = true
+		}
+	}
+}
+
+/**
+ * Get the tri vertex index of some point in a list,
+ * or -1 if none are vertices.
+ *
+ * @param t the tri to test for containing a point
+ * @param pts the points to test
+ * @return the vertex index of a point, or -1
+ */
+func concaveHullOfPolygonsVertexIndex(t *tri.Tri, pts []geom.Coordinate) int {
+	for i := range pts {
+		index := t.GetIndex(&pts[i])
+		if index >= 0 {
+			return index
+		}
+	}
+	return concaveHullOfPolygons_NOT_FOUND
+}
+
+func (hull *ConcaveHullOfPolygons) removeBorderTris() {
+	for len(hull.borderTriQue) > 0 {
+		t := hull.borderTriQue[0]
+		hull.borderTriQue = hull.borderTriQue[1:]
+		//-- tri might have been removed already
+		if !hull.isHullTri[t] {
+			continue
+		}
+		if hull.isRemovable(t) {
+			hull.addBorderTris(t)
+			hull.removeBorderTri(t)
+		}
+	}
+}
+
+func (hull *ConcaveHullOfPolygons) removeHoleTris() {
+	for {
+		holeTri := hull.findHoleSeedTri()
+		if holeTri == nil {
+			return
+		}
+		hull.addBorderTris(holeTri)
+		hull.removeBorderTri(holeTri)
+		hull.removeBorderTris()
+	}
+}
+
+func (hull *ConcaveHullOfPolygons) findHoleSeedTri() *tri.Tri {
+	for _, t := range hull.tris {
+		if hull.isHullTri[t] && hull.isHoleSeedTri(t) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (hull *ConcaveHullOfPolygons) isHoleSeedTri(t *tri.Tri) bool {
+	if concaveHullOfPolygonsIsBorderTri(t) {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		if t.HasAdjacent(i) && t.GetEdgeLength(i) > hull.maxEdgeLength {
+			return true
+		}
+	}
+	return false
+}
+
+func concaveHullOfPolygonsIsBorderTri(t *tri.Tri) bool {
+	for i := 0; i < 3; i++ {
+		if !t.HasAdjacent(i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (hull *ConcaveHullOfPolygons) isRemovable(t *tri.Tri) bool {
+	//-- remove non-bridging tris if keeping hull boundary tight
+	if hull.isTight && hull.isTouchingSinglePolygon(t) {
+		return true
+	}
+	//-- check if outside edge is longer than threshold
+	if borderEdgeIndex, ok := hull.borderEdgeMap[t]; ok {
+		edgeLen := t.GetEdgeLength(borderEdgeIndex)
+		if edgeLen > hull.maxEdgeLength {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether a triangle touches a single polygon at all vertices.
+ * If so, it is a candidate for removal if the hull polygon
+ * is being kept tight to the outer boundary of the input polygons.
+ * Tris which touch more than one polygon are called "bridging".
+ *
+ * @param t the triangle to test
+ * @return true if the tri touches a single polygon
+ */
+func (hull *ConcaveHullOfPolygons) isTouchingSinglePolygon(t *tri.Tri) bool {
+	envTri := geom.NewEnvelopeFromCoordinateArray(t.ToRing())
+	for i := range hull.polygonRings {
+		//-- optimization heuristic: a touching tri must be in ring envelope
+		if !hull.polygonRingEnvs[i].IntersectsEnvelope(envTri) {
+			continue
+		}
+		if hull.hasAllVertices(i, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (hull *ConcaveHullOfPolygons) hasAllVertices(ringIndex int, t *tri.Tri) bool {
+	for i := 0; i < 3; i++ {
+		v := t.GetCoordinate(i)
+		if !hull.polygonRingPts[ringIndex][hullNodeKey{v.X, v.Y}] {
+			return false
+		}
+	}
+	return true
+}
+
+func (hull *ConcaveHullOfPolygons) addBorderTris(t *tri.Tri) {
+	hull.addBorderTri(t, 0)
+	hull.addBorderTri(t, 1)
+	hull.addBorderTri(t, 2)
+}
+
+/**
+ * Adds an adjacent tri to the current border.
+ * The adjacent edge is recorded as the border edge for the tri.
+ * Note that only edges adjacent to another tri can become border edges.
+ * Since constraint-adjacent edges do not have an adjacent tri,
+ * they can never be on the border and thus will not be removed
+ * due to being shorter than the length threshold.
+ * The tri containing them may still be removed via another edge, however.
+ *
+ * @param t the tri adjacent to the tri to be added to the border
+ * @param index the index of the adjacent tri
+ */
+func (hull *ConcaveHullOfPolygons) addBorderTri(t *tri.Tri, index int) {
+	adj := t.GetAdjacent(index)
+	if adj == nil {
+		return
+	}
+	hull.borderTriQue = append(hull.borderTriQue, adj)
+	borderEdgeIndex := adj.GetIndexOfTri(t)
+	hull.borderEdgeMap[adj] = borderEdgeIndex
+}
+
+func (hull *ConcaveHullOfPolygons) removeBorderTri(t *tri.Tri) {
+	t.Remove()
+	hull.isHullTri[t] = false
+	delete(hull.borderEdgeMap, t)
+}
+
+func (hull *ConcaveHullOfPolygons) createHullGeometry(isIncludeInput bool) ([][][]geom.Coordinate, error) {
+	hullTris := make([]*tri.Tri, 0)
+	for _, t := range hull.tris {
+		if hull.isHullTri[t] {
+			hullTris = append(hullTris, t)
+		}
+	}
+	if !isIncludeInput && len(hullTris) == 0 {
+		return [][][]geom.Coordinate{}, nil
+	}
+
+	//-- union triangulation
+	edges := hullAddTriEdges(nil, hullTris)
+	if isIncludeInput {
+		//-- union with input polygons
+		vertexIndex := concaveHullOfPolygonsVertexIndexTree(hullTris)
+		for _, poly := range hull.inputPolygons {
+			for i, ring := range poly {
+				edges = hullAddRingEdges(edges, concaveHullOfPolygonsNodeRing(ring, vertexIndex), i == 0)
+			}
+		}
+	}
+	return hullCoverageUnion(edges)
+}
+
+/**
+ * Creates an index of the vertices of the hull triangles.
+ */
+func concaveHullOfPolygonsVertexIndexTree(tris []*tri.Tri) *strtree.STRtree[geom.Coordinate] {
+	index := strtree.NewSTRtree[geom.Coordinate]()
+	isAdded := make(map[hullNodeKey]bool)
+	for _, t := range tris {
+		for i := 0; i < 3; i++ {
+			v := t.GetCoordinate(i)
+			key := hullNodeKey{v.X, v.Y}
+			if isAdded[key] {
+				continue
+			}
+			isAdded[key] = true
+			index.Insert(geom.NewEnvelopeFromCoordinate(v), *v)
+		}
+	}
+	return index
+}
+
+type concaveHullOfPolygonsNode struct {
+	pt   geom.Coordinate
+	dist float64
+}
+
+/**
+ * Adds the triangulation vertices which lie in the interior
+ * of the segments of an input ring as ring vertices.
+ * These occur where the input polygons touch,
+ * so that the triangulation edges and the ring edges match.
+ */
+func concaveHullOfPolygonsNodeRing(ring []geom.Coordinate, vertexIndex *strtree.STRtree[geom.Coordinate]) []geom.Coordinate {
+	nodedRing := make([]geom.Coordinate, 0, len(ring))
+	for i := 0; i < len(ring)-1; i++ {
+		p0 := &ring[i]
+		p1 := &ring[i+1]
+		nodedRing = append(nodedRing, *p0)
+		nodes := make([]concaveHullOfPolygonsNode, 0)
+		vertexIndex.QueryVisitor(geom.NewEnvelopeFromCoordinates(p0, p1), func(v geom.Coordinate) bool {
+			if v.Equals2D(p0) || v.Equals2D(p1) {
+				return true
+			}
+			if algorithm.PointLocationIsOnSegment(&v, p0, p1) {
+				nodes = append(nodes, concaveHullOfPolygonsNode{v, v.Distance(p0)})
+			}
+			return true
+		})
+		sort.Slice(nodes, func(m, n int) bool {
+			return nodes[m].dist < nodes[n].dist
+		})
+		for _, node := range nodes {
+			nodedRing = append(nodedRing, node.pt)
+		}
+	}
+	if len(ring) > 0 {
+		nodedRing = append(nodedRing, ring[len(ring)-1])
+	}
+	return nodedRing
+}
+
+/**
+ * Creates a rectangular "frame" around the input polygons,
+ * with the input polygon shells as holes.
+ * The frame is large enough that the constrained Delaunay triangulation
+ * of it should contain the convex hull of the input as edges.
+ * The frame corner triangles can be removed to produce a
+ * triangulation of the space around and between the input polygons.
+ *
+ * @return the frame polygon
+ */
+func (hull *ConcaveHullOfPolygons) createFrame() [][]geom.Coordinate {
+	polygonsEnv := geom.DefaultEnvelope()
+	for _, ring := range hull.polygonRings {
+		polygonsEnv.ExpandToIncludeEnvelope(geom.NewEnvelopeFromCoordinateArray(ring))
+	}
+	diam := polygonsEnv.GetDiameter()
+	envFrame := polygonsEnv.Copy()
+	envFrame.ExpandBy(concaveHullOfPolygons_FRAME_EXPAND_FACTOR * diam)
+
+	shell := []geom.Coordinate{
+		*geom.NewCoordinateXY(envFrame.GetMinX(), envFrame.GetMinY()),
+		*geom.NewCoordinateXY(envFrame.GetMinX(), envFrame.GetMaxY()),
+		*geom.NewCoordinateXY(envFrame.GetMaxX(), envFrame.GetMaxY()),
+		*geom.NewCoordinateXY(envFrame.GetMaxX(), envFrame.GetMinY()),
+		*geom.NewCoordinateXY(envFrame.GetMinX(), envFrame.GetMinY()),
+	}
+	frame := make([][]geom.Coordinate, 0, len(hull.polygonRings)+1)
+	frame = append(frame, shell)
+	frame = append(frame, hull.polygonRings...)
+	return frame
+}
+
+func (hull *ConcaveHullOfPolygons) extractShellRings() {
+	hull.polygonRings = make([][]geom.Coordinate, 0, len(hull.inputPolygons))
+	hull.polygonRingPts = make([]map[hullNodeKey]bool, 0, len(hull.inputPolygons))
+	hull.polygonRingEnvs = make([]*geom.Envelope, 0, len(hull.inputPolygons))
+	for _, poly := range hull.inputPolygons {
+		ring := poly[0]
+		hull.polygonRings = append(hull.polygonRings, ring)
+		pts := make(map[hullNodeKey]bool, len(ring))
+		for _, p := range ring {
+			pts[hullNodeKey{p.X, p.Y}] = true
+		}
+		hull.polygonRingPts = append(hull.polygonRingPts, pts)
+		hull.polygonRingEnvs = append(hull.polygonRingEnvs, geom.NewEnvelopeFromCoordinateArray(ring))
+	}
+}
@@ -0,0 +1,120 @@
+package geos
+
+import (
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * A queue entry for a {@link tri.Tri} in a hull triangulation,
+ * recording the size of the triangle when it was queued.
+ * Larger triangles are removed first.
+ * If the sizes are identical the areas are compared,
+ * to ensure a (more) deterministic ordering.
+ */
+type hullTriEntry struct {
+	tri  *tri.Tri
+	size float64
+	area float64
+}
+
+type hullTriQueue []hullTriEntry
+
+func (q hullTriQueue) Len() int {
+	return len(q)
+}
+
+func (q hullTriQueue) Less(i, j int) bool {
+	if q[i].size == q[j].size {
+		return q[i].area > q[j].area
+	}
+	return q[i].size > q[j].size
+}
+
+func (q hullTriQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *hullTriQueue) Push(x any) {
+	*q = append(*q, x.(hullTriEntry))
+}
+
+func (q *hullTriQueue) Pop() any {
+	old := *q
+	n := len(old)
+	entry := old[n-1]
+	*q = old[:n-1]
+	return entry
+}
+
+/**
+ * Gets the length of the longest edge of a triangle.
+ */
+func hullTriLengthOfLongestEdge(t *tri.Tri) float64 {
+	return max(t.GetEdgeLength(0), t.GetEdgeLength(1), t.GetEdgeLength(2))
+}
+
+/**
+ * Gets the total length of the boundary edges of a triangle
+ * (the edges with no adjacent triangle).
+ */
+func hullTriLengthOfBoundary(t *tri.Tri) float64 {
+	length := 0.0
+	for i := 0; i < 3; i++ {
+		if !t.HasAdjacent(i) {
+			length += t.GetEdgeLength(i)
+		}
+	}
+	return length
+}
+
+/**
+ * Tests if a tri is the only one connecting its 2 adjacents.
+ * Assumes that the tri is on the border of the triangulation
+ * and that the triangulation does not contain holes.
+ */
+func hullTriIsConnecting(t *tri.Tri) bool {
+	adj2Index := hullTriAdjacent2VertexIndex(t)
+	isInterior := t.IsInteriorVertex(adj2Index)
+	return !isInterior
+}
+
+/**
+ * Gets the index of a vertex which is adjacent to two other tris (if any).
+ */
+func hullTriAdjacent2VertexIndex(t *tri.Tri) int {
+	if t.HasAdjacent(0) && t.HasAdjacent(1) {
+		return 1
+	}
+	if t.HasAdjacent(1) && t.HasAdjacent(2) {
+		return 2
+	}
+	if t.HasAdjacent(2) && t.HasAdjacent(0) {
+		return 0
+	}
+	return -1
+}
+
+/**
+ * Tests if a tri has a vertex which is in the boundary,
+ * but not in a boundary edge.
+ */
+func hullTriHasBoundaryTouch(t *tri.Tri) bool {
+	for i := 0; i < 3; i++ {
+		if hullTriIsBoundaryTouch(t, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func hullTriIsBoundaryTouch(t *tri.Tri, index int) bool {
+	//-- If vertex is in a boundary edge it is not a touch
+	if t.IsBoundary(index) {
+		return false
+	}
+	if t.IsBoundary(tri.TriPrev(index)) {
+		return false
+	}
+	//-- if vertex is not in interior it is on boundary
+	return !t.IsInteriorVertex(index)
+}
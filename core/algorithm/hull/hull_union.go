@@ -0,0 +1,90 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygonbuilder "github.com/UltimateThread/geos-go/core/internal/polygonbuilder"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * A directed edge of a polygonal coverage,
+ * oriented with the interior of its polygon on the right.
+ */
+type hullEdge struct {
+	p0 geom.Coordinate
+	p1 geom.Coordinate
+}
+
+type hullEdgeKey struct {
+	x0, y0, x1, y1 float64
+}
+
+type hullNodeKey struct {
+	x, y float64
+}
+
+/**
+ * Adds the edges of a set of clockwise triangles to a list of coverage edges.
+ */
+func hullAddTriEdges(edges []hullEdge, tris []*tri.Tri) []hullEdge {
+	for _, t := range tris {
+		for i := 0; i < 3; i++ {
+			edges = append(edges, hullEdge{*t.GetCoordinate(i), *t.GetCoordinate(tri.TriNext(i))})
+		}
+	}
+	return edges
+}
+
+/**
+ * Adds the edges of a ring to a list of coverage edges.
+ * The ring is oriented so that its edges have the interior
+ * on the right if it is a shell, or on the left if it is a hole.
+ */
+func hullAddRingEdges(edges []hullEdge, ring []geom.Coordinate, isShell bool) []hullEdge {
+	isCW := !algorithm.OrientationIsCCW(ring)
+	isForward := isCW == isShell
+	for i := 0; i < len(ring)-1; i++ {
+		if ring[i].Equals2D(&ring[i+1]) {
+			continue
+		}
+		if isForward {
+			edges = append(edges, hullEdge{ring[i], ring[i+1]})
+		} else {
+			edges = append(edges, hullEdge{ring[i+1], ring[i]})
+		}
+	}
+	return edges
+}
+
+/**
+ * Computes the union of a polygonal coverage given by its directed edges.
+ * Edges which are matched by an oppositely-oriented edge
+ * lie in the interior of the union and are discarded.
+ * The remaining edges are linked into rings,
+ * which form shells (clockwise) and holes (counter-clockwise).
+ *
+ * @param edges the coverage edges, with the interior on the right
+ * @return the union polygons, each as a shell followed by its holes
+ * @return an error if the union edges do not form closed rings
+ */
+func hullCoverageUnion(edges []hullEdge) ([][][]geom.Coordinate, error) {
+	edgeCount := make(map[hullEdgeKey]int)
+	for _, e := range edges {
+		edgeCount[hullEdgeKey{e.p0.X, e.p0.Y, e.p1.X, e.p1.Y}]++
+	}
+	boundary := make([]polygonbuilder.BoundaryEdge, 0)
+	for _, e := range edges {
+		key := hullEdgeKey{e.p0.X, e.p0.Y, e.p1.X, e.p1.Y}
+		if edgeCount[key] == 0 {
+			//-- a duplicate edge has already been added
+			continue
+		}
+		edgeCount[key] = 0
+		if _, ok := edgeCount[hullEdgeKey{e.p1.X, e.p1.Y, e.p0.X, e.p0.Y}]; ok {
+			continue
+		}
+		boundary = append(boundary, polygonbuilder.BoundaryEdge{P0: e.p0, P1: e.p1})
+	}
+	return polygonbuilder.PolygonBuilderBuild(boundary)
+}
@@ -0,0 +1,133 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the intersection point of two lines.
+ * If the lines are parallel or collinear this case is detected
+ * and <code>nil</code> is returned.
+ * <p>
+ * In general it is not possible to accurately compute
+ * the intersection point of two lines, due to
+ * numerical roundoff.
+ * This is particularly true when the input lines are nearly parallel.
+ * This routine uses numerical conditioning on the input values
+ * to ensure that the computed value is very close to the correct value.
+ *
+ * @param p1 an endpoint of line 1
+ * @param p2 an endpoint of line 1
+ * @param q1 an endpoint of line 2
+ * @param q2 an endpoint of line 2
+ * @return the intersection point between the lines, if there is one,
+ * or nil if the lines are parallel or collinear
+ */
+func Intersection(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate) *geom.Coordinate {
+	// compute midpoint of "kernel envelope"
+	minX0 := math.Min(p1.X, p2.X)
+	minY0 := math.Min(p1.Y, p2.Y)
+	maxX0 := math.Max(p1.X, p2.X)
+	maxY0 := math.Max(p1.Y, p2.Y)
+
+	minX1 := math.Min(q1.X, q2.X)
+	minY1 := math.Min(q1.Y, q2.Y)
+	maxX1 := math.Max(q1.X, q2.X)
+	maxY1 := math.Max(q1.Y, q2.Y)
+
+	intMinX := math.Max(minX0, minX1)
+	intMaxX := math.Min(maxX0, maxX1)
+	intMinY := math.Max(minY0, minY1)
+	intMaxY := math.Min(maxY0, maxY1)
+
+	midx := (intMinX + intMaxX) / 2.0
+	midy := (intMinY + intMaxY) / 2.0
+
+	// condition ordinate values by subtracting midpoint
+	p1x := p1.X - midx
+	p1y := p1.Y - midy
+	p2x := p2.X - midx
+	p2y := p2.Y - midy
+	q1x := q1.X - midx
+	q1y := q1.Y - midy
+	q2x := q2.X - midx
+	q2y := q2.Y - midy
+
+	// unrolled computation using homogeneous coordinates eqn
+	px := p1y - p2y
+	py := p2x - p1x
+	pw := p1x*p2y - p2x*p1y
+
+	qx := q1y - q2y
+	qy := q2x - q1x
+	qw := q1x*q2y - q2x*q1y
+
+	x := py*qw - qy*pw
+	y := qx*pw - px*qw
+	w := px*qy - qx*py
+
+	xInt := x / w
+	yInt := y / w
+	// check for parallel lines
+	if math.IsNaN(xInt) || math.IsInf(xInt, 0) || math.IsNaN(yInt) || math.IsInf(yInt, 0) {
+		return nil
+	}
+	// de-condition intersection point
+	return geom.NewCoordinateXY(xInt+midx, yInt+midy)
+}
+
+/**
+ * Computes the intersection point of a line and a line segment (if any).
+ * There will be no intersection point if:
+ * <ul>
+ * <li>the segment does not intersect the line
+ * <li>the line or the segment are degenerate (have zero length)
+ * </ul>
+ * If the segment is collinear with the line the first segment endpoint is returned.
+ *
+ * @param line1 a point on the line
+ * @param line2 a point on the line
+ * @param seg1 an endpoint of the line segment
+ * @param seg2 an endpoint of the line segment
+ * @return the intersection point, or nil if it is not possible to find an intersection
+ */
+func IntersectionLineSegment(line1 *geom.Coordinate, line2 *geom.Coordinate, seg1 *geom.Coordinate, seg2 *geom.Coordinate) *geom.Coordinate {
+	orientS1 := OrientationIndex(line1, line2, seg1)
+	if orientS1 == 0 {
+		return seg1.Clone()
+	}
+
+	orientS2 := OrientationIndex(line1, line2, seg2)
+	if orientS2 == 0 {
+		return seg2.Clone()
+	}
+
+	/**
+	 * If segment lies completely on one side of the line, it does not intersect
+	 */
+	if (orientS1 > 0 && orientS2 > 0) || (orientS1 < 0 && orientS2 < 0) {
+		return nil
+	}
+
+	/**
+	 * The segment intersects the line.
+	 * The full line-line intersection is used to compute the intersection point.
+	 */
+	intPt := Intersection(line1, line2, seg1, seg2)
+	if intPt != nil {
+		return intPt
+	}
+
+	/**
+	 * Due to robustness failure it is possible the intersection computation will return null.
+	 * In this case choose the closest point
+	 */
+	dist1 := DistancePointToLinePerpendicular(seg1, line1, line2)
+	dist2 := DistancePointToLinePerpendicular(seg2, line1, line2)
+	if dist1 < dist2 {
+		return seg1.Clone()
+	}
+	return seg2.Clone()
+}
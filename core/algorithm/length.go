@@ -0,0 +1,39 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the length of a linestring specified by a sequence of points.
+ *
+ * @param pts the points specifying the linestring
+ * @return the length of the linestring
+ */
+func LengthOfLine(pts []geom.Coordinate) float64 {
+	// optimized for processing CoordinateSequences
+	n := len(pts)
+	if n <= 1 {
+		return 0.0
+	}
+
+	length := 0.0
+
+	x0 := pts[0].X
+	y0 := pts[0].Y
+
+	for i := 1; i < n; i++ {
+		x1 := pts[i].X
+		y1 := pts[i].Y
+		dx := x1 - x0
+		dy := y1 - y0
+
+		length += math.Sqrt(dx*dx + dy*dy)
+
+		x0 = x1
+		y0 = y1
+	}
+	return length
+}
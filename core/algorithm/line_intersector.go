@@ -0,0 +1,487 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A robust algorithm to find and classify the intersection of two line segments.
+ * The intersection of two line segments can be
+ * either empty, a single point, or a line segment
+ * (if the segments are collinear and overlap).
+ * <p>
+ * The predicates used are robust, since they use the
+ * extended-precision orientation index.
+ * The intersection point itself is computed with numerical conditioning
+ * and is guaranteed to lie within the envelopes of both input segments.
+ * <p>
+ * The intersection result codes are the
+ * <code>LINE_INTERSECTOR_*</code> constants.
+ */
+type LineIntersector struct {
+	result     int
+	inputLines [2][2]geom.Coordinate
+	intPt      [2]geom.Coordinate
+	/**
+	 * The indexes of the endpoints of the intersection lines, in order along
+	 * the corresponding line
+	 */
+	intLineIndex [][]int
+	isProper     bool
+}
+
+/**
+ * Creates a new line intersector.
+ */
+func NewLineIntersector() *LineIntersector {
+	li := new(LineIntersector)
+	li.result = constants.LINE_INTERSECTOR_NO_INTERSECTION
+	return li
+}
+
+/**
+ * Computes the "edge distance" of an intersection point p along a segment.
+ * The edge distance is a metric of the point along the edge.
+ * The metric used is a robust and easy to compute metric function.
+ * It is <b>not</b> equivalent to the usual Euclidean metric.
+ * It relies on the fact that either the x or the y ordinates of the
+ * points in the edge are unique, depending on whether the edge is longer in
+ * the horizontal or vertical direction.
+ * <p>
+ * NOTE: This function may produce incorrect distances
+ *  for inputs where p is not precisely on p1-p2
+ * (E.g. p = (139,9) p1 = (139,10), p2 = (280,1) produces distance 0.0, which is incorrect.
+ * <p>
+ * My hypothesis is that the function is safe to use for points which are the
+ * result of <b>rounding</b> points which lie on the line,
+ * but not safe to use for <b>truncated</b> points.
+ */
+func ComputeEdgeDistance(p *geom.Coordinate, p0 *geom.Coordinate, p1 *geom.Coordinate) float64 {
+	dx := math.Abs(p1.X - p0.X)
+	dy := math.Abs(p1.Y - p0.Y)
+
+	dist := -1.0 // sentinel value
+	if p.Equals2D(p0) {
+		dist = 0.0
+	} else if p.Equals2D(p1) {
+		if dx > dy {
+			dist = dx
+		} else {
+			dist = dy
+		}
+	} else {
+		pdx := math.Abs(p.X - p0.X)
+		pdy := math.Abs(p.Y - p0.Y)
+		if dx > dy {
+			dist = pdx
+		} else {
+			dist = pdy
+		}
+		// <FIX>
+		// hack to ensure that non-endpoints always have a non-zero distance
+		if dist == 0.0 && !p.Equals2D(p0) {
+			dist = math.Max(pdx, pdy)
+		}
+	}
+	return dist
+}
+
+/**
+ * Computes the intersection of a point p and the line p1-p2.
+ * This function computes the boolean value of the hasIntersection test.
+ * The actual value of the intersection (if there is one)
+ * is equal to the value of <code>p</code>.
+ */
+func (li *LineIntersector) ComputePointIntersection(p *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate) {
+	li.isProper = false
+	// do between check first, since it is faster than the orientation test
+	if geom.EnvelopeIntersectsPoint(p1, p2, p) {
+		if (OrientationIndex(p1, p2, p) == 0) && (OrientationIndex(p2, p1, p) == 0) {
+			li.isProper = true
+			if p.Equals2D(p1) || p.Equals2D(p2) {
+				li.isProper = false
+			}
+			li.intPt[0] = *p
+			li.result = constants.LINE_INTERSECTOR_POINT_INTERSECTION
+			return
+		}
+	}
+	li.result = constants.LINE_INTERSECTOR_NO_INTERSECTION
+}
+
+/**
+ * Computes the intersection of the lines p1-p2 and p3-p4.
+ * This function computes both the boolean value of the hasIntersection test
+ * and the (approximate) value of the intersection point itself (if there is one).
+ */
+func (li *LineIntersector) ComputeIntersection(p1 *geom.Coordinate, p2 *geom.Coordinate, p3 *geom.Coordinate, p4 *geom.Coordinate) {
+	li.inputLines[0][0] = *p1
+	li.inputLines[0][1] = *p2
+	li.inputLines[1][0] = *p3
+	li.inputLines[1][1] = *p4
+	li.intLineIndex = nil
+	li.result = li.computeIntersect(p1, p2, p3, p4)
+}
+
+/**
+ * Tests whether the input geometries intersect.
+ *
+ * @return true if the input geometries intersect
+ */
+func (li *LineIntersector) HasIntersection() bool {
+	return li.result != constants.LINE_INTERSECTOR_NO_INTERSECTION
+}
+
+/**
+ * Returns the number of intersection points found.  This will be either 0, 1 or 2.
+ *
+ * @return the number of intersection points found (0, 1, or 2)
+ */
+func (li *LineIntersector) GetIntersectionNum() int {
+	return li.result
+}
+
+/**
+ * Returns the intIndex'th intersection point
+ *
+ * @param intIndex is 0 or 1
+ *
+ * @return the intIndex'th intersection point
+ */
+func (li *LineIntersector) GetIntersection(intIndex int) *geom.Coordinate {
+	return li.intPt[intIndex].Clone()
+}
+
+/**
+ * Test whether a point is a intersection point of two line segments.
+ * Note that if the intersection is a line segment, this method only tests for
+ * equality with the endpoints of the intersection segment.
+ * It does <b>not</b> return true if
+ * the input point is internal to the intersection segment.
+ *
+ * @return true if the input point is one of the intersection points.
+ */
+func (li *LineIntersector) IsIntersection(pt *geom.Coordinate) bool {
+	for i := 0; i < li.result; i++ {
+		if li.intPt[i].Equals2D(pt) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether either intersection point is an interior point of one of the input segments.
+ *
+ * @return <code>true</code> if either intersection point is in the interior of one of the input segments
+ */
+func (li *LineIntersector) IsInteriorIntersection() bool {
+	if li.IsInteriorIntersectionIndex(0) {
+		return true
+	}
+	if li.IsInteriorIntersectionIndex(1) {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests whether either intersection point is an interior point of the specified input segment.
+ *
+ * @return <code>true</code> if either intersection point is in the interior of the input segment
+ */
+func (li *LineIntersector) IsInteriorIntersectionIndex(inputLineIndex int) bool {
+	for i := 0; i < li.result; i++ {
+		if !(li.intPt[i].Equals2D(&li.inputLines[inputLineIndex][0]) ||
+			li.intPt[i].Equals2D(&li.inputLines[inputLineIndex][1])) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether an intersection is proper.
+ * <br>
+ * The intersection between two line segments is considered proper if
+ * they intersect in a single point in the interior of both segments
+ * (e.g. the intersection is a single point and is not equal to any of the
+ * endpoints).
+ * <p>
+ * The intersection between a point and a line segment is considered proper
+ * if the point lies in the interior of the segment (e.g. is not equal to
+ * either of the endpoints).
+ *
+ * @return true if the intersection is proper
+ */
+func (li *LineIntersector) IsProper() bool {
+	return li.HasIntersection() && li.isProper
+}
+
+/**
+ * Computes the intIndex'th intersection point in the direction of
+ * a specified input line segment
+ *
+ * @param segmentIndex is 0 or 1
+ * @param intIndex is 0 or 1
+ *
+ * @return the intIndex'th intersection point in the direction of the specified input line segment
+ */
+func (li *LineIntersector) GetIntersectionAlongSegment(segmentIndex int, intIndex int) *geom.Coordinate {
+	// lazily compute int line array
+	li.computeIntLineIndex()
+	return li.intPt[li.intLineIndex[segmentIndex][intIndex]].Clone()
+}
+
+/**
+ * Computes the index (order) of the intIndex'th intersection point in the direction of
+ * a specified input line segment
+ *
+ * @param segmentIndex is 0 or 1
+ * @param intIndex is 0 or 1
+ *
+ * @return the index of the intersection point along the input segment (0 or 1)
+ */
+func (li *LineIntersector) GetIndexAlongSegment(segmentIndex int, intIndex int) int {
+	li.computeIntLineIndex()
+	return li.intLineIndex[segmentIndex][intIndex]
+}
+
+/**
+ * Computes the "edge distance" of an intersection point along the specified input line segment.
+ *
+ * @param segmentIndex is 0 or 1
+ * @param intIndex is 0 or 1
+ *
+ * @return the edge distance of the intersection point
+ */
+func (li *LineIntersector) GetEdgeDistance(segmentIndex int, intIndex int) float64 {
+	return ComputeEdgeDistance(&li.intPt[intIndex], &li.inputLines[segmentIndex][0], &li.inputLines[segmentIndex][1])
+}
+
+func (li *LineIntersector) computeIntLineIndex() {
+	if li.intLineIndex == nil {
+		li.intLineIndex = [][]int{{0, 1}, {0, 1}}
+		li.computeIntLineIndexForSegment(0)
+		li.computeIntLineIndexForSegment(1)
+	}
+}
+
+func (li *LineIntersector) computeIntLineIndexForSegment(segmentIndex int) {
+	dist0 := li.GetEdgeDistance(segmentIndex, 0)
+	dist1 := li.GetEdgeDistance(segmentIndex, 1)
+	if dist0 > dist1 {
+		li.intLineIndex[segmentIndex][0] = 1
+		li.intLineIndex[segmentIndex][1] = 0
+	} else {
+		li.intLineIndex[segmentIndex][0] = 0
+		li.intLineIndex[segmentIndex][1] = 1
+	}
+}
+
+func (li *LineIntersector) computeIntersect(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate) int {
+	li.isProper = false
+
+	// first try a fast test to see if the envelopes of the lines intersect
+	if !geom.EnvelopeIntersectsSegments(p1, p2, q1, q2) {
+		return constants.LINE_INTERSECTOR_NO_INTERSECTION
+	}
+
+	// for each endpoint, compute which side of the other segment it lies
+	// if both endpoints lie on the same side of the other segment,
+	// the segments do not intersect
+	Pq1 := OrientationIndex(p1, p2, q1)
+	Pq2 := OrientationIndex(p1, p2, q2)
+
+	if (Pq1 > 0 && Pq2 > 0) || (Pq1 < 0 && Pq2 < 0) {
+		return constants.LINE_INTERSECTOR_NO_INTERSECTION
+	}
+
+	Qp1 := OrientationIndex(q1, q2, p1)
+	Qp2 := OrientationIndex(q1, q2, p2)
+
+	if (Qp1 > 0 && Qp2 > 0) || (Qp1 < 0 && Qp2 < 0) {
+		return constants.LINE_INTERSECTOR_NO_INTERSECTION
+	}
+	/**
+	 * Intersection is collinear if each endpoint lies on the other line.
+	 */
+	collinear := Pq1 == 0 && Pq2 == 0 && Qp1 == 0 && Qp2 == 0
+	if collinear {
+		return li.computeCollinearIntersection(p1, p2, q1, q2)
+	}
+
+	/**
+	 * At this point we know that there is a single intersection point
+	 * (since the lines are not collinear).
+	 */
+
+	/**
+	 *  Check if the intersection is an endpoint. If it is, copy the endpoint as
+	 *  the intersection point. Copying the point rather than computing it
+	 *  ensures the point has the exact value, which is important for
+	 *  robustness. It is sufficient to simply check for an endpoint which is on
+	 *  the other line, since at this point we know that the inputLines must
+	 *  intersect.
+	 */
+	if Pq1 == 0 || Pq2 == 0 || Qp1 == 0 || Qp2 == 0 {
+		li.isProper = false
+
+		/**
+		 * Check for two equal endpoints.
+		 * This is done explicitly rather than by the orientation tests
+		 * below in order to improve robustness.
+		 */
+		if p1.Equals2D(q1) || p1.Equals2D(q2) {
+			li.intPt[0] = *p1
+		} else if p2.Equals2D(q1) || p2.Equals2D(q2) {
+			li.intPt[0] = *p2
+		} else if Pq1 == 0 {
+			/**
+			 * Now check to see if any endpoint lies on the interior of the other segment.
+			 */
+			li.intPt[0] = *q1
+		} else if Pq2 == 0 {
+			li.intPt[0] = *q2
+		} else if Qp1 == 0 {
+			li.intPt[0] = *p1
+		} else if Qp2 == 0 {
+			li.intPt[0] = *p2
+		}
+	} else {
+		li.isProper = true
+		li.intPt[0] = *li.intersection(p1, p2, q1, q2)
+	}
+	return constants.LINE_INTERSECTOR_POINT_INTERSECTION
+}
+
+func (li *LineIntersector) computeCollinearIntersection(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate) int {
+	q1inP := geom.EnvelopeIntersectsPoint(p1, p2, q1)
+	q2inP := geom.EnvelopeIntersectsPoint(p1, p2, q2)
+	p1inQ := geom.EnvelopeIntersectsPoint(q1, q2, p1)
+	p2inQ := geom.EnvelopeIntersectsPoint(q1, q2, p2)
+
+	if q1inP && q2inP {
+		li.intPt[0] = *q1
+		li.intPt[1] = *q2
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	if p1inQ && p2inQ {
+		li.intPt[0] = *p1
+		li.intPt[1] = *p2
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	if q1inP && p1inQ {
+		li.intPt[0] = *q1
+		li.intPt[1] = *p1
+		if q1.Equals2D(p1) && !q2inP && !p2inQ {
+			return constants.LINE_INTERSECTOR_POINT_INTERSECTION
+		}
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	if q1inP && p2inQ {
+		li.intPt[0] = *q1
+		li.intPt[1] = *p2
+		if q1.Equals2D(p2) && !q2inP && !p1inQ {
+			return constants.LINE_INTERSECTOR_POINT_INTERSECTION
+		}
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	if q2inP && p1inQ {
+		li.intPt[0] = *q2
+		li.intPt[1] = *p1
+		if q2.Equals2D(p1) && !q1inP && !p2inQ {
+			return constants.LINE_INTERSECTOR_POINT_INTERSECTION
+		}
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	if q2inP && p2inQ {
+		li.intPt[0] = *q2
+		li.intPt[1] = *p2
+		if q2.Equals2D(p2) && !q1inP && !p1inQ {
+			return constants.LINE_INTERSECTOR_POINT_INTERSECTION
+		}
+		return constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION
+	}
+	return constants.LINE_INTERSECTOR_NO_INTERSECTION
+}
+
+/**
+ * This method computes the actual value of the intersection point.
+ * It is rounded to the segment envelopes if the computed value
+ * falls outside them, which can happen due to round-off error.
+ */
+func (li *LineIntersector) intersection(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate) *geom.Coordinate {
+	intPt := Intersection(p1, p2, q1, q2)
+	if intPt == nil {
+		intPt = nearestEndpoint(p1, p2, q1, q2).Clone()
+	}
+
+	/**
+	 * Due to rounding it can happen that the computed intersection is
+	 * outside the envelopes of the input segments.  Clearly this
+	 * is inconsistent.
+	 * This code checks this condition and forces a more reasonable answer
+	 */
+	if !li.isInSegmentEnvelopes(intPt) {
+		intPt = nearestEndpoint(p1, p2, q1, q2).Clone()
+	}
+	return intPt
+}
+
+/**
+ * Tests whether a point lies in the envelopes of both input segments.
+ * A correctly computed intersection point should return <code>true</code>
+ * for this test.
+ * Since this test is for debugging purposes only, no attempt is
+ * made to optimize the envelope test.
+ *
+ * @return <code>true</code> if the input point lies within both input segment envelopes
+ */
+func (li *LineIntersector) isInSegmentEnvelopes(intPt *geom.Coordinate) bool {
+	return geom.EnvelopeIntersectsPoint(&li.inputLines[0][0], &li.inputLines[0][1], intPt) &&
+		geom.EnvelopeIntersectsPoint(&li.inputLines[1][0], &li.inputLines[1][1], intPt)
+}
+
+/**
+ * Finds the endpoint of the segments P and Q which
+ * is closest to the other segment.
+ * This is a reasonable surrogate for the true
+ * intersection points in ill-conditioned cases
+ * (e.g. where two segments are nearly coincident,
+ * or where the endpoint of one segment lies almost on the other segment).
+ * <p>
+ * This replaces the older CentralEndpoint heuristic,
+ * which chose the wrong endpoint in some cases
+ * where the segments had very distinct slopes
+ * and one endpoint lay almost on the other segment.
+ *
+ * @param p1 an endpoint of segment P
+ * @param p2 an endpoint of segment P
+ * @param q1 an endpoint of segment Q
+ * @param q2 an endpoint of segment Q
+ * @return the nearest endpoint to the other segment
+ */
+func nearestEndpoint(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate) *geom.Coordinate {
+	nearestPt := p1
+	minDist := DistancePointToSegment(p1, q1, q2)
+
+	dist := DistancePointToSegment(p2, q1, q2)
+	if dist < minDist {
+		minDist = dist
+		nearestPt = p2
+	}
+	dist = DistancePointToSegment(q1, p1, p2)
+	if dist < minDist {
+		minDist = dist
+		nearestPt = q1
+	}
+	dist = DistancePointToSegment(q2, p1, p2)
+	if dist < minDist {
+		nearestPt = q2
+	}
+	return nearestPt
+}
@@ -0,0 +1,90 @@
+package geos
+
+import (
+	"math"
+	"sync"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	intervalrtree "github.com/UltimateThread/geos-go/core/index/intervalrtree"
+)
+
+/**
+ * Determines the {@link Location} of {@link Coordinate}s relative to
+ * an areal geometry, using indexing for efficiency.
+ * This algorithm is suitable for use in cases where
+ * many points will be tested against a given area.
+ * <p>
+ * The area is given as the set of its rings,
+ * which may be the shell and holes of a polygon,
+ * or all the rings of the polygons of a multipolygon.
+ * The orientation of the rings is unimportant.
+ * <p>
+ * The Location is computed precisely, in that points
+ * located on the geometry boundary or segments will
+ * return {@link Location.BOUNDARY}.
+ * <p>
+ * The index is built lazily on the first call to {@link #Locate},
+ * and locating points is thread-safe.
+ * The index refers to the coordinates of the input rings,
+ * so they must not be modified while the locator is in use.
+ */
+type IndexedPointInAreaLocator struct {
+	rings [][]geom.Coordinate
+	index *intervalrtree.SortedPackedIntervalRTree[indexedSegment]
+	once  sync.Once
+}
+
+type indexedSegment struct {
+	p0 *geom.Coordinate
+	p1 *geom.Coordinate
+}
+
+/**
+ * Creates a new locator for a given area.
+ *
+ * @param rings the rings of the area to locate points in
+ */
+func NewIndexedPointInAreaLocator(rings [][]geom.Coordinate) *IndexedPointInAreaLocator {
+	locator := new(IndexedPointInAreaLocator)
+	locator.rings = rings
+	return locator
+}
+
+/**
+ * Determines the {@link Location} of a point in the area.
+ *
+ * @param p the point to test
+ * @return the location of the point in the geometry
+ */
+func (locator *IndexedPointInAreaLocator) Locate(p *geom.Coordinate) int {
+	locator.once.Do(locator.createIndex)
+
+	rcc := algorithm.NewRayCrossingCounter(p)
+	locator.index.QueryVisitor(p.Y, p.Y, func(seg indexedSegment) bool {
+		rcc.CountSegment(seg.p0, seg.p1)
+		// the location is known once the point is found on a segment
+		return !rcc.IsOnSegment()
+	})
+	return rcc.GetLocation()
+}
+
+/**
+ * Creates an index of the ring segments, keyed by their Y-interval.
+ */
+func (locator *IndexedPointInAreaLocator) createIndex() {
+	locator.index = intervalrtree.NewSortedPackedIntervalRTree[indexedSegment]()
+	for _, ring := range locator.rings {
+		for i := 1; i < len(ring); i++ {
+			p0 := &ring[i-1]
+			p1 := &ring[i]
+			minY := math.Min(p0.Y, p1.Y)
+			maxY := math.Max(p0.Y, p1.Y)
+			locator.index.Insert(minY, maxY, indexedSegment{p0, p1})
+		}
+	}
+	// build the tree here, since building it lazily during queries is not thread-safe
+	locator.index.Build()
+	// the indexed segments still point into the rings, so only the ring list is released
+	locator.rings = nil
+}
@@ -0,0 +1,240 @@
+package geos
+
+import (
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	geosmath "github.com/UltimateThread/geos-go/core/math"
+)
+
+/**
+ * A filter for computing the orientation index of three coordinates.
+ * <p>
+ * If the orientation can be computed safely using standard DP
+ * arithmetic, this routine returns the orientation index.
+ * Otherwise, a value i &gt; 1 is returned.
+ * In this case the orientation index must
+ * be computed using some other more robust method.
+ * The filter is fast to compute, so can be used to
+ * avoid the use of slower robust methods except when they are really needed,
+ * thus providing better average performance.
+ */
+const orientation_DP_SAFE_EPSILON = 1e-15
+
+/**
+ * Returns the orientation index of the direction of the point <code>q</code> relative to
+ * a directed infinite line specified by <code>p1-p2</code>.
+ * The index indicates whether the point lies to the {@link constants#ORIENTATION_LEFT} or
+ * {@link constants#ORIENTATION_RIGHT} of the line, or lies on it {@link constants#ORIENTATION_COLLINEAR}.
+ * The index also indicates the orientation of the triangle formed by the three points
+ * ( {@link constants#ORIENTATION_COUNTERCLOCKWISE},
+ * {@link constants#ORIENTATION_CLOCKWISE}, or {@link constants#ORIENTATION_STRAIGHT} )
+ * <p>
+ * The predicate is robust: a fast floating-point filter is used first,
+ * and if its result is uncertain the index is recomputed using
+ * extended-precision arithmetic.
+ *
+ * @param p1 the origin point of the line vector
+ * @param p2 the final point of the line vector
+ * @param q the point to compute the direction to
+ *
+ * @return -1 ( {@link constants#ORIENTATION_CLOCKWISE} or {@link constants#ORIENTATION_RIGHT} ) if q is clockwise (right) from p1-p2;
+ *         1 ( {@link constants#ORIENTATION_COUNTERCLOCKWISE} or {@link constants#ORIENTATION_LEFT} ) if q is counter-clockwise (left) from p1-p2;
+ *         0 ( {@link constants#ORIENTATION_COLLINEAR} or {@link constants#ORIENTATION_STRAIGHT} ) if q is collinear with p1-p2
+ */
+func OrientationIndex(p1 *geom.Coordinate, p2 *geom.Coordinate, q *geom.Coordinate) int {
+	return OrientationIndexXY(p1.X, p1.Y, p2.X, p2.Y, q.X, q.Y)
+}
+
+/**
+ * Returns the index of the direction of the point <code>q</code> relative to
+ * a vector specified by <code>p1-p2</code>.
+ *
+ * @param p1x the x ordinate of the vector origin point
+ * @param p1y the y ordinate of the vector origin point
+ * @param p2x the x ordinate of the vector final point
+ * @param p2y the y ordinate of the vector final point
+ * @param qx the x ordinate of the query point
+ * @param qy the y ordinate of the query point
+ *
+ * @return 1 if q is counter-clockwise (left) from p1-p2
+ * @return -1 if q is clockwise (right) from p1-p2
+ * @return 0 if q is collinear with p1-p2
+ */
+func OrientationIndexXY(p1x float64, p1y float64, p2x float64, p2y float64, qx float64, qy float64) int {
+	// fast filter for orientation index
+	// avoids use of slow extended-precision arithmetic in many cases
+	index := orientationIndexFilter(p1x, p1y, p2x, p2y, qx, qy)
+	if index <= 1 {
+		return index
+	}
+
+	// normalize coordinates
+	dx1 := geosmath.DDValueOf(p2x).SelfAddFloat(-p1x)
+	dy1 := geosmath.DDValueOf(p2y).SelfAddFloat(-p1y)
+	dx2 := geosmath.DDValueOf(qx).SelfAddFloat(-p2x)
+	dy2 := geosmath.DDValueOf(qy).SelfAddFloat(-p2y)
+
+	// sign of determinant - unrolled for performance
+	return dx1.SelfMultiply(dy2).SelfSubtract(dy1.SelfMultiply(dx2)).Signum()
+}
+
+/**
+ * A filter for computing the orientation index of three coordinates.
+ * Uses an approach due to Jonathan Shewchuk, which is in the public domain.
+ *
+ * @return the orientation index if it can be computed safely
+ * @return i &gt; 1 if the orientation index cannot be computed safely
+ */
+func orientationIndexFilter(pax float64, pay float64, pbx float64, pby float64, pcx float64, pcy float64) int {
+	var detsum float64
+
+	detleft := float64((pax - pcx) * (pby - pcy))
+	detright := float64((pay - pcy) * (pbx - pcx))
+	det := detleft - detright
+
+	if detleft > 0.0 {
+		if detright <= 0.0 {
+			return signum(det)
+		} else {
+			detsum = detleft + detright
+		}
+	} else if detleft < 0.0 {
+		if detright >= 0.0 {
+			return signum(det)
+		} else {
+			detsum = -detleft - detright
+		}
+	} else {
+		return signum(det)
+	}
+
+	errbound := orientation_DP_SAFE_EPSILON * detsum
+	if (det >= errbound) || (-det >= errbound) {
+		return signum(det)
+	}
+
+	return 2
+}
+
+func signum(x float64) int {
+	if x > 0 {
+		return 1
+	}
+	if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+/**
+ * Tests if a ring defined by an array of {@link Coordinate}s is
+ * oriented counter-clockwise.
+ * <ul>
+ * <li>The list of points is assumed to have the first and last points equal.
+ * <li>This handles coordinate lists which contain repeated points.
+ * <li>This handles rings which contain collapsed segments
+ *     (in particular, along the top of the ring).
+ * </ul>
+ * This algorithm is guaranteed to work with valid rings.
+ * It also works with "mildly invalid" rings
+ * which contain collapsed (coincident) flat segments along the top of the ring.
+ * If the ring is "more" invalid (e.g. self-crosses or touches),
+ * the computed result may not be correct.
+ *
+ * @param ring an array of Coordinates forming a ring (with first and last point identical)
+ * @return true if the ring is oriented counter-clockwise.
+ */
+func OrientationIsCCW(ring []geom.Coordinate) bool {
+	// number of points without closing endpoint
+	nPts := len(ring) - 1
+	// return default value if ring is flat
+	if nPts < 3 {
+		return false
+	}
+
+	/**
+	 * Find first highest point after a lower point, if one exists
+	 * (e.g. a rising segment)
+	 * If one does not exist, hiIndex will remain 0
+	 * and the ring must be flat.
+	 * Note this relies on the convention that
+	 * rings have the same start and end point.
+	 */
+	upHiPt := ring[0]
+	prevY := upHiPt.Y
+	var upLowPt geom.Coordinate
+	iUpHi := 0
+	for i := 1; i <= nPts; i++ {
+		py := ring[i].Y
+		/**
+		 * If segment is upwards and endpoint is higher, record it
+		 */
+		if py > prevY && py >= upHiPt.Y {
+			upHiPt = ring[i]
+			iUpHi = i
+			upLowPt = ring[i-1]
+		}
+		prevY = py
+	}
+	/**
+	 * Check if ring is flat and return default value if so
+	 */
+	if iUpHi == 0 {
+		return false
+	}
+
+	/**
+	 * Find the next lower point after the high point
+	 * (e.g. a falling segment).
+	 * This must exist since ring is not flat.
+	 */
+	iDownLow := iUpHi
+	for {
+		iDownLow = (iDownLow + 1) % nPts
+		if !(iDownLow != iUpHi && ring[iDownLow].Y == upHiPt.Y) {
+			break
+		}
+	}
+
+	downLowPt := ring[iDownLow]
+	iDownHi := nPts - 1
+	if iDownLow > 0 {
+		iDownHi = iDownLow - 1
+	}
+	downHiPt := ring[iDownHi]
+
+	/**
+	 * Two cases can occur:
+	 * 1) the hiPt and the downPrevPt are the same.
+	 *    This is the general position case of a "pointed cap".
+	 *    The ring orientation is determined by the orientation of the cap
+	 * 2) The hiPt and the downPrevPt are different.
+	 *    In this case the top of the cap is flat.
+	 *    The ring orientation is given by the direction of the flat segment
+	 */
+	if upHiPt.Equals2D(&downHiPt) {
+		/**
+		 * Check for the case where the cap has configuration A-B-A.
+		 * This can happen if the ring does not contain 3 distinct points
+		 * (including the case where the input array has fewer than 4 elements), or
+		 * it contains coincident line segments.
+		 */
+		if upLowPt.Equals2D(&upHiPt) || downLowPt.Equals2D(&upHiPt) || upLowPt.Equals2D(&downLowPt) {
+			return false
+		}
+
+		/**
+		 * It can happen that the top segments are coincident.
+		 * This is an invalid ring, which cannot be computed correctly.
+		 * In this case the orientation is 0, and the result is false.
+		 */
+		index := OrientationIndex(&upLowPt, &upHiPt, &downLowPt)
+		return index == constants.ORIENTATION_COUNTERCLOCKWISE
+	} else {
+		/**
+		 * Flat cap - direction of flat top determines orientation
+		 */
+		delX := downHiPt.X - upHiPt.X
+		return delX < 0
+	}
+}
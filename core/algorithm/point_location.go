@@ -0,0 +1,84 @@
+package geos
+
+import (
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Tests whether a point lies on a line segment.
+ *
+ * @param p the point to test
+ * @param p0 a point of the line segment
+ * @param p1 a point of the line segment
+ * @return true if the point lies on the line segment
+ */
+func PointLocationIsOnSegment(p *geom.Coordinate, p0 *geom.Coordinate, p1 *geom.Coordinate) bool {
+	//-- test envelope first since it's faster
+	if !geom.EnvelopeIntersectsPoint(p0, p1, p) {
+		return false
+	}
+	//-- handle zero-length segments
+	if p.Equals2D(p0) {
+		return true
+	}
+	isOnLine := constants.ORIENTATION_COLLINEAR == OrientationIndex(p0, p1, p)
+	return isOnLine
+}
+
+/**
+ * Tests whether a point lies on the line defined by a list of
+ * coordinates.
+ *
+ * @param p the point to test
+ * @param line the line coordinates
+ * @return true if the point is a vertex of the line or lies in the interior
+ *         of a line segment in the line
+ */
+func PointLocationIsOnLine(p *geom.Coordinate, line []geom.Coordinate) bool {
+	for i := 1; i < len(line); i++ {
+		if PointLocationIsOnSegment(p, &line[i-1], &line[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether a point lies inside or on a ring. The ring may be oriented in
+ * either direction. A point lying exactly on the ring boundary is considered
+ * to be inside the ring.
+ * <p>
+ * This method does <i>not</i> first check the point against the envelope of
+ * the ring.
+ *
+ * @param p
+ *          point to check for ring inclusion
+ * @param ring
+ *          an array of coordinates representing the ring (which must have
+ *          first point identical to last point)
+ * @return true if p is inside ring
+ *
+ * @see LocatePointInRing
+ */
+func PointLocationIsInRing(p *geom.Coordinate, ring []geom.Coordinate) bool {
+	return PointLocationLocateInRing(p, ring) != constants.LOCATION_EXTERIOR
+}
+
+/**
+ * Determines whether a point lies in the interior, on the boundary, or in the
+ * exterior of a ring. The ring may be oriented in either direction.
+ * <p>
+ * This method does <i>not</i> first check the point against the envelope of
+ * the ring.
+ *
+ * @param p
+ *          point to check for ring inclusion
+ * @param ring
+ *          an array of coordinates representing the ring (which must have
+ *          first point identical to last point)
+ * @return the {@link Location} of p relative to the ring
+ */
+func PointLocationLocateInRing(p *geom.Coordinate, ring []geom.Coordinate) int {
+	return LocatePointInRing(p, ring)
+}
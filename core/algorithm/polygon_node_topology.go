@@ -0,0 +1,160 @@
+package geos
+
+import (
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Checks if two edges of a node cross, given the edges as pairs of
+ * edge endpoints incident at the node.
+ * The edges are given as the edge pairs of two rings which touch at the node:
+ * edge pair a is (a0, nodePt, a1) and edge pair b is (b0, nodePt, b1).
+ * The edges cross if the endpoints of b lie on different sides
+ * of the two edges of a.
+ * If any edges are collinear the result is false.
+ *
+ * @param nodePt the node vertex
+ * @param a0 the previous segment endpoint in a ring
+ * @param a1 the next segment endpoint in a ring
+ * @param b0 the previous segment endpoint in the other ring
+ * @param b1 the next segment endpoint in the other ring
+ * @return true if the rings cross at the node
+ */
+func PolygonNodeTopologyIsCrossing(nodePt *geom.Coordinate, a0 *geom.Coordinate, a1 *geom.Coordinate, b0 *geom.Coordinate, b1 *geom.Coordinate) bool {
+	aLo := a0
+	aHi := a1
+	if polygonNodeIsAngleGreater(nodePt, aLo, aHi) {
+		aLo = a1
+		aHi = a0
+	}
+
+	/**
+	 * Find positions of b0 and b1.
+	 * The edges cross if the positions are different.
+	 * If any edge is collinear they are reported as not crossing
+	 */
+	compBetween0 := polygonNodeCompareBetween(nodePt, b0, aLo, aHi)
+	if compBetween0 == 0 {
+		return false
+	}
+	compBetween1 := polygonNodeCompareBetween(nodePt, b1, aLo, aHi)
+	if compBetween1 == 0 {
+		return false
+	}
+	return compBetween0 != compBetween1
+}
+
+/**
+ * Tests whether a segment node-b lies in the interior or exterior
+ * of a corner of a ring formed by the two segments a0-node-a1.
+ * The ring interior is assumed to be on the right of the corner
+ * (i.e. a CW shell or CCW hole).
+ * The test segment must not be collinear with the corner segments.
+ *
+ * @param nodePt the node vertex
+ * @param a0 the first vertex of the corner
+ * @param a1 the second vertex of the corner
+ * @param b the other vertex of the test segment
+ * @return true if the segment is interior to the ring corner
+ */
+func PolygonNodeTopologyIsInteriorSegment(nodePt *geom.Coordinate, a0 *geom.Coordinate, a1 *geom.Coordinate, b *geom.Coordinate) bool {
+	aLo := a0
+	aHi := a1
+	isInteriorBetween := true
+	if polygonNodeIsAngleGreater(nodePt, aLo, aHi) {
+		aLo = a1
+		aHi = a0
+		isInteriorBetween = false
+	}
+	isBetween := polygonNodeIsBetween(nodePt, b, aLo, aHi)
+	isInterior := (isBetween && isInteriorBetween) || (!isBetween && !isInteriorBetween)
+	return isInterior
+}
+
+/**
+ * Tests if an edge p is between edges e0 and e1,
+ * where the edges all originate at a common origin.
+ * The "inside" of e0 and e1 is the arc which does not include the origin.
+ * The edges are assumed to be distinct (non-collinear).
+ */
+func polygonNodeIsBetween(origin *geom.Coordinate, p *geom.Coordinate, e0 *geom.Coordinate, e1 *geom.Coordinate) bool {
+	isGreater0 := polygonNodeIsAngleGreater(origin, p, e0)
+	if !isGreater0 {
+		return false
+	}
+	isGreater1 := polygonNodeIsAngleGreater(origin, p, e1)
+	return !isGreater1
+}
+
+/**
+ * Compares whether an edge p is between or outside the edges e0 and e1,
+ * where the edges all originate at a common origin.
+ * The "inside" of e0 and e1 is the arc which does not include
+ * the positive X-axis at the origin.
+ * If p is collinear with an edge 0 is returned.
+ *
+ * @return a negative integer, zero or positive integer as the vector P lies outside, collinear with, or inside the vectors E0 and E1
+ */
+func polygonNodeCompareBetween(origin *geom.Coordinate, p *geom.Coordinate, e0 *geom.Coordinate, e1 *geom.Coordinate) int {
+	comp0 := PolygonNodeTopologyCompareAngle(origin, p, e0)
+	if comp0 == 0 {
+		return 0
+	}
+	comp1 := PolygonNodeTopologyCompareAngle(origin, p, e1)
+	if comp1 == 0 {
+		return 0
+	}
+	if comp0 > 0 && comp1 < 0 {
+		return 1
+	}
+	return -1
+}
+
+/**
+ * Tests if the angle with the origin of a vector P is greater than that of the
+ * vector Q.
+ *
+ * @return true if vector P has angle greater than Q
+ */
+func polygonNodeIsAngleGreater(origin *geom.Coordinate, p *geom.Coordinate, q *geom.Coordinate) bool {
+	return PolygonNodeTopologyCompareAngle(origin, p, q) > 0
+}
+
+/**
+ * Compares the angles of two vectors
+ * relative to the positive X-axis at their origin.
+ * Angles increase CCW from the X-axis.
+ *
+ * @param origin the origin of the vectors
+ * @param p the endpoint of the vector P
+ * @param q the endpoint of the vector Q
+ * @return a negative integer, zero, or a positive integer as this vector P has angle less than, equal to, or greater than vector Q
+ */
+func PolygonNodeTopologyCompareAngle(origin *geom.Coordinate, p *geom.Coordinate, q *geom.Coordinate) int {
+	quadrantP := polygonNodeQuadrant(origin, p)
+	quadrantQ := polygonNodeQuadrant(origin, q)
+	if quadrantP > quadrantQ {
+		return 1
+	}
+	if quadrantP < quadrantQ {
+		return -1
+	}
+	//--- vectors are in the same quadrant
+	// Check relative orientation of vectors
+	// P > Q if it is CCW of Q
+	orient := OrientationIndex(origin, q, p)
+	switch orient {
+	case constants.ORIENTATION_COUNTERCLOCKWISE:
+		return 1
+	case constants.ORIENTATION_CLOCKWISE:
+		return -1
+	}
+	return 0
+}
+
+func polygonNodeQuadrant(origin *geom.Coordinate, p *geom.Coordinate) int {
+	// the vectors of a node are never zero-length, so the quadrant is always defined
+	quad, _ := geom.QuadrantOfDisplacement(p.X-origin.X, p.Y-origin.Y)
+	return quad
+}
@@ -0,0 +1,192 @@
+package geos
+
+import (
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Counts the number of segments crossed by a horizontal ray extending to the right
+ * from a given point, in an incremental fashion.
+ * This can be used to determine whether a point lies in a polygonal geometry.
+ * The class determines the situation where the point lies exactly on a segment.
+ * When being used for Point-In-Polygon determination, this case allows short-circuiting
+ * the evaluation.
+ * <p>
+ * This class handles polygonal geometries with any number of shells and holes.
+ * The orientation of the shell and hole rings is unimportant.
+ * In order to compute a correct location for a given polygonal geometry,
+ * it is essential that <b>all</b> segments are counted which
+ * <ul>
+ * <li>touch the ray
+ * <li>lie in in any ring which may contain the point
+ * </ul>
+ * The only exception is when the point-on-segment situation is detected, in which
+ * case no further processing is required.
+ * The implication of the above rule is that segments
+ * which can be a priori determined to <i>not</i> touch the ray
+ * (i.e. by a test of their bounding box or Y-extent)
+ * do not need to be counted.  This allows for optimization by indexing.
+ * <p>
+ * This implementation uses the extended-precision orientation test,
+ * to provide maximum robustness and consistency within
+ * other algorithms.
+ */
+type RayCrossingCounter struct {
+	p             geom.Coordinate
+	crossingCount int
+	// true if the test point lies on an input segment
+	isPointOnSegment bool
+}
+
+/**
+ * Determines the {@link Location} of a point in a ring.
+ * This method is an exemplar of how to use this class.
+ *
+ * @param p the point to test
+ * @param ring an array of Coordinates forming a ring
+ * @return the location of the point in the ring
+ */
+func LocatePointInRing(p *geom.Coordinate, ring []geom.Coordinate) int {
+	counter := NewRayCrossingCounter(p)
+
+	for i := 1; i < len(ring); i++ {
+		counter.CountSegment(&ring[i], &ring[i-1])
+		if counter.IsOnSegment() {
+			return counter.GetLocation()
+		}
+	}
+	return counter.GetLocation()
+}
+
+func NewRayCrossingCounter(p *geom.Coordinate) *RayCrossingCounter {
+	rcc := new(RayCrossingCounter)
+	rcc.p = *p
+	return rcc
+}
+
+/**
+ * Counts a segment
+ *
+ * @param p1 an endpoint of the segment
+ * @param p2 another endpoint of the segment
+ */
+func (rcc *RayCrossingCounter) CountSegment(p1 *geom.Coordinate, p2 *geom.Coordinate) {
+	p := &rcc.p
+	/**
+	 * For each segment, check if it crosses
+	 * a horizontal ray running from the test point in the positive x direction.
+	 */
+
+	// check if the segment is strictly to the left of the test point
+	if p1.X < p.X && p2.X < p.X {
+		return
+	}
+
+	// check if the point is equal to the current ring vertex
+	if p.X == p2.X && p.Y == p2.Y {
+		rcc.isPointOnSegment = true
+		return
+	}
+	/**
+	 * For horizontal segments, check if the point is on the segment.
+	 * Otherwise, horizontal segments are not counted.
+	 */
+	if p1.Y == p.Y && p2.Y == p.Y {
+		minx := p1.X
+		maxx := p2.X
+		if minx > maxx {
+			minx = p2.X
+			maxx = p1.X
+		}
+		if p.X >= minx && p.X <= maxx {
+			rcc.isPointOnSegment = true
+		}
+		return
+	}
+	/**
+	 * Evaluate all non-horizontal segments which cross a horizontal ray to the
+	 * right of the test pt. To avoid double-counting shared vertices, we use the
+	 * convention that
+	 * <ul>
+	 * <li>an upward edge includes its starting endpoint, and excludes its
+	 * final endpoint
+	 * <li>a downward edge excludes its starting endpoint, and includes its
+	 * final endpoint
+	 * </ul>
+	 */
+	if ((p1.Y > p.Y) && (p2.Y <= p.Y)) || ((p2.Y > p.Y) && (p1.Y <= p.Y)) {
+		orient := OrientationIndex(p1, p2, p)
+		if orient == constants.ORIENTATION_COLLINEAR {
+			rcc.isPointOnSegment = true
+			return
+		}
+		// Re-orient the result if needed to ensure effective segment direction is upwards
+		if p2.Y < p1.Y {
+			orient = -orient
+		}
+		// The upward segment crosses the ray if the test point lies to the left (CCW) of the segment.
+		if orient == constants.ORIENTATION_LEFT {
+			rcc.crossingCount++
+		}
+	}
+}
+
+/**
+ * Gets the count of crossings.
+ *
+ * @return the crossing count
+ */
+func (rcc *RayCrossingCounter) GetCount() int {
+	return rcc.crossingCount
+}
+
+/**
+ * Reports whether the point lies exactly on one of the supplied segments.
+ * This method may be called at any time as segments are processed.
+ * If the result of this method is <tt>true</tt>,
+ * no further segments need be supplied, since the result
+ * will never change again.
+ *
+ * @return true if the point lies exactly on a segment
+ */
+func (rcc *RayCrossingCounter) IsOnSegment() bool {
+	return rcc.isPointOnSegment
+}
+
+/**
+ * Gets the {@link Location} of the point relative to
+ * the ring, polygon
+ * or multipolygon from which the processed segments were provided.
+ * <p>
+ * This method only determines the correct location
+ * if <b>all</b> relevant segments must have been processed.
+ *
+ * @return the Location of the point
+ */
+func (rcc *RayCrossingCounter) GetLocation() int {
+	if rcc.isPointOnSegment {
+		return constants.LOCATION_BOUNDARY
+	}
+
+	// The point is in the interior of the ring if the number of X-crossings is
+	// odd.
+	if (rcc.crossingCount % 2) == 1 {
+		return constants.LOCATION_INTERIOR
+	}
+	return constants.LOCATION_EXTERIOR
+}
+
+/**
+ * Tests whether the point lies in or on
+ * the ring, polygon
+ * or multipolygon from which the processed segments were provided.
+ * <p>
+ * This method only determines the correct location
+ * if <b>all</b> relevant segments must have been processed.
+ *
+ * @return true if the point lies in or on the supplied polygon
+ */
+func (rcc *RayCrossingCounter) IsPointInPolygon() bool {
+	return rcc.GetLocation() != constants.LOCATION_EXTERIOR
+}
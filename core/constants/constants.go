@@ -1,5 +1,7 @@
 package geos
 
+import "math"
+
 const (
 	/** Standard ordinate index value for, where X is 0 */
 	COORDINATE_X = 0
@@ -25,3 +27,289 @@ const (
 	 */
 	COORDINATE_M = 3
 )
+
+const (
+	/**
+	 * A value that indicates an orientation of clockwise, or a right turn.
+	 */
+	ORIENTATION_CLOCKWISE = -1
+
+	/**
+	 * A value that indicates an orientation of clockwise, or a right turn.
+	 */
+	ORIENTATION_RIGHT = ORIENTATION_CLOCKWISE
+
+	/**
+	 * A value that indicates an orientation of counterclockwise, or a left turn.
+	 */
+	ORIENTATION_COUNTERCLOCKWISE = 1
+
+	/**
+	 * A value that indicates an orientation of counterclockwise, or a left turn.
+	 */
+	ORIENTATION_LEFT = ORIENTATION_COUNTERCLOCKWISE
+
+	/**
+	 * A value that indicates an orientation of collinear, or no turn (straight).
+	 */
+	ORIENTATION_COLLINEAR = 0
+
+	/**
+	 * A value that indicates an orientation of collinear, or no turn (straight).
+	 */
+	ORIENTATION_STRAIGHT = ORIENTATION_COLLINEAR
+)
+
+const (
+	/**
+	 * Indicates that line segments do not intersect
+	 */
+	LINE_INTERSECTOR_NO_INTERSECTION = 0
+
+	/**
+	 * Indicates that line segments intersect in a single point
+	 */
+	LINE_INTERSECTOR_POINT_INTERSECTION = 1
+
+	/**
+	 * Indicates that line segments intersect in a line segment
+	 */
+	LINE_INTERSECTOR_COLLINEAR_INTERSECTION = 2
+)
+
+const (
+	/**
+	 * Specifies a round line buffer end cap style.
+	 */
+	BUFFER_CAP_ROUND = 1
+
+	/**
+	 * Specifies a flat line buffer end cap style.
+	 */
+	BUFFER_CAP_FLAT = 2
+
+	/**
+	 * Specifies a square line buffer end cap style.
+	 */
+	BUFFER_CAP_SQUARE = 3
+
+	/**
+	 * Specifies a round join style.
+	 */
+	BUFFER_JOIN_ROUND = 1
+
+	/**
+	 * Specifies a mitre join style.
+	 */
+	BUFFER_JOIN_MITRE = 2
+
+	/**
+	 * Specifies a bevel join style.
+	 */
+	BUFFER_JOIN_BEVEL = 3
+
+	/**
+	 * The default number of facets into which to divide a fillet of 90 degrees.
+	 * A value of 8 gives less than 2% max error in the buffer distance.
+	 * For a max error of &lt; 1%, use QS = 12.
+	 * For a max error of &lt; 0.1%, use QS = 18.
+	 */
+	BUFFER_DEFAULT_QUADRANT_SEGMENTS = 8
+
+	/**
+	 * The default mitre limit
+	 * Allows fairly pointy mitres.
+	 */
+	BUFFER_DEFAULT_MITRE_LIMIT = 5.0
+
+	/**
+	 * The default simplify factor
+	 * Provides an accuracy of about 1%, which matches the accuracy of the default Quadrant Segments parameter.
+	 */
+	BUFFER_DEFAULT_SIMPLIFY_FACTOR = 0.01
+)
+
+const (
+	/** Specifies that a location is <i>on</i> a component */
+	POSITION_ON = 0
+
+	/** Specifies that a location is to the <i>left</i> of a component */
+	POSITION_LEFT = 1
+
+	/** Specifies that a location is to the <i>right</i> of a component */
+	POSITION_RIGHT = 2
+)
+
+const (
+	/**
+	 * The value of 2*Pi
+	 */
+	ANGLE_PI_TIMES_2 = 2.0 * math.Pi
+
+	/**
+	 * The value of Pi/2
+	 */
+	ANGLE_PI_OVER_2 = math.Pi / 2.0
+
+	/**
+	 * The value of Pi/4
+	 */
+	ANGLE_PI_OVER_4 = math.Pi / 4.0
+)
+
+const (
+	/**
+	 * The location value for the interior of a geometry.
+	 * Also, DE-9IM row index of the interior of the first geometry and column index of
+	 *  the interior of the second geometry.
+	 */
+	LOCATION_INTERIOR = 0
+
+	/**
+	 * The location value for the boundary of a geometry.
+	 * Also, DE-9IM row index of the boundary of the first geometry and column index of
+	 *  the boundary of the second geometry.
+	 */
+	LOCATION_BOUNDARY = 1
+
+	/**
+	 * The location value for the exterior of a geometry.
+	 * Also, DE-9IM row index of the exterior of the first geometry and column index of
+	 *  the exterior of the second geometry.
+	 */
+	LOCATION_EXTERIOR = 2
+
+	/**
+	 *  Used for uninitialized location values.
+	 */
+	LOCATION_NONE = -1
+)
+
+const (
+	/**
+	 * The default number of child boundables per node of an STRtree.
+	 */
+	STRTREE_DEFAULT_NODE_CAPACITY = 10
+)
+
+const (
+	/**
+	 * This value is chosen to be a few powers of 2 less than the
+	 * number of bits available in the double representation (i.e. 53).
+	 * This should allow enough extra precision for simple computations to be correct,
+	 * at least for comparison purposes.
+	 */
+	INTERVALSIZE_MIN_BINARY_EXPONENT = -50
+)
+
+const (
+	/**
+	 * The default number of child nodes or items per node of an HPRtree.
+	 */
+	HPRTREE_DEFAULT_NODE_CAPACITY = 16
+
+	/**
+	 * The level of the Hilbert curve used to sort the items of an HPRtree.
+	 */
+	HPRTREE_HILBERT_LEVEL = 12
+)
+
+const (
+	/**
+	 * The maximum curve level that can be represented.
+	 */
+	HILBERTCODE_MAX_LEVEL = 16
+)
+
+const (
+	/**
+	 * The quadrant of vectors with non-negative X and Y components.
+	 */
+	QUADRANT_NE = 0
+
+	/**
+	 * The quadrant of vectors with negative X and non-negative Y components.
+	 */
+	QUADRANT_NW = 1
+
+	/**
+	 * The quadrant of vectors with negative X and Y components.
+	 */
+	QUADRANT_SW = 2
+
+	/**
+	 * The quadrant of vectors with non-negative X and negative Y components.
+	 */
+	QUADRANT_SE = 3
+)
+
+const (
+	/**
+	 * Not used
+	 * @deprecated
+	 */
+	TOPOLOGYVALIDATIONERROR_ERROR = 0
+
+	/**
+	 * No longer used - repeated points are considered valid as per the SFS
+	 * @deprecated
+	 */
+	TOPOLOGYVALIDATIONERROR_REPEATED_POINT = 1
+
+	/**
+	 * Indicates that a hole of a polygon lies partially or completely in the exterior of the shell
+	 */
+	TOPOLOGYVALIDATIONERROR_HOLE_OUTSIDE_SHELL = 2
+
+	/**
+	 * Indicates that a hole lies in the interior of another hole in the same polygon
+	 */
+	TOPOLOGYVALIDATIONERROR_NESTED_HOLES = 3
+
+	/**
+	 * Indicates that the interior of a polygon is disjoint
+	 * (often caused by set of contiguous holes splitting the polygon into two parts)
+	 */
+	TOPOLOGYVALIDATIONERROR_DISCONNECTED_INTERIOR = 4
+
+	/**
+	 * Indicates that two rings of a polygonal geometry intersect
+	 */
+	TOPOLOGYVALIDATIONERROR_SELF_INTERSECTION = 5
+
+	/**
+	 * Indicates that a ring self-intersects
+	 */
+	TOPOLOGYVALIDATIONERROR_RING_SELF_INTERSECTION = 6
+
+	/**
+	 * Indicates that a polygon component of a MultiPolygon lies inside another polygonal component
+	 */
+	TOPOLOGYVALIDATIONERROR_NESTED_SHELLS = 7
+
+	/**
+	 * Indicates that a polygonal geometry contains two rings which are identical
+	 */
+	TOPOLOGYVALIDATIONERROR_DUPLICATE_RINGS = 8
+
+	/**
+	 * Indicates that either
+	 * <ul>
+	 * <li>a LineString contains a single point
+	 * <li>a LinearRing contains 2 or 3 points
+	 * </ul>
+	 */
+	TOPOLOGYVALIDATIONERROR_TOO_FEW_POINTS = 9
+
+	/**
+	 * Indicates that the <code>X</code> or <code>Y</code> ordinate of
+	 * a Coordinate is not a valid numeric value (e.g. {@link Double#NaN} )
+	 */
+	TOPOLOGYVALIDATIONERROR_INVALID_COORDINATE = 10
+
+	/**
+	 * Indicates that a ring is not correctly closed
+	 * (the first and the last coordinate are different)
+	 */
+	TOPOLOGYVALIDATIONERROR_RING_NOT_CLOSED = 11
+)
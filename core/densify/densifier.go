@@ -0,0 +1,163 @@
+package geos
+
+import (
+	"errors"
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Densifies a line or ring by inserting extra vertices along the line segments
+ * it contains.
+ * All segments in the created line will be no longer than the given distance tolerance.
+ * The coordinates created during densification respect the Z and M values
+ * of the segment endpoints: they are linearly interpolated if both
+ * endpoints have a value, and are NaN otherwise.
+ * <p>
+ * Since polygon rings are densified independently of each other,
+ * densifying a polygon does not change its topology.
+ */
+type Densifier struct {
+	inputPts          []geom.Coordinate
+	distanceTolerance float64
+}
+
+/**
+ * Densifies a line or ring using a given distance tolerance.
+ *
+ * @param pts the points to densify
+ * @param distanceTolerance the distance tolerance to densify
+ * @return the densified points
+ * @return an error if the tolerance is not positive
+ */
+func Densify(pts []geom.Coordinate, distanceTolerance float64) ([]geom.Coordinate, error) {
+	densifier := NewDensifier(pts)
+	if err := densifier.SetDistanceTolerance(distanceTolerance); err != nil {
+		return nil, err
+	}
+	return densifier.GetResult(), nil
+}
+
+/**
+ * Densifies a line or ring by splitting every segment into
+ * equal-length subsegments, each of which is the given fraction
+ * of the length of the segment.
+ * The number of subsegments is <code>1 / fraction</code>, rounded to the nearest integer.
+ *
+ * @param pts the points to densify
+ * @param fraction the fraction of the segment length for each subsegment, in (0, 1]
+ * @return the densified points
+ * @return an error if the fraction is not in the range (0, 1]
+ */
+func DensifyFraction(pts []geom.Coordinate, fraction float64) ([]geom.Coordinate, error) {
+	if fraction <= 0.0 || fraction > 1.0 || math.IsNaN(fraction) {
+		return nil, errors.New("fraction is not in range (0.0 - 1.0]")
+	}
+	numSubSegs := int(math.RoundToEven(1.0 / fraction))
+
+	coordList := geom.DefaultCoordinateList()
+	for i := 0; i < len(pts)-1; i++ {
+		p0 := &pts[i]
+		p1 := &pts[i+1]
+		coordList.AddCoordinateRepeated(p0.Clone(), false)
+		for j := 1; j < numSubSegs; j++ {
+			segFract := float64(j) / float64(numSubSegs)
+			coordList.AddCoordinateRepeated(interpolate(p0, p1, segFract), false)
+		}
+	}
+	// this check handles empty sequences
+	if len(pts) > 0 {
+		coordList.AddCoordinateRepeated(pts[len(pts)-1].Clone(), false)
+	}
+	return coordList.ToCoordinateArray(), nil
+}
+
+/**
+ * Densifies a list of coordinates.
+ *
+ * @param pts the coordinate list
+ * @param distanceTolerance the densify tolerance
+ * @return the densified coordinate sequence
+ */
+func densifyPoints(pts []geom.Coordinate, distanceTolerance float64) []geom.Coordinate {
+	coordList := geom.DefaultCoordinateList()
+	for i := 0; i < len(pts)-1; i++ {
+		p0 := &pts[i]
+		p1 := &pts[i+1]
+		coordList.AddCoordinateRepeated(p0.Clone(), false)
+		segLen := p0.Distance(p1)
+
+		// check if no densification is required
+		if segLen <= distanceTolerance || distanceTolerance <= 0 {
+			continue
+		}
+
+		// densify the segment
+		densifiedSegCount := int(math.Ceil(segLen / distanceTolerance))
+		densifiedSegLen := segLen / float64(densifiedSegCount)
+		for j := 1; j < densifiedSegCount; j++ {
+			segFract := (float64(j) * densifiedSegLen) / segLen
+			coordList.AddCoordinateRepeated(interpolate(p0, p1, segFract), false)
+		}
+	}
+	// this check handles empty sequences
+	if len(pts) > 0 {
+		coordList.AddCoordinateRepeated(pts[len(pts)-1].Clone(), false)
+	}
+	return coordList.ToCoordinateArray()
+}
+
+/**
+ * Computes the point at a fraction of the way along a segment,
+ * interpolating the Z and M values if they are present at both endpoints.
+ */
+func interpolate(p0 *geom.Coordinate, p1 *geom.Coordinate, segFract float64) *geom.Coordinate {
+	p := geom.NewCoordinateXY(
+		p0.X+segFract*(p1.X-p0.X),
+		p0.Y+segFract*(p1.Y-p0.Y))
+	if !math.IsNaN(p0.Z) && !math.IsNaN(p1.Z) {
+		p.Z = p0.Z + segFract*(p1.Z-p0.Z)
+	}
+	if !math.IsNaN(p0.M) && !math.IsNaN(p1.M) {
+		p.M = p0.M + segFract*(p1.M-p0.M)
+	}
+	return p
+}
+
+/**
+ * Creates a new densifier instance.
+ *
+ * @param inputPts the points to densify
+ */
+func NewDensifier(inputPts []geom.Coordinate) *Densifier {
+	densifier := new(Densifier)
+	densifier.inputPts = inputPts
+	return densifier
+}
+
+/**
+ * Sets the distance tolerance for the densification. All line segments
+ * in the densified geometry will be no longer than the distance tolerance.
+ * The distance tolerance must be positive.
+ * If no tolerance is set the points are not densified.
+ *
+ * @param distanceTolerance the densification tolerance to use
+ * @return an error if the tolerance is not positive
+ */
+func (densifier *Densifier) SetDistanceTolerance(distanceTolerance float64) error {
+	if !(distanceTolerance > 0.0) {
+		return errors.New("tolerance must be positive")
+	}
+	densifier.distanceTolerance = distanceTolerance
+	return nil
+}
+
+/**
+ * Gets the densified points.
+ *
+ * @return the densified points
+ */
+func (densifier *Densifier) GetResult() []geom.Coordinate {
+	return densifyPoints(densifier.inputPts, densifier.distanceTolerance)
+}
@@ -216,10 +216,10 @@ func (coord *Coordinate) SetOrdinate(ordinateIndex int, value float64) {
  * @see Double#isFinite(double)
  */
 func (coord *Coordinate) IsValid() bool {
-	if math.IsInf(coord.X, 0) {
+	if math.IsInf(coord.X, 0) || math.IsNaN(coord.X) {
 		return false
 	}
-	if math.IsInf(coord.Y, 0) {
+	if math.IsInf(coord.Y, 0) || math.IsNaN(coord.Y) {
 		return false
 	}
 	return true
@@ -0,0 +1,113 @@
+package geos
+
+/**
+ * Tests whether an array of {@link Coordinate}s forms a ring,
+ * by checking length and closure.
+ * Self-intersection is not checked.
+ *
+ * @param pts an array of Coordinates
+ * @return true if the coordinate form a ring.
+ */
+func IsRing(pts []Coordinate) bool {
+	if len(pts) < 4 {
+		return false
+	}
+	if !pts[0].Equals2D(&pts[len(pts)-1]) {
+		return false
+	}
+	return true
+}
+
+/**
+ * Tests whether an array of {@link Coordinate}s contains
+ * consecutive repeated points.
+ *
+ * @param coord an array of coordinates
+ * @return true if coord has repeated points
+ */
+func HasRepeatedPoints(coord []Coordinate) bool {
+	for i := 1; i < len(coord); i++ {
+		if coord[i-1].Equals2D(&coord[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * If the coordinate array argument has repeated points,
+ * constructs a new array containing no repeated points.
+ * Otherwise, returns the argument.
+ *
+ * @param coord an array of coordinates
+ * @return the array with repeated coordinates removed
+ * @see #HasRepeatedPoints(Coordinate[])
+ */
+func RemoveRepeatedPoints(coord []Coordinate) []Coordinate {
+	if !HasRepeatedPoints(coord) {
+		return coord
+	}
+	coordList := NewCoordinateListWithRepeated(coord, false)
+	return coordList.ToCoordinateArray()
+}
+
+/**
+ * Tests whether an array of {@link Coordinate}s contains
+ * consecutive repeated points or invalid coordinates.
+ *
+ * @param coord an array of coordinates
+ * @return true if coord has repeated or invalid points
+ * @see Coordinate#IsValid()
+ */
+func HasRepeatedOrInvalidPoints(coord []Coordinate) bool {
+	for i := 0; i < len(coord); i++ {
+		if !coord[i].IsValid() {
+			return true
+		}
+		if i > 0 && coord[i-1].Equals2D(&coord[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * If the coordinate array argument has repeated or invalid points,
+ * constructs a new array containing no repeated points.
+ * Otherwise, returns the argument.
+ *
+ * @param coord an array of coordinates
+ * @return the array with repeated and invalid coordinates removed
+ * @see #HasRepeatedOrInvalidPoints(Coordinate[])
+ * @see Coordinate#IsValid()
+ */
+func RemoveRepeatedOrInvalidPoints(coord []Coordinate) []Coordinate {
+	if !HasRepeatedOrInvalidPoints(coord) {
+		return coord
+	}
+	coordList := DefaultCoordinateList()
+	for i := range coord {
+		if !coord[i].IsValid() {
+			continue
+		}
+		coordList.AddCoordinateRepeated(&coord[i], false)
+	}
+	return coordList.ToCoordinateArray()
+}
+
+/**
+ * Reverses the coordinates in an array in-place.
+ */
+func ReverseCoordinates(coord []Coordinate) {
+	if len(coord) <= 1 {
+		return
+	}
+
+	last := len(coord) - 1
+	mid := last / 2
+	for i := 0; i <= mid; i++ {
+		tmp := coord[i]
+		coord[i] = coord[last-i]
+		coord[last-i] = tmp
+	}
+}
@@ -0,0 +1,774 @@
+package geos
+
+import (
+	"fmt"
+	"math"
+)
+
+/**
+ *  Defines a rectangular region of the 2D coordinate plane.
+ *  It is often used to represent the bounding box of a geometry,
+ *  e.g. the minimum and maximum x and y values of the coordinates.
+ *  <p>
+ *  Envelopes support infinite or half-infinite regions, by using the values of
+ *  <code>+Inf</code> and <code>-Inf</code>.
+ *  Envelope objects may have a null value.
+ *  <p>
+ *  When Envelope objects are created or initialized,
+ *  the supplies extent values are automatically sorted into the correct order.
+ */
+type Envelope struct {
+	/**
+	 *  the minimum x-coordinate
+	 */
+	minx float64
+
+	/**
+	 *  the maximum x-coordinate
+	 */
+	maxx float64
+
+	/**
+	 *  the minimum y-coordinate
+	 */
+	miny float64
+
+	/**
+	 *  the maximum y-coordinate
+	 */
+	maxy float64
+}
+
+/**
+ *  Creates a null <code>Envelope</code>.
+ */
+func DefaultEnvelope() *Envelope {
+	env := new(Envelope)
+	env.SetToNull()
+	return env
+}
+
+/**
+ *  Creates an <code>Envelope</code> for a region defined by maximum and minimum values.
+ *
+ *@param  x1  the first x-value
+ *@param  x2  the second x-value
+ *@param  y1  the first y-value
+ *@param  y2  the second y-value
+ */
+func NewEnvelope(x1 float64, x2 float64, y1 float64, y2 float64) *Envelope {
+	env := new(Envelope)
+	env.Init(x1, x2, y1, y2)
+	return env
+}
+
+/**
+ *  Creates an <code>Envelope</code> for a region defined by two Coordinates.
+ *
+ *@param  p1  the first Coordinate
+ *@param  p2  the second Coordinate
+ */
+func NewEnvelopeFromCoordinates(p1 *Coordinate, p2 *Coordinate) *Envelope {
+	return NewEnvelope(p1.X, p2.X, p1.Y, p2.Y)
+}
+
+/**
+ *  Creates an <code>Envelope</code> for a region defined by a single Coordinate.
+ *
+ *@param  p  the Coordinate
+ */
+func NewEnvelopeFromCoordinate(p *Coordinate) *Envelope {
+	return NewEnvelope(p.X, p.X, p.Y, p.Y)
+}
+
+/**
+ *  Create an <code>Envelope</code> from an existing Envelope.
+ *
+ *@param  env  the Envelope to initialize from
+ */
+func NewEnvelopeFromEnvelope(existing *Envelope) *Envelope {
+	env := new(Envelope)
+	env.minx = existing.minx
+	env.maxx = existing.maxx
+	env.miny = existing.miny
+	env.maxy = existing.maxy
+	return env
+}
+
+/**
+ *  Creates an <code>Envelope</code> covering all the coordinates of an array.
+ *  An empty array produces a null envelope.
+ *
+ *@param  coords  the coordinates to include
+ */
+func NewEnvelopeFromCoordinateArray(coords []Coordinate) *Envelope {
+	env := DefaultEnvelope()
+	for i := range coords {
+		env.ExpandToIncludeCoordinate(&coords[i])
+	}
+	return env
+}
+
+/**
+ * Test the point q to see whether it intersects the Envelope defined by p1-p2
+ * @param p1 one extremal point of the envelope
+ * @param p2 another extremal point of the envelope
+ * @param q the point to test for intersection
+ * @return <code>true</code> if q intersects the envelope p1-p2
+ */
+func EnvelopeIntersectsPoint(p1 *Coordinate, p2 *Coordinate, q *Coordinate) bool {
+	minx := math.Min(p1.X, p2.X)
+	maxx := math.Max(p1.X, p2.X)
+	if q.X < minx || q.X > maxx {
+		return false
+	}
+	miny := math.Min(p1.Y, p2.Y)
+	maxy := math.Max(p1.Y, p2.Y)
+	if q.Y < miny || q.Y > maxy {
+		return false
+	}
+	return true
+}
+
+/**
+ * Tests whether the envelope defined by p1-p2
+ * and the envelope defined by q1-q2
+ * intersect.
+ *
+ * @param p1 one extremal point of the envelope P
+ * @param p2 another extremal point of the envelope P
+ * @param q1 one extremal point of the envelope Q
+ * @param q2 another extremal point of the envelope Q
+ * @return <code>true</code> if Q intersects P
+ */
+func EnvelopeIntersectsSegments(p1 *Coordinate, p2 *Coordinate, q1 *Coordinate, q2 *Coordinate) bool {
+	minq := math.Min(q1.X, q2.X)
+	maxq := math.Max(q1.X, q2.X)
+	minp := math.Min(p1.X, p2.X)
+	maxp := math.Max(p1.X, p2.X)
+
+	if minp > maxq {
+		return false
+	}
+	if maxp < minq {
+		return false
+	}
+
+	minq = math.Min(q1.Y, q2.Y)
+	maxq = math.Max(q1.Y, q2.Y)
+	minp = math.Min(p1.Y, p2.Y)
+	maxp = math.Max(p1.Y, p2.Y)
+
+	if minp > maxq {
+		return false
+	}
+	if maxp < minq {
+		return false
+	}
+	return true
+}
+
+/**
+ *  Initialize an <code>Envelope</code> for a region defined by maximum and minimum values.
+ *
+ *@param  x1  the first x-value
+ *@param  x2  the second x-value
+ *@param  y1  the first y-value
+ *@param  y2  the second y-value
+ */
+func (env *Envelope) Init(x1 float64, x2 float64, y1 float64, y2 float64) {
+	if x1 < x2 {
+		env.minx = x1
+		env.maxx = x2
+	} else {
+		env.minx = x2
+		env.maxx = x1
+	}
+	if y1 < y2 {
+		env.miny = y1
+		env.maxy = y2
+	} else {
+		env.miny = y2
+		env.maxy = y1
+	}
+}
+
+/**
+ * Creates a copy of this envelope object.
+ *
+ * @return a copy of this envelope
+ */
+func (env *Envelope) Copy() *Envelope {
+	return NewEnvelopeFromEnvelope(env)
+}
+
+/**
+ *  Makes this <code>Envelope</code> a "null" envelope, that is, the envelope
+ *  of the empty geometry.
+ */
+func (env *Envelope) SetToNull() {
+	env.minx = 0
+	env.maxx = -1
+	env.miny = 0
+	env.maxy = -1
+}
+
+/**
+ *  Returns <code>true</code> if this <code>Envelope</code> is a "null"
+ *  envelope.
+ *
+ *@return    <code>true</code> if this <code>Envelope</code> is uninitialized
+ *      or is the envelope of the empty geometry.
+ */
+func (env *Envelope) IsNull() bool {
+	return env.maxx < env.minx
+}
+
+/**
+ *  Returns the difference between the maximum and minimum x values.
+ *
+ *@return    max x - min x, or 0 if this is a null <code>Envelope</code>
+ */
+func (env *Envelope) GetWidth() float64 {
+	if env.IsNull() {
+		return 0
+	}
+	return env.maxx - env.minx
+}
+
+/**
+ *  Returns the difference between the maximum and minimum y values.
+ *
+ *@return    max y - min y, or 0 if this is a null <code>Envelope</code>
+ */
+func (env *Envelope) GetHeight() float64 {
+	if env.IsNull() {
+		return 0
+	}
+	return env.maxy - env.miny
+}
+
+/**
+ * Gets the length of the diameter (diagonal) of the envelope.
+ *
+ * @return the diameter length
+ */
+func (env *Envelope) GetDiameter() float64 {
+	if env.IsNull() {
+		return 0
+	}
+	w := env.GetWidth()
+	h := env.GetHeight()
+	return math.Hypot(w, h)
+}
+
+/**
+ *  Returns the <code>Envelope</code>s minimum x-value. min x &gt; max x
+ *  indicates that this is a null <code>Envelope</code>.
+ *
+ *@return    the minimum x-coordinate
+ */
+func (env *Envelope) GetMinX() float64 {
+	return env.minx
+}
+
+/**
+ *  Returns the <code>Envelope</code>s maximum x-value. min x &gt; max x
+ *  indicates that this is a null <code>Envelope</code>.
+ *
+ *@return    the maximum x-coordinate
+ */
+func (env *Envelope) GetMaxX() float64 {
+	return env.maxx
+}
+
+/**
+ *  Returns the <code>Envelope</code>s minimum y-value. min y &gt; max y
+ *  indicates that this is a null <code>Envelope</code>.
+ *
+ *@return    the minimum y-coordinate
+ */
+func (env *Envelope) GetMinY() float64 {
+	return env.miny
+}
+
+/**
+ *  Returns the <code>Envelope</code>s maximum y-value. min y &gt; max y
+ *  indicates that this is a null <code>Envelope</code>.
+ *
+ *@return    the maximum y-coordinate
+ */
+func (env *Envelope) GetMaxY() float64 {
+	return env.maxy
+}
+
+/**
+ * Gets the area of this envelope.
+ *
+ * @return the area of the envelope
+ * @return 0.0 if the envelope is null
+ */
+func (env *Envelope) GetArea() float64 {
+	return env.GetWidth() * env.GetHeight()
+}
+
+/**
+ * Gets the minimum extent of this envelope across both dimensions.
+ *
+ * @return the minimum extent of this envelope
+ */
+func (env *Envelope) MinExtent() float64 {
+	if env.IsNull() {
+		return 0.0
+	}
+	return math.Min(env.GetWidth(), env.GetHeight())
+}
+
+/**
+ * Gets the maximum extent of this envelope across both dimensions.
+ *
+ * @return the maximum extent of this envelope
+ */
+func (env *Envelope) MaxExtent() float64 {
+	if env.IsNull() {
+		return 0.0
+	}
+	return math.Max(env.GetWidth(), env.GetHeight())
+}
+
+/**
+ *  Enlarges this <code>Envelope</code> so that it contains
+ *  the given {@link Coordinate}.
+ *  Has no effect if the point is already on or within the envelope.
+ *
+ *@param  p  the Coordinate to expand to include
+ */
+func (env *Envelope) ExpandToIncludeCoordinate(p *Coordinate) {
+	env.ExpandToIncludeXY(p.X, p.Y)
+}
+
+/**
+ *  Enlarges this <code>Envelope</code> so that it contains
+ *  the given point.
+ *  Has no effect if the point is already on or within the envelope.
+ *
+ *@param  x  the value to lower the minimum x to or to raise the maximum x to
+ *@param  y  the value to lower the minimum y to or to raise the maximum y to
+ */
+func (env *Envelope) ExpandToIncludeXY(x float64, y float64) {
+	if env.IsNull() {
+		env.minx = x
+		env.maxx = x
+		env.miny = y
+		env.maxy = y
+	} else {
+		if x < env.minx {
+			env.minx = x
+		}
+		if x > env.maxx {
+			env.maxx = x
+		}
+		if y < env.miny {
+			env.miny = y
+		}
+		if y > env.maxy {
+			env.maxy = y
+		}
+	}
+}
+
+/**
+ *  Enlarges this <code>Envelope</code> so that it contains
+ *  the <code>other</code> Envelope.
+ *  Has no effect if <code>other</code> is wholly on or
+ *  within the envelope.
+ *
+ *@param  other  the <code>Envelope</code> to expand to include
+ */
+func (env *Envelope) ExpandToIncludeEnvelope(other *Envelope) {
+	if other.IsNull() {
+		return
+	}
+	if env.IsNull() {
+		env.minx = other.minx
+		env.maxx = other.maxx
+		env.miny = other.miny
+		env.maxy = other.maxy
+	} else {
+		if other.minx < env.minx {
+			env.minx = other.minx
+		}
+		if other.maxx > env.maxx {
+			env.maxx = other.maxx
+		}
+		if other.miny < env.miny {
+			env.miny = other.miny
+		}
+		if other.maxy > env.maxy {
+			env.maxy = other.maxy
+		}
+	}
+}
+
+/**
+ * Expands this envelope by a given distance in all directions.
+ * Both positive and negative distances are supported.
+ *
+ * @param distance the distance to expand the envelope
+ */
+func (env *Envelope) ExpandBy(distance float64) {
+	env.ExpandByXY(distance, distance)
+}
+
+/**
+ * Expands this envelope by a given distance in all directions.
+ * Both positive and negative distances are supported.
+ *
+ * @param deltaX the distance to expand the envelope along the the X axis
+ * @param deltaY the distance to expand the envelope along the the Y axis
+ */
+func (env *Envelope) ExpandByXY(deltaX float64, deltaY float64) {
+	if env.IsNull() {
+		return
+	}
+
+	env.minx -= deltaX
+	env.maxx += deltaX
+	env.miny -= deltaY
+	env.maxy += deltaY
+
+	// check for envelope disappearing
+	if env.minx > env.maxx || env.miny > env.maxy {
+		env.SetToNull()
+	}
+}
+
+/**
+ * Translates this envelope by given amounts in the X and Y direction.
+ *
+ * @param transX the amount to translate along the X axis
+ * @param transY the amount to translate along the Y axis
+ */
+func (env *Envelope) Translate(transX float64, transY float64) {
+	if env.IsNull() {
+		return
+	}
+	env.Init(env.minx+transX, env.maxx+transX, env.miny+transY, env.maxy+transY)
+}
+
+/**
+ * Computes the coordinate of the centre of this envelope (as long as it is non-null
+ *
+ * @return the centre coordinate of this envelope
+ * <code>nil</code> if the envelope is null
+ */
+func (env *Envelope) Centre() *Coordinate {
+	if env.IsNull() {
+		return nil
+	}
+	return NewCoordinateXY(
+		(env.minx+env.maxx)/2.0,
+		(env.miny+env.maxy)/2.0)
+}
+
+/**
+ * Computes the intersection of two {@link Envelope}s.
+ *
+ * @param env the envelope to intersect with
+ * @return a new Envelope representing the intersection of the envelopes (this will be
+ * the null envelope if either argument is null, or they do not intersect
+ */
+func (env *Envelope) Intersection(other *Envelope) *Envelope {
+	if env.IsNull() || other.IsNull() || !env.IntersectsEnvelope(other) {
+		return DefaultEnvelope()
+	}
+
+	intMinX := math.Max(env.minx, other.minx)
+	intMinY := math.Max(env.miny, other.miny)
+	intMaxX := math.Min(env.maxx, other.maxx)
+	intMaxY := math.Min(env.maxy, other.maxy)
+	return NewEnvelope(intMinX, intMaxX, intMinY, intMaxY)
+}
+
+/**
+ * Tests if the region defined by <code>other</code>
+ * intersects the region of this <code>Envelope</code>.
+ * <p>
+ * A null envelope never intersects.
+ *
+ *@param  other  the <code>Envelope</code> which this <code>Envelope</code> is
+ *          being checked for intersecting
+ *@return        <code>true</code> if the <code>Envelope</code>s intersect
+ */
+func (env *Envelope) IntersectsEnvelope(other *Envelope) bool {
+	if env.IsNull() || other.IsNull() {
+		return false
+	}
+	return !(other.minx > env.maxx ||
+		other.maxx < env.minx ||
+		other.miny > env.maxy ||
+		other.maxy < env.miny)
+}
+
+/**
+ * Tests if the extent defined by two extremal points
+ * intersects the extent of this <code>Envelope</code>.
+ *
+ *@param a a point
+ *@param b another point
+ *@return   <code>true</code> if the extents intersect
+ */
+func (env *Envelope) IntersectsSegment(a *Coordinate, b *Coordinate) bool {
+	if env.IsNull() {
+		return false
+	}
+
+	envminx := math.Min(a.X, b.X)
+	if envminx > env.maxx {
+		return false
+	}
+
+	envmaxx := math.Max(a.X, b.X)
+	if envmaxx < env.minx {
+		return false
+	}
+
+	envminy := math.Min(a.Y, b.Y)
+	if envminy > env.maxy {
+		return false
+	}
+
+	envmaxy := math.Max(a.Y, b.Y)
+	if envmaxy < env.miny {
+		return false
+	}
+
+	return true
+}
+
+/**
+ * Tests if the region defined by <code>other</code>
+ * is disjoint from the region of this <code>Envelope</code>.
+ * <p>
+ * A null envelope is always disjoint.
+ *
+ *@param  other  the <code>Envelope</code> being checked for disjointness
+ *@return        <code>true</code> if the <code>Envelope</code>s are disjoint
+ */
+func (env *Envelope) Disjoint(other *Envelope) bool {
+	return !env.IntersectsEnvelope(other)
+}
+
+/**
+ * Tests if the point <code>p</code>
+ * intersects (lies inside) the region of this <code>Envelope</code>.
+ *
+ *@param  p  the <code>Coordinate</code> to be tested
+ *@return <code>true</code> if the point intersects this <code>Envelope</code>
+ */
+func (env *Envelope) IntersectsCoordinate(p *Coordinate) bool {
+	return env.IntersectsXY(p.X, p.Y)
+}
+
+/**
+ *  Check if the point <code>(x, y)</code>
+ *  intersects (lies inside) the region of this <code>Envelope</code>.
+ *
+ *@param  x  the x-ordinate of the point
+ *@param  y  the y-ordinate of the point
+ *@return        <code>true</code> if the point overlaps this <code>Envelope</code>
+ */
+func (env *Envelope) IntersectsXY(x float64, y float64) bool {
+	if env.IsNull() {
+		return false
+	}
+	return !(x > env.maxx ||
+		x < env.minx ||
+		y > env.maxy ||
+		y < env.miny)
+}
+
+/**
+ * Tests if the <code>Envelope other</code>
+ * lies wholly inside this <code>Envelope</code> (inclusive of the boundary).
+ *
+ *@param  other the <code>Envelope</code> to check
+ *@return true if this <code>Envelope</code> covers the <code>other</code>
+ */
+func (env *Envelope) CoversEnvelope(other *Envelope) bool {
+	if env.IsNull() || other.IsNull() {
+		return false
+	}
+	return other.minx >= env.minx &&
+		other.maxx <= env.maxx &&
+		other.miny >= env.miny &&
+		other.maxy <= env.maxy
+}
+
+/**
+ * Tests if the given point lies in or on the envelope.
+ *
+ *@param  p  the point which this <code>Envelope</code> is
+ *      being checked for containing
+ *@return    <code>true</code> if the point lies in the interior or
+ *      on the boundary of this <code>Envelope</code>.
+ */
+func (env *Envelope) CoversCoordinate(p *Coordinate) bool {
+	return env.CoversXY(p.X, p.Y)
+}
+
+/**
+ * Tests if the given point lies in or on the envelope.
+ *
+ *@param  x  the x-coordinate of the point which this <code>Envelope</code> is
+ *      being checked for containing
+ *@param  y  the y-coordinate of the point which this <code>Envelope</code> is
+ *      being checked for containing
+ *@return    <code>true</code> if <code>(x, y)</code> lies in the interior or
+ *      on the boundary of this <code>Envelope</code>.
+ */
+func (env *Envelope) CoversXY(x float64, y float64) bool {
+	if env.IsNull() {
+		return false
+	}
+	return x >= env.minx &&
+		x <= env.maxx &&
+		y >= env.miny &&
+		y <= env.maxy
+}
+
+/**
+ * Tests if the <code>Envelope other</code>
+ * lies wholly inside this <code>Envelope</code> (inclusive of the boundary).
+ * <p>
+ * Note that this is <b>not</b> the same definition as the SFS <tt>contains</tt>,
+ * which would exclude the envelope boundary.
+ *
+ *@param  other the <code>Envelope</code> to check
+ *@return true if <code>other</code> is contained in this <code>Envelope</code>
+ *
+ *@see #CoversEnvelope(Envelope)
+ */
+func (env *Envelope) ContainsEnvelope(other *Envelope) bool {
+	return env.CoversEnvelope(other)
+}
+
+/**
+ * Tests if the given point lies in or on the envelope.
+ * <p>
+ * Note that this is <b>not</b> the same definition as the SFS <tt>contains</tt>,
+ * which would exclude the envelope boundary.
+ *
+ *@param  p  the point which this <code>Envelope</code> is
+ *      being checked for containing
+ *@return    <code>true</code> if the point lies in the interior or
+ *      on the boundary of this <code>Envelope</code>.
+ *
+ *@see #CoversCoordinate(Coordinate)
+ */
+func (env *Envelope) ContainsCoordinate(p *Coordinate) bool {
+	return env.CoversCoordinate(p)
+}
+
+/**
+ * Computes the distance between this and another
+ * <code>Envelope</code>.
+ * The distance between overlapping Envelopes is 0.  Otherwise, the
+ * distance is the Euclidean distance between the closest points.
+ */
+func (env *Envelope) Distance(other *Envelope) float64 {
+	if env.IntersectsEnvelope(other) {
+		return 0
+	}
+
+	dx := 0.0
+	if env.maxx < other.minx {
+		dx = other.minx - env.maxx
+	} else if env.minx > other.maxx {
+		dx = env.minx - other.maxx
+	}
+
+	dy := 0.0
+	if env.maxy < other.miny {
+		dy = other.miny - env.maxy
+	} else if env.miny > other.maxy {
+		dy = env.miny - other.maxy
+	}
+
+	// if either is zero, the envelopes overlap either vertically or horizontally
+	if dx == 0.0 {
+		return dy
+	}
+	if dy == 0.0 {
+		return dx
+	}
+	return math.Hypot(dx, dy)
+}
+
+/**
+ * Tests if another envelope has the same extent as this one.
+ * Two null envelopes are equal.
+ *
+ * @param other the envelope to compare with
+ * @return true if the envelopes are equal
+ */
+func (env *Envelope) Equals(other *Envelope) bool {
+	if env.IsNull() {
+		return other.IsNull()
+	}
+	return env.maxx == other.maxx &&
+		env.maxy == other.maxy &&
+		env.minx == other.minx &&
+		env.miny == other.miny
+}
+
+/**
+ * Compares two envelopes using lexicographic ordering.
+ * The ordering comparison is based on the usual numerical
+ * comparison between the sequence of ordinates.
+ * Null envelopes are less than all non-null envelopes.
+ *
+ * @param other an envelope
+ * @return -1, 0 or 1 as this envelope is less than, equal to or greater than the other
+ */
+func (env *Envelope) CompareTo(other *Envelope) int {
+	// compare nulls if present
+	if env.IsNull() {
+		if other.IsNull() {
+			return 0
+		}
+		return -1
+	} else if other.IsNull() {
+		return 1
+	}
+	// compare based on numerical ordering of ordinates
+	if env.minx < other.minx {
+		return -1
+	}
+	if env.minx > other.minx {
+		return 1
+	}
+	if env.miny < other.miny {
+		return -1
+	}
+	if env.miny > other.miny {
+		return 1
+	}
+	if env.maxx < other.maxx {
+		return -1
+	}
+	if env.maxx > other.maxx {
+		return 1
+	}
+	if env.maxy < other.maxy {
+		return -1
+	}
+	if env.maxy > other.maxy {
+		return 1
+	}
+	return 0
+}
+
+func (env *Envelope) ToString() string {
+	return fmt.Sprintf("Env[%f : %f, %f : %f]", env.minx, env.maxx, env.miny, env.maxy)
+}
@@ -0,0 +1,77 @@
+package geos
+
+import (
+	"errors"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+)
+
+/**
+ * Returns the quadrant of a directed line segment (specified as x and y
+ * displacements, which cannot both be 0).
+ * Quadrants are numbered as follows:
+ * <pre>
+ * 1 | 0
+ * --+--
+ * 2 | 3
+ * </pre>
+ *
+ * @param dx the X displacement
+ * @param dy the Y displacement
+ * @return the quadrant, or an error if the displacements are both 0
+ */
+func QuadrantOfDisplacement(dx float64, dy float64) (int, error) {
+	if dx == 0.0 && dy == 0.0 {
+		return -1, errors.New("cannot compute the quadrant for point ( 0, 0 )")
+	}
+	if dx >= 0.0 {
+		if dy >= 0.0 {
+			return constants.QUADRANT_NE, nil
+		}
+		return constants.QUADRANT_SE, nil
+	}
+	if dy >= 0.0 {
+		return constants.QUADRANT_NW, nil
+	}
+	return constants.QUADRANT_SW, nil
+}
+
+/**
+ * Returns the quadrant of a directed line segment from p0 to p1.
+ *
+ * @param p0 the origin of the segment
+ * @param p1 the end of the segment
+ * @return the quadrant, or an error if the points are equal
+ */
+func QuadrantOf(p0 *Coordinate, p1 *Coordinate) (int, error) {
+	if p1.X == p0.X && p1.Y == p0.Y {
+		return -1, errors.New("cannot compute the quadrant for two identical points " + p0.ToString())
+	}
+	return QuadrantOfDisplacement(p1.X-p0.X, p1.Y-p0.Y)
+}
+
+/**
+ * Returns true if the quadrants are 1 and 3, or 2 and 4
+ *
+ * @param quad1 a quadrant
+ * @param quad2 another quadrant
+ * @return true if the quadrants are opposite
+ */
+func QuadrantIsOpposite(quad1 int, quad2 int) bool {
+	if quad1 == quad2 {
+		return false
+	}
+	diff := (quad1 - quad2 + 4) % 4
+	// if quadrants are not adjacent, they are opposite
+	return diff == 2
+}
+
+/**
+ * Returns true if the given quadrant is 0 or 1.
+ *
+ * @param quad a quadrant
+ * @return true if the quadrant is in the northern half-plane
+ */
+func QuadrantIsNorthern(quad int) bool {
+	return quad == constants.QUADRANT_NE || quad == constants.QUADRANT_NW
+}
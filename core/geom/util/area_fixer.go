@@ -0,0 +1,603 @@
+package geos
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+	polygonbuilder "github.com/UltimateThread/geos-go/core/internal/polygonbuilder"
+)
+
+const (
+	/**
+	 * The distance within which intersection points are snapped together,
+	 * as a fraction of the size of the input extent.
+	 */
+	areaFixer_SNAP_TOLERANCE_FACTOR = 1.0e-12
+
+	/**
+	 * The maximum number of times the segments are re-noded
+	 * after snapping the intersection points.
+	 */
+	areaFixer_MAX_NODING_ITERATIONS = 10
+)
+
+/**
+ * An edge of the noded arrangement of the input rings.
+ * Edges are stored with their endpoints in a normalized order,
+ * and record for each input ring the number of times
+ * the ring runs along the edge in that direction,
+ * less the number of times it runs in the opposite direction.
+ */
+type areaFixerEdge struct {
+	p0   geom.Coordinate
+	p1   geom.Coordinate
+	nets map[int]int
+}
+
+type areaFixerEdgeKey struct {
+	x0, y0, x1, y1 float64
+}
+
+/**
+ * A segment of an input ring, which is split at its intersections
+ * with other segments during noding.
+ */
+type areaFixerSegment struct {
+	p0   geom.Coordinate
+	p1   geom.Coordinate
+	ring int
+}
+
+type areaFixerNodeKey struct {
+	x, y float64
+}
+
+/**
+ * Snaps points together within a tolerance,
+ * using a hash grid of cells the size of the tolerance.
+ * Since a point within the tolerance of a query point lies
+ * in the cell of the query point or one of its neighbours,
+ * each snap inspects a constant number of cells
+ * regardless of the order in which points are added.
+ */
+type areaFixerSnapGrid struct {
+	tolerance float64
+	cells     map[areaFixerNodeKey][]geom.Coordinate
+}
+
+func newAreaFixerSnapGrid(tolerance float64) *areaFixerSnapGrid {
+	grid := new(areaFixerSnapGrid)
+	grid.tolerance = tolerance
+	grid.cells = make(map[areaFixerNodeKey][]geom.Coordinate)
+	return grid
+}
+
+func (grid *areaFixerSnapGrid) cellKey(p *geom.Coordinate) areaFixerNodeKey {
+	return areaFixerNodeKey{math.Floor(p.X / grid.tolerance), math.Floor(p.Y / grid.tolerance)}
+}
+
+/**
+ * Adds a point to the grid without snapping it.
+ * Points which are already present are not added again.
+ */
+func (grid *areaFixerSnapGrid) add(p *geom.Coordinate) {
+	if grid.tolerance <= 0 {
+		return
+	}
+	key := grid.cellKey(p)
+	for i := range grid.cells[key] {
+		if grid.cells[key][i].Equals2D(p) {
+			return
+		}
+	}
+	grid.cells[key] = append(grid.cells[key], *p)
+}
+
+/**
+ * Snaps a point to the nearest point in the grid within the tolerance.
+ * If there is no such point the point is added to the grid.
+ *
+ * @param p the point to snap
+ * @return the snapped point
+ */
+func (grid *areaFixerSnapGrid) snap(p *geom.Coordinate) geom.Coordinate {
+	if grid.tolerance <= 0 {
+		return *p
+	}
+	key := grid.cellKey(p)
+	var nearest *geom.Coordinate
+	nearestDist := grid.tolerance
+	for dx := -1.0; dx <= 1; dx++ {
+		for dy := -1.0; dy <= 1; dy++ {
+			cell := grid.cells[areaFixerNodeKey{key.x + dx, key.y + dy}]
+			for i := range cell {
+				dist := cell[i].Distance(p)
+				if dist <= nearestDist {
+					nearest = &cell[i]
+					nearestDist = dist
+				}
+			}
+		}
+	}
+	if nearest != nil {
+		return *nearest
+	}
+	grid.cells[key] = append(grid.cells[key], *p)
+	return *p
+}
+
+type areaFixerNode struct {
+	pt   geom.Coordinate
+	dist float64
+}
+
+/**
+ * Computes the valid polygonal area enclosed by a set of
+ * possibly invalid polygons.
+ * <p>
+ * The area of a polygon is taken to be the points
+ * which have a non-zero winding number with respect to the shell
+ * and a zero winding number with respect to every hole.
+ * This treats self-intersecting and self-overlapping rings
+ * the same way regardless of their orientation,
+ * and discards collapsed spikes and cut lines.
+ * The area of a set of polygons is the union of their areas.
+ * <p>
+ * The rings are noded against each other,
+ * and each edge of the arrangement is classified by
+ * computing the winding numbers on either side of it.
+ * The edges which separate the interior from the exterior
+ * are linked into the rings of the result.
+ */
+type areaFixer struct {
+	rings     [][]geom.Coordinate
+	ringPoly  []int
+	isHole    []bool
+	numPolys  int
+	edges     []*areaFixerEdge
+	edgeIndex *strtree.STRtree[*areaFixerEdge]
+	extent    *geom.Envelope
+}
+
+/**
+ * Creates a fixer for a set of polygons.
+ * The rings must be closed and free of repeated points.
+ *
+ * @param polygons the polygons, each as a shell followed by its holes
+ */
+func newAreaFixer(polygons [][][]geom.Coordinate) *areaFixer {
+	af := new(areaFixer)
+	af.rings = make([][]geom.Coordinate, 0)
+	af.ringPoly = make([]int, 0)
+	af.isHole = make([]bool, 0)
+	af.numPolys = len(polygons)
+	for i, poly := range polygons {
+		for j, ring := range poly {
+			af.rings = append(af.rings, ring)
+			af.ringPoly = append(af.ringPoly, i)
+			af.isHole = append(af.isHole, j > 0)
+		}
+	}
+	return af
+}
+
+/**
+ * Computes the polygons of the fixed area.
+ * Shells are oriented clockwise and holes counter-clockwise.
+ *
+ * @return the polygons of the area, each as a shell followed by its holes
+ * @return an error if the rings could not be noded robustly
+ */
+func (af *areaFixer) getResult() ([][][]geom.Coordinate, error) {
+	if err := af.buildEdges(); err != nil {
+		return nil, err
+	}
+	polys, err := polygonbuilder.PolygonBuilderBuild(af.findBoundaryEdges())
+	if err != nil {
+		return nil, err
+	}
+	return polys, nil
+}
+
+/**
+ * Tests whether a ring encloses any area,
+ * i.e. whether some edge is not cancelled out by
+ * the ring running back along it.
+ * Must be called after the result has been computed.
+ */
+func (af *areaFixer) hasArea(ringIndex int) bool {
+	for _, e := range af.edges {
+		if e.nets[ringIndex] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Nodes the rings against each other and merges coincident edges.
+ * <p>
+ * Intersection points are snapped to the input vertices and to each other
+ * within a small tolerance, so that segments crossing at a common point
+ * are noded at exactly the same point.
+ * The input vertices themselves are not snapped.
+ * Since snapping moves the nodes slightly, the noded segments
+ * are noded again until no further intersections are found.
+ *
+ * @return an error if the noding does not converge
+ */
+func (af *areaFixer) buildEdges() error {
+	af.extent = geom.DefaultEnvelope()
+	for _, ring := range af.rings {
+		for i := range ring {
+			af.extent.ExpandToIncludeCoordinate(&ring[i])
+		}
+	}
+	snapTol := math.Max(af.extent.GetWidth(), af.extent.GetHeight()) * areaFixer_SNAP_TOLERANCE_FACTOR
+	snapGrid := newAreaFixerSnapGrid(snapTol)
+
+	segs := make([]areaFixerSegment, 0)
+	for i, ring := range af.rings {
+		for a := 0; a < len(ring)-1; a++ {
+			snapGrid.add(&ring[a])
+			if !ring[a].Equals2D(&ring[a+1]) {
+				segs = append(segs, areaFixerSegment{ring[a], ring[a+1], i})
+			}
+		}
+	}
+
+	isNoded := false
+	for iter := 0; iter < areaFixer_MAX_NODING_ITERATIONS; iter++ {
+		var numNodes int
+		segs, numNodes = nodeAreaFixerSegments(segs, snapGrid)
+		if numNodes == 0 {
+			isNoded = true
+			break
+		}
+	}
+	if !isNoded {
+		return errors.New("area fixer noding failed to converge")
+	}
+
+	edgeMap := make(map[areaFixerEdgeKey]*areaFixerEdge)
+	af.edges = make([]*areaFixerEdge, 0)
+	for i := range segs {
+		af.addEdge(edgeMap, &segs[i].p0, &segs[i].p1, segs[i].ring)
+	}
+
+	af.edgeIndex = strtree.NewSTRtree[*areaFixerEdge]()
+	for _, e := range af.edges {
+		af.edgeIndex.Insert(geom.NewEnvelopeFromCoordinates(&e.p0, &e.p1), e)
+	}
+	return nil
+}
+
+/**
+ * Splits segments at their intersections with each other.
+ * The intersection points are snapped to the points in the snap grid,
+ * and added to it.
+ *
+ * @return the noded segments, and the number of nodes added
+ */
+func nodeAreaFixerSegments(segs []areaFixerSegment, snapGrid *areaFixerSnapGrid) ([]areaFixerSegment, int) {
+	index := strtree.NewSTRtree[int]()
+	for i := range segs {
+		index.Insert(geom.NewEnvelopeFromCoordinates(&segs[i].p0, &segs[i].p1), i)
+	}
+
+	segNodes := make([][]geom.Coordinate, len(segs))
+	numNodes := 0
+	addNode := func(i int, nodePt *geom.Coordinate) {
+		if nodePt.Equals2D(&segs[i].p0) || nodePt.Equals2D(&segs[i].p1) {
+			return
+		}
+		segNodes[i] = append(segNodes[i], *nodePt)
+		numNodes++
+	}
+	li := algorithm.NewLineIntersector()
+	for i := range segs {
+		seg := &segs[i]
+		index.QueryVisitor(geom.NewEnvelopeFromCoordinates(&seg.p0, &seg.p1), func(j int) bool {
+			//-- compare each pair of segments once
+			if j <= i {
+				return true
+			}
+			li.ComputeIntersection(&seg.p0, &seg.p1, &segs[j].p0, &segs[j].p1)
+			for k := 0; k < li.GetIntersectionNum(); k++ {
+				nodePt := snapGrid.snap(li.GetIntersection(k))
+				addNode(i, &nodePt)
+				addNode(j, &nodePt)
+			}
+			return true
+		})
+	}
+	if numNodes == 0 {
+		return segs, 0
+	}
+
+	noded := make([]areaFixerSegment, 0, len(segs)+numNodes)
+	for i := range segs {
+		p0 := segs[i].p0
+		p1 := segs[i].p1
+		nodes := make([]areaFixerNode, 0, len(segNodes[i]))
+		for _, nodePt := range segNodes[i] {
+			nodes = append(nodes, areaFixerNode{nodePt, nodePt.Distance(&p0)})
+		}
+		sort.Slice(nodes, func(m, n int) bool {
+			return nodes[m].dist < nodes[n].dist
+		})
+		prev := p0
+		for _, node := range nodes {
+			if node.pt.Equals2D(&prev) {
+				continue
+			}
+			noded = append(noded, areaFixerSegment{prev, node.pt, segs[i].ring})
+			prev = node.pt
+		}
+		if !prev.Equals2D(&p1) {
+			noded = append(noded, areaFixerSegment{prev, p1, segs[i].ring})
+		}
+	}
+	return noded, numNodes
+}
+
+func (af *areaFixer) addEdge(edgeMap map[areaFixerEdgeKey]*areaFixerEdge, p0 *geom.Coordinate, p1 *geom.Coordinate, ringIndex int) {
+	dir := 1
+	if p1.CompareTo(p0) < 0 {
+		p0, p1 = p1, p0
+		dir = -1
+	}
+	key := areaFixerEdgeKey{p0.X, p0.Y, p1.X, p1.Y}
+	e, ok := edgeMap[key]
+	if !ok {
+		e = &areaFixerEdge{*p0, *p1, make(map[int]int)}
+		edgeMap[key] = e
+		af.edges = append(af.edges, e)
+	}
+	e.nets[ringIndex] += dir
+}
+
+/**
+ * Finds the edges which separate the interior of the area from the exterior,
+ * oriented with the interior on their right.
+ * <p>
+ * The ring winding numbers are computed for every face of the arrangement.
+ * The winding numbers of one face in each connected component of the edges
+ * are found by ray casting, and are then propagated to the adjacent faces,
+ * since the winding numbers on the two sides of an edge
+ * differ by the edge net counts.
+ */
+func (af *areaFixer) findBoundaryEdges() []polygonbuilder.BoundaryEdge {
+	faces, faceEdges := af.buildFaces()
+	windings := make([]map[int]int, len(faceEdges))
+	for seed := range faceEdges {
+		if windings[seed] != nil {
+			continue
+		}
+		h := faceEdges[seed][0]
+		left, right := af.sideWindings(af.edges[h/2])
+		if h%2 == 0 {
+			windings[seed] = left
+		} else {
+			windings[seed] = right
+		}
+		queue := []int{seed}
+		for len(queue) > 0 {
+			face := queue[0]
+			queue = queue[1:]
+			for _, h := range faceEdges[face] {
+				adj := faces[h^1]
+				if windings[adj] != nil {
+					continue
+				}
+				windings[adj] = areaFixerCrossWinding(windings[face], af.edges[h/2].nets, h%2 == 0)
+				queue = append(queue, adj)
+			}
+		}
+	}
+
+	boundary := make([]polygonbuilder.BoundaryEdge, 0)
+	for i, e := range af.edges {
+		isInLeft := af.isInterior(windings[faces[2*i]])
+		isInRight := af.isInterior(windings[faces[2*i+1]])
+		if isInLeft == isInRight {
+			continue
+		}
+		if isInRight {
+			boundary = append(boundary, polygonbuilder.BoundaryEdge{P0: e.p0, P1: e.p1})
+		} else {
+			boundary = append(boundary, polygonbuilder.BoundaryEdge{P0: e.p1, P1: e.p0})
+		}
+	}
+	return boundary
+}
+
+/**
+ * Traces the faces of the arrangement of edges.
+ * Edge i has the half-edges 2i, running from p0 to p1,
+ * and 2i+1, running from p1 to p0.
+ * Each face is traced with its interior on the left of its half-edges.
+ *
+ * @return the face on the left of each half-edge, and the half-edges of each face
+ */
+func (af *areaFixer) buildFaces() ([]int, [][]int) {
+	numHalfEdges := 2 * len(af.edges)
+	origin := func(h int) *geom.Coordinate {
+		if h%2 == 0 {
+			return &af.edges[h/2].p0
+		}
+		return &af.edges[h/2].p1
+	}
+
+	angles := make([]float64, numHalfEdges)
+	outEdges := make(map[areaFixerNodeKey][]int)
+	for h := 0; h < numHalfEdges; h++ {
+		p := origin(h)
+		angles[h] = algorithm.AngleOf(p, origin(h^1))
+		key := areaFixerNodeKey{p.X, p.Y}
+		outEdges[key] = append(outEdges[key], h)
+	}
+	//-- the next half-edge clockwise around the origin of each half-edge
+	cwNext := make([]int, numHalfEdges)
+	for _, out := range outEdges {
+		sort.Slice(out, func(i, j int) bool {
+			return angles[out[i]] < angles[out[j]]
+		})
+		for i, h := range out {
+			cwNext[h] = out[(i+len(out)-1)%len(out)]
+		}
+	}
+
+	faces := make([]int, numHalfEdges)
+	for h := range faces {
+		faces[h] = -1
+	}
+	faceEdges := make([][]int, 0)
+	for start := 0; start < numHalfEdges; start++ {
+		if faces[start] >= 0 {
+			continue
+		}
+		face := len(faceEdges)
+		halfEdges := make([]int, 0)
+		//-- the face continues clockwise around the end of each half-edge
+		for h := start; faces[h] < 0; h = cwNext[h^1] {
+			faces[h] = face
+			halfEdges = append(halfEdges, h)
+		}
+		faceEdges = append(faceEdges, halfEdges)
+	}
+	return faces, faceEdges
+}
+
+/**
+ * Computes the winding numbers on the far side of an edge
+ * from the winding numbers on one side.
+ *
+ * @param winding the winding numbers on one side of the edge
+ * @param nets the edge net counts
+ * @param isFromLeft true if the winding numbers are for the left side of the edge
+ * @return the winding numbers on the other side of the edge
+ */
+func areaFixerCrossWinding(winding map[int]int, nets map[int]int, isFromLeft bool) map[int]int {
+	other := make(map[int]int, len(winding))
+	for ring, w := range winding {
+		other[ring] = w
+	}
+	for ring, net := range nets {
+		if isFromLeft {
+			other[ring] -= net
+		} else {
+			other[ring] += net
+		}
+	}
+	return other
+}
+
+/**
+ * Computes the winding numbers of the rings
+ * on the left and right sides of an edge.
+ * <p>
+ * The winding numbers are computed for a point
+ * displaced infinitesimally from the edge midpoint,
+ * by counting the crossings of a ray from the midpoint
+ * with all edges other than the given one.
+ * The ray is horizontal for non-horizontal edges, and vertical otherwise.
+ * The winding numbers on the other side differ by the edge net counts.
+ */
+func (af *areaFixer) sideWindings(e *areaFixerEdge) (map[int]int, map[int]int) {
+	mid := geom.NewCoordinateXY((e.p0.X+e.p1.X)/2, (e.p0.Y+e.p1.Y)/2)
+	isHorizontal := e.p0.Y == e.p1.Y
+	var rayEnv *geom.Envelope
+	if isHorizontal {
+		rayEnv = geom.NewEnvelope(mid.X, mid.X, mid.Y, af.extent.GetMaxY())
+	} else {
+		rayEnv = geom.NewEnvelope(mid.X, af.extent.GetMaxX(), mid.Y, mid.Y)
+	}
+
+	winding := make(map[int]int)
+	af.edgeIndex.QueryVisitor(rayEnv, func(other *areaFixerEdge) bool {
+		if other == e {
+			return true
+		}
+		crossing := areaFixerRayCrossing(mid, &other.p0, &other.p1, isHorizontal)
+		if crossing != 0 {
+			for ring, net := range other.nets {
+				winding[ring] += crossing * net
+			}
+		}
+		return true
+	})
+
+	/**
+	 * A horizontal ray gives the winding number on the right of an upward edge,
+	 * and a vertical ray gives it on the right of a leftward edge.
+	 */
+	isRayOnRight := e.p1.Y > e.p0.Y
+	if isHorizontal {
+		isRayOnRight = e.p1.X < e.p0.X
+	}
+	other := areaFixerCrossWinding(winding, e.nets, !isRayOnRight)
+	if isRayOnRight {
+		return other, winding
+	}
+	return winding, other
+}
+
+/**
+ * Computes the signed crossing of a ray from a point with an edge,
+ * for computing winding numbers.
+ * The ray extends in the positive X direction,
+ * or in the positive Y direction if isVertical is set.
+ * Edges crossing upwards (or leftwards for a vertical ray) count as 1,
+ * and edges crossing in the other direction as -1.
+ * Edge endpoints lying on the ray are handled by including
+ * the lower endpoint of an edge and excluding the upper one.
+ */
+func areaFixerRayCrossing(p *geom.Coordinate, p0 *geom.Coordinate, p1 *geom.Coordinate, isVertical bool) int {
+	if isVertical {
+		//-- reflecting in the line y = x reverses the orientation
+		return -areaFixerRayCrossing(
+			geom.NewCoordinateXY(p.Y, p.X),
+			geom.NewCoordinateXY(p0.Y, p0.X),
+			geom.NewCoordinateXY(p1.Y, p1.X),
+			false)
+	}
+	if p0.Y <= p.Y {
+		if p1.Y > p.Y && algorithm.OrientationIndex(p0, p1, p) == constants.ORIENTATION_LEFT {
+			return 1
+		}
+	} else if p1.Y <= p.Y && algorithm.OrientationIndex(p0, p1, p) == constants.ORIENTATION_RIGHT {
+		return -1
+	}
+	return 0
+}
+
+/**
+ * Tests whether a point with the given ring winding numbers
+ * lies in the interior of the area.
+ */
+func (af *areaFixer) isInterior(winding map[int]int) bool {
+	isInShell := make([]bool, af.numPolys)
+	isInHole := make([]bool, af.numPolys)
+	for ring, w := range winding {
+		if w == 0 {
+			continue
+		}
+		if af.isHole[ring] {
+			isInHole[af.ringPoly[ring]] = true
+		} else {
+			isInShell[af.ringPoly[ring]] = true
+		}
+	}
+	for i := 0; i < af.numPolys; i++ {
+		if isInShell[i] && !isInHole[i] {
+			return true
+		}
+	}
+	return false
+}
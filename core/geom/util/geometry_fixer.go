@@ -0,0 +1,298 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * The result of fixing a geometry.
+ * Fixing may change the type of a geometry
+ * (for instance a collapsed polygon may become a line),
+ * so the valid components of the result are grouped by dimension.
+ * Polygons are given as a shell followed by its holes,
+ * with shells oriented clockwise and holes counter-clockwise.
+ */
+type GeometryFixerResult struct {
+	points   []geom.Coordinate
+	lines    [][]geom.Coordinate
+	polygons [][][]geom.Coordinate
+}
+
+func newGeometryFixerResult() *GeometryFixerResult {
+	gfr := new(GeometryFixerResult)
+	gfr.points = make([]geom.Coordinate, 0)
+	gfr.lines = make([][]geom.Coordinate, 0)
+	gfr.polygons = make([][][]geom.Coordinate, 0)
+	return gfr
+}
+
+/**
+ * Gets the point components of the result.
+ */
+func (gfr *GeometryFixerResult) GetPoints() []geom.Coordinate {
+	return gfr.points
+}
+
+/**
+ * Gets the linear components of the result.
+ */
+func (gfr *GeometryFixerResult) GetLines() [][]geom.Coordinate {
+	return gfr.lines
+}
+
+/**
+ * Gets the polygonal components of the result.
+ */
+func (gfr *GeometryFixerResult) GetPolygons() [][][]geom.Coordinate {
+	return gfr.polygons
+}
+
+/**
+ * Tests whether the result has no components.
+ */
+func (gfr *GeometryFixerResult) IsEmpty() bool {
+	return len(gfr.points) == 0 && len(gfr.lines) == 0 && len(gfr.polygons) == 0
+}
+
+/**
+ * Fixes a geometry to be a valid geometry, while preserving as much as
+ * possible of the shape and location of the input.
+ * Validity is determined according to {@link IsValidOp}.
+ * <p>
+ * Input geometries are always processed, so even valid inputs may
+ * have some minor alterations.  The output is always a new geometry object.
+ *
+ * <h2>Semantic Rules</h2>
+ * <ol>
+ * <li>Vertices with non-finite X or Y ordinates are removed</li>
+ * <li>Repeated points are reduced to a single point</li>
+ * <li>Empty atomic geometries are valid and are returned unchanged</li>
+ * <li>Empty elements are removed from collections</li>
+ * <li><code>Point</code>: keep valid coordinate, or EMPTY</li>
+ * <li><code>LineString</code>: coordinates are fixed</li>
+ * <li><code>LinearRing</code>: coordinates are fixed.  Keep valid ring, or else convert into <code>LineString</code></li>
+ * <li><code>Polygon</code>: transform into a valid polygon,
+ * preserving as much of the extent and vertices as possible.
+ * <ul>
+ * <li>Rings are fixed to ensure they are valid</li>
+ * <li>Holes intersecting the shell are subtracted from the shell</li>
+ * <li>Holes outside the shell are removed</li>
+ * </ul></li>
+ * <li><code>MultiPolygon</code>: each polygon is fixed,
+ * then result made non-overlapping (via union)</li>
+ * </ol>
+ * <p>
+ * A ring is fixed by taking the area it encloses to be
+ * the points around which it winds a non-zero number of times.
+ * The ring is noded at its self-intersections, and the
+ * edges bounding that area are linked into valid rings.
+ * This removes spikes and cuts, and splits self-crossing rings
+ * into separate polygons.
+ * <p>
+ * Collapsed components (lines of a single point,
+ * and rings and polygons of zero area) are removed,
+ * unless {@link #SetKeepCollapsed(bool)} is set,
+ * in which case they are kept as points or lines.
+ */
+type GeometryFixer struct {
+	isKeepCollapsed bool
+}
+
+/**
+ * Creates a new fixer.
+ */
+func NewGeometryFixer() *GeometryFixer {
+	gf := new(GeometryFixer)
+	gf.isKeepCollapsed = false
+	return gf
+}
+
+/**
+ * Sets whether collapsed geometries are converted to empty,
+ * (which will be removed from collections),
+ * or to a valid geometry of lower dimension.
+ * The default is to convert collapses to empty geometries.
+ *
+ * @param isKeepCollapsed whether collapses should be converted to a lower dimension geometry
+ */
+func (gf *GeometryFixer) SetKeepCollapsed(isKeepCollapsed bool) {
+	gf.isKeepCollapsed = isKeepCollapsed
+}
+
+/**
+ * Fixes a point.
+ * An invalid point is removed.
+ *
+ * @param pt the point to fix
+ * @return the fixed geometry
+ */
+func (gf *GeometryFixer) FixPoint(pt *geom.Coordinate) *GeometryFixerResult {
+	result := newGeometryFixerResult()
+	if pt.IsValid() {
+		result.points = append(result.points, *pt)
+	}
+	return result
+}
+
+/**
+ * Fixes a multipoint by removing invalid points.
+ *
+ * @param pts the points to fix
+ * @return the fixed geometry
+ */
+func (gf *GeometryFixer) FixMultiPoint(pts []geom.Coordinate) *GeometryFixerResult {
+	result := newGeometryFixerResult()
+	for i := range pts {
+		if pts[i].IsValid() {
+			result.points = append(result.points, pts[i])
+		}
+	}
+	return result
+}
+
+/**
+ * Fixes a linestring by removing invalid and repeated points.
+ * A line which collapses to a single point is removed,
+ * or kept as a point if collapses are kept.
+ *
+ * @param line the line to fix
+ * @return the fixed geometry
+ */
+func (gf *GeometryFixer) FixLineString(line []geom.Coordinate) *GeometryFixerResult {
+	result := newGeometryFixerResult()
+	gf.fixLineString(line, result)
+	return result
+}
+
+/**
+ * Fixes each line of a multilinestring.
+ *
+ * @param lines the lines to fix
+ * @return the fixed geometry
+ */
+func (gf *GeometryFixer) FixMultiLineString(lines [][]geom.Coordinate) *GeometryFixerResult {
+	result := newGeometryFixerResult()
+	for _, line := range lines {
+		gf.fixLineString(line, result)
+	}
+	return result
+}
+
+func (gf *GeometryFixer) fixLineString(line []geom.Coordinate, result *GeometryFixerResult) {
+	ptsFix := geom.RemoveRepeatedOrInvalidPoints(line)
+	if len(ptsFix) == 1 && gf.isKeepCollapsed {
+		result.points = append(result.points, ptsFix[0])
+		return
+	}
+	if len(ptsFix) <= 1 {
+		return
+	}
+	result.lines = append(result.lines, ptsFix)
+}
+
+/**
+ * Fixes a linear ring by removing invalid and repeated points.
+ * A ring with too few points is removed,
+ * or kept as a line or point if collapses are kept.
+ * A ring which is not simple is kept,
+ * since it is still a valid linestring.
+ *
+ * @param ring the ring to fix
+ * @return the fixed geometry
+ */
+func (gf *GeometryFixer) FixLinearRing(ring []geom.Coordinate) *GeometryFixerResult {
+	result := newGeometryFixerResult()
+	ptsFix := geom.RemoveRepeatedOrInvalidPoints(ring)
+	if gf.isKeepCollapsed {
+		if len(ptsFix) == 1 {
+			result.points = append(result.points, ptsFix[0])
+			return result
+		}
+		if len(ptsFix) > 1 && len(ptsFix) <= 3 {
+			result.lines = append(result.lines, ptsFix)
+			return result
+		}
+	}
+	//--- too short to be a valid ring
+	if len(ptsFix) <= 3 {
+		return result
+	}
+	result.lines = append(result.lines, ptsFix)
+	return result
+}
+
+/**
+ * Fixes a polygon.
+ * The shell and holes are fixed to enclose valid areas,
+ * and the hole areas are subtracted from the shell area.
+ * A polygon whose shell encloses no area is removed,
+ * or kept as a line or point if collapses are kept.
+ *
+ * @param polygon the rings of the polygon, with the shell first
+ * @return the fixed geometry
+ * @return an error if the rings could not be noded robustly
+ */
+func (gf *GeometryFixer) FixPolygon(polygon [][]geom.Coordinate) (*GeometryFixerResult, error) {
+	return gf.FixMultiPolygon([][][]geom.Coordinate{polygon})
+}
+
+/**
+ * Fixes a multipolygon.
+ * Each polygon is fixed, and the result is the union of the fixed polygons.
+ *
+ * @param polygons the polygons, each as a shell followed by its holes
+ * @return the fixed geometry
+ * @return an error if the rings could not be noded robustly
+ */
+func (gf *GeometryFixer) FixMultiPolygon(polygons [][][]geom.Coordinate) (*GeometryFixerResult, error) {
+	result := newGeometryFixerResult()
+	polysFix := make([][][]geom.Coordinate, 0, len(polygons))
+	shells := make([][]geom.Coordinate, 0, len(polygons))
+	for _, poly := range polygons {
+		if len(poly) == 0 || len(poly[0]) == 0 {
+			continue
+		}
+		polyFix := make([][]geom.Coordinate, 0, len(poly))
+		for _, ring := range poly {
+			polyFix = append(polyFix, fixRingCoordinates(ring))
+		}
+		polysFix = append(polysFix, polyFix)
+		shells = append(shells, poly[0])
+	}
+
+	af := newAreaFixer(polysFix)
+	polygonsFix, err := af.getResult()
+	if err != nil {
+		return nil, err
+	}
+	result.polygons = polygonsFix
+
+	if gf.isKeepCollapsed {
+		ringIndex := 0
+		for i, poly := range polysFix {
+			if !af.hasArea(ringIndex) {
+				gf.fixLineString(shells[i], result)
+			}
+			ringIndex += len(poly)
+		}
+	}
+	return result, nil
+}
+
+/**
+ * Removes invalid and repeated points from a ring,
+ * and closes it if required.
+ * A ring with too few points to enclose an area is returned empty.
+ */
+func fixRingCoordinates(ring []geom.Coordinate) []geom.Coordinate {
+	ptsFix := geom.RemoveRepeatedOrInvalidPoints(ring)
+	if len(ptsFix) > 0 && !ptsFix[0].Equals2D(&ptsFix[len(ptsFix)-1]) {
+		closed := make([]geom.Coordinate, len(ptsFix), len(ptsFix)+1)
+		copy(closed, ptsFix)
+		ptsFix = append(closed, ptsFix[0])
+	}
+	if len(ptsFix) < 4 {
+		return []geom.Coordinate{}
+	}
+	return ptsFix
+}
@@ -0,0 +1,366 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Monotone Chains are a way of partitioning the segments of a linestring to
+ * allow for fast searching of intersections.
+ * They have the following properties:
+ * <ol>
+ * <li>the segments within a monotone chain never intersect each other
+ * <li>the envelope of any contiguous subset of the segments in a monotone chain
+ * is equal to the envelope of the endpoints of the subset.
+ * </ol>
+ * Property 1 means that there is no need to test pairs of segments from within
+ * the same monotone chain for intersection.
+ * <p>
+ * Property 2 allows
+ * an efficient binary search to be used to find the intersection points of two monotone chains.
+ * For many types of real-world data, these properties eliminate a large number of
+ * segment comparisons, producing substantial speed gains.
+ * <p>
+ * One of the goals of this implementation of MonotoneChains is to be
+ * as space and time efficient as possible. One design choice that aids this
+ * is that a MonotoneChain is based on a subarray of a list of points.
+ * This means that new arrays of points (potentially very large) do not
+ * have to be allocated.
+ * <p>
+ *
+ * MonotoneChains support the following kinds of queries:
+ * <ul>
+ * <li>Envelope select: determine all the segments in the chain which
+ * intersect a given envelope
+ * <li>Overlap: determine all the pairs of segments in two chains whose
+ * envelopes overlap
+ * </ul>
+ *
+ * This implementation of MonotoneChains uses the concept of internal iterators
+ * ({@link MonotoneChainSelectAction} and {@link MonotoneChainOverlapAction})
+ * to return the results for queries.
+ * This has time and space advantages, since it
+ * is not necessary to build lists of instantiated objects to represent the segments
+ * returned by the query.
+ * Queries made in this manner are thread-safe.
+ * <p>
+ * MonotoneChains support being assigned an integer id value
+ * to provide a total ordering for a set of chains.
+ * This can be used during some kinds of processing to
+ * avoid redundant comparisons
+ * (i.e. by comparing only chains where the first id is less than the second).
+ * <p>
+ * MonotoneChains support using an tolerance distance for overlap tests.
+ * This allows reporting overlap in situations where
+ * intersection snapping is being used.
+ * If this is used the chain envelope must be computed
+ * providing an expansion distance using {@link #GetEnvelopeExpanded}.
+ */
+type MonotoneChain struct {
+	pts     []geom.Coordinate
+	start   int
+	end     int
+	env     *geom.Envelope
+	context any // user-defined information
+	id      int // useful for optimizing chain comparisons
+}
+
+/**
+ * The action performed for each pair of segments whose envelopes overlap
+ * in a {@link MonotoneChain#ComputeOverlaps} query.
+ * The segments are identified by the chains and their start indexes.
+ */
+type MonotoneChainOverlapAction func(mc1 *MonotoneChain, start1 int, mc2 *MonotoneChain, start2 int)
+
+/**
+ * The action performed for each segment selected
+ * by a {@link MonotoneChain#Select} query.
+ * The segment is identified by the chain and its start index.
+ */
+type MonotoneChainSelectAction func(mc *MonotoneChain, startIndex int)
+
+/**
+ * Creates a new MonotoneChain based on the given array of points.
+ *
+ * @param pts the points containing the chain
+ * @param start the index of the first coordinate in the chain
+ * @param end the index of the last coordinate in the chain
+ * @param context a user-defined data object
+ */
+func NewMonotoneChain(pts []geom.Coordinate, start int, end int, context any) *MonotoneChain {
+	mc := new(MonotoneChain)
+	mc.pts = pts
+	mc.start = start
+	mc.end = end
+	mc.context = context
+	return mc
+}
+
+/**
+ * Sets the id of this chain.
+ * Useful for assigning an ordering to a set of
+ * chains, which can be used to avoid redundant processing.
+ *
+ * @param id an id value
+ */
+func (mc *MonotoneChain) SetId(id int) {
+	mc.id = id
+}
+
+/**
+ * Gets the id of this chain.
+ *
+ * @return the id value
+ */
+func (mc *MonotoneChain) GetId() int {
+	return mc.id
+}
+
+/**
+ * Gets the user-defined context data value.
+ *
+ * @return a data value
+ */
+func (mc *MonotoneChain) GetContext() any {
+	return mc.context
+}
+
+/**
+ * Gets the envelope of the chain.
+ *
+ * @return the envelope of the chain
+ */
+func (mc *MonotoneChain) GetEnvelope() *geom.Envelope {
+	return mc.GetEnvelopeExpanded(0.0)
+}
+
+/**
+ * Gets the envelope for this chain,
+ * expanded by a given distance.
+ * The envelope is computed on the first call and cached,
+ * so the expansion distance must be the same for all calls.
+ *
+ * @param expansionDistance distance to expand the envelope by
+ * @return the expanded envelope of the chain
+ */
+func (mc *MonotoneChain) GetEnvelopeExpanded(expansionDistance float64) *geom.Envelope {
+	if mc.env == nil {
+		/**
+		 * The monotonicity property allows fast envelope determination
+		 */
+		mc.env = geom.NewEnvelopeFromCoordinates(&mc.pts[mc.start], &mc.pts[mc.end])
+		if expansionDistance > 0.0 {
+			mc.env.ExpandBy(expansionDistance)
+		}
+	}
+	return mc.env
+}
+
+/**
+ * Gets the index of the start of the monotone chain
+ * in the underlying array of points.
+ *
+ * @return the start index of the chain
+ */
+func (mc *MonotoneChain) GetStartIndex() int {
+	return mc.start
+}
+
+/**
+ * Gets the index of the end of the monotone chain
+ * in the underlying array of points.
+ *
+ * @return the end index of the chain
+ */
+func (mc *MonotoneChain) GetEndIndex() int {
+	return mc.end
+}
+
+/**
+ * Gets the endpoints of the line segment of this chain
+ * which starts at a given index.
+ *
+ * @param index index of segment
+ * @return the start and end points of the segment
+ */
+func (mc *MonotoneChain) GetLineSegment(index int) (*geom.Coordinate, *geom.Coordinate) {
+	return &mc.pts[index], &mc.pts[index+1]
+}
+
+/**
+ * Return the subsequence of coordinates forming this chain.
+ * Allocates a new array to hold the Coordinates
+ */
+func (mc *MonotoneChain) GetCoordinates() []geom.Coordinate {
+	coord := make([]geom.Coordinate, mc.end-mc.start+1)
+	copy(coord, mc.pts[mc.start:mc.end+1])
+	return coord
+}
+
+/**
+ * Determine all the line segments in the chain whose envelopes overlap
+ * the searchEnvelope, and process them.
+ * <p>
+ * The monotone chain search algorithm attempts to optimize
+ * performance by not calling the select action on chain segments
+ * which it can determine are not in the search envelope.
+ *
+ * @param searchEnv the search envelope
+ * @param mcs the select action to execute on selected segments
+ */
+func (mc *MonotoneChain) Select(searchEnv *geom.Envelope, mcs MonotoneChainSelectAction) {
+	mc.computeSelect(searchEnv, mc.start, mc.end, mcs)
+}
+
+func (mc *MonotoneChain) computeSelect(searchEnv *geom.Envelope, start0 int, end0 int, mcs MonotoneChainSelectAction) {
+	p0 := &mc.pts[start0]
+	p1 := &mc.pts[end0]
+
+	// nothing to do if the envelopes don't overlap
+	if !searchEnv.IntersectsSegment(p0, p1) {
+		return
+	}
+	// terminating condition for the recursion
+	if end0-start0 == 1 {
+		mcs(mc, start0)
+		return
+	}
+
+	// the chains overlap, so split each in half and iterate  (binary search)
+	mid := (start0 + end0) / 2
+
+	// Assert: mid != start or end (since we checked above for end - start <= 1)
+	// check terminating conditions before recursing
+	if start0 < mid {
+		mc.computeSelect(searchEnv, start0, mid, mcs)
+	}
+	if mid < end0 {
+		mc.computeSelect(searchEnv, mid, end0, mcs)
+	}
+}
+
+/**
+ * Determines the line segments in two chains which may overlap,
+ * and passes them to an overlap action.
+ * <p>
+ * The monotone chain search algorithm attempts to optimize
+ * performance by not calling the overlap action on chain segments
+ * which it can determine do not overlap.
+ * However, it *may* call the overlap action on segments
+ * which do not actually interact.
+ * This saves on the overhead of checking envelope intersection
+ * each time, since clients may be able to do this more efficiently.
+ *
+ * @param other the chain to compare to
+ * @param mco the overlap action to execute on overlapping segments
+ */
+func (mc *MonotoneChain) ComputeOverlaps(other *MonotoneChain, mco MonotoneChainOverlapAction) {
+	mc.computeOverlaps(mc.start, mc.end, other, other.start, other.end, 0.0, mco)
+}
+
+/**
+ * Determines the line segments in two chains which may overlap,
+ * using an overlap distance tolerance,
+ * and passes them to an overlap action.
+ *
+ * @param other the chain to compare to
+ * @param overlapTolerance the distance tolerance for the overlap test
+ * @param mco the overlap action to execute on selected segments
+ */
+func (mc *MonotoneChain) ComputeOverlapsTolerance(other *MonotoneChain, overlapTolerance float64, mco MonotoneChainOverlapAction) {
+	mc.computeOverlaps(mc.start, mc.end, other, other.start, other.end, overlapTolerance, mco)
+}
+
+/**
+ * Uses an efficient mutual binary search strategy
+ * to determine which pairs of chain segments
+ * may overlap, and calls the given overlap action on them.
+ *
+ * @param start0 the start index of this chain section
+ * @param end0 the end index of this chain section
+ * @param other the target monotone chain
+ * @param start1 the start index of the target chain section
+ * @param end1 the end index of the target chain section
+ * @param overlapTolerance the overlap tolerance distance (may be 0)
+ * @param mco the overlap action to execute on selected segments
+ */
+func (mc *MonotoneChain) computeOverlaps(start0 int, end0 int, other *MonotoneChain, start1 int, end1 int, overlapTolerance float64, mco MonotoneChainOverlapAction) {
+	// nothing to do if the envelopes of these subchains don't overlap
+	if !mc.overlaps(start0, end0, other, start1, end1, overlapTolerance) {
+		return
+	}
+	// terminating condition for the recursion
+	if end0-start0 == 1 && end1-start1 == 1 {
+		mco(mc, start0, other, start1)
+		return
+	}
+
+	// the chains overlap, so split each in half and iterate  (binary search)
+	mid0 := (start0 + end0) / 2
+	mid1 := (start1 + end1) / 2
+
+	// Assert: mid != start or end (since we checked above for end - start <= 1)
+	// check terminating conditions before recursing
+	if start0 < mid0 {
+		if start1 < mid1 {
+			mc.computeOverlaps(start0, mid0, other, start1, mid1, overlapTolerance, mco)
+		}
+		if mid1 < end1 {
+			mc.computeOverlaps(start0, mid0, other, mid1, end1, overlapTolerance, mco)
+		}
+	}
+	if mid0 < end0 {
+		if start1 < mid1 {
+			mc.computeOverlaps(mid0, end0, other, start1, mid1, overlapTolerance, mco)
+		}
+		if mid1 < end1 {
+			mc.computeOverlaps(mid0, end0, other, mid1, end1, overlapTolerance, mco)
+		}
+	}
+}
+
+/**
+ * Tests whether the envelope of a section of the chain
+ * overlaps (intersects) the envelope of a section of another target chain.
+ * This test is efficient due to the monotonicity property
+ * of the sections (i.e. the envelopes can be are determined
+ * from the section endpoints
+ * rather than a full scan).
+ *
+ * @return true if the section envelopes overlap
+ */
+func (mc *MonotoneChain) overlaps(start0 int, end0 int, other *MonotoneChain, start1 int, end1 int, overlapTolerance float64) bool {
+	if overlapTolerance > 0.0 {
+		return overlapsTolerance(&mc.pts[start0], &mc.pts[end0], &other.pts[start1], &other.pts[end1], overlapTolerance)
+	}
+	return geom.EnvelopeIntersectsSegments(&mc.pts[start0], &mc.pts[end0], &other.pts[start1], &other.pts[end1])
+}
+
+func overlapsTolerance(p1 *geom.Coordinate, p2 *geom.Coordinate, q1 *geom.Coordinate, q2 *geom.Coordinate, overlapTolerance float64) bool {
+	minq := math.Min(q1.X, q2.X)
+	maxq := math.Max(q1.X, q2.X)
+	minp := math.Min(p1.X, p2.X)
+	maxp := math.Max(p1.X, p2.X)
+
+	if minp > maxq+overlapTolerance {
+		return false
+	}
+	if maxp < minq-overlapTolerance {
+		return false
+	}
+
+	minq = math.Min(q1.Y, q2.Y)
+	maxq = math.Max(q1.Y, q2.Y)
+	minp = math.Min(p1.Y, p2.Y)
+	maxp = math.Max(p1.Y, p2.Y)
+
+	if minp > maxq+overlapTolerance {
+		return false
+	}
+	if maxp < minq-overlapTolerance {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the {@link MonotoneChain}s
+ * for a list of coordinates.
+ *
+ * @param pts the list of points to compute chains for
+ * @return a list of the monotone chains for the points
+ */
+func MonotoneChainBuilderGetChains(pts []geom.Coordinate) []*MonotoneChain {
+	return MonotoneChainBuilderGetChainsWithContext(pts, nil)
+}
+
+/**
+ * Computes a list of the {@link MonotoneChain}s
+ * for a list of coordinates,
+ * attaching a context data object to each.
+ *
+ * @param pts the list of points to compute chains for
+ * @param context a data object to attach to each chain
+ * @return a list of the monotone chains for the points
+ */
+func MonotoneChainBuilderGetChainsWithContext(pts []geom.Coordinate, context any) []*MonotoneChain {
+	mcList := make([]*MonotoneChain, 0)
+	if len(pts) == 0 {
+		return mcList
+	}
+	chainStart := 0
+	for {
+		chainEnd := findChainEnd(pts, chainStart)
+		mc := NewMonotoneChain(pts, chainStart, chainEnd, context)
+		mcList = append(mcList, mc)
+		chainStart = chainEnd
+		if chainStart >= len(pts)-1 {
+			break
+		}
+	}
+	return mcList
+}
+
+/**
+ * Finds the index of the last point in a monotone chain
+ * starting at a given point.
+ * Repeated points (0-length segments) are included
+ * in the monotone chain returned.
+ *
+ * @param pts the points to scan
+ * @param start the index of the start of this chain
+ * @return the index of the last point in the monotone chain
+ *
+ */
+func findChainEnd(pts []geom.Coordinate, start int) int {
+	safeStart := start
+	// skip any zero-length segments at the start of the sequence
+	// (since they cannot be used to establish a quadrant)
+	for safeStart < len(pts)-1 && pts[safeStart].Equals2D(&pts[safeStart+1]) {
+		safeStart++
+	}
+	// check if there are NO non-zero-length segments
+	if safeStart >= len(pts)-1 {
+		return len(pts) - 1
+	}
+	// determine overall quadrant for chain (which is the starting quadrant)
+	chainQuad, _ := geom.QuadrantOf(&pts[safeStart], &pts[safeStart+1])
+	last := start + 1
+	for last < len(pts) {
+		// skip zero-length segments, but include them in the chain
+		if !pts[last-1].Equals2D(&pts[last]) {
+			// compute quadrant for next possible segment in chain
+			quad, _ := geom.QuadrantOf(&pts[last-1], &pts[last])
+			if quad != chainQuad {
+				break
+			}
+		}
+		last++
+	}
+	return last - 1
+}
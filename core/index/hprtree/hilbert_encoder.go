@@ -0,0 +1,51 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	fractal "github.com/UltimateThread/geos-go/core/shape/fractal"
+)
+
+/**
+ * Computes the Hilbert code of the centre of an envelope,
+ * on a Hilbert curve of a given level scaled to cover an extent.
+ */
+type hilbertEncoder struct {
+	level   int
+	minx    float64
+	miny    float64
+	strideX float64
+	strideY float64
+}
+
+func newHilbertEncoder(level int, extent *geom.Envelope) *hilbertEncoder {
+	encoder := new(hilbertEncoder)
+	encoder.level = level
+	hside := float64(fractal.HilbertCodeMaxOrdinate(level))
+
+	encoder.minx = extent.GetMinX()
+	encoder.strideX = extent.GetWidth() / hside
+
+	encoder.miny = extent.GetMinY()
+	encoder.strideY = extent.GetHeight() / hside
+	return encoder
+}
+
+func (encoder *hilbertEncoder) encode(env *geom.Envelope) int {
+	midx := env.GetWidth()/2 + env.GetMinX()
+	midy := env.GetHeight()/2 + env.GetMinY()
+	x := hilbertOrdinate(midx, encoder.minx, encoder.strideX)
+	y := hilbertOrdinate(midy, encoder.miny, encoder.strideY)
+	return fractal.HilbertCodeEncode(encoder.level, x, y)
+}
+
+/**
+ * Computes the cell ordinate of a value.
+ * A zero stride occurs when the extent has no width or height,
+ * in which case all values lie in the first cell.
+ */
+func hilbertOrdinate(value float64, min float64, stride float64) int {
+	if stride == 0 {
+		return 0
+	}
+	return int((value - min) / stride)
+}
@@ -0,0 +1,439 @@
+package geos
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const hprtree_ENV_SIZE = 4
+
+/**
+ * A Hilbert-Packed R-tree.  This is a static R-tree
+ * which is packed by using the Hilbert ordering
+ * of the tree items.
+ * <p>
+ * The tree is constructed by sorting the items
+ * by the Hilbert code of the midpoint of their envelope.
+ * Then, a set of internal layers is created recursively
+ * as follows:
+ * <ul>
+ * <li>The items/nodes of the previous are partitioned into blocks
+ * of size <code>nodeCapacity</code>
+ * <li>For each block a layer node is created with range
+ * equal to the envelope of the items/nodess in the block
+ * </ul>
+ * The internal layers are stored using an array to
+ * store the node bounds.
+ * The link between a node and its children is
+ * stored implicitly in the indexes of the array.
+ * For efficiency, the offsets to the layers
+ * within the node array are pre-computed and stored.
+ * <p>
+ * NOTE: Based on performance testing,
+ * the HPRtree is somewhat faster than the STRtree.
+ * It should also be more memory-efficent,
+ * due to fewer object allocations.
+ * However, it is not clear whether this
+ * will produce a significant improvement
+ * for use in JTS operations.
+ * <p>
+ * Like the STRtree, the tree is built automatically on the first query,
+ * after which items may not be inserted.
+ * Removal of items is not supported.
+ */
+type HPRtree[T any] struct {
+	items           []hprtreeItem[T]
+	nodeCapacity    int
+	totalExtent     *geom.Envelope
+	layerStartIndex []int
+	nodeBounds      []float64
+	itemBounds      []float64
+	itemValues      []T
+	isBuilt         bool
+}
+
+type hprtreeItem[T any] struct {
+	env  *geom.Envelope
+	item T
+}
+
+/**
+ * Creates a new index with the default node capacity.
+ */
+func NewHPRtree[T any]() *HPRtree[T] {
+	return NewHPRtreeWithCapacity[T](constants.HPRTREE_DEFAULT_NODE_CAPACITY)
+}
+
+/**
+ * Creates a new index with the given node capacity.
+ *
+ * @param nodeCapacity the node capacity to use
+ */
+func NewHPRtreeWithCapacity[T any](nodeCapacity int) *HPRtree[T] {
+	tree := new(HPRtree[T])
+	if nodeCapacity < 2 {
+		nodeCapacity = 2
+	}
+	tree.nodeCapacity = nodeCapacity
+	tree.items = make([]hprtreeItem[T], 0)
+	tree.totalExtent = geom.DefaultEnvelope()
+	return tree
+}
+
+/**
+ * Gets the number of items in the index.
+ *
+ * @return the number of items
+ */
+func (tree *HPRtree[T]) Size() int {
+	if tree.isBuilt {
+		return len(tree.itemValues)
+	}
+	return len(tree.items)
+}
+
+/**
+ * Gets the extent of all items in the index.
+ *
+ * @return the extent of the index, which is null if the index is empty
+ */
+func (tree *HPRtree[T]) GetExtent() *geom.Envelope {
+	return geom.NewEnvelopeFromEnvelope(tree.totalExtent)
+}
+
+/**
+ * Inserts an item having the given bounds into the tree.
+ * Items with a null envelope are ignored.
+ *
+ * @param itemEnv the envelope of the item
+ * @param item the item to insert
+ * @return an error if the tree has already been built
+ */
+func (tree *HPRtree[T]) Insert(itemEnv *geom.Envelope, item T) error {
+	if tree.isBuilt {
+		return errors.New("cannot insert items after tree is built")
+	}
+	if itemEnv.IsNull() {
+		return nil
+	}
+	tree.items = append(tree.items, hprtreeItem[T]{itemEnv, item})
+	tree.totalExtent.ExpandToIncludeEnvelope(itemEnv)
+	return nil
+}
+
+/**
+ * Queries the index for all items whose extents intersect the given search Envelope.
+ *
+ * @param searchEnv the envelope to query for
+ * @return a list of the items found by the query
+ */
+func (tree *HPRtree[T]) Query(searchEnv *geom.Envelope) []T {
+	matches := make([]T, 0)
+	tree.QueryVisitor(searchEnv, func(item T) bool {
+		matches = append(matches, item)
+		return true
+	})
+	return matches
+}
+
+/**
+ * Queries the index for all items whose extents intersect the given search Envelope,
+ * and applies a visitor to them.
+ * The visitor returns false to stop the query.
+ *
+ * @param searchEnv the envelope to query for
+ * @param visitor a visitor to apply to the items found
+ */
+func (tree *HPRtree[T]) QueryVisitor(searchEnv *geom.Envelope, visitor func(item T) bool) {
+	tree.Build()
+	if !tree.totalExtent.IntersectsEnvelope(searchEnv) {
+		return
+	}
+	if tree.layerStartIndex == nil {
+		tree.queryItems(0, searchEnv, visitor)
+	} else {
+		tree.queryTopLayer(searchEnv, visitor)
+	}
+}
+
+/**
+ * Removal of items is not supported by an HPRtree.
+ *
+ * @return false, since the item cannot be removed
+ */
+func (tree *HPRtree[T]) Remove(itemEnv *geom.Envelope, item T) bool {
+	return false
+}
+
+func (tree *HPRtree[T]) queryTopLayer(searchEnv *geom.Envelope, visitor func(item T) bool) {
+	layerIndex := len(tree.layerStartIndex) - 2
+	layerSize := tree.layerSize(layerIndex)
+	// query each node in layer
+	for i := 0; i < layerSize; i += hprtree_ENV_SIZE {
+		if !tree.queryNode(layerIndex, i, searchEnv, visitor) {
+			return
+		}
+	}
+}
+
+func (tree *HPRtree[T]) queryNode(layerIndex int, nodeOffset int, searchEnv *geom.Envelope, visitor func(item T) bool) bool {
+	layerStart := tree.layerStartIndex[layerIndex]
+	nodeIndex := layerStart + nodeOffset
+	if !hprtreeIntersects(tree.nodeBounds, nodeIndex, searchEnv) {
+		return true
+	}
+	if layerIndex == 0 {
+		childNodesOffset := nodeOffset / hprtree_ENV_SIZE * tree.nodeCapacity
+		return tree.queryItems(childNodesOffset, searchEnv, visitor)
+	}
+	childNodesOffset := nodeOffset * tree.nodeCapacity
+	return tree.queryNodeChildren(layerIndex-1, childNodesOffset, searchEnv, visitor)
+}
+
+func hprtreeIntersects(bounds []float64, nodeIndex int, env *geom.Envelope) bool {
+	isBeyond := (env.GetMaxX() < bounds[nodeIndex]) ||
+		(env.GetMaxY() < bounds[nodeIndex+1]) ||
+		(env.GetMinX() > bounds[nodeIndex+2]) ||
+		(env.GetMinY() > bounds[nodeIndex+3])
+	return !isBeyond
+}
+
+func (tree *HPRtree[T]) queryNodeChildren(layerIndex int, blockOffset int, searchEnv *geom.Envelope, visitor func(item T) bool) bool {
+	layerStart := tree.layerStartIndex[layerIndex]
+	layerEnd := tree.layerStartIndex[layerIndex+1]
+	for i := 0; i < tree.nodeCapacity; i++ {
+		nodeOffset := blockOffset + hprtree_ENV_SIZE*i
+		// don't query past layer end
+		if layerStart+nodeOffset >= layerEnd {
+			break
+		}
+		if !tree.queryNode(layerIndex, nodeOffset, searchEnv, visitor) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tree *HPRtree[T]) queryItems(blockStart int, searchEnv *geom.Envelope, visitor func(item T) bool) bool {
+	for i := 0; i < tree.nodeCapacity; i++ {
+		itemIndex := blockStart + i
+		// don't query past end of items
+		if itemIndex >= len(tree.itemValues) {
+			break
+		}
+		if hprtreeIntersects(tree.itemBounds, itemIndex*hprtree_ENV_SIZE, searchEnv) {
+			if !visitor(tree.itemValues[itemIndex]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (tree *HPRtree[T]) layerSize(layerIndex int) int {
+	layerStart := tree.layerStartIndex[layerIndex]
+	layerEnd := tree.layerStartIndex[layerIndex+1]
+	return layerEnd - layerStart
+}
+
+/**
+ * Builds the index, if not already built.
+ */
+func (tree *HPRtree[T]) Build() {
+	if tree.isBuilt {
+		return
+	}
+	tree.prepareIndex()
+	tree.prepareItems()
+	tree.isBuilt = true
+}
+
+func (tree *HPRtree[T]) prepareIndex() {
+	// don't need to build an empty or very small tree
+	if len(tree.items) <= tree.nodeCapacity {
+		return
+	}
+
+	tree.sortItems()
+
+	tree.layerStartIndex = computeLayerIndices(len(tree.items), tree.nodeCapacity)
+	// allocate storage
+	nodeCount := tree.layerStartIndex[len(tree.layerStartIndex)-1] / 4
+	tree.nodeBounds = createBoundsArray(nodeCount)
+
+	// compute tree nodes
+	tree.computeLeafNodes(tree.layerStartIndex[1])
+	for i := 1; i < len(tree.layerStartIndex)-1; i++ {
+		tree.computeLayerNodes(i)
+	}
+}
+
+func (tree *HPRtree[T]) prepareItems() {
+	// copy item contents out to arrays for querying
+	boundsIndex := 0
+	tree.itemBounds = make([]float64, len(tree.items)*hprtree_ENV_SIZE)
+	tree.itemValues = make([]T, len(tree.items))
+	for i, item := range tree.items {
+		tree.itemValues[i] = item.item
+		tree.itemBounds[boundsIndex] = item.env.GetMinX()
+		tree.itemBounds[boundsIndex+1] = item.env.GetMinY()
+		tree.itemBounds[boundsIndex+2] = item.env.GetMaxX()
+		tree.itemBounds[boundsIndex+3] = item.env.GetMaxY()
+		boundsIndex += hprtree_ENV_SIZE
+	}
+	// and let GC free the original list
+	tree.items = nil
+}
+
+func createBoundsArray(size int) []float64 {
+	a := make([]float64, 4*size)
+	for i := 0; i < size; i++ {
+		index := 4 * i
+		a[index] = math.MaxFloat64
+		a[index+1] = math.MaxFloat64
+		a[index+2] = -math.MaxFloat64
+		a[index+3] = -math.MaxFloat64
+	}
+	return a
+}
+
+func (tree *HPRtree[T]) computeLayerNodes(layerIndex int) {
+	layerStart := tree.layerStartIndex[layerIndex]
+	childLayerStart := tree.layerStartIndex[layerIndex-1]
+	layerSize := tree.layerSize(layerIndex)
+	childLayerEnd := layerStart
+	for i := 0; i < layerSize; i += hprtree_ENV_SIZE {
+		childStart := childLayerStart + tree.nodeCapacity*i
+		tree.computeNodeBounds(layerStart+i, childStart, childLayerEnd)
+	}
+}
+
+func (tree *HPRtree[T]) computeNodeBounds(nodeIndex int, blockStart int, nodeMaxIndex int) {
+	for i := 0; i < tree.nodeCapacity; i++ {
+		index := blockStart + 4*i
+		if index >= nodeMaxIndex {
+			break
+		}
+		tree.updateNodeBounds(nodeIndex, tree.nodeBounds[index], tree.nodeBounds[index+1], tree.nodeBounds[index+2], tree.nodeBounds[index+3])
+	}
+}
+
+func (tree *HPRtree[T]) computeLeafNodes(layerSize int) {
+	for i := 0; i < layerSize; i += hprtree_ENV_SIZE {
+		tree.computeLeafNodeBounds(i, tree.nodeCapacity*i/4)
+	}
+}
+
+func (tree *HPRtree[T]) computeLeafNodeBounds(nodeIndex int, blockStart int) {
+	for i := 0; i < tree.nodeCapacity; i++ {
+		itemIndex := blockStart + i
+		if itemIndex >= len(tree.items) {
+			break
+		}
+		env := tree.items[itemIndex].env
+		tree.updateNodeBounds(nodeIndex, env.GetMinX(), env.GetMinY(), env.GetMaxX(), env.GetMaxY())
+	}
+}
+
+func (tree *HPRtree[T]) updateNodeBounds(nodeIndex int, minX float64, minY float64, maxX float64, maxY float64) {
+	if minX < tree.nodeBounds[nodeIndex] {
+		tree.nodeBounds[nodeIndex] = minX
+	}
+	if minY < tree.nodeBounds[nodeIndex+1] {
+		tree.nodeBounds[nodeIndex+1] = minY
+	}
+	if maxX > tree.nodeBounds[nodeIndex+2] {
+		tree.nodeBounds[nodeIndex+2] = maxX
+	}
+	if maxY > tree.nodeBounds[nodeIndex+3] {
+		tree.nodeBounds[nodeIndex+3] = maxY
+	}
+}
+
+/**
+ * Computes the offsets of the start of each layer in the node bounds array.
+ * The last entry is the size of the array.
+ */
+func computeLayerIndices(itemSize int, nodeCapacity int) []int {
+	layerIndexList := make([]int, 0)
+	layerSize := itemSize
+	index := 0
+	for {
+		layerIndexList = append(layerIndexList, index)
+		layerSize = numNodesToCover(layerSize, nodeCapacity)
+		index += hprtree_ENV_SIZE * layerSize
+		if layerSize <= 1 {
+			break
+		}
+	}
+	return append(layerIndexList, index)
+}
+
+/**
+ * Computes the number of blocks (nodes) required to
+ * cover a given number of children.
+ *
+ * @param nChild
+ * @param nodeCapacity
+ * @return the number of nodes needed to cover the children
+ */
+func numNodesToCover(nChild int, nodeCapacity int) int {
+	mult := nChild / nodeCapacity
+	total := mult * nodeCapacity
+	if total == nChild {
+		return mult
+	}
+	return mult + 1
+}
+
+/**
+ * Gets the extents of the internal index nodes
+ *
+ * @return a list of the internal node extents
+ */
+func (tree *HPRtree[T]) GetBounds() []*geom.Envelope {
+	tree.Build()
+	numNodes := len(tree.nodeBounds) / 4
+	extents := make([]*geom.Envelope, numNodes)
+	// create from largest to smallest
+	for i := numNodes - 1; i >= 0; i-- {
+		boundIndex := 4 * i
+		extents[i] = geom.NewEnvelope(tree.nodeBounds[boundIndex], tree.nodeBounds[boundIndex+2],
+			tree.nodeBounds[boundIndex+1], tree.nodeBounds[boundIndex+3])
+	}
+	return extents
+}
+
+func (tree *HPRtree[T]) sortItems() {
+	encoder := newHilbertEncoder(constants.HPRTREE_HILBERT_LEVEL, tree.totalExtent)
+	hilbertValues := make([]int, len(tree.items))
+	for i, item := range tree.items {
+		hilbertValues[i] = encoder.encode(item.env)
+	}
+	sort.Stable(hprtreeItemSorter[T]{tree.items, hilbertValues})
+}
+
+/**
+ * Sorts items and their Hilbert codes together, by code.
+ */
+type hprtreeItemSorter[T any] struct {
+	items         []hprtreeItem[T]
+	hilbertValues []int
+}
+
+func (sorter hprtreeItemSorter[T]) Len() int {
+	return len(sorter.items)
+}
+
+func (sorter hprtreeItemSorter[T]) Less(i, j int) bool {
+	return sorter.hilbertValues[i] < sorter.hilbertValues[j]
+}
+
+func (sorter hprtreeItemSorter[T]) Swap(i, j int) {
+	sorter.items[i], sorter.items[j] = sorter.items[j], sorter.items[i]
+	sorter.hilbertValues[i], sorter.hilbertValues[j] = sorter.hilbertValues[j], sorter.hilbertValues[i]
+}
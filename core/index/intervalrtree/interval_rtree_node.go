@@ -0,0 +1,120 @@
+package geos
+
+import (
+	"math"
+)
+
+/**
+ * A node of an interval R-tree.
+ * A node is either a leaf, which holds an item and its interval,
+ * or a branch, which has two child nodes and
+ * an interval covering the intervals of both children.
+ */
+type IntervalRTreeNode[T any] struct {
+	min    float64
+	max    float64
+	item   T
+	isLeaf bool
+	node1  *IntervalRTreeNode[T]
+	node2  *IntervalRTreeNode[T]
+}
+
+/**
+ * Creates a leaf node holding an item with the given interval.
+ *
+ * @param min the lower bound of the interval
+ * @param max the upper bound of the interval
+ * @param item the item
+ */
+func NewIntervalRTreeLeafNode[T any](min float64, max float64, item T) *IntervalRTreeNode[T] {
+	node := new(IntervalRTreeNode[T])
+	node.min = min
+	node.max = max
+	node.item = item
+	node.isLeaf = true
+	return node
+}
+
+/**
+ * Creates a branch node with two children.
+ *
+ * @param node1 a child node
+ * @param node2 another child node
+ */
+func NewIntervalRTreeBranchNode[T any](node1 *IntervalRTreeNode[T], node2 *IntervalRTreeNode[T]) *IntervalRTreeNode[T] {
+	node := new(IntervalRTreeNode[T])
+	node.node1 = node1
+	node.node2 = node2
+	node.min = math.Min(node1.min, node2.min)
+	node.max = math.Max(node1.max, node2.max)
+	return node
+}
+
+/**
+ * Gets the lower bound of the interval of this node.
+ */
+func (node *IntervalRTreeNode[T]) GetMin() float64 {
+	return node.min
+}
+
+/**
+ * Gets the upper bound of the interval of this node.
+ */
+func (node *IntervalRTreeNode[T]) GetMax() float64 {
+	return node.max
+}
+
+/**
+ * Tests whether this node is a leaf holding an item.
+ */
+func (node *IntervalRTreeNode[T]) IsLeaf() bool {
+	return node.isLeaf
+}
+
+/**
+ * Gets the item held by a leaf node.
+ */
+func (node *IntervalRTreeNode[T]) GetItem() T {
+	return node.item
+}
+
+/**
+ * Gets the child nodes of a branch node.
+ * The children of a leaf node are nil.
+ */
+func (node *IntervalRTreeNode[T]) GetChildren() (*IntervalRTreeNode[T], *IntervalRTreeNode[T]) {
+	return node.node1, node.node2
+}
+
+/**
+ * Tests whether the interval of this node intersects a query interval.
+ *
+ * @param queryMin the lower bound of the query interval
+ * @param queryMax the upper bound of the query interval
+ * @return true if the intervals intersect
+ */
+func (node *IntervalRTreeNode[T]) Intersects(queryMin float64, queryMax float64) bool {
+	if node.min > queryMax || node.max < queryMin {
+		return false
+	}
+	return true
+}
+
+/**
+ * Visits the items in the subtree of this node whose intervals intersect
+ * a query interval.
+ *
+ * @return false if the visitor stopped the query
+ */
+func (node *IntervalRTreeNode[T]) query(queryMin float64, queryMax float64, visitor func(item T) bool) bool {
+	if !node.Intersects(queryMin, queryMax) {
+		return true
+	}
+	if node.isLeaf {
+		return visitor(node.item)
+	}
+	if !node.node1.query(queryMin, queryMax, visitor) {
+		return false
+	}
+	return node.node2.query(queryMin, queryMax, visitor)
+}
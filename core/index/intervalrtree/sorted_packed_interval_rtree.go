@@ -0,0 +1,151 @@
+package geos
+
+import (
+	"errors"
+	"sort"
+)
+
+/**
+ * A static index on a set of 1-dimensional intervals,
+ * using an R-Tree packed based on the order of the interval midpoints.
+ * It supports range searching,
+ * where the range is an interval of the real line (which may be a single point).
+ * A common use is to index 1-dimensional intervals which
+ * are the projection of 2-D objects onto an axis of the coordinate system.
+ * <p>
+ * This index structure is <i>static</i>
+ * - items cannot be added or removed once the first query has been made.
+ * The advantage of this characteristic is that the index performance
+ * can be optimized based on a fixed set of items.
+ */
+type SortedPackedIntervalRTree[T any] struct {
+	leaves []*IntervalRTreeNode[T]
+
+	/**
+	 * If root is null that indicates
+	 * that the tree has not yet been built,
+	 * OR nothing has been added to the tree.
+	 * In both cases, the tree is still open for insertions.
+	 */
+	root    *IntervalRTreeNode[T]
+	isBuilt bool
+}
+
+/**
+ * Creates an empty index.
+ */
+func NewSortedPackedIntervalRTree[T any]() *SortedPackedIntervalRTree[T] {
+	tree := new(SortedPackedIntervalRTree[T])
+	tree.leaves = make([]*IntervalRTreeNode[T], 0)
+	return tree
+}
+
+/**
+ * Adds an item to the index which is associated with the given interval
+ *
+ * @param min the lower bound of the item interval
+ * @param max the upper bound of the item interval
+ * @param item the item to insert
+ *
+ * @return an error if the index has already been queried
+ */
+func (tree *SortedPackedIntervalRTree[T]) Insert(min float64, max float64, item T) error {
+	if tree.isBuilt {
+		return errors.New("cannot add items to a SortedPackedIntervalRTree after it has been built")
+	}
+	tree.leaves = append(tree.leaves, NewIntervalRTreeLeafNode(min, max, item))
+	return nil
+}
+
+/**
+ * Gets the number of items in the index.
+ */
+func (tree *SortedPackedIntervalRTree[T]) Size() int {
+	return len(tree.leaves)
+}
+
+/**
+ * Builds the index, if not already built.
+ * This is done automatically on the first query.
+ */
+func (tree *SortedPackedIntervalRTree[T]) Build() {
+	if tree.isBuilt {
+		return
+	}
+	tree.isBuilt = true
+	// if leaves is empty then nothing has been inserted.
+	// In this case it is safe to leave the tree in an open state
+	if len(tree.leaves) == 0 {
+		return
+	}
+	tree.root = buildTree(tree.leaves)
+}
+
+/**
+ * Gets the root node of the index,
+ * which is nil if the index is empty.
+ */
+func (tree *SortedPackedIntervalRTree[T]) GetRoot() *IntervalRTreeNode[T] {
+	tree.Build()
+	return tree.root
+}
+
+func buildTree[T any](leaves []*IntervalRTreeNode[T]) *IntervalRTreeNode[T] {
+	// sort the leaf nodes
+	src := make([]*IntervalRTreeNode[T], len(leaves))
+	copy(src, leaves)
+	sort.SliceStable(src, func(i, j int) bool {
+		mid1 := (src[i].min + src[i].max) / 2
+		mid2 := (src[j].min + src[j].max) / 2
+		return mid1 < mid2
+	})
+
+	// now group nodes into blocks of two and build tree up recursively
+	for len(src) > 1 {
+		dest := make([]*IntervalRTreeNode[T], 0, (len(src)+1)/2)
+		for i := 0; i < len(src); i += 2 {
+			if i+1 < len(src) {
+				dest = append(dest, NewIntervalRTreeBranchNode(src[i], src[i+1]))
+			} else {
+				dest = append(dest, src[i])
+			}
+		}
+		src = dest
+	}
+	return src[0]
+}
+
+/**
+ * Search for intervals in the index which intersect the given closed interval
+ * and apply the visitor to them.
+ * The visitor returns false to stop the query.
+ *
+ * @param min the lower bound of the query interval
+ * @param max the upper bound of the query interval
+ * @param visitor the visitor to pass any matched items to
+ */
+func (tree *SortedPackedIntervalRTree[T]) QueryVisitor(min float64, max float64, visitor func(item T) bool) {
+	tree.Build()
+
+	// if root is null tree must be empty
+	if tree.root == nil {
+		return
+	}
+	tree.root.query(min, max, visitor)
+}
+
+/**
+ * Search for intervals in the index which intersect the given closed interval.
+ *
+ * @param min the lower bound of the query interval
+ * @param max the upper bound of the query interval
+ * @return the items whose intervals intersect the query interval
+ */
+func (tree *SortedPackedIntervalRTree[T]) Query(min float64, max float64) []T {
+	items := make([]T, 0)
+	tree.QueryVisitor(min, max, func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
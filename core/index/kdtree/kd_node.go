@@ -0,0 +1,175 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A node of a {@link KdTree}, which represents one or more points in the same location.
+ */
+type KdNode struct {
+	p     geom.Coordinate
+	data  any
+	left  *KdNode
+	right *KdNode
+	count int
+}
+
+/**
+ * Creates a new KdNode.
+ *
+ * @param p point location of new node
+ * @param data a data object to associate with this node
+ */
+func NewKdNode(p *geom.Coordinate, data any) *KdNode {
+	node := new(KdNode)
+	node.p = *p
+	node.data = data
+	node.count = 1
+	return node
+}
+
+/**
+ * Returns the X coordinate of the node
+ *
+ * @return X coordinate of the node
+ */
+func (node *KdNode) GetX() float64 {
+	return node.p.X
+}
+
+/**
+ * Returns the Y coordinate of the node
+ *
+ * @return Y coordinate of the node
+ */
+func (node *KdNode) GetY() float64 {
+	return node.p.Y
+}
+
+/**
+ * Gets the split value at a node, depending on
+ * whether the node splits on X or Y.
+ * The X (or Y) ordinates of all points in the left subtree
+ * are less than the split value, and those
+ * in the right subtree are greater than or equal to the split value.
+ *
+ * @param isSplitOnX whether the node splits on X or Y
+ * @return the splitting value
+ */
+func (node *KdNode) SplitValue(isSplitOnX bool) float64 {
+	if isSplitOnX {
+		return node.p.X
+	}
+	return node.p.Y
+}
+
+/**
+ * Returns the location of this node
+ *
+ * @return p location of this node
+ */
+func (node *KdNode) GetCoordinate() *geom.Coordinate {
+	return &node.p
+}
+
+/**
+ * Gets the user data object associated with this node.
+ * @return user data
+ */
+func (node *KdNode) GetData() any {
+	return node.data
+}
+
+/**
+ * Returns the left node of the tree
+ *
+ * @return left node
+ */
+func (node *KdNode) GetLeft() *KdNode {
+	return node.left
+}
+
+/**
+ * Returns the right node of the tree
+ *
+ * @return right node
+ */
+func (node *KdNode) GetRight() *KdNode {
+	return node.right
+}
+
+// Increments counts of points at this location
+func (node *KdNode) increment() {
+	node.count = node.count + 1
+}
+
+/**
+ * Returns the number of inserted points that are coincident at this location.
+ *
+ * @return number of inserted points that this node represents
+ */
+func (node *KdNode) GetCount() int {
+	return node.count
+}
+
+/**
+ * Tests whether more than one point with this value have been inserted (up to the tolerance)
+ *
+ * @return true if more than one point have been inserted with this value
+ */
+func (node *KdNode) IsRepeated() bool {
+	return node.count > 1
+}
+
+/**
+ * Tests whether the node's left subtree may contain values
+ * in a given range envelope.
+ *
+ * @param isSplitOnX whether the node splits on  X or Y
+ * @param env the range envelope
+ * @return true if the left subtree is in range
+ */
+func (node *KdNode) isRangeOverLeft(isSplitOnX bool, env *geom.Envelope) bool {
+	envMin := env.GetMinY()
+	if isSplitOnX {
+		envMin = env.GetMinX()
+	}
+	return envMin < node.SplitValue(isSplitOnX)
+}
+
+/**
+ * Tests whether the node's right subtree may contain values
+ * in a given range envelope.
+ *
+ * @param isSplitOnX whether the node splits on  X or Y
+ * @param env the range envelope
+ * @return true if the right subtree is in range
+ */
+func (node *KdNode) isRangeOverRight(isSplitOnX bool, env *geom.Envelope) bool {
+	envMax := env.GetMaxY()
+	if isSplitOnX {
+		envMax = env.GetMaxX()
+	}
+	return node.SplitValue(isSplitOnX) <= envMax
+}
+
+/**
+ * Tests whether a point is strictly to the left
+ * of the splitting plane for this node.
+ * If so it may be in the left subtree of this node,
+ * Otherwise, the point may be in the right subtree.
+ * The point is to the left if its X (or Y) ordinate
+ * is less than the split value.
+ *
+ * @param isSplitOnX whether the node splits on  X or Y
+ * @param pt the query point
+ * @return true if the point is strictly to the left.
+ */
+func (node *KdNode) isPointOnLeft(isSplitOnX bool, pt *geom.Coordinate) bool {
+	ptOrdinate := pt.Y
+	if isSplitOnX {
+		ptOrdinate = pt.X
+	}
+	return ptOrdinate < node.SplitValue(isSplitOnX)
+}
@@ -0,0 +1,386 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * An implementation of a
+ * <a href='https://en.wikipedia.org/wiki/K-d_tree'>KD-Tree</a>
+ * over two dimensions (X and Y).
+ * KD-trees provide fast range searching and fast lookup for point data.
+ * The tree is built dynamically by inserting points.
+ * The tree supports queries by range and for point equality.
+ * For querying an internal stack is used instead of recursion to avoid overflow.
+ * <p>
+ * This implementation supports detecting and snapping points which are closer
+ * than a given distance tolerance.
+ * If the same point (up to tolerance) is inserted
+ * more than once, it is snapped to the existing node.
+ * In other words, if a point is inserted which lies
+ * within the tolerance of a node already in the index,
+ * it is snapped to that node.
+ * When an inserted point is snapped to a node then a new node is not created
+ * but the count of the existing node is incremented.
+ * If more than one node in the tree is within tolerance of an inserted point,
+ * the closest and then lowest node is snapped to.
+ * <p>
+ * The structure of a KD-Tree depends on the order of insertion of the points.
+ * A tree may become unbalanced if the inserted points are coherent
+ * (e.g. monotonic in one or both dimensions).
+ * A perfectly balanced tree has depth of only log2(N),
+ * but an unbalanced tree may be much deeper.
+ * This has a serious impact on query efficiency.
+ * One solution to this is to randomize the order of points before insertion
+ * (e.g. by using <a href="https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle">Fisher-Yates shuffling</a>).
+ */
+type KdTree struct {
+	root          *KdNode
+	numberOfNodes int
+	tolerance     float64
+}
+
+/**
+ * Creates a new instance of a KdTree with a snapping tolerance of 0.0. (I.e.
+ * distinct points will <i>not</i> be snapped)
+ */
+func DefaultKdTree() *KdTree {
+	return NewKdTree(0.0)
+}
+
+/**
+ * Creates a new instance of a KdTree with a snapping distance
+ * tolerance. Points which lie closer than the tolerance to a point already
+ * in the tree will be treated as identical to the existing point.
+ *
+ * @param tolerance
+ *          the tolerance distance for considering two points equal
+ */
+func NewKdTree(tolerance float64) *KdTree {
+	tree := new(KdTree)
+	tree.tolerance = tolerance
+	return tree
+}
+
+/**
+ * Converts a collection of {@link KdNode}s to an array of {@link Coordinate}s.
+ *
+ * @param kdnodes a collection of nodes
+ * @param includeRepeated true if repeated nodes should
+ *   be included multiple times
+ * @return an array of the coordinates represented by the nodes
+ */
+func KdTreeToCoordinates(kdnodes []*KdNode, includeRepeated bool) []geom.Coordinate {
+	coord := geom.DefaultCoordinateList()
+	for _, node := range kdnodes {
+		count := 1
+		if includeRepeated {
+			count = node.GetCount()
+		}
+		for i := 0; i < count; i++ {
+			coord.AddCoordinateRepeated(node.GetCoordinate(), true)
+		}
+	}
+	return coord.ToCoordinateArray()
+}
+
+/**
+ * Gets the root node of this tree.
+ *
+ * @return the root node of the tree
+ */
+func (tree *KdTree) GetRoot() *KdNode {
+	return tree.root
+}
+
+/**
+ * Tests whether the index contains any items.
+ *
+ * @return true if the index does not contain any items
+ */
+func (tree *KdTree) IsEmpty() bool {
+	return tree.root == nil
+}
+
+/**
+ * Gets the snapping tolerance of this tree.
+ */
+func (tree *KdTree) GetTolerance() float64 {
+	return tree.tolerance
+}
+
+/**
+ * Inserts a new point in the kd-tree, with no data.
+ *
+ * @param p
+ *          the point to insert
+ * @return the kdnode containing the point
+ */
+func (tree *KdTree) Insert(p *geom.Coordinate) *KdNode {
+	return tree.InsertWithData(p, nil)
+}
+
+/**
+ * Inserts a new point into the kd-tree.
+ *
+ * @param p
+ *          the point to insert
+ * @param data
+ *          a data item for the point
+ * @return a new KdNode if a new point is inserted, else an existing
+ *         node is returned with its counter incremented. This can be checked
+ *         by testing returnedNode.getCount() &gt; 1.
+ */
+func (tree *KdTree) InsertWithData(p *geom.Coordinate, data any) *KdNode {
+	if tree.root == nil {
+		tree.root = NewKdNode(p, data)
+		tree.numberOfNodes = 1
+		return tree.root
+	}
+
+	/**
+	 * Check if the point is already in the tree, up to tolerance.
+	 * If tolerance is zero, this phase of the insertion can be skipped.
+	 */
+	if tree.tolerance > 0 {
+		matchNode := tree.findBestMatchNode(p)
+		if matchNode != nil {
+			// point already in index - increment counter
+			matchNode.increment()
+			return matchNode
+		}
+	}
+
+	return tree.insertExact(p, data)
+}
+
+/**
+ * Finds the node in the tree which is the best match for a point
+ * being inserted.
+ * The match is made deterministic by returning the lowest of any nodes which
+ * lie the same distance from the point.
+ * There may be no match if the point is not within the distance tolerance of any
+ * existing node.
+ *
+ * @param p the point being inserted
+ * @return the best matching node
+ * @return null if no match was found
+ */
+func (tree *KdTree) findBestMatchNode(p *geom.Coordinate) *KdNode {
+	queryEnv := geom.NewEnvelopeFromCoordinate(p)
+	queryEnv.ExpandBy(tree.tolerance)
+
+	var matchNode *KdNode
+	matchDist := 0.0
+	tree.QueryVisitor(queryEnv, func(node *KdNode) {
+		dist := p.Distance(node.GetCoordinate())
+		isInTolerance := dist <= tree.tolerance
+		if !isInTolerance {
+			return
+		}
+		// if distances are the same, record the lesser coordinate
+		if matchNode == nil || dist < matchDist ||
+			(dist == matchDist && node.GetCoordinate().CompareTo(matchNode.GetCoordinate()) < 1) {
+			matchNode = node
+			matchDist = dist
+		}
+	})
+	return matchNode
+}
+
+/**
+ * Inserts a point known to be beyond the distance tolerance of any existing node.
+ * The point is inserted at the bottom of the exact splitting path,
+ * so that tree shape is deterministic.
+ *
+ * @param p the point to insert
+ * @param data the data for the point
+ * @return the created node
+ */
+func (tree *KdTree) insertExact(p *geom.Coordinate, data any) *KdNode {
+	currentNode := tree.root
+	leafNode := tree.root
+	isXLevel := true
+	isLessThan := true
+
+	/**
+	 * Traverse the tree, first cutting the plane left-right (by X ordinate)
+	 * then top-bottom (by Y ordinate)
+	 */
+	for currentNode != nil {
+		isInTolerance := p.Distance(currentNode.GetCoordinate()) <= tree.tolerance
+
+		// check if point is already in tree (up to tolerance) and if so simply
+		// return existing node
+		if isInTolerance {
+			currentNode.increment()
+			return currentNode
+		}
+
+		splitValue := currentNode.SplitValue(isXLevel)
+		if isXLevel {
+			isLessThan = p.X < splitValue
+		} else {
+			isLessThan = p.Y < splitValue
+		}
+		leafNode = currentNode
+		if isLessThan {
+			currentNode = currentNode.GetLeft()
+		} else {
+			currentNode = currentNode.GetRight()
+		}
+
+		isXLevel = !isXLevel
+	}
+
+	// no node found, add new leaf node to tree
+	tree.numberOfNodes = tree.numberOfNodes + 1
+	node := NewKdNode(p, data)
+	if isLessThan {
+		leafNode.left = node
+	} else {
+		leafNode.right = node
+	}
+	return node
+}
+
+type kdQueryStackFrame struct {
+	node     *KdNode
+	isXLevel bool
+}
+
+/**
+ * Performs a range search of the points in the index and visits all nodes found.
+ *
+ * @param queryEnv
+ *          the range rectangle to query
+ * @param visitor a visitor to visit all nodes found by the search
+ */
+func (tree *KdTree) QueryVisitor(queryEnv *geom.Envelope, visitor func(node *KdNode)) {
+	queryStack := make([]kdQueryStackFrame, 0)
+	currentNode := tree.root
+	isXLevel := true
+
+	// search is computed via in-order traversal
+	for {
+		if currentNode != nil {
+			queryStack = append(queryStack, kdQueryStackFrame{currentNode, isXLevel})
+
+			searchLeft := currentNode.isRangeOverLeft(isXLevel, queryEnv)
+			if searchLeft {
+				currentNode = currentNode.GetLeft()
+				if currentNode != nil {
+					isXLevel = !isXLevel
+				}
+			} else {
+				currentNode = nil
+			}
+		} else if len(queryStack) > 0 {
+			// currentNode is empty, so pop stack
+			frame := queryStack[len(queryStack)-1]
+			queryStack = queryStack[:len(queryStack)-1]
+			currentNode = frame.node
+			isXLevel = frame.isXLevel
+
+			//-- check if search matches current node
+			if queryEnv.CoversCoordinate(currentNode.GetCoordinate()) {
+				visitor(currentNode)
+			}
+
+			searchRight := currentNode.isRangeOverRight(isXLevel, queryEnv)
+			if searchRight {
+				currentNode = currentNode.GetRight()
+				if currentNode != nil {
+					isXLevel = !isXLevel
+				}
+			} else {
+				currentNode = nil
+			}
+		} else {
+			//-- stack is empty and no current node
+			return
+		}
+	}
+}
+
+/**
+ * Performs a range search of the points in the index.
+ *
+ * @param queryEnv
+ *          the range rectangle to query
+ * @return a list of the KdNodes found
+ */
+func (tree *KdTree) Query(queryEnv *geom.Envelope) []*KdNode {
+	result := make([]*KdNode, 0)
+	tree.QueryVisitor(queryEnv, func(node *KdNode) {
+		result = append(result, node)
+	})
+	return result
+}
+
+/**
+ * Searches for a given point in the index and returns its node if found.
+ *
+ * @param queryPt the point to query
+ * @return the point node, if it is found in the index, or null if not
+ */
+func (tree *KdTree) QueryPoint(queryPt *geom.Coordinate) *KdNode {
+	currentNode := tree.root
+	isXLevel := true
+
+	for currentNode != nil {
+		if currentNode.GetCoordinate().Equals2D(queryPt) {
+			return currentNode
+		}
+
+		searchLeft := currentNode.isPointOnLeft(isXLevel, queryPt)
+		if searchLeft {
+			currentNode = currentNode.GetLeft()
+		} else {
+			currentNode = currentNode.GetRight()
+		}
+		isXLevel = !isXLevel
+	}
+	//-- point not found
+	return nil
+}
+
+/**
+ * Computes the depth of the tree.
+ *
+ * @return the depth of the tree
+ */
+func (tree *KdTree) Depth() int {
+	return depthNode(tree.root)
+}
+
+func depthNode(currentNode *KdNode) int {
+	if currentNode == nil {
+		return 0
+	}
+
+	dL := depthNode(currentNode.GetLeft())
+	dR := depthNode(currentNode.GetRight())
+	return 1 + int(math.Max(float64(dL), float64(dR)))
+}
+
+/**
+ * Computes the size (number of items) in the tree.
+ *
+ * @return the size of the tree
+ */
+func (tree *KdTree) Size() int {
+	return sizeNode(tree.root)
+}
+
+func sizeNode(currentNode *KdNode) int {
+	if currentNode == nil {
+		return 0
+	}
+
+	sizeL := sizeNode(currentNode.GetLeft())
+	sizeR := sizeNode(currentNode.GetRight())
+	return 1 + sizeL + sizeR
+}
@@ -0,0 +1,55 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Snaps the points of an array to the first inserted point
+ * lying within a distance tolerance.
+ * Unlike removing consecutive repeated points,
+ * this snaps together points which are close but not adjacent in the array.
+ * The X and Y ordinates of each point are replaced by those of the
+ * point it snaps to; Z and M values are kept.
+ * The result has the same length as the input,
+ * so it may contain repeated points
+ * (which can be removed using a {@link CoordinateList}).
+ *
+ * @param pts the points to snap
+ * @param tolerance the snapping distance tolerance
+ * @return a new array of the snapped points
+ */
+func KdTreeSnapCoordinates(pts []geom.Coordinate, tolerance float64) []geom.Coordinate {
+	tree := NewKdTree(tolerance)
+	snapped := make([]geom.Coordinate, len(pts))
+	for i := range pts {
+		node := tree.Insert(&pts[i])
+		snapped[i] = pts[i]
+		snapped[i].X = node.GetX()
+		snapped[i].Y = node.GetY()
+	}
+	return snapped
+}
+
+/**
+ * Removes the points of an array which lie within a distance tolerance of
+ * a point occurring earlier in the array.
+ * The remaining points are returned in their original order.
+ * A tolerance of 0 removes only exact (2D) duplicates.
+ *
+ * @param pts the points to deduplicate
+ * @param tolerance the snapping distance tolerance
+ * @return a new array of the distinct points
+ */
+func KdTreeRemoveDuplicates(pts []geom.Coordinate, tolerance float64) []geom.Coordinate {
+	tree := NewKdTree(tolerance)
+	unique := make([]geom.Coordinate, 0, len(pts))
+	for i := range pts {
+		node := tree.Insert(&pts[i])
+		if node.IsRepeated() {
+			continue
+		}
+		unique = append(unique, pts[i])
+	}
+	return unique
+}
@@ -0,0 +1,39 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+)
+
+/**
+ * Determines whether an interval is small enough
+ * to be treated as having zero width, in which case
+ * it cannot be reliably subdivided by a quadtree node.
+ * The width is tested relative to the magnitude of the interval bounds,
+ * since this determines the precision available to represent a subdivision.
+ *
+ * @param min the lower bound of the interval
+ * @param max the upper bound of the interval
+ * @return true if the interval should be considered to have zero width
+ */
+func IntervalSizeIsZeroWidth(min float64, max float64) bool {
+	width := max - min
+	if width == 0.0 {
+		return true
+	}
+
+	maxAbs := math.Max(math.Abs(min), math.Abs(max))
+	scaledInterval := width / maxAbs
+	level := binaryExponent(scaledInterval)
+	return level <= constants.INTERVALSIZE_MIN_BINARY_EXPONENT
+}
+
+/**
+ * Computes the unbiased binary exponent of a double,
+ * i.e. the value e such that 2^e <= |d| < 2^(e+1).
+ */
+func binaryExponent(d float64) int {
+	_, exp := math.Frexp(d)
+	return exp - 1
+}
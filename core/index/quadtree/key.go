@@ -0,0 +1,56 @@
+package geos
+
+import (
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A Key is a unique identifier for a node in a quadtree.
+ * It contains a lower-left point and a level number. The level number
+ * is the power of two for the size of the node envelope.
+ */
+type quadtreeKey struct {
+	// the fields which make up the key
+	pt    *geom.Coordinate
+	level int
+	// auxiliary data which is derived from the key for use in computation
+	env *geom.Envelope
+}
+
+func newQuadtreeKey(itemEnv *geom.Envelope) *quadtreeKey {
+	key := new(quadtreeKey)
+	key.pt = geom.DefaultCoordinateXY()
+	key.env = geom.DefaultEnvelope()
+	key.computeKey(itemEnv)
+	return key
+}
+
+func computeQuadLevel(env *geom.Envelope) int {
+	dx := env.GetWidth()
+	dy := env.GetHeight()
+	dMax := math.Max(dx, dy)
+	return binaryExponent(dMax) + 1
+}
+
+/**
+ * return a square envelope containing the argument envelope,
+ * whose extent is a power of two and which is based at a power of 2
+ */
+func (key *quadtreeKey) computeKey(itemEnv *geom.Envelope) {
+	key.level = computeQuadLevel(itemEnv)
+	key.computeKeyAtLevel(key.level, itemEnv)
+	// MD - would be nice to have a non-iterative form of this algorithm
+	for !key.env.CoversEnvelope(itemEnv) {
+		key.level += 1
+		key.computeKeyAtLevel(key.level, itemEnv)
+	}
+}
+
+func (key *quadtreeKey) computeKeyAtLevel(level int, itemEnv *geom.Envelope) {
+	quadSize := math.Ldexp(1, level)
+	key.pt.X = math.Floor(itemEnv.GetMinX()/quadSize) * quadSize
+	key.pt.Y = math.Floor(itemEnv.GetMinY()/quadSize) * quadSize
+	key.env.Init(key.pt.X, key.pt.X+quadSize, key.pt.Y, key.pt.Y+quadSize)
+}
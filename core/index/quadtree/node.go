@@ -0,0 +1,385 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A node of a {@link Quadtree}.
+ * Nodes are square, with extents which are a power of 2,
+ * and are subdivided into four quadrants about their centre.
+ * <p>
+ * The root node is special: it has no extent, and its quadrants
+ * are the quadrants of the plane about the origin (0,0).
+ * This makes it possible to grow the tree to cover any inserted item
+ * without rebuilding it.
+ * Items which cross the X or Y axis are stored in the root node.
+ * <p>
+ * Subnodes are numbered as follows:
+ * <pre>
+ *  2 | 3
+ *  --+--
+ *  0 | 1
+ * </pre>
+ */
+type quadtreeNode[T comparable] struct {
+	env     *geom.Envelope
+	centrex float64
+	centrey float64
+	level   int
+	isRoot  bool
+	items   []T
+	subnode [4]*quadtreeNode[T]
+}
+
+func newQuadtreeRoot[T comparable]() *quadtreeNode[T] {
+	node := new(quadtreeNode[T])
+	node.isRoot = true
+	node.items = make([]T, 0)
+	return node
+}
+
+func newQuadtreeNode[T comparable](env *geom.Envelope, level int) *quadtreeNode[T] {
+	node := new(quadtreeNode[T])
+	node.env = env
+	node.level = level
+	node.centrex = (env.GetMinX() + env.GetMaxX()) / 2
+	node.centrey = (env.GetMinY() + env.GetMaxY()) / 2
+	node.items = make([]T, 0)
+	return node
+}
+
+func createQuadtreeNode[T comparable](env *geom.Envelope) *quadtreeNode[T] {
+	key := newQuadtreeKey(env)
+	return newQuadtreeNode[T](key.env, key.level)
+}
+
+func createExpandedQuadtreeNode[T comparable](node *quadtreeNode[T], addEnv *geom.Envelope) *quadtreeNode[T] {
+	expandEnv := geom.NewEnvelopeFromEnvelope(addEnv)
+	if node != nil {
+		expandEnv.ExpandToIncludeEnvelope(node.env)
+	}
+
+	largerNode := createQuadtreeNode[T](expandEnv)
+	if node != nil {
+		largerNode.insertNode(node)
+	}
+	return largerNode
+}
+
+/**
+ * Gets the index of the subquad that wholly contains the given envelope.
+ * If none does, returns -1.
+ *
+ * @return the index of the subquad that wholly contains the given envelope
+ * or -1 if no subquad wholly contains the envelope
+ */
+func getSubnodeIndex(env *geom.Envelope, centrex float64, centrey float64) int {
+	subnodeIndex := -1
+	if env.GetMinX() >= centrex {
+		if env.GetMinY() >= centrey {
+			subnodeIndex = 3
+		}
+		if env.GetMaxY() <= centrey {
+			subnodeIndex = 1
+		}
+	}
+	if env.GetMaxX() <= centrex {
+		if env.GetMinY() >= centrey {
+			subnodeIndex = 2
+		}
+		if env.GetMaxY() <= centrey {
+			subnodeIndex = 0
+		}
+	}
+	return subnodeIndex
+}
+
+func (node *quadtreeNode[T]) isSearchMatch(searchEnv *geom.Envelope) bool {
+	if node.isRoot {
+		return true
+	}
+	if searchEnv == nil {
+		return false
+	}
+	return node.env.IntersectsEnvelope(searchEnv)
+}
+
+func (node *quadtreeNode[T]) hasItems() bool {
+	return len(node.items) > 0
+}
+
+func (node *quadtreeNode[T]) hasChildren() bool {
+	for _, subnode := range node.subnode {
+		if subnode != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (node *quadtreeNode[T]) isPrunable() bool {
+	return !(node.hasChildren() || node.hasItems())
+}
+
+func (node *quadtreeNode[T]) isEmpty() bool {
+	if node.hasItems() {
+		return false
+	}
+	for _, subnode := range node.subnode {
+		if subnode != nil && !subnode.isEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *quadtreeNode[T]) add(item T) {
+	node.items = append(node.items, item)
+}
+
+/**
+ * Removes a single item from this subtree.
+ *
+ * @param itemEnv the envelope containing the item
+ * @param item the item to remove
+ * @return <code>true</code> if the item was found and removed
+ */
+func (node *quadtreeNode[T]) remove(itemEnv *geom.Envelope, item T) bool {
+	// use envelope to restrict nodes scanned
+	if !node.isSearchMatch(itemEnv) {
+		return false
+	}
+
+	for i, subnode := range node.subnode {
+		if subnode != nil && subnode.remove(itemEnv, item) {
+			// trim subtree if empty
+			if subnode.isPrunable() {
+				node.subnode[i] = nil
+			}
+			return true
+		}
+	}
+
+	// if item was not found lower down, try removing it from this node
+	index := slices.Index(node.items, item)
+	if index < 0 {
+		return false
+	}
+	node.items = slices.Delete(node.items, index, index+1)
+	return true
+}
+
+func (node *quadtreeNode[T]) addAllItems(resultItems []T) []T {
+	// this node may have items as well as subnodes (since items may not
+	// be wholly contained in any single subnode
+	resultItems = append(resultItems, node.items...)
+	for _, subnode := range node.subnode {
+		if subnode != nil {
+			resultItems = subnode.addAllItems(resultItems)
+		}
+	}
+	return resultItems
+}
+
+/**
+ * Visits the items of the nodes which intersect the search envelope.
+ *
+ * @return false if the visitor stopped the traversal
+ */
+func (node *quadtreeNode[T]) visit(searchEnv *geom.Envelope, visitor func(item T) bool) bool {
+	if !node.isSearchMatch(searchEnv) {
+		return true
+	}
+
+	// this node may have items as well as subnodes (since items may not
+	// be wholly contained in any single subnode
+	for _, item := range node.items {
+		if !visitor(item) {
+			return false
+		}
+	}
+
+	for _, subnode := range node.subnode {
+		if subnode != nil && !subnode.visit(searchEnv, visitor) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *quadtreeNode[T]) depth() int {
+	maxSubDepth := 0
+	for _, subnode := range node.subnode {
+		if subnode != nil {
+			maxSubDepth = max(maxSubDepth, subnode.depth())
+		}
+	}
+	return maxSubDepth + 1
+}
+
+func (node *quadtreeNode[T]) size() int {
+	subSize := 0
+	for _, subnode := range node.subnode {
+		if subnode != nil {
+			subSize += subnode.size()
+		}
+	}
+	return subSize + len(node.items)
+}
+
+func (node *quadtreeNode[T]) nodeCount() int {
+	subSize := 0
+	for _, subnode := range node.subnode {
+		if subnode != nil {
+			subSize += subnode.nodeCount()
+		}
+	}
+	return subSize + 1
+}
+
+/**
+ * Returns the subquad containing the envelope <tt>searchEnv</tt>.
+ * Creates the subquad if
+ * it does not already exist.
+ *
+ * @return the subquad containing the search envelope
+ */
+func (node *quadtreeNode[T]) getNode(searchEnv *geom.Envelope) *quadtreeNode[T] {
+	subnodeIndex := getSubnodeIndex(searchEnv, node.centrex, node.centrey)
+	// if subquadIndex is -1 searchEnv is not contained in a subquad
+	if subnodeIndex != -1 {
+		// create the quad if it does not exist
+		return node.getSubnode(subnodeIndex).getNode(searchEnv)
+	}
+	return node
+}
+
+/**
+ * Returns the smallest <i>existing</i>
+ * node containing the envelope.
+ */
+func (node *quadtreeNode[T]) find(searchEnv *geom.Envelope) *quadtreeNode[T] {
+	subnodeIndex := getSubnodeIndex(searchEnv, node.centrex, node.centrey)
+	if subnodeIndex == -1 {
+		return node
+	}
+	if node.subnode[subnodeIndex] != nil {
+		// query lies in subquad, so search it
+		return node.subnode[subnodeIndex].find(searchEnv)
+	}
+	// no existing subquad, so return this one anyway
+	return node
+}
+
+func (node *quadtreeNode[T]) insertNode(child *quadtreeNode[T]) {
+	index := getSubnodeIndex(child.env, node.centrex, node.centrey)
+	if child.level == node.level-1 {
+		node.subnode[index] = child
+	} else {
+		// the quad is not a direct child, so make a new child quad to contain it
+		// and recursively insert the quad
+		childNode := node.createSubnode(index)
+		childNode.insertNode(child)
+		node.subnode[index] = childNode
+	}
+}
+
+/**
+ * get the subquad for the index.
+ * If it doesn't exist, create it
+ */
+func (node *quadtreeNode[T]) getSubnode(index int) *quadtreeNode[T] {
+	if node.subnode[index] == nil {
+		node.subnode[index] = node.createSubnode(index)
+	}
+	return node.subnode[index]
+}
+
+func (node *quadtreeNode[T]) createSubnode(index int) *quadtreeNode[T] {
+	// create a new subquad in the appropriate quadrant
+	minx := 0.0
+	maxx := 0.0
+	miny := 0.0
+	maxy := 0.0
+
+	switch index {
+	case 0:
+		minx = node.env.GetMinX()
+		maxx = node.centrex
+		miny = node.env.GetMinY()
+		maxy = node.centrey
+	case 1:
+		minx = node.centrex
+		maxx = node.env.GetMaxX()
+		miny = node.env.GetMinY()
+		maxy = node.centrey
+	case 2:
+		minx = node.env.GetMinX()
+		maxx = node.centrex
+		miny = node.centrey
+		maxy = node.env.GetMaxY()
+	case 3:
+		minx = node.centrex
+		maxx = node.env.GetMaxX()
+		miny = node.centrey
+		maxy = node.env.GetMaxY()
+	}
+	sqEnv := geom.NewEnvelope(minx, maxx, miny, maxy)
+	return newQuadtreeNode[T](sqEnv, node.level-1)
+}
+
+/**
+ * Insert an item into the quadtree this is the root of.
+ */
+func (root *quadtreeNode[T]) insertIntoRoot(itemEnv *geom.Envelope, item T) {
+	index := getSubnodeIndex(itemEnv, 0, 0)
+	// if index is -1, itemEnv must cross the X or Y axis.
+	if index == -1 {
+		root.add(item)
+		return
+	}
+
+	/**
+	 * the item must be contained in one quadrant, so insert it into the
+	 * tree for that quadrant (which may not yet exist)
+	 */
+	node := root.subnode[index]
+	/**
+	 *  If the subquad doesn't exist or this item is not contained in it,
+	 *  have to expand the tree upward to contain the item.
+	 */
+	if node == nil || !node.env.CoversEnvelope(itemEnv) {
+		root.subnode[index] = createExpandedQuadtreeNode(node, itemEnv)
+	}
+
+	/**
+	 * At this point we have a subquad which exists and must contain
+	 * contains the env for the item.  Insert the item into the tree.
+	 */
+	insertContained(root.subnode[index], itemEnv, item)
+}
+
+/**
+ * insert an item which is known to be contained in the tree rooted at
+ * the given QuadNode root.  Lower levels of the tree will be created
+ * if necessary to hold the item.
+ */
+func insertContained[T comparable](tree *quadtreeNode[T], itemEnv *geom.Envelope, item T) {
+	/**
+	 * Do NOT create a new quad for zero-area envelopes - this would lead
+	 * to infinite recursion. Instead, use a heuristic of simply returning
+	 * the smallest existing quad containing the query
+	 */
+	isZeroX := IntervalSizeIsZeroWidth(itemEnv.GetMinX(), itemEnv.GetMaxX())
+	isZeroY := IntervalSizeIsZeroWidth(itemEnv.GetMinY(), itemEnv.GetMaxY())
+	var node *quadtreeNode[T]
+	if isZeroX || isZeroY {
+		node = tree.find(itemEnv)
+	} else {
+		node = tree.getNode(itemEnv)
+	}
+	node.add(item)
+}
@@ -0,0 +1,211 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A Quadtree is a spatial index structure for efficient range querying
+ * of items bounded by 2D rectangles.
+ * Items may be of any comparable type; items are matched for removal using <code>==</code>.
+ * <p>
+ * This Quadtree index provides a <b>primary filter</b>
+ * for range rectangle queries.  The various query methods return a list of
+ * all items which <i>may</i> intersect the query rectangle.  Note that
+ * it may thus return items which do <b>not</b> in fact intersect the query rectangle.
+ * A secondary filter is required to test for actual intersection
+ * between the query rectangle and the envelope of each candidate item.
+ * The secondary filter may be performed explicitly,
+ * or it may be provided implicitly by subsequent operations executed on the items
+ * (for instance, if the index query is followed by computing a spatial predicate
+ * between the query geometry and tree items,
+ * the envelope intersection check is performed automatically.
+ * <p>
+ * This implementation does not require specifying the extent of the inserted
+ * items beforehand.  It will automatically expand to accommodate any extent
+ * of dataset.
+ * <p>
+ * Items may be inserted and removed at any time, which makes the Quadtree
+ * suitable for dynamic data.
+ * Items with a zero-width or zero-height envelope (such as points) are
+ * expanded to a minimum extent derived from the other inserted items,
+ * so they can be indexed reliably.
+ * <p>
+ * This data structure is also known as an <i>MX-CIF quadtree</i>
+ * following the terminology of Samet and others.
+ */
+type Quadtree[T comparable] struct {
+	root *quadtreeNode[T]
+
+	/**
+	 * minExtent is the minimum envelope extent of all items
+	 * inserted into the tree so far. It is used as a heuristic value
+	 * to construct non-zero envelopes for features with zero X and/or Y extent.
+	 * Start with a non-zero extent, in case the first feature inserted has
+	 * a zero extent in both directions.  This value may be non-optimal, but
+	 * only one feature will be inserted with this value.
+	 **/
+	minExtent float64
+}
+
+/**
+ * Constructs a Quadtree with zero items.
+ */
+func NewQuadtree[T comparable]() *Quadtree[T] {
+	tree := new(Quadtree[T])
+	tree.root = newQuadtreeRoot[T]()
+	tree.minExtent = 1.0
+	return tree
+}
+
+/**
+ * Ensure that the envelope for the inserted item has non-zero extents.
+ * Use the current minExtent to pad the envelope, if necessary
+ */
+func QuadtreeEnsureExtent(itemEnv *geom.Envelope, minExtent float64) *geom.Envelope {
+	//The names "ensureExtent" and "minExtent" are misleading -- sounds like
+	//this method ensures that the extents are greater than minExtent.
+	//Perhaps we should rename them to "ensurePositiveExtent" and "defaultExtent".
+	//[Jon Aquino]
+	minx := itemEnv.GetMinX()
+	maxx := itemEnv.GetMaxX()
+	miny := itemEnv.GetMinY()
+	maxy := itemEnv.GetMaxY()
+	// has a non-zero extent
+	if minx != maxx && miny != maxy {
+		return itemEnv
+	}
+
+	// pad one or both extents
+	if minx == maxx {
+		minx = minx - minExtent/2.0
+		maxx = maxx + minExtent/2.0
+	}
+	if miny == maxy {
+		miny = miny - minExtent/2.0
+		maxy = maxy + minExtent/2.0
+	}
+	return geom.NewEnvelope(minx, maxx, miny, maxy)
+}
+
+/**
+ * Returns the number of levels in the tree.
+ */
+func (tree *Quadtree[T]) Depth() int {
+	return tree.root.depth()
+}
+
+/**
+ * Tests whether the index contains any items.
+ *
+ * @return true if the index does not contain any items
+ */
+func (tree *Quadtree[T]) IsEmpty() bool {
+	return tree.root.isEmpty()
+}
+
+/**
+ * Returns the number of items in the tree.
+ *
+ * @return the number of items in the tree
+ */
+func (tree *Quadtree[T]) Size() int {
+	return tree.root.size()
+}
+
+/**
+ * Returns the number of nodes in the tree, including the root.
+ */
+func (tree *Quadtree[T]) NodeCount() int {
+	return tree.root.nodeCount()
+}
+
+/**
+ * Inserts an item having the given bounds into the tree.
+ * Items with a null envelope are ignored.
+ *
+ * @param itemEnv the envelope of the item
+ * @param item the item to insert
+ * @return always nil, since items can be inserted at any time
+ */
+func (tree *Quadtree[T]) Insert(itemEnv *geom.Envelope, item T) error {
+	if itemEnv.IsNull() {
+		return nil
+	}
+	tree.collectStats(itemEnv)
+	insertEnv := QuadtreeEnsureExtent(itemEnv, tree.minExtent)
+	tree.root.insertIntoRoot(insertEnv, item)
+	return nil
+}
+
+/**
+ * Removes a single item from the tree.
+ *
+ * @param itemEnv the Envelope of the item to be removed
+ * @param item the item to remove
+ * @return <code>true</code> if the item was found (and thus removed)
+ */
+func (tree *Quadtree[T]) Remove(itemEnv *geom.Envelope, item T) bool {
+	posEnv := QuadtreeEnsureExtent(itemEnv, tree.minExtent)
+	return tree.root.remove(posEnv, item)
+}
+
+/**
+ * Queries the tree and returns items which may lie in the given search envelope.
+ * Precisely, the items that are returned are all items in the tree
+ * whose envelope <b>may</b> intersect the search Envelope.
+ * Note that some items with non-intersecting envelopes may be returned as well;
+ * the client is responsible for filtering these out.
+ * In most situations there will be many items in the tree which do not
+ * intersect the search envelope and which are not returned - thus
+ * providing improved performance over a simple linear scan.
+ *
+ * @param searchEnv the envelope of the desired query area.
+ * @return a list of items which may intersect the search envelope
+ */
+func (tree *Quadtree[T]) Query(searchEnv *geom.Envelope) []T {
+	matches := make([]T, 0)
+	tree.QueryVisitor(searchEnv, func(item T) bool {
+		matches = append(matches, item)
+		return true
+	})
+	return matches
+}
+
+/**
+ * Searches for items which may intersect the given search envelope,
+ * and applies a visitor to them.
+ * The visitor returns false to stop the query.
+ * Note that the visitor may be applied to items whose envelopes
+ * do not intersect the search envelope.
+ *
+ * @param searchEnv the envelope of the desired query area.
+ * @param visitor a visitor to apply to the items found
+ */
+func (tree *Quadtree[T]) QueryVisitor(searchEnv *geom.Envelope, visitor func(item T) bool) {
+	/**
+	 * the items that are matched are the items in quads which
+	 * overlap the search envelope
+	 */
+	tree.root.visit(searchEnv, visitor)
+}
+
+/**
+ * Return a list of all items in the Quadtree
+ */
+func (tree *Quadtree[T]) QueryAll() []T {
+	foundItems := make([]T, 0)
+	return tree.root.addAllItems(foundItems)
+}
+
+func (tree *Quadtree[T]) collectStats(itemEnv *geom.Envelope) {
+	delX := itemEnv.GetWidth()
+	if delX < tree.minExtent && delX > 0.0 {
+		tree.minExtent = delX
+	}
+
+	delY := itemEnv.GetHeight()
+	if delY < tree.minExtent && delY > 0.0 {
+		tree.minExtent = delY
+	}
+}
@@ -0,0 +1,44 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * The basic operations supported by spatial indexes
+ * which store items keyed by envelopes,
+ * allowing implementations to be used interchangeably.
+ * <p>
+ * Queries act as a primary filter: they return all items whose envelopes
+ * intersect the search envelope, and some implementations may
+ * also return items whose envelopes do not.
+ *
+ * @see STRtree
+ * @see HPRtree
+ * @see Quadtree
+ */
+type SpatialIndex[T any] interface {
+	/**
+	 * Adds a spatial item with an extent specified by the given {@link Envelope} to the index.
+	 * Returns an error if the index does not accept further items.
+	 */
+	Insert(itemEnv *geom.Envelope, item T) error
+
+	/**
+	 * Queries the index for all items whose extents intersect the given search {@link Envelope}.
+	 */
+	Query(searchEnv *geom.Envelope) []T
+
+	/**
+	 * Queries the index for all items whose extents intersect the given search {@link Envelope},
+	 * and applies a visitor to them.
+	 * The visitor returns false to stop the query.
+	 */
+	QueryVisitor(searchEnv *geom.Envelope, visitor func(item T) bool)
+
+	/**
+	 * Removes a single item from the tree.
+	 * Returns true if the item was found and removed.
+	 */
+	Remove(itemEnv *geom.Envelope, item T) bool
+}
@@ -0,0 +1,228 @@
+package geos
+
+import (
+	"container/heap"
+	"math"
+)
+
+/**
+ * A pair of nodes, which may be either tree nodes or items.
+ * Used to compute the distance between the members,
+ * and to expand a member relative to the other
+ * in order to produce new branches of the
+ * Branch-and-Bound evaluation tree.
+ * Provides an ordering based on the distance between the members,
+ * which allows building a priority queue by minimum distance.
+ */
+type boundablePair[T comparable] struct {
+	boundable1   *strNode[T]
+	boundable2   *strNode[T]
+	distance     float64
+	itemDistance ItemDistance[T]
+}
+
+func newBoundablePair[T comparable](boundable1 *strNode[T], boundable2 *strNode[T], itemDistance ItemDistance[T]) *boundablePair[T] {
+	bp := new(boundablePair[T])
+	bp.boundable1 = boundable1
+	bp.boundable2 = boundable2
+	bp.itemDistance = itemDistance
+	bp.distance = bp.computeDistance()
+	return bp
+}
+
+/**
+ * Computes the distance between the members of this pair.
+ * If the members are both items, the item distance is used.
+ * Otherwise, the distance between the envelopes is used,
+ * which is a lower bound on the item distances.
+ */
+func (bp *boundablePair[T]) computeDistance() float64 {
+	if bp.isLeaves() {
+		return bp.itemDistance(bp.boundable1.item, bp.boundable2.item)
+	}
+	return bp.boundable1.getBounds().Distance(bp.boundable2.getBounds())
+}
+
+/**
+ * Tests if both elements of the pair are items.
+ */
+func (bp *boundablePair[T]) isLeaves() bool {
+	return bp.boundable1.isItem && bp.boundable2.isItem
+}
+
+/**
+ * For a pair which is not a leaf
+ * (i.e. has at least one composite boundable)
+ * computes a list of new pairs
+ * from the expansion of the larger boundable
+ * with distance less than minDistance
+ * and adds them to a priority queue.
+ */
+func (bp *boundablePair[T]) expandToQueue(priQ *boundablePairQueue[T], minDistance float64) {
+	isComp1 := !bp.boundable1.isItem
+	isComp2 := !bp.boundable2.isItem
+
+	// HEURISTIC: If both boundable are composite,
+	// choose the one with largest area to expand.
+	if isComp1 && isComp2 {
+		if bp.boundable1.getBounds().GetArea() > bp.boundable2.getBounds().GetArea() {
+			bp.expand(bp.boundable1, bp.boundable2, false, priQ, minDistance)
+		} else {
+			bp.expand(bp.boundable2, bp.boundable1, true, priQ, minDistance)
+		}
+		return
+	}
+	if isComp1 {
+		bp.expand(bp.boundable1, bp.boundable2, false, priQ, minDistance)
+		return
+	}
+	if isComp2 {
+		bp.expand(bp.boundable2, bp.boundable1, true, priQ, minDistance)
+	}
+}
+
+func (bp *boundablePair[T]) expand(bndComposite *strNode[T], bndOther *strNode[T], isFlipped bool, priQ *boundablePairQueue[T], minDistance float64) {
+	for _, child := range bndComposite.children {
+		//-- empty nodes can be left behind by removals
+		if !child.isItem && len(child.children) == 0 {
+			continue
+		}
+		//-- an item is never paired with itself
+		if child == bndOther {
+			continue
+		}
+		var childPair *boundablePair[T]
+		if isFlipped {
+			childPair = newBoundablePair(bndOther, child, bp.itemDistance)
+		} else {
+			childPair = newBoundablePair(child, bndOther, bp.itemDistance)
+		}
+		// only add to queue if this pair might contain the closest points
+		if childPair.distance < minDistance {
+			heap.Push(priQ, childPair)
+		}
+	}
+}
+
+/**
+ * A priority queue of pairs, ordered by minimum or maximum distance.
+ */
+type boundablePairQueue[T comparable] struct {
+	pairs  []*boundablePair[T]
+	isMaxQ bool
+}
+
+func (q *boundablePairQueue[T]) Len() int {
+	return len(q.pairs)
+}
+
+func (q *boundablePairQueue[T]) Less(i, j int) bool {
+	if q.isMaxQ {
+		return q.pairs[i].distance > q.pairs[j].distance
+	}
+	return q.pairs[i].distance < q.pairs[j].distance
+}
+
+func (q *boundablePairQueue[T]) Swap(i, j int) {
+	q.pairs[i], q.pairs[j] = q.pairs[j], q.pairs[i]
+}
+
+func (q *boundablePairQueue[T]) Push(x any) {
+	q.pairs = append(q.pairs, x.(*boundablePair[T]))
+}
+
+func (q *boundablePairQueue[T]) Pop() any {
+	n := len(q.pairs)
+	pair := q.pairs[n-1]
+	q.pairs = q.pairs[:n-1]
+	return pair
+}
+
+func (q *boundablePairQueue[T]) peek() *boundablePair[T] {
+	return q.pairs[0]
+}
+
+/**
+ * Finds the nearest pair of items using a Branch-and-Bound search.
+ */
+func nearestNeighbourPair[T comparable](initBndPair *boundablePair[T]) *boundablePair[T] {
+	distanceLowerBound := math.Inf(1)
+	var minPair *boundablePair[T]
+
+	priQ := &boundablePairQueue[T]{}
+	heap.Push(priQ, initBndPair)
+
+	for priQ.Len() > 0 && distanceLowerBound > 0.0 {
+		// pop head of queue and expand one side of pair
+		bndPair := heap.Pop(priQ).(*boundablePair[T])
+		currentDistance := bndPair.distance
+
+		/**
+		 * If the distance for the first pair in the queue
+		 * is >= current minimum distance, other nodes
+		 * in the queue must also have a greater distance.
+		 * So the current minDistance must be the true minimum,
+		 * and we are done.
+		 */
+		if currentDistance >= distanceLowerBound {
+			break
+		}
+
+		/**
+		 * If the pair members are leaves
+		 * then their distance is the exact lower bound.
+		 * Update the distanceLowerBound to reflect this
+		 * (which must be smaller, due to the test
+		 * immediately prior to this).
+		 */
+		if bndPair.isLeaves() {
+			distanceLowerBound = currentDistance
+			minPair = bndPair
+		} else {
+			bndPair.expandToQueue(priQ, distanceLowerBound)
+		}
+	}
+	return minPair
+}
+
+/**
+ * Finds the k nearest pairs of items using a Branch-and-Bound search.
+ * The pairs are returned in order of increasing distance.
+ */
+func nearestNeighbourPairsK[T comparable](initBndPair *boundablePair[T], k int) []*boundablePair[T] {
+	distanceLowerBound := math.Inf(1)
+
+	priQ := &boundablePairQueue[T]{}
+	heap.Push(priQ, initBndPair)
+	kNearestNeighbors := &boundablePairQueue[T]{isMaxQ: true}
+
+	for priQ.Len() > 0 && distanceLowerBound >= 0.0 {
+		bndPair := heap.Pop(priQ).(*boundablePair[T])
+		currentDistance := bndPair.distance
+
+		if currentDistance >= distanceLowerBound {
+			break
+		}
+
+		if bndPair.isLeaves() {
+			if kNearestNeighbors.Len() < k {
+				heap.Push(kNearestNeighbors, bndPair)
+			} else {
+				// replace the furthest of the current neighbours
+				heap.Pop(kNearestNeighbors)
+				heap.Push(kNearestNeighbors, bndPair)
+			}
+			if kNearestNeighbors.Len() == k {
+				distanceLowerBound = kNearestNeighbors.peek().distance
+			}
+		} else {
+			bndPair.expandToQueue(priQ, distanceLowerBound)
+		}
+	}
+
+	pairs := make([]*boundablePair[T], kNearestNeighbors.Len())
+	for i := len(pairs) - 1; i >= 0; i-- {
+		pairs[i] = heap.Pop(kNearestNeighbors).(*boundablePair[T])
+	}
+	return pairs
+}
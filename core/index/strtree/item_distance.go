@@ -0,0 +1,15 @@
+package geos
+
+/**
+ * A function for computing a distance between two items,
+ * used in nearest neighbour searches of an {@link STRtree}.
+ * <p>
+ * The distance must be a metric lower-bounded by the distance
+ * between the item envelopes, since this is used to prune
+ * the search.
+ *
+ * @param item1 an item
+ * @param item2 another item
+ * @return the distance between the items
+ */
+type ItemDistance[T any] func(item1 T, item2 T) float64
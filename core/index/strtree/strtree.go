@@ -0,0 +1,499 @@
+package geos
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ *  A query-only R-tree created using the Sort-Tile-Recursive (STR) algorithm.
+ *  For two-dimensional spatial data.
+ * <P>
+ *  The STR packed R-tree is simple to implement and maximizes space
+ *  utilization; that is, as many leaves as possible are filled to capacity.
+ *  Overlap between nodes is far less than in a basic R-tree.
+ *  However, the index is semi-static; once the tree has been built
+ *  (which happens automatically upon the first query), items may
+ *  not be added.
+ *  Items may be removed from the tree using {@link #Remove}.
+ *  <P>
+ * Described in: P. Rigaux, Michel Scholl and Agnes Voisard.
+ * <i>Spatial Databases With Application To GIS</i>.
+ * Morgan Kaufmann, San Francisco, 2002.
+ * <p>
+ * Items are of any comparable type; items are matched for removal using <code>==</code>.
+ * <p>
+ * <b>Note that inserting items into a tree is not thread-safe.</b>
+ * Inserting performed on more than one thread must be synchronized externally.
+ * <p>
+ * Querying a tree is thread-safe.
+ * The building phase is done synchronously, at most once,
+ * and querying is stateless.
+ */
+type STRtree[T comparable] struct {
+	root           *strNode[T]
+	built          bool
+	buildOnce      sync.Once
+	itemBoundables []*strNode[T]
+	nodeCapacity   int
+}
+
+/**
+ * A node of the tree, or an item stored in it.
+ * Items are the leaves of the tree, and have no children.
+ */
+type strNode[T comparable] struct {
+	bounds   *geom.Envelope
+	level    int
+	children []*strNode[T]
+	item     T
+	isItem   bool
+}
+
+/**
+ * Constructs an STRtree with the default node capacity.
+ */
+func NewSTRtree[T comparable]() *STRtree[T] {
+	return NewSTRtreeWithCapacity[T](constants.STRTREE_DEFAULT_NODE_CAPACITY)
+}
+
+/**
+ * Constructs an STRtree with the given maximum number of child nodes that
+ * a node may have.
+ * <p>
+ * The minimum recommended capacity setting is 4.
+ *
+ * @param nodeCapacity the maximum number of child nodes in a node
+ */
+func NewSTRtreeWithCapacity[T comparable](nodeCapacity int) *STRtree[T] {
+	tree := new(STRtree[T])
+	if nodeCapacity < 2 {
+		nodeCapacity = 2
+	}
+	tree.nodeCapacity = nodeCapacity
+	tree.itemBoundables = make([]*strNode[T], 0)
+	return tree
+}
+
+/**
+ * Returns the maximum number of child nodes that a node may have.
+ *
+ * @return the node capacity
+ */
+func (tree *STRtree[T]) GetNodeCapacity() int {
+	return tree.nodeCapacity
+}
+
+/**
+ * Inserts an item having the given bounds into the tree.
+ * Items with a null envelope are ignored.
+ *
+ * @param itemEnv the envelope of the item
+ * @param item the item to insert
+ * @return an error if the tree has already been built
+ */
+func (tree *STRtree[T]) Insert(itemEnv *geom.Envelope, item T) error {
+	if tree.built {
+		return errors.New("cannot insert items into an STR packed R-tree after it has been built")
+	}
+	if itemEnv.IsNull() {
+		return nil
+	}
+	tree.itemBoundables = append(tree.itemBoundables, &strNode[T]{bounds: itemEnv, item: item, isItem: true})
+	return nil
+}
+
+/**
+ * Creates parent nodes, grandparent nodes, and so forth up to the root
+ * node, for the data that has been inserted into the tree. Can only be
+ * called once, and thus can be called only after all of the data has been
+ * inserted into the tree.
+ * The bounds of all nodes are computed during the build,
+ * so that the tree is not modified by queries.
+ */
+func (tree *STRtree[T]) Build() {
+	tree.buildOnce.Do(tree.build)
+}
+
+func (tree *STRtree[T]) build() {
+	if len(tree.itemBoundables) == 0 {
+		tree.root = &strNode[T]{bounds: geom.DefaultEnvelope(), level: 0, children: make([]*strNode[T], 0)}
+	} else {
+		tree.root = tree.createHigherLevels(tree.itemBoundables, -1)
+	}
+	// the item list is no longer needed
+	tree.itemBoundables = nil
+	tree.built = true
+}
+
+/**
+ * Tests whether the index contains any items.
+ * This method does not build the index,
+ * so items can still be inserted after it has been called.
+ *
+ * @return true if the index does not contain any items
+ */
+func (tree *STRtree[T]) IsEmpty() bool {
+	if !tree.built {
+		return len(tree.itemBoundables) == 0
+	}
+	return len(tree.root.children) == 0
+}
+
+/**
+ * Returns the number of items in the tree.
+ *
+ * @return the number of items in the tree
+ */
+func (tree *STRtree[T]) Size() int {
+	tree.Build()
+	if tree.IsEmpty() {
+		return 0
+	}
+	return tree.root.size()
+}
+
+/**
+ * Returns the number of levels in the tree.
+ * An empty tree has depth 0.
+ *
+ * @return the depth of the tree
+ */
+func (tree *STRtree[T]) Depth() int {
+	tree.Build()
+	if tree.IsEmpty() {
+		return 0
+	}
+	return tree.root.depth()
+}
+
+/**
+ * Sorts the boundables into vertical slices by their centre X,
+ * and packs each slice into parent nodes by centre Y.
+ */
+func (tree *STRtree[T]) createParentBoundables(childBoundables []*strNode[T], newLevel int) []*strNode[T] {
+	minLeafCount := int(math.Ceil(float64(len(childBoundables)) / float64(tree.nodeCapacity)))
+	sortedChildBoundables := make([]*strNode[T], len(childBoundables))
+	copy(sortedChildBoundables, childBoundables)
+	sort.SliceStable(sortedChildBoundables, func(i, j int) bool {
+		return centreX(sortedChildBoundables[i].getBounds()) < centreX(sortedChildBoundables[j].getBounds())
+	})
+	verticalSlices := verticalSlices(sortedChildBoundables, int(math.Ceil(math.Sqrt(float64(minLeafCount)))))
+
+	parentBoundables := make([]*strNode[T], 0)
+	for _, slice := range verticalSlices {
+		parentBoundables = append(parentBoundables, tree.createParentBoundablesFromVerticalSlice(slice, newLevel)...)
+	}
+	return parentBoundables
+}
+
+func (tree *STRtree[T]) createParentBoundablesFromVerticalSlice(childBoundables []*strNode[T], newLevel int) []*strNode[T] {
+	sortedChildBoundables := make([]*strNode[T], len(childBoundables))
+	copy(sortedChildBoundables, childBoundables)
+	sort.SliceStable(sortedChildBoundables, func(i, j int) bool {
+		return centreY(sortedChildBoundables[i].getBounds()) < centreY(sortedChildBoundables[j].getBounds())
+	})
+
+	parentBoundables := make([]*strNode[T], 0)
+	var lastNode *strNode[T]
+	for _, childBoundable := range sortedChildBoundables {
+		if lastNode == nil || len(lastNode.children) == tree.nodeCapacity {
+			lastNode = &strNode[T]{level: newLevel, children: make([]*strNode[T], 0, tree.nodeCapacity)}
+			parentBoundables = append(parentBoundables, lastNode)
+		}
+		lastNode.children = append(lastNode.children, childBoundable)
+	}
+	for _, parent := range parentBoundables {
+		parent.computeBounds()
+	}
+	return parentBoundables
+}
+
+/**
+ * @param childBoundables Must be sorted by the x-value of the envelope midpoints
+ */
+func verticalSlices[T comparable](childBoundables []*strNode[T], sliceCount int) [][]*strNode[T] {
+	sliceCapacity := int(math.Ceil(float64(len(childBoundables)) / float64(sliceCount)))
+	slices := make([][]*strNode[T], 0, sliceCount)
+	for i := 0; i < len(childBoundables); i += sliceCapacity {
+		end := min(i+sliceCapacity, len(childBoundables))
+		slices = append(slices, childBoundables[i:end])
+	}
+	return slices
+}
+
+/**
+ * Creates the levels higher than the given level
+ *
+ * @param boundablesOfALevel
+ *            the level to build on
+ * @param level
+ *            the level of the Boundables, or -1 if the boundables are item
+ *            boundables (that is, below level 0)
+ * @return the root, which may be a ParentNode or a LeafNode
+ */
+func (tree *STRtree[T]) createHigherLevels(boundablesOfALevel []*strNode[T], level int) *strNode[T] {
+	parentBoundables := tree.createParentBoundables(boundablesOfALevel, level+1)
+	if len(parentBoundables) == 1 {
+		return parentBoundables[0]
+	}
+	return tree.createHigherLevels(parentBoundables, level+1)
+}
+
+/**
+ * Queries the index for all items whose extents intersect the given search Envelope.
+ * Note that some kinds of indexes may also return objects which do not in fact
+ * intersect the query envelope.
+ *
+ * @param searchEnv the envelope to query for
+ * @return a list of the items found by the query
+ */
+func (tree *STRtree[T]) Query(searchEnv *geom.Envelope) []T {
+	matches := make([]T, 0)
+	tree.QueryVisitor(searchEnv, func(item T) bool {
+		matches = append(matches, item)
+		return true
+	})
+	return matches
+}
+
+/**
+ * Queries the index for all items whose extents intersect the given search Envelope,
+ * and applies a visitor to them.
+ * The visitor returns false to stop the query.
+ * No memory is allocated by the query itself.
+ *
+ * @param searchEnv the envelope to query for
+ * @param visitor a visitor to apply to the items found
+ */
+func (tree *STRtree[T]) QueryVisitor(searchEnv *geom.Envelope, visitor func(item T) bool) {
+	tree.Build()
+	if tree.IsEmpty() {
+		return
+	}
+	if tree.root.getBounds().IntersectsEnvelope(searchEnv) {
+		queryInternal(searchEnv, tree.root, visitor)
+	}
+}
+
+func queryInternal[T comparable](searchEnv *geom.Envelope, node *strNode[T], visitor func(item T) bool) bool {
+	for _, child := range node.children {
+		if !child.getBounds().IntersectsEnvelope(searchEnv) {
+			continue
+		}
+		if child.isItem {
+			if !visitor(child.item) {
+				return false
+			}
+		} else if !queryInternal(searchEnv, child, visitor) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * Removes a single item from the tree.
+ * The item is matched using <code>==</code>.
+ * Items can be removed before or after the tree is built.
+ *
+ * @param itemEnv the Envelope of the item to remove
+ * @param item the item to remove
+ * @return <code>true</code> if the item was found
+ */
+func (tree *STRtree[T]) Remove(itemEnv *geom.Envelope, item T) bool {
+	if !tree.built {
+		for i, itemBoundable := range tree.itemBoundables {
+			if itemBoundable.item == item {
+				tree.itemBoundables = append(tree.itemBoundables[:i], tree.itemBoundables[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+	if tree.IsEmpty() {
+		return false
+	}
+	if tree.root.getBounds().IntersectsEnvelope(itemEnv) {
+		return removeInternal(itemEnv, tree.root, item)
+	}
+	return false
+}
+
+func removeInternal[T comparable](searchBounds *geom.Envelope, node *strNode[T], item T) bool {
+	// first try removing item from this node
+	for i, child := range node.children {
+		if child.isItem && child.item == item {
+			node.children = append(node.children[:i], node.children[i+1:]...)
+			return true
+		}
+	}
+	// next try removing item from lower nodes
+	for i, child := range node.children {
+		if child.isItem || !child.getBounds().IntersectsEnvelope(searchBounds) {
+			continue
+		}
+		if removeInternal(searchBounds, child, item) {
+			// prune child if possible
+			if len(child.children) == 0 {
+				node.children = append(node.children[:i], node.children[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Gets the bounds of a node.
+ * An empty node has a null envelope.
+ */
+func (node *strNode[T]) getBounds() *geom.Envelope {
+	return node.bounds
+}
+
+/**
+ * Computes the bounds of a parent node from the bounds of its children.
+ */
+func (node *strNode[T]) computeBounds() {
+	bounds := geom.DefaultEnvelope()
+	for _, child := range node.children {
+		bounds.ExpandToIncludeEnvelope(child.getBounds())
+	}
+	node.bounds = bounds
+}
+
+func (node *strNode[T]) size() int {
+	size := 0
+	for _, child := range node.children {
+		if child.isItem {
+			size += 1
+		} else {
+			size += child.size()
+		}
+	}
+	return size
+}
+
+func (node *strNode[T]) depth() int {
+	maxChildDepth := 0
+	for _, child := range node.children {
+		if !child.isItem {
+			maxChildDepth = max(maxChildDepth, child.depth())
+		}
+	}
+	return maxChildDepth + 1
+}
+
+func centreX(env *geom.Envelope) float64 {
+	return (env.GetMinX() + env.GetMaxX()) / 2
+}
+
+func centreY(env *geom.Envelope) float64 {
+	return (env.GetMinY() + env.GetMaxY()) / 2
+}
+
+/**
+ * Finds the item in this tree which is nearest to the given item,
+ * using the given distance metric.
+ * <p>
+ * The query item does not have to be
+ * contained in the tree, but it does
+ * have to be compatible with the <code>itemDist</code>
+ * distance metric.
+ *
+ * @param env the envelope of the query item
+ * @param item the item to find the nearest neighbour of
+ * @param itemDist a distance metric applicable to the items in this tree and the query item
+ * @return the nearest item in this tree, and false if the tree is empty
+ */
+func (tree *STRtree[T]) NearestNeighbour(env *geom.Envelope, item T, itemDist ItemDistance[T]) (T, bool) {
+	var nearest T
+	tree.Build()
+	if tree.IsEmpty() {
+		return nearest, false
+	}
+	bnd := &strNode[T]{bounds: env, item: item, isItem: true}
+	bp := newBoundablePair(tree.root, bnd, itemDist)
+	minPair := nearestNeighbourPair(bp)
+	if minPair == nil {
+		return nearest, false
+	}
+	return minPair.boundable1.item, true
+}
+
+/**
+ * Finds up to k items in this tree which are nearest to the given item,
+ * using the given distance metric.
+ * The items are returned in order of increasing distance.
+ *
+ * @param env the envelope of the query item
+ * @param item the item to find the nearest neighbours of
+ * @param itemDist a distance metric applicable to the items in this tree and the query item
+ * @param k the maximum number of nearest items to find
+ * @return the k nearest items in this tree, nearest first
+ */
+func (tree *STRtree[T]) NearestNeighbourK(env *geom.Envelope, item T, itemDist ItemDistance[T], k int) []T {
+	items := make([]T, 0)
+	tree.Build()
+	if tree.IsEmpty() || k <= 0 {
+		return items
+	}
+	bnd := &strNode[T]{bounds: env, item: item, isItem: true}
+	bp := newBoundablePair(tree.root, bnd, itemDist)
+	for _, pair := range nearestNeighbourPairsK(bp, k) {
+		items = append(items, pair.boundable1.item)
+	}
+	return items
+}
+
+/**
+ * Finds the two nearest items in the tree,
+ * using the given distance metric.
+ * An item is never paired with itself, although
+ * two distinct entries holding the same value may be returned.
+ *
+ * @param itemDist a distance metric applicable to the items in this tree
+ * @return the pair of the nearest items, and false if the tree has fewer than two items
+ */
+func (tree *STRtree[T]) NearestNeighbourPair(itemDist ItemDistance[T]) (T, T, bool) {
+	var item1, item2 T
+	tree.Build()
+	if tree.IsEmpty() {
+		return item1, item2, false
+	}
+	bp := newBoundablePair(tree.root, tree.root, itemDist)
+	minPair := nearestNeighbourPair(bp)
+	if minPair == nil {
+		return item1, item2, false
+	}
+	return minPair.boundable1.item, minPair.boundable2.item, true
+}
+
+/**
+ * Finds the item in this tree which is nearest to an item in another tree,
+ * using the given distance metric.
+ *
+ * @param other another tree
+ * @param itemDist a distance metric applicable to the items in the trees
+ * @return the nearest items from this and the other tree, and false if either tree is empty
+ */
+func (tree *STRtree[T]) NearestNeighbourTree(other *STRtree[T], itemDist ItemDistance[T]) (T, T, bool) {
+	var item1, item2 T
+	tree.Build()
+	other.Build()
+	if tree.IsEmpty() || other.IsEmpty() {
+		return item1, item2, false
+	}
+	bp := newBoundablePair(tree.root, other.root, itemDist)
+	minPair := nearestNeighbourPair(bp)
+	if minPair == nil {
+		return item1, item2, false
+	}
+	return minPair.boundable1.item, minPair.boundable2.item, true
+}
@@ -0,0 +1,217 @@
+package geos
+
+import (
+	"errors"
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A directed edge of the boundary of a polygonal area,
+ * oriented with the interior of the area on its right.
+ */
+type BoundaryEdge struct {
+	P0 geom.Coordinate
+	P1 geom.Coordinate
+}
+
+type polygonBuilderNodeKey struct {
+	x, y float64
+}
+
+/**
+ * Builds polygons from the boundary edges of a polygonal area.
+ * Shells in the result are clockwise and holes are counter-clockwise.
+ * <p>
+ * If some edges do not form closed rings an error is returned,
+ * along with the polygons formed by the closed rings.
+ * Dangling edge sequences can only arise from robustness failures
+ * in computing the edges, so callers may choose to use the partial result.
+ *
+ * @param edges the boundary edges, with the interior on their right
+ * @return the polygons, each as a shell followed by its holes
+ * @return an error if the edges do not form closed rings
+ */
+func PolygonBuilderBuild(edges []BoundaryEdge) ([][][]geom.Coordinate, error) {
+	rings, err := PolygonBuilderBuildRings(edges)
+	return PolygonBuilderAssignHoles(rings), err
+}
+
+/**
+ * Links boundary edges into rings.
+ * The edges are oriented with the interior on their right,
+ * so where several edges leave a node the one which turns
+ * most sharply to the right is taken.
+ * This traces the boundary of each face of the interior,
+ * which keeps shells which touch at a node separate.
+ * A face boundary which touches itself
+ * (such as a shell touched by a hole)
+ * is split into simple rings at the touching nodes.
+ *
+ * @param edges the boundary edges, with the interior on their right
+ * @return the closed rings
+ * @return an error if the edges do not form closed rings
+ */
+func PolygonBuilderBuildRings(edges []BoundaryEdge) ([][]geom.Coordinate, error) {
+	outEdges := make(map[polygonBuilderNodeKey][]int)
+	for i, e := range edges {
+		key := polygonBuilderNodeKey{e.P0.X, e.P0.Y}
+		outEdges[key] = append(outEdges[key], i)
+	}
+
+	var err error
+	isUsed := make([]bool, len(edges))
+	rings := make([][]geom.Coordinate, 0)
+	for start := range edges {
+		if isUsed[start] {
+			continue
+		}
+		ring := make([]geom.Coordinate, 0)
+		startPt := edges[start].P0
+		curr := start
+		isClosed := false
+		for {
+			isUsed[curr] = true
+			e := &edges[curr]
+			ring = append(ring, e.P0)
+			if e.P1.Equals2D(&startPt) {
+				isClosed = true
+				break
+			}
+			next := polygonBuilderNextEdge(edges, outEdges[polygonBuilderNodeKey{e.P1.X, e.P1.Y}], isUsed, e)
+			if next < 0 {
+				break
+			}
+			curr = next
+		}
+		if !isClosed {
+			err = errors.New("boundary edges do not form closed rings")
+			continue
+		}
+		if len(ring) < 3 {
+			continue
+		}
+		ring = append(ring, startPt)
+		rings = append(rings, polygonBuilderSplitRing(ring)...)
+	}
+	return rings, err
+}
+
+/**
+ * Splits a closed ring at the vertices it visits more than once,
+ * returning simple rings.
+ */
+func polygonBuilderSplitRing(ring []geom.Coordinate) [][]geom.Coordinate {
+	rings := make([][]geom.Coordinate, 0, 1)
+	stack := make([]geom.Coordinate, 0, len(ring))
+	stackPos := make(map[polygonBuilderNodeKey]int)
+	for i := 0; i < len(ring)-1; i++ {
+		p := ring[i]
+		key := polygonBuilderNodeKey{p.X, p.Y}
+		j, isVisited := stackPos[key]
+		if !isVisited {
+			stackPos[key] = len(stack)
+			stack = append(stack, p)
+			continue
+		}
+		//-- pop the loop which returns to the vertex
+		loop := make([]geom.Coordinate, 0, len(stack)-j+1)
+		loop = append(loop, stack[j:]...)
+		loop = append(loop, p)
+		if len(loop) >= 4 {
+			rings = append(rings, loop)
+		}
+		for _, q := range stack[j+1:] {
+			delete(stackPos, polygonBuilderNodeKey{q.X, q.Y})
+		}
+		stack = stack[:j+1]
+	}
+	if len(stack) >= 3 {
+		rings = append(rings, append(stack, stack[0]))
+	}
+	return rings
+}
+
+func polygonBuilderNextEdge(edges []BoundaryEdge, candidates []int, isUsed []bool, inEdge *BoundaryEdge) int {
+	angRev := algorithm.AngleOf(&inEdge.P1, &inEdge.P0)
+	next := -1
+	minDelta := math.Inf(1)
+	for _, i := range candidates {
+		if isUsed[i] {
+			continue
+		}
+		delta := algorithm.AngleNormalizePositive(algorithm.AngleOf(&edges[i].P0, &edges[i].P1) - angRev)
+		if delta == 0 {
+			delta = 2 * math.Pi
+		}
+		if delta < minDelta {
+			minDelta = delta
+			next = i
+		}
+	}
+	return next
+}
+
+/**
+ * Groups rings into polygons,
+ * by assigning each hole to the smallest shell containing it.
+ * Clockwise rings are shells and counter-clockwise rings are holes.
+ * Holes which are not contained in any shell are discarded.
+ *
+ * @param rings the rings
+ * @return the polygons, each as a shell followed by its holes
+ */
+func PolygonBuilderAssignHoles(rings [][]geom.Coordinate) [][][]geom.Coordinate {
+	shells := make([][][]geom.Coordinate, 0)
+	shellAreas := make([]float64, 0)
+	shellEnvs := make([]*geom.Envelope, 0)
+	holes := make([][]geom.Coordinate, 0)
+	for _, ring := range rings {
+		area := algorithm.AreaOfRingSigned(ring)
+		if area > 0 {
+			shells = append(shells, [][]geom.Coordinate{ring})
+			shellAreas = append(shellAreas, area)
+			shellEnvs = append(shellEnvs, geom.NewEnvelopeFromCoordinateArray(ring))
+		} else if area < 0 {
+			holes = append(holes, ring)
+		}
+	}
+
+	for _, hole := range holes {
+		holeEnv := geom.NewEnvelopeFromCoordinateArray(hole)
+		minShell := -1
+		for i, shell := range shells {
+			if !shellEnvs[i].CoversEnvelope(holeEnv) {
+				continue
+			}
+			if !polygonBuilderIsRingInRing(hole, shell[0]) {
+				continue
+			}
+			if minShell < 0 || shellAreas[i] < shellAreas[minShell] {
+				minShell = i
+			}
+		}
+		if minShell >= 0 {
+			shells[minShell] = append(shells[minShell], hole)
+		}
+	}
+	return shells
+}
+
+/**
+ * Tests whether a ring lies inside another, using the first vertex
+ * of the ring which does not lie on the other ring.
+ */
+func polygonBuilderIsRingInRing(ring []geom.Coordinate, container []geom.Coordinate) bool {
+	for i := range ring {
+		loc := algorithm.PointLocationLocateInRing(&ring[i], container)
+		if loc != constants.LOCATION_BOUNDARY {
+			return loc == constants.LOCATION_INTERIOR
+		}
+	}
+	//-- all vertices lie on the container, so treat the ring as inside
+	return true
+}
@@ -0,0 +1,111 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Extracts the subline of a linear geometry between
+ * two {@link LinearLocation}s on the line.
+ */
+type extractLineByLocation struct {
+	line [][]geom.Coordinate
+
+	lines     [][]geom.Coordinate
+	coordList *geom.CoordinateList
+}
+
+/**
+ * Computes the subline of a linear geometry between
+ * two {@link LinearLocation}s on the line.
+ * If the start location is after the end location,
+ * the computed linear geometry has reverse orientation to the input line.
+ *
+ * @param line the line to use as the baseline
+ * @param start the start location
+ * @param end the end location
+ * @return the extracted subline
+ */
+func extractLineByLocationExtract(line [][]geom.Coordinate, start *LinearLocation, end *LinearLocation) [][]geom.Coordinate {
+	ls := new(extractLineByLocation)
+	ls.line = line
+	return ls.extract(start, end)
+}
+
+/**
+ * Extracts a subline of the input.
+ * If <code>end < start</code> the linear geometry computed will be reversed.
+ */
+func (ls *extractLineByLocation) extract(start *LinearLocation, end *LinearLocation) [][]geom.Coordinate {
+	if end.CompareTo(start) < 0 {
+		return extractLineByLocationReverse(ls.computeLinear(end, start))
+	}
+	return ls.computeLinear(start, end)
+}
+
+func extractLineByLocationReverse(linear [][]geom.Coordinate) [][]geom.Coordinate {
+	n := len(linear)
+	revLines := make([][]geom.Coordinate, n)
+	for i, line := range linear {
+		geom.ReverseCoordinates(line)
+		revLines[n-1-i] = line
+	}
+	return revLines
+}
+
+/**
+ * Assumes input is valid (e.g. start <= end)
+ */
+func (ls *extractLineByLocation) computeLinear(start *LinearLocation, end *LinearLocation) [][]geom.Coordinate {
+	ls.lines = make([][]geom.Coordinate, 0)
+	ls.coordList = nil
+
+	if !start.IsVertex() {
+		ls.add(start.GetCoordinate(ls.line))
+	}
+
+	for it := newLinearIteratorAtLocation(ls.line, start); it.hasNext(); it.next() {
+		if end.CompareLocationValues(it.componentIndex, it.vertexIndex, 0.0) < 0 {
+			break
+		}
+		// empty components have no points to add
+		if len(it.currentLine) == 0 {
+			continue
+		}
+
+		ls.add(it.getSegmentStart())
+		if it.isEndOfLine() {
+			ls.endLine()
+		}
+	}
+	if !end.IsVertex() {
+		ls.add(end.GetCoordinate(ls.line))
+	}
+
+	ls.endLine()
+	return ls.lines
+}
+
+func (ls *extractLineByLocation) add(pt *geom.Coordinate) {
+	if ls.coordList == nil {
+		ls.coordList = geom.DefaultCoordinateList()
+	}
+	ls.coordList.AddCoordinateRepeated(pt, true)
+}
+
+/**
+ * Terminates the current line.
+ * Lines with only one point are fixed by
+ * duplicating the point, so that zero-length extracts are preserved.
+ */
+func (ls *extractLineByLocation) endLine() {
+	if ls.coordList == nil {
+		return
+	}
+	pts := ls.coordList.ToCoordinateArray()
+	ls.coordList = nil
+	if len(pts) < 2 {
+		pts = []geom.Coordinate{pts[0], pts[0]}
+	}
+	ls.lines = append(ls.lines, pts)
+}
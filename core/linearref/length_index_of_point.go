@@ -0,0 +1,110 @@
+package geos
+
+import (
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the length index of the point
+ * on a linear geometry nearest a given {@link Coordinate}.
+ * The nearest point is not necessarily unique; this class
+ * always computes the nearest point closest to
+ * the start of the geometry.
+ */
+type lengthIndexOfPoint struct {
+	linearGeom [][]geom.Coordinate
+}
+
+func lengthIndexOfPointIndexOf(linearGeom [][]geom.Coordinate, inputPt *geom.Coordinate) float64 {
+	return newLengthIndexOfPoint(linearGeom).indexOf(inputPt)
+}
+
+func lengthIndexOfPointIndexOfAfter(linearGeom [][]geom.Coordinate, inputPt *geom.Coordinate, minIndex float64) float64 {
+	return newLengthIndexOfPoint(linearGeom).indexOfAfter(inputPt, minIndex)
+}
+
+func newLengthIndexOfPoint(linearGeom [][]geom.Coordinate) *lengthIndexOfPoint {
+	locator := new(lengthIndexOfPoint)
+	locator.linearGeom = linearGeom
+	return locator
+}
+
+/**
+ * Find the nearest location along a linear geometry to a given point.
+ *
+ * @param inputPt the coordinate to locate
+ * @return the location of the nearest point
+ */
+func (locator *lengthIndexOfPoint) indexOf(inputPt *geom.Coordinate) float64 {
+	return locator.indexOfFromStart(inputPt, -1.0)
+}
+
+/**
+ * Finds the nearest index along the linear geometry
+ * to a given {@link Coordinate}
+ * after the specified minimum index.
+ * If possible the location returned will be strictly greater than the
+ * <code>minLocation</code>.
+ * If this is not possible, the
+ * value returned will equal <code>minLocation</code>.
+ * (An example where this is not possible is when
+ * minLocation = [end of line] ).
+ *
+ * @param inputPt the coordinate to locate
+ * @param minIndex the minimum location for the point location
+ * @return the location of the nearest point
+ */
+func (locator *lengthIndexOfPoint) indexOfAfter(inputPt *geom.Coordinate, minIndex float64) float64 {
+	if minIndex < 0.0 {
+		return locator.indexOf(inputPt)
+	}
+
+	// sanity check for minIndex at or past end of line
+	endIndex := linearGeometryLength(locator.linearGeom)
+	if endIndex < minIndex {
+		return endIndex
+	}
+
+	return locator.indexOfFromStart(inputPt, minIndex)
+}
+
+func (locator *lengthIndexOfPoint) indexOfFromStart(inputPt *geom.Coordinate, minIndex float64) float64 {
+	minDistance := math.MaxFloat64
+
+	ptMeasure := minIndex
+	segmentStartMeasure := 0.0
+	for it := newLinearIterator(locator.linearGeom); it.hasNext(); it.next() {
+		if !it.isEndOfLine() {
+			p0 := it.getSegmentStart()
+			p1 := it.getSegmentEnd()
+			segDistance := algorithm.DistancePointToSegment(inputPt, p0, p1)
+			segMeasureToPt := lengthIndexOfPointSegmentNearestMeasure(p0, p1, inputPt, segmentStartMeasure)
+			if segDistance < minDistance && segMeasureToPt > minIndex {
+				ptMeasure = segMeasureToPt
+				minDistance = segDistance
+			}
+			segmentStartMeasure += p0.Distance(p1)
+		}
+	}
+	// an empty geometry has no nearest point, so the start index is returned
+	if ptMeasure < 0.0 {
+		return 0.0
+	}
+	return ptMeasure
+}
+
+func lengthIndexOfPointSegmentNearestMeasure(p0 *geom.Coordinate, p1 *geom.Coordinate, inputPt *geom.Coordinate, segmentStartMeasure float64) float64 {
+	// found new minimum, so compute location distance of point
+	projFactor := lineSegmentProjectionFactor(p0, p1, inputPt)
+	if projFactor <= 0.0 {
+		return segmentStartMeasure
+	}
+	if projFactor <= 1.0 {
+		return segmentStartMeasure + projFactor*p0.Distance(p1)
+	}
+	// projFactor > 1.0
+	return segmentStartMeasure + p0.Distance(p1)
+}
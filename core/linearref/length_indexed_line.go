@@ -0,0 +1,227 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Supports linear referencing along a linear geometry
+ * using the length along the line as the index.
+ * Negative length values are taken as measured in the reverse direction
+ * from the end of the geometry.
+ * Out-of-range index values are handled by clamping
+ * them to the valid range of values.
+ * Non-simple lines (i.e. which loop back to cross or touch
+ * themselves) are supported.
+ */
+type LengthIndexedLine struct {
+	linearGeom [][]geom.Coordinate
+}
+
+/**
+ * Constructs an object which allows a line
+ * to be linearly referenced using length as an index.
+ *
+ * @param line the line to reference along
+ */
+func NewLengthIndexedLine(line []geom.Coordinate) *LengthIndexedLine {
+	return NewLengthIndexedLineFromLines([][]geom.Coordinate{line})
+}
+
+/**
+ * Constructs an object which allows a multi-line
+ * to be linearly referenced using length as an index.
+ * The lines are referenced in the order given.
+ *
+ * @param lines the lines to reference along
+ */
+func NewLengthIndexedLineFromLines(lines [][]geom.Coordinate) *LengthIndexedLine {
+	indexedLine := new(LengthIndexedLine)
+	indexedLine.linearGeom = lines
+	return indexedLine
+}
+
+/**
+ * Computes the {@link Coordinate} for the point
+ * on the line at the given index.
+ * If the index is out of range the first or last point on the
+ * line will be returned.
+ * The Z and M values of the coordinate are interpolated
+ * from the line (NaN if not present).
+ *
+ * @param index the index of the desired point
+ * @return the Coordinate at the given index, or nil if the line is empty
+ */
+func (indexedLine *LengthIndexedLine) ExtractPoint(index float64) *geom.Coordinate {
+	loc := LengthLocationMapGetLocation(indexedLine.linearGeom, index)
+	return loc.GetCoordinate(indexedLine.linearGeom)
+}
+
+/**
+ * Computes the {@link Coordinate} for the point
+ * on the line at the given index, offset by the given distance.
+ * If the index is out of range the first or last point on the
+ * line will be returned.
+ * The computed point is offset to the left of the line if the offset distance is
+ * positive, to the right if negative.
+ * <p>
+ * An error is returned if the offset is taken from a zero-length segment.
+ *
+ * @param index the index of the desired point
+ * @param offsetDistance the distance the point is offset from the segment
+ *    (positive is to the left, negative is to the right)
+ * @return the Coordinate at the given index
+ */
+func (indexedLine *LengthIndexedLine) ExtractPointOffset(index float64, offsetDistance float64) (*geom.Coordinate, error) {
+	loc := LengthLocationMapGetLocation(indexedLine.linearGeom, index)
+	return linearRefExtractPointOffset(indexedLine.linearGeom, loc, offsetDistance)
+}
+
+/**
+ * Computes the linear geometry for the interval
+ * between the given indices.
+ * If the endIndex lies before the startIndex,
+ * the computed geometry is reversed.
+ *
+ * @param startIndex the index of the start of the interval
+ * @param endIndex the index of the end of the interval
+ * @return the linear interval between the indices
+ */
+func (indexedLine *LengthIndexedLine) ExtractLine(startIndex float64, endIndex float64) [][]geom.Coordinate {
+	startIndex2 := indexedLine.ClampIndex(startIndex)
+	endIndex2 := indexedLine.ClampIndex(endIndex)
+	// if extracted line is zero-length, resolve start lower as well to ensure they are equal
+	resolveStartLower := startIndex2 == endIndex2
+	startLoc := LengthLocationMapGetLocationResolveLower(indexedLine.linearGeom, startIndex2, resolveStartLower)
+	endLoc := LengthLocationMapGetLocation(indexedLine.linearGeom, endIndex2)
+	return extractLineByLocationExtract(indexedLine.linearGeom, startLoc, endLoc)
+}
+
+/**
+ * Computes the minimum index for a point on the line.
+ * If the line is not simple (i.e. loops back on itself)
+ * a single point may have more than one possible index.
+ * In this case, the smallest index is returned.
+ *
+ * The supplied point does not <i>necessarily</i> have to lie precisely
+ * on the line, but if it is far from the line the accuracy and
+ * performance of this function is not guaranteed.
+ * Use {@link #Project} to compute a guaranteed result for points
+ * which may be far from the line.
+ *
+ * @param pt a point on the line
+ * @return the minimum index of the point
+ */
+func (indexedLine *LengthIndexedLine) IndexOf(pt *geom.Coordinate) float64 {
+	return lengthIndexOfPointIndexOf(indexedLine.linearGeom, pt)
+}
+
+/**
+ * Finds the index for a point on the line
+ * which is greater than the given index.
+ * If no such index exists, returns <tt>minIndex</tt>.
+ * This method can be used to determine all indexes for
+ * a point which occurs more than once on a non-simple line.
+ * It can also be used to disambiguate cases where the given point lies
+ * slightly off the line and is equidistant from two different
+ * points on the line.
+ *
+ * The supplied point does not <i>necessarily</i> have to lie precisely
+ * on the line, but if it is far from the line the accuracy and
+ * performance of this function is not guaranteed.
+ * Use {@link #Project} to compute a guaranteed result for points
+ * which may be far from the line.
+ *
+ * @param pt a point on the line
+ * @param minIndex the value the returned index must be greater than
+ * @return the index of the point greater than the given minimum index
+ */
+func (indexedLine *LengthIndexedLine) IndexOfAfter(pt *geom.Coordinate, minIndex float64) float64 {
+	return lengthIndexOfPointIndexOfAfter(indexedLine.linearGeom, pt, minIndex)
+}
+
+/**
+ * Computes the indices for a subline of the line.
+ * (The subline must <b>conform</b> to the line; that is,
+ * all vertices in the subline (except possibly the first and last)
+ * must be vertices of the line and occur in the same order).
+ *
+ * @param subLine a subline of the line
+ * @return a pair of indices for the start and end of the subline.
+ */
+func (indexedLine *LengthIndexedLine) IndicesOf(subLine [][]geom.Coordinate) [2]float64 {
+	locIndex := locationIndexOfLineIndicesOf(indexedLine.linearGeom, subLine)
+	return [2]float64{
+		LengthLocationMapGetLength(indexedLine.linearGeom, locIndex[0]),
+		LengthLocationMapGetLength(indexedLine.linearGeom, locIndex[1]),
+	}
+}
+
+/**
+ * Computes the index for the closest point on the line to the given point.
+ * If more than one point has the closest distance the first one along the line
+ * is returned.
+ * (The point does not necessarily have to lie precisely on the line.)
+ *
+ * @param pt a point on the line
+ * @return the index of the point
+ */
+func (indexedLine *LengthIndexedLine) Project(pt *geom.Coordinate) float64 {
+	return lengthIndexOfPointIndexOf(indexedLine.linearGeom, pt)
+}
+
+/**
+ * Returns the index of the start of the line
+ *
+ * @return the start index
+ */
+func (indexedLine *LengthIndexedLine) GetStartIndex() float64 {
+	return 0.0
+}
+
+/**
+ * Returns the index of the end of the line
+ *
+ * @return the end index
+ */
+func (indexedLine *LengthIndexedLine) GetEndIndex() float64 {
+	return linearGeometryLength(indexedLine.linearGeom)
+}
+
+/**
+ * Tests whether an index is in the valid index range for the line.
+ *
+ * @param index the index to test
+ * @return <code>true</code> if the index is in the valid range
+ */
+func (indexedLine *LengthIndexedLine) IsValidIndex(index float64) bool {
+	return index >= indexedLine.GetStartIndex() && index <= indexedLine.GetEndIndex()
+}
+
+/**
+ * Computes a valid index for this line
+ * by clamping the given index to the valid range of index values
+ *
+ * @return a valid index value
+ */
+func (indexedLine *LengthIndexedLine) ClampIndex(index float64) float64 {
+	posIndex := indexedLine.positiveIndex(index)
+	startIndex := indexedLine.GetStartIndex()
+	if posIndex < startIndex {
+		return startIndex
+	}
+
+	endIndex := indexedLine.GetEndIndex()
+	if posIndex > endIndex {
+		return endIndex
+	}
+
+	return posIndex
+}
+
+func (indexedLine *LengthIndexedLine) positiveIndex(index float64) float64 {
+	if index >= 0.0 {
+		return index
+	}
+	return linearGeometryLength(indexedLine.linearGeom) + index
+}
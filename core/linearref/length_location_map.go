@@ -0,0 +1,193 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the {@link LinearLocation} for a given length
+ * along a linear geometry.
+ * Negative lengths are measured in reverse from end of the linear geometry.
+ * Out-of-range values are clamped.
+ */
+type LengthLocationMap struct {
+	linearGeom [][]geom.Coordinate
+}
+
+/**
+ * Computes the {@link LinearLocation} for a
+ * given length along a linear geometry.
+ *
+ * @param linearGeom the linear geometry to use
+ * @param length the length index of the location
+ * @return the {@link LinearLocation} for the length
+ */
+func LengthLocationMapGetLocation(linearGeom [][]geom.Coordinate, length float64) *LinearLocation {
+	return NewLengthLocationMap(linearGeom).GetLocation(length)
+}
+
+/**
+ * Computes the {@link LinearLocation} for a
+ * given length along a linear geometry,
+ * with control over how the location
+ * is resolved at component endpoints.
+ *
+ * @param linearGeom the linear geometry to use
+ * @param length the length index of the location
+ * @param resolveLower if true lengths are resolved to the lowest possible index
+ * @return the {@link LinearLocation} for the length
+ */
+func LengthLocationMapGetLocationResolveLower(linearGeom [][]geom.Coordinate, length float64, resolveLower bool) *LinearLocation {
+	return NewLengthLocationMap(linearGeom).GetLocationResolveLower(length, resolveLower)
+}
+
+/**
+ * Computes the length for a given {@link LinearLocation}
+ * on a linear geometry.
+ *
+ * @param linearGeom the linear geometry to use
+ * @param loc the {@link LinearLocation} index of the location
+ * @return the length for the {@link LinearLocation}
+ */
+func LengthLocationMapGetLength(linearGeom [][]geom.Coordinate, loc *LinearLocation) float64 {
+	return NewLengthLocationMap(linearGeom).GetLength(loc)
+}
+
+func NewLengthLocationMap(linearGeom [][]geom.Coordinate) *LengthLocationMap {
+	locMap := new(LengthLocationMap)
+	locMap.linearGeom = linearGeom
+	return locMap
+}
+
+/**
+ * Compute the {@link LinearLocation} corresponding to a length.
+ * Negative lengths are measured in reverse from end of the linear geometry.
+ * Out-of-range values are clamped.
+ * Ambiguous indexes are resolved to the lowest possible location value.
+ *
+ * @param length the length index
+ * @return the corresponding LinearLocation
+ */
+func (locMap *LengthLocationMap) GetLocation(length float64) *LinearLocation {
+	return locMap.GetLocationResolveLower(length, true)
+}
+
+/**
+ * Compute the {@link LinearLocation} corresponding to a length.
+ * Negative lengths are measured in reverse from end of the linear geometry.
+ * Out-of-range values are clamped.
+ * Ambiguous indexes are resolved to the lowest or highest possible location value,
+ * depending on the value of <tt>resolveLower</tt>
+ *
+ * @param length the length index
+ * @param resolveLower if true lengths are resolved to the lowest possible index
+ * @return the corresponding LinearLocation
+ */
+func (locMap *LengthLocationMap) GetLocationResolveLower(length float64, resolveLower bool) *LinearLocation {
+	forwardLength := length
+
+	// negative values are measured from end of geometry
+	if length < 0.0 {
+		lineLen := linearGeometryLength(locMap.linearGeom)
+		forwardLength = lineLen + length
+	}
+	loc := locMap.getLocationForward(forwardLength)
+	if resolveLower {
+		return loc
+	}
+	return locMap.resolveHigher(loc)
+}
+
+func (locMap *LengthLocationMap) getLocationForward(length float64) *LinearLocation {
+	if length <= 0.0 {
+		return NewLinearLocation()
+	}
+
+	totalLength := 0.0
+
+	it := newLinearIterator(locMap.linearGeom)
+	for it.hasNext() {
+		/**
+		 * Special handling is required for the situation when the
+		 * length references exactly to a component endpoint.
+		 * In this case, the endpoint location of the current component
+		 * is returned,
+		 * rather than the startpoint location of the next component.
+		 * This produces consistent behaviour with the project method.
+		 */
+		if it.isEndOfLine() {
+			if totalLength == length {
+				return NewLinearLocationWithComponent(it.componentIndex, it.vertexIndex, 0.0)
+			}
+		} else {
+			p0 := it.getSegmentStart()
+			p1 := it.getSegmentEnd()
+			segLen := p1.Distance(p0)
+			// length falls in this segment
+			if totalLength+segLen > length {
+				frac := (length - totalLength) / segLen
+				return NewLinearLocationWithComponent(it.componentIndex, it.vertexIndex, frac)
+			}
+			totalLength += segLen
+		}
+
+		it.next()
+	}
+	// length is longer than line - return end location
+	return LinearLocationGetEndLocation(locMap.linearGeom)
+}
+
+func (locMap *LengthLocationMap) resolveHigher(loc *LinearLocation) *LinearLocation {
+	if !loc.IsEndpoint(locMap.linearGeom) {
+		return loc
+	}
+	compIndex := loc.GetComponentIndex()
+	// if last component can't resolve any higher
+	if compIndex >= len(locMap.linearGeom)-1 {
+		return loc
+	}
+
+	for {
+		compIndex++
+		if compIndex >= len(locMap.linearGeom)-1 ||
+			algorithm.LengthOfLine(locMap.linearGeom[compIndex]) != 0 {
+			break
+		}
+	}
+	// resolve to next higher location
+	return NewLinearLocationWithComponent(compIndex, 0, 0.0)
+}
+
+/**
+ * Computes the length index of a {@link LinearLocation}.
+ *
+ * @param loc the location
+ * @return the length along the linear geometry to the location
+ */
+func (locMap *LengthLocationMap) GetLength(loc *LinearLocation) float64 {
+	totalLength := 0.0
+
+	it := newLinearIterator(locMap.linearGeom)
+	for it.hasNext() {
+		isAtLocation := loc.GetComponentIndex() == it.componentIndex &&
+			loc.GetSegmentIndex() == it.vertexIndex
+		if it.isEndOfLine() {
+			// location is the endpoint of a component
+			if isAtLocation {
+				return totalLength
+			}
+		} else {
+			p0 := it.getSegmentStart()
+			p1 := it.getSegmentEnd()
+			segLen := p1.Distance(p0)
+			// length falls in this segment
+			if isAtLocation {
+				return totalLength + segLen*loc.GetSegmentFraction()
+			}
+			totalLength += segLen
+		}
+		it.next()
+	}
+	return totalLength
+}
@@ -0,0 +1,99 @@
+package geos
+
+import (
+	"errors"
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the Projection Factor for the projection of the point p
+ * onto the line segment (p0, p1).
+ * The Projection Factor is the constant r
+ * by which the vector for this segment must be multiplied to
+ * equal the vector for the projection of <tt>p</tt> on the line
+ * defined by this segment.
+ * The projection factor is NaN if the segment has zero length.
+ */
+func lineSegmentProjectionFactor(p0 *geom.Coordinate, p1 *geom.Coordinate, p *geom.Coordinate) float64 {
+	if p.Equals2D(p0) {
+		return 0.0
+	}
+	if p.Equals2D(p1) {
+		return 1.0
+	}
+	dx := p1.X - p0.X
+	dy := p1.Y - p0.Y
+	len2 := dx*dx + dy*dy
+
+	// handle zero-length segments
+	if len2 <= 0.0 {
+		return math.NaN()
+	}
+
+	return ((p.X-p0.X)*dx + (p.Y-p0.Y)*dy) / len2
+}
+
+/**
+ * Computes the fraction of distance (in <tt>[0.0, 1.0]</tt>)
+ * that the projection of a point occurs along the line segment (p0, p1).
+ * If the point is beyond either ends of the line segment,
+ * the closest fractional value (<tt>0.0</tt> or <tt>1.0</tt>) is returned.
+ */
+func lineSegmentFraction(p0 *geom.Coordinate, p1 *geom.Coordinate, p *geom.Coordinate) float64 {
+	segFrac := lineSegmentProjectionFactor(p0, p1, p)
+	if segFrac < 0.0 {
+		segFrac = 0.0
+	} else if segFrac > 1.0 || math.IsNaN(segFrac) {
+		segFrac = 1.0
+	}
+	return segFrac
+}
+
+/**
+ * Computes the {@link Coordinate} that lies a given
+ * fraction along the line segment (p0, p1) and offset from
+ * the segment by a given distance.
+ * If the offset distance is positive the point lies to the left of the segment,
+ * otherwise it lies to the right.
+ * An error is returned if an offset is requested from a zero-length segment.
+ */
+func lineSegmentPointAlongOffset(p0 *geom.Coordinate, p1 *geom.Coordinate, segmentLengthFraction float64, offsetDistance float64) (*geom.Coordinate, error) {
+	// the point on the segment line
+	segx := p0.X + segmentLengthFraction*(p1.X-p0.X)
+	segy := p0.Y + segmentLengthFraction*(p1.Y-p0.Y)
+
+	dx := p1.X - p0.X
+	dy := p1.Y - p0.Y
+	length := math.Sqrt(dx*dx + dy*dy)
+	ux := 0.0
+	uy := 0.0
+	if offsetDistance != 0.0 {
+		if length <= 0.0 {
+			return nil, errors.New("cannot compute offset from zero-length line segment")
+		}
+
+		// u is the vector that is the length of the offset, in the direction of the segment
+		ux = offsetDistance * dx / length
+		uy = offsetDistance * dy / length
+	}
+
+	// the offset point is the seg point plus the offset vector rotated 90 degrees CCW
+	offsetx := segx - uy
+	offsety := segy + ux
+
+	return geom.NewCoordinateXY(offsetx, offsety), nil
+}
+
+/**
+ * Computes the total length of the components of a linear geometry.
+ */
+func linearGeometryLength(linearGeom [][]geom.Coordinate) float64 {
+	length := 0.0
+	for _, line := range linearGeom {
+		length += algorithm.LengthOfLine(line)
+	}
+	return length
+}
@@ -0,0 +1,150 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * An iterator over the components and coordinates of a linear geometry.
+ * <p>
+ * The standard usage pattern for a {@link linearIterator} is:
+ *
+ * <pre>
+ * for it := newLinearIterator(...); it.hasNext(); it.next() {
+ *   if it.isEndOfLine() {
+ *     ... // handle end of line
+ *   }
+ *   ... // process the current segment
+ * }
+ * </pre>
+ */
+type linearIterator struct {
+	linearGeom     [][]geom.Coordinate
+	numLines       int
+	currentLine    []geom.Coordinate
+	componentIndex int
+	vertexIndex    int
+}
+
+func linearIteratorSegmentEndVertexIndex(loc *LinearLocation) int {
+	if loc.GetSegmentFraction() > 0.0 {
+		return loc.GetSegmentIndex() + 1
+	}
+	return loc.GetSegmentIndex()
+}
+
+/**
+ * Creates an iterator initialized to the start of a linear geometry.
+ *
+ * @param linear the linear geometry to iterate over
+ */
+func newLinearIterator(linear [][]geom.Coordinate) *linearIterator {
+	return newLinearIteratorAtVertex(linear, 0, 0)
+}
+
+/**
+ * Creates an iterator starting at
+ * a {@link LinearLocation} on a linear geometry.
+ *
+ * @param linear the linear geometry to iterate over
+ * @param start the location to start at
+ */
+func newLinearIteratorAtLocation(linear [][]geom.Coordinate, start *LinearLocation) *linearIterator {
+	return newLinearIteratorAtVertex(linear, start.GetComponentIndex(), linearIteratorSegmentEndVertexIndex(start))
+}
+
+/**
+ * Creates an iterator starting at
+ * a specified component and vertex in a linear geometry.
+ *
+ * @param linearGeom the linear geometry to iterate over
+ * @param componentIndex the component to start at
+ * @param vertexIndex the vertex to start at
+ */
+func newLinearIteratorAtVertex(linearGeom [][]geom.Coordinate, componentIndex int, vertexIndex int) *linearIterator {
+	it := new(linearIterator)
+	it.linearGeom = linearGeom
+	it.numLines = len(linearGeom)
+	it.componentIndex = componentIndex
+	it.vertexIndex = vertexIndex
+	it.loadCurrentLine()
+	return it
+}
+
+func (it *linearIterator) loadCurrentLine() {
+	if it.componentIndex >= it.numLines {
+		it.currentLine = nil
+		return
+	}
+	it.currentLine = it.linearGeom[it.componentIndex]
+}
+
+/**
+ * Tests whether there are any vertices left to iterator over.
+ * Specifically, <code>hasNext()</code> return <tt>true</tt> if the
+ * current state of the iterator represents a valid location
+ * on the linear geometry.
+ *
+ * @return <code>true</code> if there are more vertices to scan
+ */
+func (it *linearIterator) hasNext() bool {
+	if it.componentIndex >= it.numLines {
+		return false
+	}
+	if it.componentIndex == it.numLines-1 && it.vertexIndex >= len(it.currentLine) {
+		return false
+	}
+	return true
+}
+
+/**
+ * Moves the iterator ahead to the next vertex and (possibly) linear component.
+ */
+func (it *linearIterator) next() {
+	if !it.hasNext() {
+		return
+	}
+
+	it.vertexIndex++
+	if it.vertexIndex >= len(it.currentLine) {
+		it.componentIndex++
+		it.loadCurrentLine()
+		it.vertexIndex = 0
+	}
+}
+
+/**
+ * Checks whether the iterator cursor is pointing to the
+ * endpoint of a component line.
+ *
+ * @return <code>true</code> if the iterator is at an endpoint
+ */
+func (it *linearIterator) isEndOfLine() bool {
+	if it.componentIndex >= it.numLines {
+		return false
+	}
+	if it.vertexIndex < len(it.currentLine)-1 {
+		return false
+	}
+	return true
+}
+
+/**
+ * Gets the first {@link Coordinate} of the current segment.
+ * (the coordinate of the current vertex).
+ */
+func (it *linearIterator) getSegmentStart() *geom.Coordinate {
+	return &it.currentLine[it.vertexIndex]
+}
+
+/**
+ * Gets the second {@link Coordinate} of the current segment.
+ * (the coordinate of the next vertex).
+ * If the iterator is at the end of a line, nil is returned.
+ */
+func (it *linearIterator) getSegmentEnd() *geom.Coordinate {
+	if it.vertexIndex < len(it.currentLine)-1 {
+		return &it.currentLine[it.vertexIndex+1]
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+package geos
+
+import (
+	"fmt"
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents a location along a linear geometry
+ * (a line or a list of lines forming a multi-line).
+ * The referenced geometry is not maintained within
+ * this location, but must be provided for operations which require it.
+ * Various methods are provided to manipulate the location value
+ * and query the geometry it references.
+ */
+type LinearLocation struct {
+	componentIndex  int
+	segmentIndex    int
+	segmentFraction float64
+}
+
+/**
+ * Gets a location which refers to the end of a linear geometry.
+ *
+ * @param linear the linear geometry
+ * @return a new <tt>LinearLocation</tt>
+ */
+func LinearLocationGetEndLocation(linear [][]geom.Coordinate) *LinearLocation {
+	loc := NewLinearLocation()
+	loc.SetToEnd(linear)
+	return loc
+}
+
+/**
+ * Computes the coordinate of a point a given fraction
+ * along the line segment <tt>(p0, p1)</tt>.
+ * If the fraction is greater than 1.0 the last
+ * point of the segment is returned.
+ * If the fraction is less than or equal to 0.0 the first point
+ * of the segment is returned.
+ * The Z and M values of the coordinate are interpolated between
+ * the Z and M values of the segment endpoints (NaN if either is NaN).
+ *
+ * @param p0 the first point of the line segment
+ * @param p1 the last point of the line segment
+ * @param frac the length to the desired point
+ * @return the coordinate of the desired point
+ */
+func LinearLocationPointAlongSegmentByFraction(p0 *geom.Coordinate, p1 *geom.Coordinate, frac float64) *geom.Coordinate {
+	if frac <= 0.0 {
+		return p0.Clone()
+	}
+	if frac >= 1.0 {
+		return p1.Clone()
+	}
+
+	x := (p1.X-p0.X)*frac + p0.X
+	y := (p1.Y-p0.Y)*frac + p0.Y
+	// interpolate Z and M values. If either input value is NaN, the result will be NaN as well.
+	z := (p1.Z-p0.Z)*frac + p0.Z
+	m := (p1.M-p0.M)*frac + p0.M
+	return geom.NewCoordinateXYZM(x, y, z, m)
+}
+
+/**
+ * Creates a location referring to the start of a linear geometry.
+ */
+func NewLinearLocation() *LinearLocation {
+	return new(LinearLocation)
+}
+
+/**
+ * Creates a location referring to a segment of the first component of a linear geometry.
+ *
+ * @param segmentIndex the index of the segment
+ * @param segmentFraction the fraction along the segment
+ */
+func NewLinearLocationWithSegment(segmentIndex int, segmentFraction float64) *LinearLocation {
+	return NewLinearLocationWithComponent(0, segmentIndex, segmentFraction)
+}
+
+/**
+ * Creates a location referring to a segment of a component of a linear geometry.
+ * The location is normalized.
+ *
+ * @param componentIndex the index of the component
+ * @param segmentIndex the index of the segment
+ * @param segmentFraction the fraction along the segment
+ */
+func NewLinearLocationWithComponent(componentIndex int, segmentIndex int, segmentFraction float64) *LinearLocation {
+	loc := newLinearLocation(componentIndex, segmentIndex, segmentFraction)
+	loc.normalize()
+	return loc
+}
+
+func newLinearLocation(componentIndex int, segmentIndex int, segmentFraction float64) *LinearLocation {
+	loc := new(LinearLocation)
+	loc.componentIndex = componentIndex
+	loc.segmentIndex = segmentIndex
+	loc.segmentFraction = segmentFraction
+	return loc
+}
+
+/**
+ * Ensures the individual values are locally valid.
+ * Does <b>not</b> ensure that the indexes are valid for
+ * a particular linear geometry.
+ *
+ * @see #Clamp
+ */
+func (loc *LinearLocation) normalize() {
+	if loc.segmentFraction < 0.0 {
+		loc.segmentFraction = 0.0
+	}
+	if loc.segmentFraction > 1.0 {
+		loc.segmentFraction = 1.0
+	}
+
+	if loc.componentIndex < 0 {
+		loc.componentIndex = 0
+		loc.segmentIndex = 0
+		loc.segmentFraction = 0.0
+	}
+	if loc.segmentIndex < 0 {
+		loc.segmentIndex = 0
+		loc.segmentFraction = 0.0
+	}
+	if loc.segmentFraction == 1.0 {
+		loc.segmentFraction = 0.0
+		loc.segmentIndex += 1
+	}
+}
+
+/**
+ * Ensures the indexes are valid for a given linear geometry.
+ *
+ * @param linear a linear geometry
+ */
+func (loc *LinearLocation) Clamp(linear [][]geom.Coordinate) {
+	if loc.componentIndex >= len(linear) {
+		loc.SetToEnd(linear)
+		return
+	}
+	nseg := linearLocationNumSegments(linear[loc.componentIndex])
+	if loc.segmentIndex >= nseg {
+		loc.segmentIndex = nseg
+		loc.segmentFraction = 0.0
+	}
+}
+
+/**
+ * Snaps the value of this location to
+ * the nearest vertex on the given linear geometry,
+ * if the vertex is closer than <tt>minDistance</tt>.
+ *
+ * @param linearGeom a linear geometry
+ * @param minDistance the minimum allowable distance to a vertex
+ */
+func (loc *LinearLocation) SnapToVertex(linearGeom [][]geom.Coordinate, minDistance float64) {
+	if loc.segmentFraction <= 0.0 || loc.segmentFraction >= 1.0 {
+		return
+	}
+	segLen := loc.GetSegmentLength(linearGeom)
+	lenToStart := loc.segmentFraction * segLen
+	lenToEnd := segLen - lenToStart
+	if lenToStart <= lenToEnd && lenToStart < minDistance {
+		loc.segmentFraction = 0.0
+	} else if lenToEnd <= lenToStart && lenToEnd < minDistance {
+		loc.segmentFraction = 1.0
+	}
+}
+
+/**
+ * Gets the length of the segment in the given
+ * geometry which is referenced by this location.
+ *
+ * @param linearGeom a linear geometry
+ * @return the length of the segment
+ */
+func (loc *LinearLocation) GetSegmentLength(linearGeom [][]geom.Coordinate) float64 {
+	lineComp := linearGeom[loc.componentIndex]
+	if len(lineComp) < 2 {
+		return 0.0
+	}
+
+	// ensure segment index is valid
+	segIndex := loc.segmentIndex
+	if loc.segmentIndex >= linearLocationNumSegments(lineComp) {
+		segIndex = len(lineComp) - 2
+	}
+
+	p0 := &lineComp[segIndex]
+	p1 := &lineComp[segIndex+1]
+	return p0.Distance(p1)
+}
+
+/**
+ * Sets the value of this location to
+ * refer to the end of a linear geometry.
+ *
+ * @param linear the linear geometry to use to set the end
+ */
+func (loc *LinearLocation) SetToEnd(linear [][]geom.Coordinate) {
+	if len(linear) == 0 {
+		loc.componentIndex = 0
+		loc.segmentIndex = 0
+		loc.segmentFraction = 0.0
+		return
+	}
+	loc.componentIndex = len(linear) - 1
+	lastLine := linear[loc.componentIndex]
+	loc.segmentIndex = linearLocationNumSegments(lastLine)
+	loc.segmentFraction = 0.0
+}
+
+/**
+ * Gets the component index for this location.
+ *
+ * @return the component index
+ */
+func (loc *LinearLocation) GetComponentIndex() int {
+	return loc.componentIndex
+}
+
+/**
+ * Gets the segment index for this location.
+ *
+ * @return the segment index
+ */
+func (loc *LinearLocation) GetSegmentIndex() int {
+	return loc.segmentIndex
+}
+
+/**
+ * Gets the segment fraction for this location.
+ *
+ * @return the segment fraction
+ */
+func (loc *LinearLocation) GetSegmentFraction() float64 {
+	return loc.segmentFraction
+}
+
+/**
+ * Tests whether this location refers to a vertex.
+ *
+ * @return true if the location is a vertex
+ */
+func (loc *LinearLocation) IsVertex() bool {
+	return loc.segmentFraction <= 0.0 || loc.segmentFraction >= 1.0
+}
+
+/**
+ * Gets the {@link Coordinate} along the
+ * given linear geometry which is
+ * referenced by this location.
+ *
+ * @param linearGeom the linear geometry referenced by this location
+ * @return the coordinate at the location, or nil if the geometry is empty
+ */
+func (loc *LinearLocation) GetCoordinate(linearGeom [][]geom.Coordinate) *geom.Coordinate {
+	if loc.componentIndex >= len(linearGeom) || len(linearGeom[loc.componentIndex]) == 0 {
+		return nil
+	}
+	lineComp := linearGeom[loc.componentIndex]
+	p0 := &lineComp[loc.segmentIndex]
+	if loc.segmentIndex >= linearLocationNumSegments(lineComp) {
+		return p0.Clone()
+	}
+	p1 := &lineComp[loc.segmentIndex+1]
+	return LinearLocationPointAlongSegmentByFraction(p0, p1, loc.segmentFraction)
+}
+
+/**
+ * Gets the endpoints of the line segment in the given
+ * linear geometry which is referenced by this location.
+ * If the location is at the end of a component,
+ * the last segment of the component is returned.
+ *
+ * @param linearGeom the linear geometry referenced by this location
+ * @return the endpoints of the segment, or nil if the component has no segments
+ */
+func (loc *LinearLocation) GetSegment(linearGeom [][]geom.Coordinate) (*geom.Coordinate, *geom.Coordinate) {
+	if loc.componentIndex >= len(linearGeom) || len(linearGeom[loc.componentIndex]) < 2 {
+		return nil, nil
+	}
+	lineComp := linearGeom[loc.componentIndex]
+	p0 := &lineComp[loc.segmentIndex]
+	// check for endpoint - return last segment of the line if so
+	if loc.segmentIndex >= linearLocationNumSegments(lineComp) {
+		prev := &lineComp[len(lineComp)-2]
+		return prev.Clone(), p0.Clone()
+	}
+	p1 := &lineComp[loc.segmentIndex+1]
+	return p0.Clone(), p1.Clone()
+}
+
+/**
+ * Tests whether this location refers to a valid
+ * location on the given linear geometry.
+ *
+ * @param linearGeom a linear geometry
+ * @return true if this location is valid
+ */
+func (loc *LinearLocation) IsValid(linearGeom [][]geom.Coordinate) bool {
+	if loc.componentIndex < 0 || loc.componentIndex >= len(linearGeom) {
+		return false
+	}
+
+	lineComp := linearGeom[loc.componentIndex]
+	numSegments := linearLocationNumSegments(lineComp)
+	if len(lineComp) == 0 {
+		return false
+	}
+	if loc.segmentIndex < 0 || loc.segmentIndex > numSegments {
+		return false
+	}
+	if loc.segmentIndex == numSegments && loc.segmentFraction != 0.0 {
+		return false
+	}
+
+	if loc.segmentFraction < 0.0 || loc.segmentFraction > 1.0 || math.IsNaN(loc.segmentFraction) {
+		return false
+	}
+	return true
+}
+
+/**
+ * Compares this object with the specified object for order.
+ *
+ * @param other the <code>LinearLocation</code> with which this <code>Coordinate</code>
+ *      is being compared
+ * @return a negative integer, zero, or a positive integer as this <code>LinearLocation</code>
+ *      is less than, equal to, or greater than the specified <code>LinearLocation</code>
+ */
+func (loc *LinearLocation) CompareTo(other *LinearLocation) int {
+	return LinearLocationCompareLocationValues(
+		loc.componentIndex, loc.segmentIndex, loc.segmentFraction,
+		other.componentIndex, other.segmentIndex, other.segmentFraction)
+}
+
+/**
+ * Compares this object with the specified index values for order.
+ *
+ * @param componentIndex1 a component index
+ * @param segmentIndex1 a segment index
+ * @param segmentFraction1 a segment fraction
+ * @return a negative integer, zero, or a positive integer as this <code>LinearLocation</code>
+ *      is less than, equal to, or greater than the specified locationValues
+ */
+func (loc *LinearLocation) CompareLocationValues(componentIndex1 int, segmentIndex1 int, segmentFraction1 float64) int {
+	return LinearLocationCompareLocationValues(
+		loc.componentIndex, loc.segmentIndex, loc.segmentFraction,
+		componentIndex1, segmentIndex1, segmentFraction1)
+}
+
+/**
+ * Compares two sets of location values for order.
+ *
+ * @param componentIndex0 a component index
+ * @param segmentIndex0 a segment index
+ * @param segmentFraction0 a segment fraction
+ * @param componentIndex1 another component index
+ * @param segmentIndex1 another segment index
+ * @param segmentFraction1 another segment fraction
+ * @return a negative integer, zero, or a positive integer
+ *      as the first set of location values
+ *      is less than, equal to, or greater than the second set of locationValues
+ */
+func LinearLocationCompareLocationValues(
+	componentIndex0 int, segmentIndex0 int, segmentFraction0 float64,
+	componentIndex1 int, segmentIndex1 int, segmentFraction1 float64) int {
+	// compare component indices
+	if componentIndex0 < componentIndex1 {
+		return -1
+	}
+	if componentIndex0 > componentIndex1 {
+		return 1
+	}
+	// compare segments
+	if segmentIndex0 < segmentIndex1 {
+		return -1
+	}
+	if segmentIndex0 > segmentIndex1 {
+		return 1
+	}
+	// same segment, so compare segment fraction
+	if segmentFraction0 < segmentFraction1 {
+		return -1
+	}
+	if segmentFraction0 > segmentFraction1 {
+		return 1
+	}
+	// same location
+	return 0
+}
+
+/**
+ * Tests whether two locations
+ * are on the same segment in the parent linear geometry.
+ *
+ * @param other a location on the same geometry
+ * @return true if the locations are on the same segment of the parent geometry
+ */
+func (loc *LinearLocation) IsOnSameSegment(other *LinearLocation) bool {
+	if loc.componentIndex != other.componentIndex {
+		return false
+	}
+	if loc.segmentIndex == other.segmentIndex {
+		return true
+	}
+	if other.segmentIndex-loc.segmentIndex == 1 && other.segmentFraction == 0.0 {
+		return true
+	}
+	if loc.segmentIndex-other.segmentIndex == 1 && loc.segmentFraction == 0.0 {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests whether this location is an endpoint of
+ * the linear component it refers to.
+ *
+ * @param linearGeom the linear geometry referenced by this location
+ * @return true if the location is a component endpoint
+ */
+func (loc *LinearLocation) IsEndpoint(linearGeom [][]geom.Coordinate) bool {
+	if loc.componentIndex >= len(linearGeom) {
+		return true
+	}
+	lineComp := linearGeom[loc.componentIndex]
+	// check for endpoint
+	nseg := linearLocationNumSegments(lineComp)
+	return loc.segmentIndex >= nseg ||
+		(loc.segmentIndex == nseg-1 && loc.segmentFraction >= 1.0)
+}
+
+/**
+ * Converts a linear location to the lowest equivalent location index.
+ * The lowest index has the lowest possible component and segment indices.
+ * <p>
+ * Specifically:
+ * <ul>
+ * <li>if the location point is an endpoint, a location value is returned as (nseg-1, 1.0)
+ * <li>if the location point is ambiguous (i.e. an endpoint and a startpoint), the lowest endpoint location is returned
+ * </ul>
+ * If the location index is already the lowest possible value, the original location is returned.
+ *
+ * @param linearGeom the linear geometry referenced by this location
+ * @return the lowest equivalent location
+ */
+func (loc *LinearLocation) ToLowest(linearGeom [][]geom.Coordinate) *LinearLocation {
+	if loc.componentIndex >= len(linearGeom) {
+		return loc
+	}
+	lineComp := linearGeom[loc.componentIndex]
+	nseg := linearLocationNumSegments(lineComp)
+	// if not an endpoint can be returned directly
+	if loc.segmentIndex < nseg || nseg == 0 {
+		return loc
+	}
+	return newLinearLocation(loc.componentIndex, nseg-1, 1.0)
+}
+
+/**
+ * Copies this location.
+ *
+ * @return a copy of this location
+ */
+func (loc *LinearLocation) Copy() *LinearLocation {
+	return newLinearLocation(loc.componentIndex, loc.segmentIndex, loc.segmentFraction)
+}
+
+func (loc *LinearLocation) ToString() string {
+	return fmt.Sprintf("LinearLoc[%d, %d, %v]", loc.componentIndex, loc.segmentIndex, loc.segmentFraction)
+}
+
+func linearLocationNumSegments(line []geom.Coordinate) int {
+	npts := len(line)
+	if npts <= 1 {
+		return 0
+	}
+	return npts - 1
+}
@@ -0,0 +1,50 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Determines the location of a subline along a linear geometry.
+ * The location is reported as a pair of {@link LinearLocation}s.
+ * <p>
+ * <b>Note:</b> Currently this algorithm is not guaranteed to
+ * return the correct substring in some situations where
+ * an endpoint of the test line occurs more than once in the input line.
+ * (However, the common case of a ring is always handled correctly).
+ */
+type locationIndexOfLine struct {
+	linearGeom [][]geom.Coordinate
+}
+
+/**
+ * Computes the locations of the start and end of a subline
+ * along a linear geometry.
+ */
+func locationIndexOfLineIndicesOf(linearGeom [][]geom.Coordinate, subLine [][]geom.Coordinate) [2]*LinearLocation {
+	return newLocationIndexOfLine(linearGeom).indicesOf(subLine)
+}
+
+func newLocationIndexOfLine(linearGeom [][]geom.Coordinate) *locationIndexOfLine {
+	locator := new(locationIndexOfLine)
+	locator.linearGeom = linearGeom
+	return locator
+}
+
+func (locator *locationIndexOfLine) indicesOf(subLine [][]geom.Coordinate) [2]*LinearLocation {
+	startPt := &subLine[0][0]
+	lastLine := subLine[len(subLine)-1]
+	endPt := &lastLine[len(lastLine)-1]
+
+	locPt := newLocationIndexOfPoint(locator.linearGeom)
+	var subLineLoc [2]*LinearLocation
+	subLineLoc[0] = locPt.indexOf(startPt)
+
+	// check for case where subline is zero length
+	if linearGeometryLength(subLine) == 0.0 {
+		subLineLoc[1] = subLineLoc[0].Copy()
+	} else {
+		subLineLoc[1] = locPt.indexOfAfter(endPt, subLineLoc[0])
+	}
+	return subLineLoc
+}
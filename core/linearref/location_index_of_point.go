@@ -0,0 +1,111 @@
+package geos
+
+import (
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the {@link LinearLocation} of the point
+ * on a linear geometry nearest a given {@link Coordinate}.
+ * The nearest point is not necessarily unique; this class
+ * always computes the nearest point closest to
+ * the start of the geometry.
+ */
+type locationIndexOfPoint struct {
+	linearGeom [][]geom.Coordinate
+}
+
+func locationIndexOfPointIndexOf(linearGeom [][]geom.Coordinate, inputPt *geom.Coordinate) *LinearLocation {
+	return newLocationIndexOfPoint(linearGeom).indexOf(inputPt)
+}
+
+func locationIndexOfPointIndexOfAfter(linearGeom [][]geom.Coordinate, inputPt *geom.Coordinate, minIndex *LinearLocation) *LinearLocation {
+	return newLocationIndexOfPoint(linearGeom).indexOfAfter(inputPt, minIndex)
+}
+
+func newLocationIndexOfPoint(linearGeom [][]geom.Coordinate) *locationIndexOfPoint {
+	locator := new(locationIndexOfPoint)
+	locator.linearGeom = linearGeom
+	return locator
+}
+
+/**
+ * Find the nearest location along a linear geometry to a given point.
+ *
+ * @param inputPt the coordinate to locate
+ * @return the location of the nearest point
+ */
+func (locator *locationIndexOfPoint) indexOf(inputPt *geom.Coordinate) *LinearLocation {
+	return locator.indexOfFromStart(inputPt, nil)
+}
+
+/**
+ * Find the nearest {@link LinearLocation} along the linear geometry
+ * to a given {@link Coordinate}
+ * after the specified minimum {@link LinearLocation}.
+ * If possible the location returned will be strictly greater than the
+ * <code>minLocation</code>.
+ * If this is not possible, the
+ * value returned will equal <code>minLocation</code>.
+ * (An example where this is not possible is when
+ * minLocation = [end of line] ).
+ *
+ * @param inputPt the coordinate to locate
+ * @param minIndex the minimum location for the point location
+ * @return the location of the nearest point
+ */
+func (locator *locationIndexOfPoint) indexOfAfter(inputPt *geom.Coordinate, minIndex *LinearLocation) *LinearLocation {
+	if minIndex == nil {
+		return locator.indexOf(inputPt)
+	}
+
+	// sanity check for minLocation at or past end of line
+	endLoc := LinearLocationGetEndLocation(locator.linearGeom)
+	if endLoc.CompareTo(minIndex) <= 0 {
+		return endLoc
+	}
+
+	return locator.indexOfFromStart(inputPt, minIndex)
+}
+
+func (locator *locationIndexOfPoint) indexOfFromStart(inputPt *geom.Coordinate, minIndex *LinearLocation) *LinearLocation {
+	minDistance := math.MaxFloat64
+	minComponentIndex := 0
+	minSegmentIndex := 0
+	minFrac := -1.0
+
+	for it := newLinearIterator(locator.linearGeom); it.hasNext(); it.next() {
+		if !it.isEndOfLine() {
+			p0 := it.getSegmentStart()
+			p1 := it.getSegmentEnd()
+			segDistance := algorithm.DistancePointToSegment(inputPt, p0, p1)
+			segFrac := lineSegmentFraction(p0, p1, inputPt)
+
+			candidateComponentIndex := it.componentIndex
+			candidateSegmentIndex := it.vertexIndex
+			if segDistance < minDistance {
+				// ensure after minLocation, if any
+				if minIndex == nil ||
+					minIndex.CompareLocationValues(candidateComponentIndex, candidateSegmentIndex, segFrac) < 0 {
+					// otherwise, save this as new minimum
+					minComponentIndex = candidateComponentIndex
+					minSegmentIndex = candidateSegmentIndex
+					minFrac = segFrac
+					minDistance = segDistance
+				}
+			}
+		}
+	}
+	if minDistance == math.MaxFloat64 {
+		// no minimum was found past minLocation, so return it
+		if minIndex == nil {
+			return NewLinearLocation()
+		}
+		return minIndex.Copy()
+	}
+	// otherwise, return computed location
+	return NewLinearLocationWithComponent(minComponentIndex, minSegmentIndex, minFrac)
+}
@@ -0,0 +1,207 @@
+package geos
+
+import (
+	"errors"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Supports linear referencing
+ * along a linear geometry
+ * using {@link LinearLocation}s as the index.
+ */
+type LocationIndexedLine struct {
+	linearGeom [][]geom.Coordinate
+}
+
+/**
+ * Constructs an object which allows linear referencing along
+ * a given line.
+ *
+ * @param line the line to reference along
+ */
+func NewLocationIndexedLine(line []geom.Coordinate) *LocationIndexedLine {
+	return NewLocationIndexedLineFromLines([][]geom.Coordinate{line})
+}
+
+/**
+ * Constructs an object which allows linear referencing along
+ * a given multi-line.
+ * The lines are referenced in the order given.
+ *
+ * @param lines the lines to reference along
+ */
+func NewLocationIndexedLineFromLines(lines [][]geom.Coordinate) *LocationIndexedLine {
+	indexedLine := new(LocationIndexedLine)
+	indexedLine.linearGeom = lines
+	return indexedLine
+}
+
+/**
+ * Computes the {@link Coordinate} for the point
+ * on the line at the given index.
+ * If the index is out of range the first or last point on the
+ * line will be returned.
+ * The Z and M values of the coordinate are interpolated
+ * from the line (NaN if not present).
+ *
+ * @param index the index of the desired point
+ * @return the Coordinate at the given index, or nil if the line is empty
+ */
+func (indexedLine *LocationIndexedLine) ExtractPoint(index *LinearLocation) *geom.Coordinate {
+	return index.GetCoordinate(indexedLine.linearGeom)
+}
+
+/**
+ * Computes the {@link Coordinate} for the point
+ * on the line at the given index, offset by the given distance.
+ * If the index is out of range the first or last point on the
+ * line will be returned.
+ * The computed point is offset to the left of the line if the offset distance is
+ * positive, to the right if negative.
+ * <p>
+ * An error is returned if the offset is taken from a zero-length segment.
+ *
+ * @param index the index of the desired point
+ * @param offsetDistance the distance the point is offset from the segment
+ *    (positive is to the left, negative is to the right)
+ * @return the Coordinate at the given index
+ */
+func (indexedLine *LocationIndexedLine) ExtractPointOffset(index *LinearLocation, offsetDistance float64) (*geom.Coordinate, error) {
+	return linearRefExtractPointOffset(indexedLine.linearGeom, index, offsetDistance)
+}
+
+/**
+ * Computes the offset point at a location,
+ * using the lowest equivalent location so that
+ * points at the end of a component are offset from its last segment.
+ */
+func linearRefExtractPointOffset(linearGeom [][]geom.Coordinate, index *LinearLocation, offsetDistance float64) (*geom.Coordinate, error) {
+	indexLow := index.ToLowest(linearGeom)
+	p0, p1 := indexLow.GetSegment(linearGeom)
+	if p0 == nil {
+		return nil, errors.New("cannot compute offset point on a line with no segments")
+	}
+	return lineSegmentPointAlongOffset(p0, p1, indexLow.GetSegmentFraction(), offsetDistance)
+}
+
+/**
+ * Computes the linear geometry for the interval
+ * between the given indices.
+ * If the start location is after the end location,
+ * the computed linear geometry has reverse orientation to the input line.
+ *
+ * @param startIndex the index of the start of the interval
+ * @param endIndex the index of the end of the interval
+ * @return the linear interval between the indices
+ */
+func (indexedLine *LocationIndexedLine) ExtractLine(startIndex *LinearLocation, endIndex *LinearLocation) [][]geom.Coordinate {
+	return extractLineByLocationExtract(indexedLine.linearGeom, startIndex, endIndex)
+}
+
+/**
+ * Computes the index for a given point on the line.
+ * <p>
+ * The supplied point does not <i>necessarily</i> have to lie precisely
+ * on the line, but if it is far from the line the accuracy and
+ * performance of this function is not guaranteed.
+ * Use {@link #Project} to compute a guaranteed result for points
+ * which may be far from the line.
+ *
+ * @param pt a point on the line
+ * @return the index of the point
+ */
+func (indexedLine *LocationIndexedLine) IndexOf(pt *geom.Coordinate) *LinearLocation {
+	return locationIndexOfPointIndexOf(indexedLine.linearGeom, pt)
+}
+
+/**
+ * Finds the index for a point on the line
+ * which is greater than the given index.
+ * If no such index exists, returns <tt>minIndex</tt>.
+ * This method can be used to determine all indexes for
+ * a point which occurs more than once on a non-simple line.
+ * It can also be used to disambiguate cases where the given point lies
+ * slightly off the line and is equidistant from two different
+ * points on the line.
+ *
+ * The supplied point does not <i>necessarily</i> have to lie precisely
+ * on the line, but if it is far from the line the accuracy and
+ * performance of this function is not guaranteed.
+ * Use {@link #Project} to compute a guaranteed result for points
+ * which may be far from the line.
+ *
+ * @param pt a point on the line
+ * @param minIndex the value the returned index must be greater than
+ * @return the index of the point greater than the given minimum index
+ */
+func (indexedLine *LocationIndexedLine) IndexOfAfter(pt *geom.Coordinate, minIndex *LinearLocation) *LinearLocation {
+	return locationIndexOfPointIndexOfAfter(indexedLine.linearGeom, pt, minIndex)
+}
+
+/**
+ * Computes the indices for a subline of the line.
+ * (The subline must <b>conform</b> to the line; that is,
+ * all vertices in the subline (except possibly the first and last)
+ * must be vertices of the line and occur in the same order).
+ *
+ * @param subLine a subline of the line
+ * @return a pair of indices for the start and end of the subline.
+ */
+func (indexedLine *LocationIndexedLine) IndicesOf(subLine [][]geom.Coordinate) [2]*LinearLocation {
+	return locationIndexOfLineIndicesOf(indexedLine.linearGeom, subLine)
+}
+
+/**
+ * Computes the index for the closest point on the line to the given point.
+ * If more than one point has the closest distance the first one along the line
+ * is returned.
+ * (The point does not necessarily have to lie precisely on the line.)
+ *
+ * @param pt a point on the line
+ * @return the index of the point
+ */
+func (indexedLine *LocationIndexedLine) Project(pt *geom.Coordinate) *LinearLocation {
+	return locationIndexOfPointIndexOf(indexedLine.linearGeom, pt)
+}
+
+/**
+ * Returns the index of the start of the line
+ *
+ * @return the location index
+ */
+func (indexedLine *LocationIndexedLine) GetStartIndex() *LinearLocation {
+	return NewLinearLocation()
+}
+
+/**
+ * Returns the index of the end of the line
+ *
+ * @return the location index
+ */
+func (indexedLine *LocationIndexedLine) GetEndIndex() *LinearLocation {
+	return LinearLocationGetEndLocation(indexedLine.linearGeom)
+}
+
+/**
+ * Tests whether an index is in the valid index range for the line.
+ *
+ * @param index the index to test
+ * @return <code>true</code> if the index is in the valid range
+ */
+func (indexedLine *LocationIndexedLine) IsValidIndex(index *LinearLocation) bool {
+	return index.IsValid(indexedLine.linearGeom)
+}
+
+/**
+ * Computes a valid index for this line
+ * by clamping the given index to the valid range of index values
+ *
+ * @return a valid index value
+ */
+func (indexedLine *LocationIndexedLine) ClampIndex(index *LinearLocation) *LinearLocation {
+	loc := index.Copy()
+	loc.Clamp(indexedLine.linearGeom)
+	return loc
+}
@@ -0,0 +1,367 @@
+package geos
+
+import (
+	"errors"
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Supports linear referencing along a linear geometry
+ * using the M (measure) values of the vertices as the index.
+ * The measure of a point along a segment is
+ * interpolated linearly between the measures of the segment endpoints.
+ * Segments with a NaN measure at either endpoint are not measured,
+ * and are ignored by the locating operations.
+ * <p>
+ * The measures are not required to be monotonic,
+ * so a measure may occur at more than one location along the line.
+ * {@link #IsMonotonic} can be used to check that measures
+ * determine a unique location.
+ */
+type MeasureIndexedLine struct {
+	linearGeom [][]geom.Coordinate
+}
+
+/**
+ * Computes a copy of a line with M values assigned
+ * by linear interpolation along the length of the line
+ * from a start measure to an end measure.
+ * Existing M values are replaced.
+ * If the line has zero length all vertices are assigned the start measure.
+ *
+ * @param line the line to measure
+ * @param startMeasure the measure of the start point
+ * @param endMeasure the measure of the end point
+ * @return a copy of the line with measures assigned
+ */
+func MeasureIndexedLineAddMeasure(line []geom.Coordinate, startMeasure float64, endMeasure float64) []geom.Coordinate {
+	result := make([]geom.Coordinate, len(line))
+	copy(result, line)
+
+	totalLength := linearGeometryLength([][]geom.Coordinate{line})
+	length := 0.0
+	for i := range result {
+		if i > 0 {
+			length += line[i-1].Distance(&line[i])
+		}
+		frac := 0.0
+		if totalLength > 0.0 {
+			frac = length / totalLength
+		}
+		result[i].M = startMeasure + frac*(endMeasure-startMeasure)
+	}
+	return result
+}
+
+/**
+ * Computes a copy of a line with missing (NaN) M values
+ * interpolated along the length of the line
+ * between the nearest vertices which have measures.
+ * Vertices before the first or after the last measured vertex
+ * are assigned the measure of that vertex.
+ * This allows vertices added to a measured line (e.g. by densification)
+ * to be given consistent measures.
+ * <p>
+ * An error is returned if no vertex of the line has a measure.
+ *
+ * @param line the line to interpolate measures for
+ * @return a copy of the line with all measures populated
+ */
+func MeasureIndexedLineInterpolateMeasures(line []geom.Coordinate) ([]geom.Coordinate, error) {
+	result := make([]geom.Coordinate, len(line))
+	copy(result, line)
+
+	prevIndex := -1
+	for i := range result {
+		if math.IsNaN(result[i].M) {
+			continue
+		}
+		if prevIndex < 0 {
+			// vertices before the first measure take its value
+			for j := 0; j < i; j++ {
+				result[j].M = result[i].M
+			}
+		} else if i-prevIndex > 1 {
+			measureIndexedLineInterpolateGap(result, prevIndex, i)
+		}
+		prevIndex = i
+	}
+	if prevIndex < 0 {
+		return nil, errors.New("line has no measures to interpolate from")
+	}
+	// vertices after the last measure take its value
+	for j := prevIndex + 1; j < len(result); j++ {
+		result[j].M = result[prevIndex].M
+	}
+	return result, nil
+}
+
+/**
+ * Interpolates the measures of the vertices strictly between
+ * two measured vertices, by length along the line.
+ */
+func measureIndexedLineInterpolateGap(pts []geom.Coordinate, startIndex int, endIndex int) {
+	gapLength := linearGeometryLength([][]geom.Coordinate{pts[startIndex : endIndex+1]})
+	m0 := pts[startIndex].M
+	m1 := pts[endIndex].M
+	length := 0.0
+	for i := startIndex + 1; i < endIndex; i++ {
+		length += pts[i-1].Distance(&pts[i])
+		frac := 0.0
+		if gapLength > 0.0 {
+			frac = length / gapLength
+		}
+		pts[i].M = m0 + frac*(m1-m0)
+	}
+}
+
+/**
+ * Constructs an object which allows a line
+ * to be linearly referenced using M values as an index.
+ *
+ * @param line the measured line to reference along
+ */
+func NewMeasureIndexedLine(line []geom.Coordinate) *MeasureIndexedLine {
+	return NewMeasureIndexedLineFromLines([][]geom.Coordinate{line})
+}
+
+/**
+ * Constructs an object which allows a multi-line
+ * to be linearly referenced using M values as an index.
+ * The lines are referenced in the order given.
+ *
+ * @param lines the measured lines to reference along
+ */
+func NewMeasureIndexedLineFromLines(lines [][]geom.Coordinate) *MeasureIndexedLine {
+	indexedLine := new(MeasureIndexedLine)
+	indexedLine.linearGeom = lines
+	return indexedLine
+}
+
+/**
+ * Tests whether the measures of the line are monotonic,
+ * i.e. either never decrease or never increase along the line
+ * (including across components, in the order given).
+ * If <tt>isStrict</tt> is true the measures must be strictly
+ * increasing or strictly decreasing.
+ * A line with a missing (NaN) measure is not monotonic.
+ *
+ * @param isStrict whether consecutive measures must differ
+ * @return true if the measures are monotonic
+ */
+func (indexedLine *MeasureIndexedLine) IsMonotonic(isStrict bool) bool {
+	isIncreasing := true
+	isDecreasing := true
+	prevMeasure := math.NaN()
+	for _, line := range indexedLine.linearGeom {
+		for i := range line {
+			m := line[i].M
+			if math.IsNaN(m) {
+				return false
+			}
+			if !math.IsNaN(prevMeasure) {
+				if m < prevMeasure || (isStrict && m == prevMeasure) {
+					isIncreasing = false
+				}
+				if m > prevMeasure || (isStrict && m == prevMeasure) {
+					isDecreasing = false
+				}
+			}
+			prevMeasure = m
+		}
+	}
+	return isIncreasing || isDecreasing
+}
+
+/**
+ * Computes the locations along the line which have a given measure,
+ * in order along the line.
+ * Where a segment has a constant measure equal to the given measure,
+ * the start of the segment is returned.
+ *
+ * @param measure the measure to locate
+ * @return the locations with the measure (empty if there are none)
+ */
+func (indexedLine *MeasureIndexedLine) LocationsOf(measure float64) []*LinearLocation {
+	locs := make([]*LinearLocation, 0)
+	for it := newLinearIterator(indexedLine.linearGeom); it.hasNext(); it.next() {
+		if it.isEndOfLine() {
+			continue
+		}
+		m0 := it.getSegmentStart().M
+		m1 := it.getSegmentEnd().M
+		frac, ok := measureIndexedLineFractionOf(m0, m1, measure)
+		if !ok {
+			continue
+		}
+		loc := NewLinearLocationWithComponent(it.componentIndex, it.vertexIndex, frac)
+		// a vertex shared by two segments is only located once
+		if len(locs) > 0 && locs[len(locs)-1].CompareTo(loc) == 0 {
+			continue
+		}
+		locs = append(locs, loc)
+	}
+	return locs
+}
+
+/**
+ * Computes the fraction along a segment with endpoint measures
+ * <tt>m0</tt> and <tt>m1</tt> at which a measure occurs.
+ *
+ * @return the fraction, and false if the measure does not occur on the segment
+ */
+func measureIndexedLineFractionOf(m0 float64, m1 float64, measure float64) (float64, bool) {
+	if math.IsNaN(m0) || math.IsNaN(m1) {
+		return 0.0, false
+	}
+	if measure < math.Min(m0, m1) || measure > math.Max(m0, m1) {
+		return 0.0, false
+	}
+	if m0 == m1 {
+		return 0.0, true
+	}
+	return (measure - m0) / (m1 - m0), true
+}
+
+/**
+ * Computes the points along the line which have a given measure,
+ * in order along the line.
+ * This is equivalent to the PostGIS function <tt>ST_LocateAlong</tt>.
+ * The Z and M values of the points are interpolated from the line.
+ *
+ * @param measure the measure to locate
+ * @return the points with the measure (empty if there are none)
+ */
+func (indexedLine *MeasureIndexedLine) LocateAlong(measure float64) []geom.Coordinate {
+	pts := make([]geom.Coordinate, 0)
+	for _, loc := range indexedLine.LocationsOf(measure) {
+		pts = append(pts, *loc.GetCoordinate(indexedLine.linearGeom))
+	}
+	return pts
+}
+
+/**
+ * Computes the points along the line which have a given measure,
+ * offset from the line by a given distance.
+ * The computed points are offset to the left of the line if the offset distance is
+ * positive, to the right if negative.
+ * The Z and M values of the points are those of the located points on the line.
+ * <p>
+ * An error is returned if an offset is taken from a zero-length segment.
+ *
+ * @param measure the measure to locate
+ * @param offsetDistance the distance the points are offset from the line
+ *    (positive is to the left, negative is to the right)
+ * @return the offset points with the measure (empty if there are none)
+ */
+func (indexedLine *MeasureIndexedLine) LocateAlongOffset(measure float64, offsetDistance float64) ([]geom.Coordinate, error) {
+	pts := make([]geom.Coordinate, 0)
+	for _, loc := range indexedLine.LocationsOf(measure) {
+		pt, err := linearRefExtractPointOffset(indexedLine.linearGeom, loc, offsetDistance)
+		if err != nil {
+			return nil, err
+		}
+		linePt := loc.GetCoordinate(indexedLine.linearGeom)
+		pt.Z = linePt.Z
+		pt.M = linePt.M
+		pts = append(pts, *pt)
+	}
+	return pts, nil
+}
+
+/**
+ * Computes the sections of the line which have measures
+ * in the range between two measures (inclusive).
+ * The order of the measures does not matter.
+ * This is equivalent to the PostGIS function <tt>ST_LocateBetween</tt>.
+ * <p>
+ * The sections are returned in order along the line,
+ * with the orientation of the line.
+ * A section which collapses to a single point
+ * (e.g. where the line only touches the range at a vertex)
+ * is returned as a zero-length line.
+ * The Z and M values of the section endpoints are interpolated from the line.
+ *
+ * @param startMeasure one end of the measure range
+ * @param endMeasure the other end of the measure range
+ * @return the sections of the line within the measure range
+ */
+func (indexedLine *MeasureIndexedLine) LocateBetween(startMeasure float64, endMeasure float64) [][]geom.Coordinate {
+	minMeasure := math.Min(startMeasure, endMeasure)
+	maxMeasure := math.Max(startMeasure, endMeasure)
+
+	sections := make([][]geom.Coordinate, 0)
+	var sectionStart *LinearLocation
+	var sectionEnd *LinearLocation
+	isSectionOpen := false
+	for it := newLinearIterator(indexedLine.linearGeom); it.hasNext(); it.next() {
+		if it.isEndOfLine() {
+			isSectionOpen = false
+			continue
+		}
+		frac0, frac1, ok := measureIndexedLineRangeFractions(it.getSegmentStart().M, it.getSegmentEnd().M, minMeasure, maxMeasure)
+		if !ok {
+			isSectionOpen = false
+			continue
+		}
+		// continue the current section if it reaches the start of this segment
+		if !(isSectionOpen && frac0 <= 0.0) {
+			if sectionStart != nil {
+				sections = append(sections, extractLineByLocationExtract(indexedLine.linearGeom, sectionStart, sectionEnd)...)
+			}
+			sectionStart = NewLinearLocationWithComponent(it.componentIndex, it.vertexIndex, frac0)
+		}
+		sectionEnd = NewLinearLocationWithComponent(it.componentIndex, it.vertexIndex, frac1)
+		isSectionOpen = frac1 >= 1.0
+	}
+	if sectionStart != nil {
+		sections = append(sections, extractLineByLocationExtract(indexedLine.linearGeom, sectionStart, sectionEnd)...)
+	}
+	return sections
+}
+
+/**
+ * Computes the interval of fractions along a segment with endpoint measures
+ * <tt>m0</tt> and <tt>m1</tt> which have measures in a range.
+ *
+ * @return the start and end fractions, and false if no part of the segment is in the range
+ */
+func measureIndexedLineRangeFractions(m0 float64, m1 float64, minMeasure float64, maxMeasure float64) (float64, float64, bool) {
+	if math.IsNaN(m0) || math.IsNaN(m1) {
+		return 0.0, 0.0, false
+	}
+	if m0 == m1 {
+		if m0 < minMeasure || m0 > maxMeasure {
+			return 0.0, 0.0, false
+		}
+		return 0.0, 1.0, true
+	}
+	fracMin := (minMeasure - m0) / (m1 - m0)
+	fracMax := (maxMeasure - m0) / (m1 - m0)
+	frac0 := math.Max(0.0, math.Min(fracMin, fracMax))
+	frac1 := math.Min(1.0, math.Max(fracMin, fracMax))
+	if frac0 > frac1 {
+		return 0.0, 0.0, false
+	}
+	return frac0, frac1, true
+}
+
+/**
+ * Computes the measure of the closest point on the line to the given point.
+ * If more than one point has the closest distance the first one along the line
+ * is used.
+ * This is equivalent to the PostGIS function <tt>ST_InterpolatePoint</tt>.
+ *
+ * @param pt the point to project
+ * @return the interpolated measure, or NaN if the line is empty or not measured there
+ */
+func (indexedLine *MeasureIndexedLine) Project(pt *geom.Coordinate) float64 {
+	loc := locationIndexOfPointIndexOf(indexedLine.linearGeom, pt)
+	linePt := loc.GetCoordinate(indexedLine.linearGeom)
+	if linePt == nil {
+		return math.NaN()
+	}
+	return linePt.M
+}
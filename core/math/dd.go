@@ -0,0 +1,205 @@
+package geos
+
+/**
+ * Implements extended-precision floating-point numbers
+ * which maintain 106 bits (approximately 30 decimal digits) of precision.
+ * <p>
+ * A DD uses a representation containing two double-precision values.
+ * A number x is represented as a pair of doubles, x.hi and x.lo,
+ * such that the number represented by x is x.hi + x.lo, where
+ * <pre>
+ *    |x.lo| &lt;= 0.5*ulp(x.hi)
+ * </pre>
+ * and ulp(y) means "unit in the last place of y".
+ * <p>
+ * Only the operations required by the robust geometric predicates are provided.
+ * Products are wrapped in explicit conversions so that the compiler
+ * does not fuse them into FMA instructions, which would break the
+ * error-free transformations the algorithms rely on.
+ */
+type DD struct {
+	hi float64
+	lo float64
+}
+
+/**
+ * The value to split a double-precision value on during multiplication
+ */
+const dd_SPLIT = 134217729.0 // 2^27+1, for IEEE double
+
+/**
+ * Creates a new DD with value x.
+ *
+ * @param x the value to initialize
+ */
+func NewDD(x float64) *DD {
+	dd := new(DD)
+	dd.hi = x
+	dd.lo = 0.0
+	return dd
+}
+
+/**
+ * Creates a new DD with value (hi, lo).
+ *
+ * @param hi the high-order component
+ * @param lo the high-order component
+ */
+func NewDDHiLo(hi float64, lo float64) *DD {
+	dd := new(DD)
+	dd.hi = hi
+	dd.lo = lo
+	return dd
+}
+
+/**
+ * Converts the given double value to a DD number.
+ *
+ * @param x a numeric value
+ * @return the extended precision version of the value
+ */
+func DDValueOf(x float64) *DD {
+	return NewDD(x)
+}
+
+/**
+ * Creates a new DD with the same value as this one.
+ */
+func (dd *DD) Copy() *DD {
+	return NewDDHiLo(dd.hi, dd.lo)
+}
+
+/**
+ * Adds the argument to the value of this DD.
+ * To prevent altering constants,
+ * this method <b>must only</b> be used on values known to
+ * be newly created.
+ *
+ * @param y the addend
+ * @return this object, increased by y
+ */
+func (dd *DD) SelfAddFloat(y float64) *DD {
+	return dd.selfAdd(y, 0.0)
+}
+
+/**
+ * Adds the argument to the value of this DD.
+ *
+ * @param y the addend
+ * @return this object, increased by y
+ */
+func (dd *DD) SelfAdd(y *DD) *DD {
+	return dd.selfAdd(y.hi, y.lo)
+}
+
+/**
+ * Subtracts the argument from the value of this DD.
+ *
+ * @param y the subtrahend
+ * @return this object, decreased by y
+ */
+func (dd *DD) SelfSubtract(y *DD) *DD {
+	return dd.selfAdd(-y.hi, -y.lo)
+}
+
+/**
+ * Subtracts the argument from the value of this DD.
+ *
+ * @param y the subtrahend
+ * @return this object, decreased by y
+ */
+func (dd *DD) SelfSubtractFloat(y float64) *DD {
+	return dd.selfAdd(-y, 0.0)
+}
+
+func (dd *DD) selfAdd(yhi float64, ylo float64) *DD {
+	var H, h, T, t, S, s, e, f float64
+	S = dd.hi + yhi
+	T = dd.lo + ylo
+	e = S - dd.hi
+	f = T - dd.lo
+	s = S - e
+	t = T - f
+	s = (yhi - e) + (dd.hi - s)
+	t = (ylo - f) + (dd.lo - t)
+	e = s + T
+	H = S + e
+	h = e + (S - H)
+	e = t + h
+
+	zhi := H + e
+	zlo := e + (H - zhi)
+	dd.hi = zhi
+	dd.lo = zlo
+	return dd
+}
+
+/**
+ * Multiplies this object by the argument, returning <tt>this</tt>.
+ *
+ * @param y the value to multiply by
+ * @return this object, multiplied by y
+ */
+func (dd *DD) SelfMultiply(y *DD) *DD {
+	return dd.selfMultiply(y.hi, y.lo)
+}
+
+/**
+ * Multiplies this object by the argument, returning <tt>this</tt>.
+ *
+ * @param y the value to multiply by
+ * @return this object, multiplied by y
+ */
+func (dd *DD) SelfMultiplyFloat(y float64) *DD {
+	return dd.selfMultiply(y, 0.0)
+}
+
+func (dd *DD) selfMultiply(yhi float64, ylo float64) *DD {
+	var hx, tx, hy, ty, C, c float64
+	C = float64(dd_SPLIT * dd.hi)
+	hx = C - dd.hi
+	c = float64(dd_SPLIT * yhi)
+	hx = C - hx
+	tx = dd.hi - hx
+	hy = c - yhi
+	C = float64(dd.hi * yhi)
+	hy = c - hy
+	ty = yhi - hy
+	c = ((((float64(hx*hy) - C) + float64(hx*ty)) + float64(tx*hy)) + float64(tx*ty)) + (float64(dd.hi*ylo) + float64(dd.lo*yhi))
+	zhi := C + c
+	hx = C - zhi
+	zlo := c + hx
+	dd.hi = zhi
+	dd.lo = zlo
+	return dd
+}
+
+/**
+ * Returns the signum function of this value.
+ *
+ * @return -1, 0 or 1 depending on the sign of this value
+ */
+func (dd *DD) Signum() int {
+	if dd.hi > 0 {
+		return 1
+	}
+	if dd.hi < 0 {
+		return -1
+	}
+	if dd.lo > 0 {
+		return 1
+	}
+	if dd.lo < 0 {
+		return -1
+	}
+	return 0
+}
+
+/**
+ * Converts this value to the nearest double-precision number.
+ *
+ * @return the nearest double-precision number to this value
+ */
+func (dd *DD) DoubleValue() float64 {
+	return dd.hi + dd.lo
+}
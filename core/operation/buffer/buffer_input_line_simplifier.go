@@ -0,0 +1,221 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const (
+	bufferInputLineSimplifier_NUM_PTS_TO_CHECK = 10
+
+	bufferInputLineSimplifier_DELETE = 1
+)
+
+/**
+ * Simplifies a buffer input line to
+ * remove concavities with shallow depth.
+ * <p>
+ * The most important benefit of doing this
+ * is to reduce the number of points and the complexity of
+ * shape which will be buffered.
+ * It also reduces the risk of gores created by
+ * the quantized fillet arcs (although this issue
+ * should be eliminated in any case by the
+ * offset curve generation logic).
+ * <p>
+ * A key aspect of the simplification is that it
+ * affects inside (concave or inward) corners only.
+ * Convex (outward) corners are preserved, since they
+ * are required to ensure that the generated buffer curve
+ * lies at the correct distance from the input geometry.
+ * <p>
+ * Another important heuristic used is that the end segments
+ * of the input are never simplified.  This ensures that
+ * the client buffer code is able to generate end caps faithfully.
+ * <p>
+ * No attempt is made to avoid self-intersections in the output.
+ * This is acceptable for use for generating a buffer offset curve,
+ * since the buffer algorithm is insensitive to invalid polygonal
+ * geometry.  However,
+ * this means that this algorithm
+ * cannot be used as a general-purpose polygon simplification technique.
+ */
+type BufferInputLineSimplifier struct {
+	inputLine        []geom.Coordinate
+	distanceTol      float64
+	isDeleted        []byte
+	angleOrientation int
+}
+
+/**
+ * Simplify the input coordinate list.
+ * If the distance tolerance is positive,
+ * concavities on the LEFT side of the line are simplified.
+ * If the supplied distance tolerance is negative,
+ * concavities on the RIGHT side of the line are simplified.
+ *
+ * @param inputLine the coordinate list to simplify
+ * @param distanceTol simplification distance tolerance to use
+ * @return the simplified coordinate list
+ */
+func BufferInputLineSimplify(inputLine []geom.Coordinate, distanceTol float64) []geom.Coordinate {
+	simp := NewBufferInputLineSimplifier(inputLine)
+	return simp.Simplify(distanceTol)
+}
+
+func NewBufferInputLineSimplifier(inputLine []geom.Coordinate) *BufferInputLineSimplifier {
+	bils := new(BufferInputLineSimplifier)
+	bils.inputLine = inputLine
+	bils.angleOrientation = constants.ORIENTATION_COUNTERCLOCKWISE
+	return bils
+}
+
+/**
+ * Simplify the input coordinate list.
+ * If the distance tolerance is positive,
+ * concavities on the LEFT side of the line are simplified.
+ * If the supplied distance tolerance is negative,
+ * concavities on the RIGHT side of the line are simplified.
+ *
+ * @param distanceTol simplification distance tolerance to use
+ * @return the simplified coordinate list
+ */
+func (bils *BufferInputLineSimplifier) Simplify(distanceTol float64) []geom.Coordinate {
+	bils.distanceTol = distanceTol
+	if bils.distanceTol < 0 {
+		bils.distanceTol = -bils.distanceTol
+		bils.angleOrientation = constants.ORIENTATION_CLOCKWISE
+	}
+
+	// rely on fact that boolean array is filled with false value
+	bils.isDeleted = make([]byte, len(bils.inputLine))
+
+	isChanged := false
+	for {
+		isChanged = bils.deleteShallowConcavities()
+		if !isChanged {
+			break
+		}
+	}
+
+	return bils.collapseLine()
+}
+
+/**
+ * Uses a sliding window containing 3 vertices to detect shallow angles
+ * in which the middle vertex can be deleted, since it does not
+ * affect the shape of the resulting buffer in a significant way.
+ * @return true if any vertices were deleted
+ */
+func (bils *BufferInputLineSimplifier) deleteShallowConcavities() bool {
+	/**
+	 * Do not simplify end line segments of the line string.
+	 * This ensures that end caps are generated consistently.
+	 */
+	index := 1
+
+	midIndex := bils.findNextNonDeletedIndex(index)
+	lastIndex := bils.findNextNonDeletedIndex(midIndex)
+
+	isChanged := false
+	for lastIndex < len(bils.inputLine) {
+		// test triple for shallow concavity
+		isMiddleVertexDeleted := false
+		if bils.isDeletable(index, midIndex, lastIndex, bils.distanceTol) {
+			bils.isDeleted[midIndex] = bufferInputLineSimplifier_DELETE
+			isMiddleVertexDeleted = true
+			isChanged = true
+		}
+		// move simplification window forward
+		if isMiddleVertexDeleted {
+			index = lastIndex
+		} else {
+			index = midIndex
+		}
+
+		midIndex = bils.findNextNonDeletedIndex(index)
+		lastIndex = bils.findNextNonDeletedIndex(midIndex)
+	}
+	return isChanged
+}
+
+/**
+ * Finds the next non-deleted index, or the end of the point array if none
+ * @param index
+ * @return the next non-deleted index, if any
+ * or inputLine.length if there are no more non-deleted indices
+ */
+func (bils *BufferInputLineSimplifier) findNextNonDeletedIndex(index int) int {
+	next := index + 1
+	for next < len(bils.inputLine) && bils.isDeleted[next] == bufferInputLineSimplifier_DELETE {
+		next++
+	}
+	return next
+}
+
+func (bils *BufferInputLineSimplifier) collapseLine() []geom.Coordinate {
+	coordList := geom.DefaultCoordinateList()
+	for i := 0; i < len(bils.inputLine); i++ {
+		if bils.isDeleted[i] != bufferInputLineSimplifier_DELETE {
+			coordList.AddCoordinateRepeated(&bils.inputLine[i], true)
+		}
+	}
+	return coordList.ToCoordinateArray()
+}
+
+func (bils *BufferInputLineSimplifier) isDeletable(i0 int, i1 int, i2 int, distanceTol float64) bool {
+	p0 := &bils.inputLine[i0]
+	p1 := &bils.inputLine[i1]
+	p2 := &bils.inputLine[i2]
+
+	if !bils.isConcave(p0, p1, p2) {
+		return false
+	}
+	if !bils.isShallow(p0, p1, p2, distanceTol) {
+		return false
+	}
+
+	// MD - don't use this heuristic - it's too restricting
+	//  if (p0.distance(p2) > distanceTol) return false;
+
+	return bils.isShallowSampled(p0, p2, i0, i2, distanceTol)
+}
+
+/**
+ * Checks for shallowness over a sample of points in the given section.
+ * This helps prevents the simplification from incrementally
+ * "skipping" over points which are in fact non-shallow.
+ *
+ * @param p0 start coordinate of section
+ * @param p2 end coordinate of section
+ * @param i0 start index of section
+ * @param i2 end index of section
+ * @param distanceTol distance tolerance
+ * @return true if all sampled points are within tolerance of p0-p2
+ */
+func (bils *BufferInputLineSimplifier) isShallowSampled(p0 *geom.Coordinate, p2 *geom.Coordinate, i0 int, i2 int, distanceTol float64) bool {
+	// check every n'th point to see if it is within tolerance
+	inc := (i2 - i0) / bufferInputLineSimplifier_NUM_PTS_TO_CHECK
+	if inc <= 0 {
+		inc = 1
+	}
+
+	for i := i0; i < i2; i += inc {
+		if !bils.isShallow(p0, &bils.inputLine[i], p2, distanceTol) {
+			return false
+		}
+	}
+	return true
+}
+
+func (bils *BufferInputLineSimplifier) isShallow(p0 *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate, distanceTol float64) bool {
+	dist := algorithm.DistancePointToSegment(p1, p0, p2)
+	return dist < distanceTol
+}
+
+func (bils *BufferInputLineSimplifier) isConcave(p0 *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate) bool {
+	orientation := algorithm.OrientationIndex(p0, p1, p2)
+	isConcave := (orientation == bils.angleOrientation)
+	return isConcave
+}
@@ -0,0 +1,266 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+)
+
+/**
+ * A value class containing the parameters which
+ * specify how a buffer should be constructed.
+ * <p>
+ * The parameters allow control over:
+ * <ul>
+ * <li>Quadrant segments (accuracy of approximation for circular arcs)
+ * <li>End Cap style
+ * <li>Join style
+ * <li>Mitre limit
+ * <li>whether the buffer is single-sided
+ * </ul>
+ */
+type BufferParameters struct {
+	quadrantSegments int
+	endCapStyle      int
+	joinStyle        int
+	mitreLimit       float64
+	isSingleSided    bool
+	simplifyFactor   float64
+}
+
+/**
+ * Creates a default set of parameters
+ */
+func DefaultBufferParameters() *BufferParameters {
+	bp := new(BufferParameters)
+	bp.quadrantSegments = constants.BUFFER_DEFAULT_QUADRANT_SEGMENTS
+	bp.endCapStyle = constants.BUFFER_CAP_ROUND
+	bp.joinStyle = constants.BUFFER_JOIN_ROUND
+	bp.mitreLimit = constants.BUFFER_DEFAULT_MITRE_LIMIT
+	bp.isSingleSided = false
+	bp.simplifyFactor = constants.BUFFER_DEFAULT_SIMPLIFY_FACTOR
+	return bp
+}
+
+/**
+ * Creates a set of parameters with the
+ * given quadrantSegments value.
+ *
+ * @param quadrantSegments the number of quadrant segments to use
+ */
+func NewBufferParametersQuadrantSegments(quadrantSegments int) *BufferParameters {
+	bp := DefaultBufferParameters()
+	bp.SetQuadrantSegments(quadrantSegments)
+	return bp
+}
+
+/**
+ * Creates a set of parameters with the
+ * given quadrantSegments and endCapStyle values.
+ *
+ * @param quadrantSegments the number of quadrant segments to use
+ * @param endCapStyle the end cap style to use
+ */
+func NewBufferParametersEndCap(quadrantSegments int, endCapStyle int) *BufferParameters {
+	bp := DefaultBufferParameters()
+	bp.SetQuadrantSegments(quadrantSegments)
+	bp.SetEndCapStyle(endCapStyle)
+	return bp
+}
+
+/**
+ * Creates a set of parameters with the
+ * given parameter values.
+ *
+ * @param quadrantSegments the number of quadrant segments to use
+ * @param endCapStyle the end cap style to use
+ * @param joinStyle the join style to use
+ * @param mitreLimit the mitre limit to use
+ */
+func NewBufferParameters(quadrantSegments int, endCapStyle int, joinStyle int, mitreLimit float64) *BufferParameters {
+	bp := DefaultBufferParameters()
+	bp.SetQuadrantSegments(quadrantSegments)
+	bp.SetEndCapStyle(endCapStyle)
+	bp.SetJoinStyle(joinStyle)
+	bp.SetMitreLimit(mitreLimit)
+	return bp
+}
+
+/**
+ * Computes the maximum distance error due to a given level
+ * of approximation to a true arc.
+ *
+ * @param quadSegs the number of segments used to approximate a quarter-circle
+ * @return the error of approximation
+ */
+func BufferDistanceError(quadSegs int) float64 {
+	alpha := constants.ANGLE_PI_OVER_2 / float64(quadSegs)
+	return 1 - math.Cos(alpha/2.0)
+}
+
+/**
+ * Gets the number of quadrant segments which will be used
+ * to approximate angle fillets in round endcaps and joins.
+ *
+ * @return the number of quadrant segments
+ */
+func (bp *BufferParameters) GetQuadrantSegments() int {
+	return bp.quadrantSegments
+}
+
+/**
+ * Sets the number of line segments in a quarter-circle
+ * used to approximate angle fillets in round endcaps and joins.
+ * The value should be at least 1.
+ * <p>
+ * This determines the
+ * error in the approximation to the true buffer curve.
+ * The default value of 8 gives less than 2% error in the buffer distance.
+ * For a error of &lt; 1%, use QS = 12.
+ * For a error of &lt; 0.1%, use QS = 18.
+ * The error is always less than the buffer distance
+ * (in other words, the computed buffer curve is always inside the true
+ * curve).
+ *
+ * @param quadSegs the number of segments in a fillet for a circle quadrant
+ */
+func (bp *BufferParameters) SetQuadrantSegments(quadSegs int) {
+	bp.quadrantSegments = quadSegs
+}
+
+/**
+ * Gets the end cap style.
+ *
+ * @return the end cap style code
+ */
+func (bp *BufferParameters) GetEndCapStyle() int {
+	return bp.endCapStyle
+}
+
+/**
+ * Specifies the end cap style of the generated buffer.
+ * The styles supported are {@link constants#BUFFER_CAP_ROUND},
+ * {@link constants#BUFFER_CAP_FLAT}, and {@link constants#BUFFER_CAP_SQUARE}.
+ * The default is {@link constants#BUFFER_CAP_ROUND}.
+ *
+ * @param endCapStyle the code for the end cap style
+ */
+func (bp *BufferParameters) SetEndCapStyle(endCapStyle int) {
+	bp.endCapStyle = endCapStyle
+}
+
+/**
+ * Gets the join style
+ *
+ * @return the join style code
+ */
+func (bp *BufferParameters) GetJoinStyle() int {
+	return bp.joinStyle
+}
+
+/**
+ * Sets the join style for outside (reflex) corners between line segments.
+ * The styles supported are {@link constants#BUFFER_JOIN_ROUND},
+ * {@link constants#BUFFER_JOIN_MITRE} and {@link constants#BUFFER_JOIN_BEVEL}.
+ * The default is {@link constants#BUFFER_JOIN_ROUND}.
+ *
+ * @param joinStyle the code for the join style
+ */
+func (bp *BufferParameters) SetJoinStyle(joinStyle int) {
+	bp.joinStyle = joinStyle
+}
+
+/**
+ * Gets the mitre ratio limit.
+ *
+ * @return the limit value
+ */
+func (bp *BufferParameters) GetMitreLimit() float64 {
+	return bp.mitreLimit
+}
+
+/**
+ * Sets the limit on the mitre ratio used for very sharp corners.
+ * The mitre ratio is the ratio of the distance from the corner
+ * to the end of the mitred offset corner.
+ * When two line segments meet at a sharp angle,
+ * a miter join will extend far beyond the original geometry.
+ * (and in the extreme case will be infinitely far.)
+ * To prevent unreasonable geometry, the mitre limit
+ * allows controlling the maximum length of the join corner.
+ * Corners with a ratio which exceed the limit will be beveled.
+ *
+ * @param mitreLimit the mitre ratio limit
+ */
+func (bp *BufferParameters) SetMitreLimit(mitreLimit float64) {
+	bp.mitreLimit = mitreLimit
+}
+
+/**
+ * Sets whether the computed buffer should be single-sided.
+ * A single-sided buffer is constructed on only one side of each input line.
+ * <p>
+ * The side used is determined by the sign of the buffer distance:
+ * <ul>
+ * <li>a positive distance indicates the left-hand side
+ * <li>a negative distance indicates the right-hand side
+ * </ul>
+ * The single-sided buffer of point geometries is
+ * the same as the regular buffer.
+ * <p>
+ * The End Cap Style for single-sided buffers is
+ * always ignored,
+ * and forced to the equivalent of <tt>CAP_FLAT</tt>.
+ *
+ * @param isSingleSided true if a single-sided buffer should be constructed
+ */
+func (bp *BufferParameters) SetSingleSided(isSingleSided bool) {
+	bp.isSingleSided = isSingleSided
+}
+
+/**
+ * Tests whether the buffer is to be generated on a single side only.
+ *
+ * @return true if the generated buffer is to be single-sided
+ */
+func (bp *BufferParameters) IsSingleSided() bool {
+	return bp.isSingleSided
+}
+
+/**
+ * Gets the simplify factor.
+ *
+ * @return the simplify factor
+ */
+func (bp *BufferParameters) GetSimplifyFactor() float64 {
+	return bp.simplifyFactor
+}
+
+/**
+ * Sets the factor used to determine the simplify distance tolerance
+ * for input simplification.
+ * Simplifying can increase the performance of computing buffers.
+ * Generally the simplify factor should be greater than 0.
+ * Values between 0.01 and .1 produce relatively good accuracy for the generate buffer.
+ * Larger values sacrifice accuracy in return for performance.
+ *
+ * @param simplifyFactor a value greater than or equal to zero.
+ */
+func (bp *BufferParameters) SetSimplifyFactor(simplifyFactor float64) {
+	if simplifyFactor < 0 {
+		bp.simplifyFactor = 0
+	} else {
+		bp.simplifyFactor = simplifyFactor
+	}
+}
+
+/**
+ * Creates a copy of these parameters.
+ *
+ * @return a copy of these parameters
+ */
+func (bp *BufferParameters) Copy() *BufferParameters {
+	bpCopy := new(BufferParameters)
+	*bpCopy = *bp
+	return bpCopy
+}
@@ -0,0 +1,180 @@
+package geos
+
+import (
+	"slices"
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygonbuilder "github.com/UltimateThread/geos-go/core/internal/polygonbuilder"
+)
+
+/**
+ * A directed boundary edge of a convex part,
+ * produced by noding the part boundaries against each other.
+ */
+type convexPartEdge struct {
+	p0   geom.Coordinate
+	p1   geom.Coordinate
+	part int
+}
+
+type convexPartEdgeKey struct {
+	x0, y0, x1, y1 float64
+}
+
+/**
+ * Computes the union of a set of convex polygons,
+ * each given as a clockwise-oriented ring.
+ * The part boundaries are noded against each other,
+ * and the edges which do not lie in the interior of another part
+ * (or duplicate an edge of another part) are linked into rings.
+ * Clockwise rings form the shells of the result,
+ * and counter-clockwise rings the holes.
+ *
+ * @param parts the clockwise rings of the convex parts
+ * @return the union polygons, each as a shell followed by its holes
+ */
+func unionConvexParts(parts [][]geom.Coordinate) [][][]geom.Coordinate {
+	envs := make([]*geom.Envelope, len(parts))
+	for i, part := range parts {
+		envs[i] = geom.NewEnvelopeFromCoordinateArray(part)
+	}
+
+	edges := nodeConvexParts(parts, envs)
+	edges = selectUnionEdges(edges, parts, envs)
+	boundary := make([]polygonbuilder.BoundaryEdge, len(edges))
+	for i, e := range edges {
+		boundary[i] = polygonbuilder.BoundaryEdge{P0: e.p0, P1: e.p1}
+	}
+	//-- dangling edges can only arise from robustness failures, so the closed rings are kept
+	polys, _ := polygonbuilder.PolygonBuilderBuild(boundary)
+	return polys
+}
+
+/**
+ * Nodes the boundaries of the parts against each other,
+ * returning the noded boundary edges.
+ */
+func nodeConvexParts(parts [][]geom.Coordinate, envs []*geom.Envelope) []convexPartEdge {
+	segNodes := make([][][]geom.Coordinate, len(parts))
+	for i, part := range parts {
+		segNodes[i] = make([][]geom.Coordinate, len(part)-1)
+	}
+
+	li := algorithm.NewLineIntersector()
+	for i := 0; i < len(parts); i++ {
+		for j := i + 1; j < len(parts); j++ {
+			if !envs[i].IntersectsEnvelope(envs[j]) {
+				continue
+			}
+			pi := parts[i]
+			pj := parts[j]
+			for a := 0; a < len(pi)-1; a++ {
+				for b := 0; b < len(pj)-1; b++ {
+					if !geom.EnvelopeIntersectsSegments(&pi[a], &pi[a+1], &pj[b], &pj[b+1]) {
+						continue
+					}
+					li.ComputeIntersection(&pi[a], &pi[a+1], &pj[b], &pj[b+1])
+					for k := 0; k < li.GetIntersectionNum(); k++ {
+						intPt := li.GetIntersection(k)
+						segNodes[i][a] = append(segNodes[i][a], *intPt)
+						segNodes[j][b] = append(segNodes[j][b], *intPt)
+					}
+				}
+			}
+		}
+	}
+
+	edges := make([]convexPartEdge, 0)
+	for i, part := range parts {
+		for a := 0; a < len(part)-1; a++ {
+			p0 := part[a]
+			p1 := part[a+1]
+			nodes := make([]offsetCurveNode, 0, len(segNodes[i][a]))
+			for _, nodePt := range segNodes[i][a] {
+				if nodePt.Equals2D(&p0) || nodePt.Equals2D(&p1) {
+					continue
+				}
+				nodes = append(nodes, offsetCurveNode{nodePt, nodePt.Distance(&p0)})
+			}
+			sort.Slice(nodes, func(m, n int) bool {
+				return nodes[m].dist < nodes[n].dist
+			})
+			prev := p0
+			for _, node := range nodes {
+				if node.pt.Equals2D(&prev) {
+					continue
+				}
+				edges = append(edges, convexPartEdge{prev, node.pt, i})
+				prev = node.pt
+			}
+			if !prev.Equals2D(&p1) {
+				edges = append(edges, convexPartEdge{prev, p1, i})
+			}
+		}
+	}
+	return edges
+}
+
+/**
+ * Selects the noded edges which lie on the boundary of the union.
+ * An edge is discarded if it lies in the interior of another part,
+ * if it is matched by an oppositely-oriented edge of another part
+ * (in which case the parts lie on both sides of it),
+ * or if it duplicates an edge of a part with a lower index.
+ */
+func selectUnionEdges(edges []convexPartEdge, parts [][]geom.Coordinate, envs []*geom.Envelope) []convexPartEdge {
+	edgeParts := make(map[convexPartEdgeKey][]int)
+	for _, e := range edges {
+		key := convexPartEdgeKey{e.p0.X, e.p0.Y, e.p1.X, e.p1.Y}
+		edgeParts[key] = append(edgeParts[key], e.part)
+	}
+
+	selected := make([]convexPartEdge, 0, len(edges))
+	for _, e := range edges {
+		dupParts := edgeParts[convexPartEdgeKey{e.p0.X, e.p0.Y, e.p1.X, e.p1.Y}]
+		if dupParts[0] != e.part {
+			continue
+		}
+		if _, ok := edgeParts[convexPartEdgeKey{e.p1.X, e.p1.Y, e.p0.X, e.p0.Y}]; ok {
+			continue
+		}
+		//-- the midpoint may not be computed exactly on the edge,
+		//-- so parts sharing the edge are not tested
+		mid := geom.NewCoordinateXY((e.p0.X+e.p1.X)/2, (e.p0.Y+e.p1.Y)/2)
+		if isInteriorToOtherPart(mid, dupParts, parts, envs) {
+			continue
+		}
+		selected = append(selected, e)
+	}
+	return selected
+}
+
+func isInteriorToOtherPart(pt *geom.Coordinate, edgeParts []int, parts [][]geom.Coordinate, envs []*geom.Envelope) bool {
+	for i, part := range parts {
+		if slices.Contains(edgeParts, i) || !envs[i].IntersectsCoordinate(pt) {
+			continue
+		}
+		if isInteriorToConvexRing(pt, part) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether a point lies strictly inside a convex clockwise ring.
+ */
+func isInteriorToConvexRing(pt *geom.Coordinate, ring []geom.Coordinate) bool {
+	for i := 0; i < len(ring)-1; i++ {
+		if ring[i].Equals2D(&ring[i+1]) {
+			continue
+		}
+		if algorithm.OrientationIndex(&ring[i], &ring[i+1], pt) != constants.ORIENTATION_CLOCKWISE {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,689 @@
+package geos
+
+import (
+	"math"
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	chain "github.com/UltimateThread/geos-go/core/index/chain"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+const (
+	/**
+	 * The minimum number of quadrant segments used to approximate
+	 * round joins. A coarser approximation makes it impossible to
+	 * distinguish the curve from the loops which must be removed.
+	 */
+	offsetCurve_MIN_QUADRANT_SEGMENTS = 8
+
+	/**
+	 * The fraction of the offset distance by which the raw curve
+	 * must penetrate the buffer to be considered inside it
+	 * (and hence not part of the offset curve).
+	 */
+	offsetCurve_INSIDE_DISTANCE_FACTOR = 1.0e-6
+)
+
+/**
+ * Computes an offset curve from a line.
+ * An offset curve is a line which lies at a given distance
+ * on one side of the input, following its shape.
+ * A positive distance offsets to the left of the line,
+ * a negative distance offsets to the right.
+ * <p>
+ * The offset curve of a line is the portion of the
+ * boundary of the line buffer which lies on the desired side.
+ * It is built from the raw offset curve generated with the buffer
+ * join styles, by noding it with itself and removing the sections
+ * which lie inside the buffer: the loops formed at narrow concave
+ * angles and where distant parts of the line are closer than
+ * twice the offset distance.
+ * This makes the result free of self-intersections.
+ * Because of this the offset curve may consist of several
+ * disjoint sections, which are returned in the order they occur along
+ * the input line.
+ * Sections may optionally be joined into a single line.
+ * <p>
+ * Closed lines (rings) produce closed offset curves,
+ * as long as no part of the curve is removed.
+ * <p>
+ * The offset curve has the following properties:
+ * <ul>
+ * <li>it is oriented in the same direction as the input line
+ * <li>it has the same vertex count as the raw offset curve,
+ *     plus the points created where sections were removed
+ * <li>it does not have any self-intersections, unless joined
+ * </ul>
+ * The buffer parameters used to generate the curve are the join style,
+ * quadrant segments and mitre limit; the end cap style is not used.
+ * To keep the curve distinguishable from the loops being removed,
+ * at least 8 quadrant segments are used.
+ */
+type OffsetCurve struct {
+	inputLine     []geom.Coordinate
+	distance      float64
+	bufferParams  *BufferParameters
+	matchDistance float64
+	chordDistance float64
+	isJoined      bool
+}
+
+/**
+ * Computes the offset curve of a line at a given distance,
+ * using the given buffer parameters to determine the join style.
+ *
+ * @param line a line
+ * @param distance the offset distance (positive = left, negative = right)
+ * @param bufParams the buffer parameters to use (may be nil)
+ * @return the sections of the offset curve
+ */
+func GetOffsetCurve(line []geom.Coordinate, distance float64, bufParams *BufferParameters) [][]geom.Coordinate {
+	oc := NewOffsetCurveWithParameters(line, distance, bufParams)
+	return oc.GetCurve()
+}
+
+/**
+ * Computes the offset curve of a line at a given distance,
+ * joining curve sections into a single line.
+ *
+ * @param line a line
+ * @param distance the offset distance (positive = left, negative = right)
+ * @return the joined offset curve
+ */
+func GetOffsetCurveJoined(line []geom.Coordinate, distance float64) []geom.Coordinate {
+	oc := NewOffsetCurve(line, distance)
+	oc.SetJoined(true)
+	curve := oc.GetCurve()
+	if len(curve) == 0 {
+		return nil
+	}
+	return curve[0]
+}
+
+/**
+ * Creates a new instance for computing an offset curve for a line at a given distance
+ * with default quadrant segments ({@link constants#BUFFER_DEFAULT_QUADRANT_SEGMENTS})
+ * and join style ({@link constants#BUFFER_JOIN_ROUND}).
+ *
+ * @param line the line to offset
+ * @param distance the offset distance (positive = left, negative = right)
+ */
+func NewOffsetCurve(line []geom.Coordinate, distance float64) *OffsetCurve {
+	return NewOffsetCurveWithParameters(line, distance, nil)
+}
+
+/**
+ * Creates a new instance for computing an offset curve for a line at a given distance.
+ * setting the quadrant segments, join style and mitre limit
+ * via {@link BufferParameters}.
+ *
+ * @param line the line to offset
+ * @param distance the offset distance (positive = left, negative = right)
+ * @param bufParams the buffer parameters to use (may be nil)
+ */
+func NewOffsetCurveWithParameters(line []geom.Coordinate, distance float64, bufParams *BufferParameters) *OffsetCurve {
+	oc := new(OffsetCurve)
+	oc.inputLine = line
+	oc.distance = distance
+
+	oc.matchDistance = math.Abs(distance) * offsetCurve_INSIDE_DISTANCE_FACTOR
+
+	//-- make new buffer params since the end cap style is irrelevant
+	oc.bufferParams = DefaultBufferParameters()
+	quadSegs := offsetCurve_MIN_QUADRANT_SEGMENTS
+	joinStyle := constants.BUFFER_JOIN_ROUND
+	mitreLimit := constants.BUFFER_DEFAULT_MITRE_LIMIT
+	if bufParams != nil {
+		quadSegs = bufParams.GetQuadrantSegments()
+		if quadSegs < offsetCurve_MIN_QUADRANT_SEGMENTS {
+			quadSegs = offsetCurve_MIN_QUADRANT_SEGMENTS
+		}
+		joinStyle = bufParams.GetJoinStyle()
+		mitreLimit = bufParams.GetMitreLimit()
+		oc.bufferParams.SetSimplifyFactor(bufParams.GetSimplifyFactor())
+	}
+	oc.bufferParams.SetQuadrantSegments(quadSegs)
+	oc.bufferParams.SetJoinStyle(joinStyle)
+	oc.bufferParams.SetMitreLimit(mitreLimit)
+
+	/**
+	 * Fillet chords are at most 1.5 angle quanta wide,
+	 * so their midpoints can lie closer to the line than the
+	 * offset distance by this amount.
+	 */
+	filletAngleQuantum := constants.ANGLE_PI_OVER_2 / float64(quadSegs)
+	oc.chordDistance = math.Abs(distance)*(1-math.Cos(0.75*filletAngleQuantum)) + oc.matchDistance
+	return oc
+}
+
+/**
+ * Computes a single curve line by joining the sections of the offset curve.
+ * The joined curve may have self-intersections.
+ *
+ * @param isJoined true if joined mode should be used.
+ */
+func (oc *OffsetCurve) SetJoined(isJoined bool) {
+	oc.isJoined = isJoined
+}
+
+/**
+ * Gets the computed offset curve sections.
+ * A copy of the line is returned if the distance is zero,
+ * and nil is returned if the line has fewer than 2 valid distinct points.
+ *
+ * @return the offset curve sections (a single section if joined)
+ */
+func (oc *OffsetCurve) GetCurve() [][]geom.Coordinate {
+	pts := geom.RemoveRepeatedOrInvalidPoints(oc.inputLine)
+	if len(pts) < 2 {
+		return nil
+	}
+	if oc.distance == 0.0 {
+		return [][]geom.Coordinate{geom.NewCoordinateList(pts).ToCoordinateArray()}
+	}
+
+	sections := oc.computeCurveSections(pts)
+	if oc.isJoined && len(sections) > 1 {
+		joined := geom.DefaultCoordinateList()
+		for _, section := range sections {
+			joined.AddCoordinateListRepeated(section, false)
+		}
+		return [][]geom.Coordinate{joined.ToCoordinateArray()}
+	}
+	return sections
+}
+
+/**
+ * Gets the raw offset curve for a line at a given distance.
+ * The quadrant segments, join style and mitre limit can be specified
+ * via {@link BufferParameters}.
+ * <p>
+ * The raw offset line may contain loops and other artifacts which are
+ * not present in the true offset curve.
+ *
+ * @param line the line to offset
+ * @param distance the offset distance (positive = left, negative = right)
+ * @param bufParams the buffer parameters to use
+ * @return the raw offset curve points
+ */
+func OffsetCurveRawOffset(line []geom.Coordinate, distance float64, bufParams *BufferParameters) []geom.Coordinate {
+	pts := geom.RemoveRepeatedOrInvalidPoints(line)
+	if len(pts) < 2 {
+		return nil
+	}
+	ocb := NewOffsetCurveBuilder(bufParams)
+	return ocb.GetOffsetCurve(pts, distance)
+}
+
+func (oc *OffsetCurve) computeCurveSections(pts []geom.Coordinate) [][]geom.Coordinate {
+	ocb := NewOffsetCurveBuilder(oc.bufferParams)
+	isRing := geom.IsRing(pts)
+
+	side := constants.POSITION_LEFT
+	if oc.distance < 0 {
+		side = constants.POSITION_RIGHT
+	}
+	var rawCurve []geom.Coordinate
+	if isRing {
+		rawCurve = ocb.GetRingCurve(pts, side, math.Abs(oc.distance))
+	} else {
+		rawCurve = ocb.GetOffsetCurve(pts, oc.distance)
+	}
+	// the distance to the curve is measured from the line the curve was generated for
+	genLine := ocb.simplifyInput(pts, side)
+
+	noded := nodeCurve(rawCurve, isRing)
+	sections := oc.extractSections(noded, genLine)
+
+	//-- a ring curve is closed, so the last section may continue into the first
+	if isRing && len(sections) > 1 {
+		first := sections[0]
+		last := sections[len(sections)-1]
+		if last[len(last)-1].Equals2D(&first[0]) {
+			merged := append(last, first[1:]...)
+			sections = append([][]geom.Coordinate{merged}, sections[1:len(sections)-1]...)
+		}
+	}
+	return sections
+}
+
+type offsetCurveNode struct {
+	pt   geom.Coordinate
+	dist float64
+}
+
+/**
+ * Nodes the raw curve with itself,
+ * by inserting the self-intersection points into every segment they lie on.
+ * The segment pairs which may intersect are found using
+ * an index of the monotone chains of the curve.
+ */
+func nodeCurve(curve []geom.Coordinate, isRing bool) []geom.Coordinate {
+	nSeg := len(curve) - 1
+	segNodes := make([][]geom.Coordinate, nSeg)
+	li := algorithm.NewLineIntersector()
+	action := func(mc1 *chain.MonotoneChain, i int, mc2 *chain.MonotoneChain, j int) {
+		if i > j {
+			i, j = j, i
+		}
+		isAdjacent := j == i+1 || (isRing && i == 0 && j == nSeg-1)
+		li.ComputeIntersection(&curve[i], &curve[i+1], &curve[j], &curve[j+1])
+		if !li.HasIntersection() {
+			return
+		}
+		//-- adjacent segments always touch at their common vertex
+		if isAdjacent && li.GetIntersectionNum() < 2 {
+			return
+		}
+		for k := 0; k < li.GetIntersectionNum(); k++ {
+			intPt := li.GetIntersection(k)
+			segNodes[i] = append(segNodes[i], *intPt)
+			segNodes[j] = append(segNodes[j], *intPt)
+		}
+	}
+
+	index := strtree.NewSTRtree[*chain.MonotoneChain]()
+	chains := chain.MonotoneChainBuilderGetChains(curve)
+	for i, mc := range chains {
+		mc.SetId(i)
+		index.Insert(mc.GetEnvelope(), mc)
+	}
+	for _, queryChain := range chains {
+		index.QueryVisitor(queryChain.GetEnvelope(), func(testChain *chain.MonotoneChain) bool {
+			//-- compare each pair of chains once; the segments of a chain do not cross
+			if testChain.GetId() > queryChain.GetId() {
+				queryChain.ComputeOverlaps(testChain, action)
+			}
+			return true
+		})
+	}
+
+	noded := make([]geom.Coordinate, 0, len(curve))
+	for i := 0; i < nSeg; i++ {
+		p0 := curve[i]
+		p1 := curve[i+1]
+		noded = append(noded, p0)
+
+		nodes := make([]offsetCurveNode, 0, len(segNodes[i]))
+		for _, nodePt := range segNodes[i] {
+			if nodePt.Equals2D(&p0) || nodePt.Equals2D(&p1) {
+				continue
+			}
+			nodes = append(nodes, offsetCurveNode{nodePt, nodePt.Distance(&p0)})
+		}
+		sort.Slice(nodes, func(a, b int) bool {
+			return nodes[a].dist < nodes[b].dist
+		})
+		for k, node := range nodes {
+			if k > 0 && node.pt.Equals2D(&nodes[k-1].pt) {
+				continue
+			}
+			noded = append(noded, node.pt)
+		}
+	}
+	noded = append(noded, curve[nSeg])
+	return noded
+}
+
+/**
+ * Extracts the sections of the noded raw curve which lie on the
+ * boundary of the buffer of the line.
+ * Every segment of the noded curve is clipped against the buffer
+ * along its whole length, so that portions which dip into the buffer
+ * between their endpoints are removed as well.
+ */
+func (oc *OffsetCurve) extractSections(noded []geom.Coordinate, line []geom.Coordinate) [][]geom.Coordinate {
+	sections := make([][]geom.Coordinate, 0)
+	var section *geom.CoordinateList
+
+	addPiece := func(p0 *geom.Coordinate, p1 *geom.Coordinate) {
+		if p0.Distance(p1) <= oc.matchDistance {
+			return
+		}
+		if section != nil {
+			last := section.GetCoordinate(len(section.Coordinates) - 1)
+			if !last.Equals2D(p0) {
+				sections = append(sections, section.ToCoordinateArray())
+				section = nil
+			}
+		}
+		if section == nil {
+			section = geom.DefaultCoordinateList()
+			section.AddCoordinateRepeated(p0, false)
+		}
+		section.AddCoordinateRepeated(p1, false)
+	}
+
+	bufIndex := oc.newBufferIndex(line)
+	for i := 0; i < len(noded)-1; i++ {
+		p0 := &noded[i]
+		p1 := &noded[i+1]
+		for _, outside := range oc.outsideIntervals(p0, p1, bufIndex) {
+			addPiece(pointAlongSegment(p0, p1, outside[0]), pointAlongSegment(p0, p1, outside[1]))
+		}
+	}
+	if section != nil {
+		sections = append(sections, section.ToCoordinateArray())
+	}
+	return sections
+}
+
+/**
+ * The region of the buffer around a vertex of the line,
+ * which is not covered by the rectangles along the adjacent segments.
+ * It is either a disc (for round joins and line endpoints)
+ * or a convex polygon given by half-planes (for bevel and mitre joins).
+ */
+type offsetCurveVertexRegion struct {
+	center geom.Coordinate
+	//-- the maximum distance of the region from the center
+	radius float64
+	//-- the depth by which the raw curve may lie inside a disc region
+	discTolerance float64
+	//-- the half-planes a*x + b*y <= c bounding a polygonal region
+	halfPlanes [][3]float64
+}
+
+/**
+ * Computes the buffer regions around the vertices of the line.
+ * The joins generated at a vertex are on the boundary of its region,
+ * except for round joins, whose fillet chords lie inside the disc
+ * by at most the chord depth.
+ * Only vertices where the line turns away from the offset side have a join
+ * on the curve, but the join regions on the other side are part
+ * of the buffer as well.
+ */
+func (oc *OffsetCurve) vertexRegions(line []geom.Coordinate) []offsetCurveVertexRegion {
+	n := len(line)
+	dist := math.Abs(oc.distance)
+	isRing := geom.IsRing(line)
+	regions := make([]offsetCurveVertexRegion, n)
+	for i := 0; i < n; i++ {
+		regions[i].center = line[i]
+		regions[i].radius = dist
+		regions[i].discTolerance = oc.matchDistance
+		iPrev := i - 1
+		iNext := i + 1
+		if isRing {
+			if i == 0 {
+				iPrev = n - 2
+			}
+			if i == n-1 {
+				iNext = 1
+			}
+		}
+		if iPrev < 0 || iNext >= n {
+			continue
+		}
+		len0 := line[iPrev].Distance(&line[i])
+		len1 := line[i].Distance(&line[iNext])
+		tx0 := (line[i].X - line[iPrev].X) / len0
+		ty0 := (line[i].Y - line[iPrev].Y) / len0
+		tx1 := (line[iNext].X - line[i].X) / len1
+		ty1 := (line[iNext].Y - line[i].Y) / len1
+		cross := tx0*ty1 - ty0*tx1
+		if oc.bufferParams.GetJoinStyle() == constants.BUFFER_JOIN_ROUND {
+			//-- only turns away from the offset side have a fillet on the curve
+			isConvex := (oc.distance > 0 && cross < 0) || (oc.distance < 0 && cross > 0)
+			if isConvex {
+				regions[i].discTolerance = oc.chordDistance
+			}
+			continue
+		}
+		if cross == 0 {
+			continue
+		}
+		//-- the normals point to the outside of the turn
+		side := 1.0
+		if cross > 0 {
+			side = -1.0
+		}
+		nx0, ny0 := -side*ty0, side*tx0
+		nx1, ny1 := -side*ty1, side*tx1
+		bx := tx0 - tx1
+		by := ty0 - ty1
+		bLen := math.Sqrt(bx*bx + by*by)
+		bx /= bLen
+		by /= bLen
+
+		turnAngle := math.Abs(math.Atan2(cross, tx0*tx1+ty0*ty1))
+		joinDist := dist * math.Cos(turnAngle/2)
+		if oc.bufferParams.GetJoinStyle() == constants.BUFFER_JOIN_MITRE {
+			joinDist = math.Max(joinDist, oc.bufferParams.GetMitreLimit()*dist)
+		}
+		//-- the region lies within the mitre point and the corners cut off by the join line
+		halfTurn := turnAngle / 2
+		cutLen := (joinDist - dist*math.Cos(halfTurn)) / math.Sin(halfTurn)
+		regions[i].radius = math.Min(math.Hypot(dist, cutLen), dist/math.Cos(halfTurn))
+		v := &line[i]
+		regions[i].halfPlanes = [][3]float64{
+			{-tx0, -ty0, -(tx0*v.X + ty0*v.Y)},
+			{tx1, ty1, tx1*v.X + ty1*v.Y},
+			{nx0, ny0, nx0*v.X + ny0*v.Y + dist},
+			{nx1, ny1, nx1*v.X + ny1*v.Y + dist},
+			{bx, by, bx*v.X + by*v.Y + joinDist},
+		}
+	}
+	return regions
+}
+
+/**
+ * The buffer of the line, given by the line segments and the regions
+ * around the line vertices,
+ * with indexes to find the parts of the buffer near a curve segment.
+ */
+type offsetCurveBufferIndex struct {
+	line        []geom.Coordinate
+	regions     []offsetCurveVertexRegion
+	segIndex    *strtree.STRtree[*chain.MonotoneChain]
+	regionIndex *strtree.STRtree[int]
+}
+
+func (oc *OffsetCurve) newBufferIndex(line []geom.Coordinate) *offsetCurveBufferIndex {
+	bufIndex := new(offsetCurveBufferIndex)
+	bufIndex.line = line
+	bufIndex.regions = oc.vertexRegions(line)
+	bufIndex.segIndex = strtree.NewSTRtree[*chain.MonotoneChain]()
+	for _, mc := range chain.MonotoneChainBuilderGetChains(line) {
+		bufIndex.segIndex.Insert(mc.GetEnvelope(), mc)
+	}
+	bufIndex.regionIndex = strtree.NewSTRtree[int]()
+	for i := range bufIndex.regions {
+		region := &bufIndex.regions[i]
+		env := geom.NewEnvelopeFromCoordinate(&region.center)
+		env.ExpandBy(region.radius)
+		bufIndex.regionIndex.Insert(env, i)
+	}
+	return bufIndex
+}
+
+/**
+ * Computes the parameter intervals of a segment which lie outside the buffer,
+ * in increasing order.
+ * The buffer is the union of the rectangles along the line segments
+ * and the regions around the line vertices.
+ * A segment portion is only considered to be inside a region if it
+ * penetrates it by more than the tolerance for the region,
+ * but once it does the entire portion inside the region is removed.
+ */
+func (oc *OffsetCurve) outsideIntervals(p0 *geom.Coordinate, p1 *geom.Coordinate, bufIndex *offsetCurveBufferIndex) [][2]float64 {
+	dist := math.Abs(oc.distance)
+	inside := make([][2]float64, 0)
+	line := bufIndex.line
+	//-- the rectangle along a line segment lies within the distance of the segment
+	searchEnv := geom.NewEnvelopeFromCoordinates(p0, p1)
+	searchEnv.ExpandBy(dist)
+	bufIndex.segIndex.QueryVisitor(searchEnv, func(mc *chain.MonotoneChain) bool {
+		mc.Select(searchEnv, func(mc *chain.MonotoneChain, i int) {
+			interval, ok := segmentInsideRectangle(p0, p1, &line[i], &line[i+1], dist, oc.matchDistance)
+			if ok {
+				inside = append(inside, interval)
+			}
+		})
+		return true
+	})
+	bufIndex.regionIndex.QueryVisitor(geom.NewEnvelopeFromCoordinates(p0, p1), func(i int) bool {
+		region := &bufIndex.regions[i]
+		var interval [2]float64
+		var ok bool
+		if region.halfPlanes == nil {
+			interval, ok = segmentInsideDisc(p0, p1, &region.center, dist, region.discTolerance)
+		} else {
+			interval, ok = segmentInsidePolygon(p0, p1, region.halfPlanes, oc.matchDistance)
+		}
+		if ok {
+			inside = append(inside, interval)
+		}
+		return true
+	})
+	sort.Slice(inside, func(a, b int) bool {
+		return inside[a][0] < inside[b][0]
+	})
+
+	outside := make([][2]float64, 0)
+	start := 0.0
+	for _, interval := range inside {
+		if interval[0] > start {
+			outside = append(outside, [2]float64{start, interval[0]})
+		}
+		if interval[1] > start {
+			start = interval[1]
+		}
+	}
+	if start < 1.0 {
+		outside = append(outside, [2]float64{start, 1.0})
+	}
+	return outside
+}
+
+/**
+ * Computes the parameter interval of the segment p0-p1 which lies inside
+ * the rectangle extending the given distance on both sides of the segment a-b.
+ * The interval is only reported if the segment comes closer to a-b
+ * than the distance less the tolerance.
+ */
+func segmentInsideRectangle(p0 *geom.Coordinate, p1 *geom.Coordinate, a *geom.Coordinate, b *geom.Coordinate, dist float64, tol float64) ([2]float64, bool) {
+	segLen := a.Distance(b)
+	if segLen == 0 {
+		return [2]float64{}, false
+	}
+	ux := (b.X - a.X) / segLen
+	uy := (b.Y - a.Y) / segLen
+	//-- position along and across the segment a-b, as linear functions of the parameter
+	along0 := (p0.X-a.X)*ux + (p0.Y-a.Y)*uy
+	along1 := (p1.X-a.X)*ux + (p1.Y-a.Y)*uy
+	across0 := (p0.X-a.X)*uy - (p0.Y-a.Y)*ux
+	across1 := (p1.X-a.X)*uy - (p1.Y-a.Y)*ux
+
+	t0 := 0.0
+	t1 := 1.0
+	if !clipSlab(along0, along1, 0, segLen, &t0, &t1) || !clipSlab(across0, across1, -dist, dist, &t0, &t1) {
+		return [2]float64{}, false
+	}
+	h0 := across0 + t0*(across1-across0)
+	h1 := across0 + t1*(across1-across0)
+	minAcross := math.Min(math.Abs(h0), math.Abs(h1))
+	if h0*h1 <= 0 {
+		minAcross = 0
+	}
+	if minAcross >= dist-tol {
+		return [2]float64{}, false
+	}
+	return [2]float64{t0, t1}, true
+}
+
+/**
+ * Clips a parameter interval to the range where a linear function
+ * with values v0 at 0 and v1 at 1 lies within [lo, hi].
+ *
+ * @return false if the clipped interval is empty
+ */
+func clipSlab(v0 float64, v1 float64, lo float64, hi float64, t0 *float64, t1 *float64) bool {
+	dv := v1 - v0
+	if dv == 0 {
+		return v0 >= lo && v0 <= hi
+	}
+	tMin := (lo - v0) / dv
+	tMax := (hi - v0) / dv
+	if tMin > tMax {
+		tMin, tMax = tMax, tMin
+	}
+	if tMin > *t0 {
+		*t0 = tMin
+	}
+	if tMax < *t1 {
+		*t1 = tMax
+	}
+	return *t0 < *t1
+}
+
+/**
+ * Computes the parameter interval of the segment p0-p1 which lies inside
+ * the convex polygon bounded by the given half-planes.
+ * The interval is only reported if the segment penetrates the polygon
+ * by more than the tolerance.
+ */
+func segmentInsidePolygon(p0 *geom.Coordinate, p1 *geom.Coordinate, halfPlanes [][3]float64, tol float64) ([2]float64, bool) {
+	t0 := 0.0
+	t1 := 1.0
+	s0 := 0.0
+	s1 := 1.0
+	for _, hp := range halfPlanes {
+		v0 := hp[0]*p0.X + hp[1]*p0.Y
+		v1 := hp[0]*p1.X + hp[1]*p1.Y
+		if !clipSlab(v0, v1, math.Inf(-1), hp[2], &t0, &t1) {
+			return [2]float64{}, false
+		}
+		//-- the polygon shrunk by the tolerance must be penetrated as well
+		if !clipSlab(v0, v1, math.Inf(-1), hp[2]-tol, &s0, &s1) {
+			return [2]float64{}, false
+		}
+	}
+	return [2]float64{t0, t1}, true
+}
+
+/**
+ * Computes the parameter interval of the segment p0-p1 which lies inside
+ * the disc of the given radius around a point.
+ * The interval is only reported if the segment comes closer to the point
+ * than the radius less the tolerance.
+ */
+func segmentInsideDisc(p0 *geom.Coordinate, p1 *geom.Coordinate, center *geom.Coordinate, radius float64, tol float64) ([2]float64, bool) {
+	if algorithm.DistancePointToSegment(center, p0, p1) >= radius-tol {
+		return [2]float64{}, false
+	}
+	dx := p1.X - p0.X
+	dy := p1.Y - p0.Y
+	ox := p0.X - center.X
+	oy := p0.Y - center.Y
+	qa := dx*dx + dy*dy
+	if qa == 0 {
+		return [2]float64{0, 1}, true
+	}
+	qb := ox*dx + oy*dy
+	qc := ox*ox + oy*oy - radius*radius
+	disc := qb*qb - qa*qc
+	if disc < 0 {
+		disc = 0
+	}
+	sqrtDisc := math.Sqrt(disc)
+	t0 := math.Max(0, (-qb-sqrtDisc)/qa)
+	t1 := math.Min(1, (-qb+sqrtDisc)/qa)
+	if t0 >= t1 {
+		return [2]float64{}, false
+	}
+	return [2]float64{t0, t1}, true
+}
+
+func pointAlongSegment(p0 *geom.Coordinate, p1 *geom.Coordinate, t float64) *geom.Coordinate {
+	if t <= 0 {
+		return p0
+	}
+	if t >= 1 {
+		return p1
+	}
+	return geom.NewCoordinateXY(p0.X+t*(p1.X-p0.X), p0.Y+t*(p1.Y-p0.Y))
+}
@@ -0,0 +1,158 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes the raw offset curve for a
+ * single {@link Coordinate} array.
+ * The raw offset curve is a single non-noded curve
+ * that may contain self-intersections (loops).
+ * It is computed using the join style and quadrant segments
+ * of the supplied {@link BufferParameters}.
+ * <p>
+ * The input coordinates are assumed to be free of
+ * repeated and invalid points.
+ */
+type OffsetCurveBuilder struct {
+	distance  float64
+	bufParams *BufferParameters
+}
+
+func NewOffsetCurveBuilder(bufParams *BufferParameters) *OffsetCurveBuilder {
+	ocb := new(OffsetCurveBuilder)
+	ocb.bufParams = bufParams
+	return ocb
+}
+
+/**
+ * Gets the buffer parameters being used to generate the curve.
+ *
+ * @return the buffer parameters being used
+ */
+func (ocb *OffsetCurveBuilder) GetBufferParameters() *BufferParameters {
+	return ocb.bufParams
+}
+
+/**
+ * Computes the raw offset curve of a line at a given distance.
+ * A positive distance offsets to the left of the line,
+ * a negative distance to the right.
+ * The returned curve has the same direction as the input line.
+ *
+ * @param inputPts the line to offset
+ * @param distance the offset distance
+ * @return the offset curve coordinates, or nil if the distance is zero
+ *  or the input has fewer than 2 points
+ */
+func (ocb *OffsetCurveBuilder) GetOffsetCurve(inputPts []geom.Coordinate, distance float64) []geom.Coordinate {
+	ocb.distance = distance
+
+	// a zero width offset curve is empty
+	if distance == 0.0 || len(inputPts) < 2 {
+		return nil
+	}
+
+	isRightSide := distance < 0.0
+	posDistance := math.Abs(distance)
+	segGen := ocb.getSegGen(posDistance)
+	ocb.computeOffsetCurve(inputPts, isRightSide, segGen)
+	curvePts := segGen.GetCoordinates()
+	// for right side line is traversed in reverse direction, so have to reverse generated line
+	if isRightSide {
+		geom.ReverseCoordinates(curvePts)
+	}
+	return curvePts
+}
+
+/**
+ * This method handles the degenerate cases of single points and lines,
+ * as well as valid rings.
+ *
+ * @param inputPts the coordinates of the ring (must not contain repeated points)
+ * @param side side the side {@link constants#POSITION_LEFT} or {@link constants#POSITION_RIGHT} of the ring on which to construct the buffer line
+ * @param distance the positive distance at which to create the offset
+ * @return the closed ring offset curve, or nil if the distance is zero
+ *  or the input is not a ring
+ */
+func (ocb *OffsetCurveBuilder) GetRingCurve(inputPts []geom.Coordinate, side int, distance float64) []geom.Coordinate {
+	ocb.distance = distance
+	if distance == 0.0 || len(inputPts) <= 2 {
+		return nil
+	}
+	segGen := ocb.getSegGen(math.Abs(distance))
+	ocb.computeRingBufferCurve(inputPts, side, segGen)
+	return segGen.GetCoordinates()
+}
+
+func (ocb *OffsetCurveBuilder) getSegGen(distance float64) *OffsetSegmentGenerator {
+	return NewOffsetSegmentGenerator(ocb.bufParams, distance)
+}
+
+/**
+ * Computes the distance tolerance to use during input
+ * line simplification.
+ *
+ * @param distance the buffer distance
+ * @return the simplification tolerance
+ */
+func (ocb *OffsetCurveBuilder) simplifyTolerance(bufDistance float64) float64 {
+	return bufDistance * ocb.bufParams.GetSimplifyFactor()
+}
+
+/**
+ * Simplifies the concavities on the given side of the input,
+ * which do not affect the offset curve significantly.
+ */
+func (ocb *OffsetCurveBuilder) simplifyInput(inputPts []geom.Coordinate, side int) []geom.Coordinate {
+	distTol := ocb.simplifyTolerance(math.Abs(ocb.distance))
+	if side == constants.POSITION_RIGHT {
+		distTol = -distTol
+	}
+	return BufferInputLineSimplify(inputPts, distTol)
+}
+
+func (ocb *OffsetCurveBuilder) computeOffsetCurve(inputPts []geom.Coordinate, isRightSide bool, segGen *OffsetSegmentGenerator) {
+	if isRightSide {
+		//---------- compute points for right side of line
+		// Simplify the appropriate side of the line before generating
+		simp2 := ocb.simplifyInput(inputPts, constants.POSITION_RIGHT)
+
+		n2 := len(simp2) - 1
+		// since we are traversing line in opposite order, offset position is still LEFT
+		segGen.InitSideSegments(&simp2[n2], &simp2[n2-1], constants.POSITION_LEFT)
+		segGen.AddFirstSegment()
+		for i := n2 - 2; i >= 0; i-- {
+			segGen.AddNextSegment(&simp2[i], true)
+		}
+	} else {
+		//--------- compute points for left side of line
+		// Simplify the appropriate side of the line before generating
+		simp1 := ocb.simplifyInput(inputPts, constants.POSITION_LEFT)
+
+		n1 := len(simp1) - 1
+		segGen.InitSideSegments(&simp1[0], &simp1[1], constants.POSITION_LEFT)
+		segGen.AddFirstSegment()
+		for i := 2; i <= n1; i++ {
+			segGen.AddNextSegment(&simp1[i], true)
+		}
+	}
+	segGen.AddLastSegment()
+}
+
+func (ocb *OffsetCurveBuilder) computeRingBufferCurve(inputPts []geom.Coordinate, side int, segGen *OffsetSegmentGenerator) {
+	// simplify input line to improve performance
+	simp := ocb.simplifyInput(inputPts, side)
+
+	n := len(simp) - 1
+	segGen.InitSideSegments(&simp[n-1], &simp[0], side)
+	for i := 1; i <= n; i++ {
+		addStartPoint := i != 1
+		segGen.AddNextSegment(&simp[i], addStartPoint)
+	}
+	segGen.CloseRing()
+}
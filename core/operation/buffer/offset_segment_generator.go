@@ -0,0 +1,544 @@
+package geos
+
+import (
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const (
+	/**
+	 * Factor which controls how close offset segments can be to
+	 * skip adding a filler or mitre.
+	 */
+	offsetSegmentGenerator_OFFSET_SEGMENT_SEPARATION_FACTOR = 1.0e-3
+
+	/**
+	 * Factor which controls how close curve vertices on inside turns can be to be snapped
+	 */
+	offsetSegmentGenerator_INSIDE_TURN_VERTEX_SNAP_DISTANCE_FACTOR = 1.0e-3
+
+	/**
+	 * Factor which controls how close curve vertices can be to be snapped
+	 */
+	offsetSegmentGenerator_CURVE_VERTEX_SNAP_DISTANCE_FACTOR = 1.0e-6
+
+	/**
+	 * Factor which determines how short closing segs can be for round buffers
+	 */
+	offsetSegmentGenerator_MAX_CLOSING_SEG_LEN_FACTOR = 80
+)
+
+/**
+ * Generates segments which form an offset curve.
+ * Supports all end cap and join options
+ * provided for buffering.
+ * This algorithm implements various heuristics to
+ * produce smoother, simpler curves which are
+ * still within a reasonable tolerance of the
+ * true curve.
+ */
+type OffsetSegmentGenerator struct {
+	/**
+	 * the max error of approximation (distance) between a quad segment and the true fillet curve
+	 */
+	maxCurveSegmentError float64
+
+	/**
+	 * The angle quantum with which to approximate a fillet curve
+	 * (based on the input # of quadrant segments)
+	 */
+	filletAngleQuantum float64
+
+	/**
+	 * The Closing Segment Length Factor controls how long
+	 * "closing segments" are.  Closing segments are added
+	 * at the middle of inside corners to ensure a smoother
+	 * boundary for the buffer offset curve.
+	 * In some cases (particularly for round joins with default-or-better
+	 * quantization) the closing segments can be made quite short.
+	 * This substantially improves performance (due to fewer intersections being created).
+	 *
+	 * A closingSegFactor of 0 results in lines to the corner vertex
+	 * A closingSegFactor of 1 results in lines halfway to the corner vertex
+	 * A closingSegFactor of 80 results in lines 1/81 of the way to the corner vertex
+	 * (this option is reasonable for the very common default situation of round joins
+	 * and quadrantSegs &gt;= 8)
+	 */
+	closingSegLengthFactor int
+
+	segList   *OffsetSegmentString
+	distance  float64
+	bufParams *BufferParameters
+	li        *algorithm.LineIntersector
+
+	s0 geom.Coordinate
+	s1 geom.Coordinate
+	s2 geom.Coordinate
+
+	seg0    [2]geom.Coordinate
+	seg1    [2]geom.Coordinate
+	offset0 [2]geom.Coordinate
+	offset1 [2]geom.Coordinate
+	side    int
+
+	hasNarrowConcaveAngle bool
+}
+
+func NewOffsetSegmentGenerator(bufParams *BufferParameters, distance float64) *OffsetSegmentGenerator {
+	osg := new(OffsetSegmentGenerator)
+	osg.bufParams = bufParams
+	osg.closingSegLengthFactor = 1
+
+	// compute intersections in full precision, to provide accuracy
+	// the points are rounded as they are inserted into the curve line
+	osg.li = algorithm.NewLineIntersector()
+
+	quadSegs := bufParams.GetQuadrantSegments()
+	if quadSegs < 1 {
+		quadSegs = 1
+	}
+	osg.filletAngleQuantum = constants.ANGLE_PI_OVER_2 / float64(quadSegs)
+
+	/**
+	 * Non-round joins cause issues with short closing segments, so don't use
+	 * them. In any case, non-round joins only really make sense for relatively
+	 * small buffer distances.
+	 */
+	if bufParams.GetQuadrantSegments() >= 8 && bufParams.GetJoinStyle() == constants.BUFFER_JOIN_ROUND {
+		osg.closingSegLengthFactor = offsetSegmentGenerator_MAX_CLOSING_SEG_LEN_FACTOR
+	}
+	osg.init(distance)
+	return osg
+}
+
+/**
+ * Tests whether the input has a narrow concave angle
+ * (relative to the offset distance).
+ * In this case the generated offset curve will contain self-intersections
+ * and heuristic closing segments.
+ * This is expected behaviour in the case of Buffer curves.
+ * For pure Offset Curves,
+ * the output needs to be further treated
+ * before it can be used.
+ *
+ * @return true if the input has a narrow concave angle
+ */
+func (osg *OffsetSegmentGenerator) HasNarrowConcaveAngle() bool {
+	return osg.hasNarrowConcaveAngle
+}
+
+func (osg *OffsetSegmentGenerator) init(distance float64) {
+	osg.distance = math.Abs(distance)
+	osg.maxCurveSegmentError = osg.distance * (1 - math.Cos(osg.filletAngleQuantum/2.0))
+	osg.segList = DefaultOffsetSegmentString()
+	/**
+	 * Choose the min vertex separation as a small fraction of the offset distance.
+	 */
+	osg.segList.SetMinimumVertexDistance(osg.distance * offsetSegmentGenerator_CURVE_VERTEX_SNAP_DISTANCE_FACTOR)
+}
+
+func (osg *OffsetSegmentGenerator) InitSideSegments(s1 *geom.Coordinate, s2 *geom.Coordinate, side int) {
+	osg.s1 = *s1
+	osg.s2 = *s2
+	osg.side = side
+	osg.seg1[0] = *s1
+	osg.seg1[1] = *s2
+	computeOffsetSegment(&osg.seg1, side, osg.distance, &osg.offset1)
+}
+
+func (osg *OffsetSegmentGenerator) GetCoordinates() []geom.Coordinate {
+	return osg.segList.GetCoordinates()
+}
+
+func (osg *OffsetSegmentGenerator) CloseRing() {
+	osg.segList.CloseRing()
+}
+
+func (osg *OffsetSegmentGenerator) AddSegments(pts []geom.Coordinate, isForward bool) {
+	osg.segList.AddPts(pts, isForward)
+}
+
+func (osg *OffsetSegmentGenerator) AddFirstSegment() {
+	osg.segList.AddPt(&osg.offset1[0])
+}
+
+/**
+ * Add last offset point
+ */
+func (osg *OffsetSegmentGenerator) AddLastSegment() {
+	osg.segList.AddPt(&osg.offset1[1])
+}
+
+func (osg *OffsetSegmentGenerator) AddNextSegment(p *geom.Coordinate, addStartPoint bool) {
+	// s0-s1-s2 are the coordinates of the previous segment and the current one
+	osg.s0 = osg.s1
+	osg.s1 = osg.s2
+	osg.s2 = *p
+	osg.seg0[0] = osg.s0
+	osg.seg0[1] = osg.s1
+	computeOffsetSegment(&osg.seg0, osg.side, osg.distance, &osg.offset0)
+	osg.seg1[0] = osg.s1
+	osg.seg1[1] = osg.s2
+	computeOffsetSegment(&osg.seg1, osg.side, osg.distance, &osg.offset1)
+
+	// do nothing if points are equal
+	if osg.s1.Equals2D(&osg.s2) {
+		return
+	}
+
+	orientation := algorithm.OrientationIndex(&osg.s0, &osg.s1, &osg.s2)
+	outsideTurn := (orientation == constants.ORIENTATION_CLOCKWISE && osg.side == constants.POSITION_LEFT) ||
+		(orientation == constants.ORIENTATION_COUNTERCLOCKWISE && osg.side == constants.POSITION_RIGHT)
+
+	if orientation == 0 { // lines are collinear
+		osg.addCollinear(addStartPoint)
+	} else if outsideTurn {
+		osg.addOutsideTurn(orientation, addStartPoint)
+	} else { // inside turn
+		osg.addInsideTurn(orientation, addStartPoint)
+	}
+}
+
+func (osg *OffsetSegmentGenerator) addCollinear(addStartPoint bool) {
+	/**
+	 * This test could probably be done more efficiently,
+	 * but the situation of exact collinearity should be fairly rare.
+	 */
+	osg.li.ComputeIntersection(&osg.s0, &osg.s1, &osg.s1, &osg.s2)
+	numInt := osg.li.GetIntersectionNum()
+	/**
+	 * if numInt is &lt; 2, the lines are parallel and in the same direction. In
+	 * this case the point can be ignored, since the offset lines will also be
+	 * parallel.
+	 */
+	if numInt >= 2 {
+		/**
+		 * segments are collinear but reversing.
+		 * Add an "end-cap" fillet
+		 * all the way around to other direction.
+		 * This case should ONLY happen for LineStrings,
+		 * so the orientation is always CW. (Polygons can never
+		 * have two consecutive segments which are parallel but
+		 * reversed, because that would be a self intersection.
+		 */
+		if osg.bufParams.GetJoinStyle() == constants.BUFFER_JOIN_BEVEL ||
+			osg.bufParams.GetJoinStyle() == constants.BUFFER_JOIN_MITRE {
+			if addStartPoint {
+				osg.segList.AddPt(&osg.offset0[1])
+			}
+			osg.segList.AddPt(&osg.offset1[0])
+		} else {
+			osg.addCornerFillet(&osg.s1, &osg.offset0[1], &osg.offset1[0], constants.ORIENTATION_CLOCKWISE, osg.distance)
+		}
+	}
+}
+
+/**
+ * Adds the offset points for an outside (convex) turn
+ *
+ * @param orientation
+ * @param addStartPoint
+ */
+func (osg *OffsetSegmentGenerator) addOutsideTurn(orientation int, addStartPoint bool) {
+	/**
+	 * Heuristic: If offset endpoints are very close together,
+	 * (which happens for nearly-parallel segments),
+	 * use an endpoint as the single offset corner vertex.
+	 * This eliminates very short single-segment joins,
+	 * which reduces the number of offset curve vertices.
+	 * This also avoids robustness problems with computing mitre corners
+	 * for nearly-parallel segments.
+	 */
+	if osg.offset0[1].Distance(&osg.offset1[0]) < osg.distance*offsetSegmentGenerator_OFFSET_SEGMENT_SEPARATION_FACTOR {
+		osg.segList.AddPt(&osg.offset0[1])
+		return
+	}
+
+	if osg.bufParams.GetJoinStyle() == constants.BUFFER_JOIN_MITRE {
+		osg.addMitreJoin(&osg.s1, &osg.offset0, &osg.offset1, osg.distance)
+	} else if osg.bufParams.GetJoinStyle() == constants.BUFFER_JOIN_BEVEL {
+		osg.addBevelJoin(&osg.offset0, &osg.offset1)
+	} else {
+		// add a circular fillet connecting the endpoints of the offset segments
+		if addStartPoint {
+			osg.segList.AddPt(&osg.offset0[1])
+		}
+		osg.addCornerFillet(&osg.s1, &osg.offset0[1], &osg.offset1[0], orientation, osg.distance)
+		osg.segList.AddPt(&osg.offset1[0])
+	}
+}
+
+/**
+ * Adds the offset points for an inside (concave) turn.
+ *
+ * @param orientation
+ * @param addStartPoint
+ */
+func (osg *OffsetSegmentGenerator) addInsideTurn(orientation int, addStartPoint bool) {
+	/**
+	 * add intersection point of offset segments (if any)
+	 */
+	osg.li.ComputeIntersection(&osg.offset0[0], &osg.offset0[1], &osg.offset1[0], &osg.offset1[1])
+	if osg.li.HasIntersection() {
+		osg.segList.AddPt(osg.li.GetIntersection(0))
+	} else {
+		/**
+		 * If no intersection is detected,
+		 * it means the angle is so small and/or the offset so
+		 * large that the offsets segments don't intersect.
+		 * In this case we must
+		 * add a "closing segment" to make sure the buffer curve is continuous,
+		 * fairly smooth (e.g. no sharp reversals in direction)
+		 * and tracks the buffer correctly around the corner. The curve connects
+		 * the endpoints of the segment offsets to points
+		 * which lie toward the centre point of the corner.
+		 * The joining curve will not appear in the final buffer outline, since it
+		 * is completely internal to the buffer polygon.
+		 *
+		 * In complex buffer cases the closing segment may cut across many other
+		 * segments in the generated offset curve.  In order to improve the
+		 * performance of the noding, the closing segment should be kept as short as possible.
+		 * (But not too short, since that would defeat its purpose).
+		 * This is the purpose of the closingSegFactor heuristic value.
+		 */
+		osg.hasNarrowConcaveAngle = true
+		if osg.offset0[1].Distance(&osg.offset1[0]) < osg.distance*offsetSegmentGenerator_INSIDE_TURN_VERTEX_SNAP_DISTANCE_FACTOR {
+			osg.segList.AddPt(&osg.offset0[1])
+		} else {
+			// add endpoint of this segment offset
+			osg.segList.AddPt(&osg.offset0[1])
+
+			/**
+			 * Add "closing segment" of required length.
+			 */
+			if osg.closingSegLengthFactor > 0 {
+				factor := float64(osg.closingSegLengthFactor)
+				mid0 := geom.NewCoordinateXY((factor*osg.offset0[1].X+osg.s1.X)/(factor+1),
+					(factor*osg.offset0[1].Y+osg.s1.Y)/(factor+1))
+				osg.segList.AddPt(mid0)
+				mid1 := geom.NewCoordinateXY((factor*osg.offset1[0].X+osg.s1.X)/(factor+1),
+					(factor*osg.offset1[0].Y+osg.s1.Y)/(factor+1))
+				osg.segList.AddPt(mid1)
+			} else {
+				/**
+				 * This branch is not expected to be used except for testing purposes.
+				 * It is equivalent to the JTS 1.9 logic for closing segments
+				 * (which results in very poor performance for large buffer distances)
+				 */
+				osg.segList.AddPt(&osg.s1)
+			}
+
+			// add start point of next segment offset
+			osg.segList.AddPt(&osg.offset1[0])
+		}
+	}
+}
+
+/**
+ * Compute an offset segment for an input segment on a given side and at a given distance.
+ * The offset points are computed in full double precision, for accuracy.
+ *
+ * @param seg the segment to offset
+ * @param side the side of the segment ({@link constants#POSITION_LEFT} or {@link constants#POSITION_RIGHT}) the offset lies on
+ * @param distance the offset distance
+ * @param offset the points computed for the offset segment
+ */
+func computeOffsetSegment(seg *[2]geom.Coordinate, side int, distance float64, offset *[2]geom.Coordinate) {
+	sideSign := -1.0
+	if side == constants.POSITION_LEFT {
+		sideSign = 1.0
+	}
+	dx := seg[1].X - seg[0].X
+	dy := seg[1].Y - seg[0].Y
+	segLen := math.Sqrt(dx*dx + dy*dy)
+	// u is the vector that is the length of the offset, in the direction of the segment
+	ux := sideSign * distance * dx / segLen
+	uy := sideSign * distance * dy / segLen
+	offset[0] = *geom.NewCoordinateXY(seg[0].X-uy, seg[0].Y+ux)
+	offset[1] = *geom.NewCoordinateXY(seg[1].X-uy, seg[1].Y+ux)
+}
+
+/**
+ * Adds a mitre join connecting two convex offset segments.
+ * The mitre is beveled if it exceeds the mitre limit factor.
+ * The mitre limit is intended to prevent very long spikes
+ * when the angle between the segments is very small.
+ *
+ * @param cornerPt the corner vertex
+ * @param offset0 the first offset segment
+ * @param offset1 the second offset segment
+ * @param distance the offset distance
+ */
+func (osg *OffsetSegmentGenerator) addMitreJoin(cornerPt *geom.Coordinate, offset0 *[2]geom.Coordinate, offset1 *[2]geom.Coordinate, distance float64) {
+	mitreLimitDistance := osg.bufParams.GetMitreLimit() * distance
+	/**
+	 * First try a non-beveled join.
+	 * Compute the intersection point of the lines determined by the offsets.
+	 * Parallel or collinear lines will return a null point ==> need to be beveled
+	 *
+	 * Note: This computation is unstable if the offset segments are nearly collinear.
+	 * However, this situation should have been eliminated earlier by the check
+	 * for whether the offset segment endpoints are almost coincident
+	 */
+	intPt := algorithm.Intersection(&offset0[0], &offset0[1], &offset1[0], &offset1[1])
+	if intPt != nil && intPt.Distance(cornerPt) <= mitreLimitDistance {
+		osg.segList.AddPt(intPt)
+		return
+	}
+	/**
+	 * In case the mitre limit is very small, try a plain bevel.
+	 * Use it if it's further than the limit.
+	 */
+	bevelDist := algorithm.DistancePointToSegment(cornerPt, &offset0[1], &offset1[0])
+	if bevelDist >= mitreLimitDistance {
+		osg.addBevelJoin(offset0, offset1)
+		return
+	}
+	osg.addLimitedMitreJoin(offset0, offset1, distance, mitreLimitDistance)
+}
+
+/**
+ * Adds a limited mitre join connecting two convex offset segments.
+ * A limited mitre join is beveled at the distance
+ * determined by the mitre limit factor,
+ * or as a standard bevel join, whichever is further.
+ *
+ * @param offset0 the first offset segment
+ * @param offset1 the second offset segment
+ * @param distance the offset distance
+ * @param mitreLimitDistance the mitre limit distance
+ */
+func (osg *OffsetSegmentGenerator) addLimitedMitreJoin(offset0 *[2]geom.Coordinate, offset1 *[2]geom.Coordinate, distance float64, mitreLimitDistance float64) {
+	cornerPt := &osg.seg0[1]
+	// oriented angle of the corner formed by segments
+	angInterior := algorithm.AngleBetweenOriented(&osg.seg0[0], cornerPt, &osg.seg1[1])
+	// half of the interior angle
+	angInterior2 := angInterior / 2
+
+	// direction of bisector of the interior angle between the segments
+	dir0 := algorithm.AngleOf(cornerPt, &osg.seg0[0])
+	dirBisector := algorithm.AngleNormalize(dir0 + angInterior2)
+	// rotating by PI gives the bisector of the outside angle,
+	// which is the direction of the bevel midpoint from the corner apex
+	dirBisectorOut := algorithm.AngleNormalize(dirBisector + math.Pi)
+
+	// compute the midpoint of the bevel segment
+	bevelMidPt := project(cornerPt, mitreLimitDistance, dirBisectorOut)
+
+	// direction of bevel segment (at right angle to corner bisector)
+	dirBevel := algorithm.AngleNormalize(dirBisectorOut + constants.ANGLE_PI_OVER_2)
+
+	// compute the candidate bevel segment by projecting both sides of the midpoint
+	bevel0 := project(bevelMidPt, distance, dirBevel)
+	bevel1 := project(bevelMidPt, distance, dirBevel+math.Pi)
+
+	// compute actual bevel segment between the offset lines
+	bevelInt0 := algorithm.IntersectionLineSegment(&offset0[0], &offset0[1], bevel0, bevel1)
+	bevelInt1 := algorithm.IntersectionLineSegment(&offset1[0], &offset1[1], bevel0, bevel1)
+
+	// add the limited bevel, if it intersects the offsets
+	if bevelInt0 != nil && bevelInt1 != nil {
+		osg.segList.AddPt(bevelInt0)
+		osg.segList.AddPt(bevelInt1)
+		return
+	}
+	/**
+	 * If the corner is very flat or the mitre limit is very small
+	 * the limited bevel segment may not intersect the offsets.
+	 * In this case just bevel the join.
+	 */
+	osg.addBevelJoin(offset0, offset1)
+}
+
+/**
+ * Projects a point to a given distance in a given direction angle.
+ *
+ * @param pt the point to project
+ * @param d the projection distance
+ * @param dir the direction angle (in radians)
+ * @return the projected point
+ */
+func project(pt *geom.Coordinate, d float64, dir float64) *geom.Coordinate {
+	x := pt.X + d*math.Cos(dir)
+	y := pt.Y + d*math.Sin(dir)
+	return geom.NewCoordinateXY(x, y)
+}
+
+/**
+ * Adds a bevel join connecting two offset segments
+ * around a convex corner.
+ *
+ * @param offset0 the first offset segment
+ * @param offset1 the second offset segment
+ */
+func (osg *OffsetSegmentGenerator) addBevelJoin(offset0 *[2]geom.Coordinate, offset1 *[2]geom.Coordinate) {
+	osg.segList.AddPt(&offset0[1])
+	osg.segList.AddPt(&offset1[0])
+}
+
+/**
+ * Add points for a circular fillet around a convex corner.
+ * Adds the start and end points
+ *
+ * @param p base point of curve
+ * @param p0 start point of fillet curve
+ * @param p1 endpoint of fillet curve
+ * @param direction the orientation of the fillet
+ * @param radius the radius of the fillet
+ */
+func (osg *OffsetSegmentGenerator) addCornerFillet(p *geom.Coordinate, p0 *geom.Coordinate, p1 *geom.Coordinate, direction int, radius float64) {
+	dx0 := p0.X - p.X
+	dy0 := p0.Y - p.Y
+	startAngle := math.Atan2(dy0, dx0)
+	dx1 := p1.X - p.X
+	dy1 := p1.Y - p.Y
+	endAngle := math.Atan2(dy1, dx1)
+
+	if direction == constants.ORIENTATION_CLOCKWISE {
+		if startAngle <= endAngle {
+			startAngle += constants.ANGLE_PI_TIMES_2
+		}
+	} else { // direction == COUNTERCLOCKWISE
+		if startAngle >= endAngle {
+			startAngle -= constants.ANGLE_PI_TIMES_2
+		}
+	}
+	osg.segList.AddPt(p0)
+	osg.addDirectedFillet(p, startAngle, endAngle, direction, radius)
+	osg.segList.AddPt(p1)
+}
+
+/**
+ * Adds points for a circular fillet arc
+ * between two specified angles.
+ * The start and end point for the fillet are not added -
+ * the caller must add them if required.
+ *
+ * @param direction is -1 for a CW angle, 1 for a CCW angle
+ * @param radius the radius of the fillet
+ */
+func (osg *OffsetSegmentGenerator) addDirectedFillet(p *geom.Coordinate, startAngle float64, endAngle float64, direction int, radius float64) {
+	directionFactor := 1.0
+	if direction == constants.ORIENTATION_CLOCKWISE {
+		directionFactor = -1.0
+	}
+
+	totalAngle := math.Abs(startAngle - endAngle)
+	nSegs := int(totalAngle/osg.filletAngleQuantum + 0.5)
+
+	if nSegs < 1 {
+		return // no segments because angle is less than increment - nothing to do!
+	}
+
+	// choose angle increment so that each segment has equal length
+	angleInc := totalAngle / float64(nSegs)
+
+	for i := 0; i < nSegs; i++ {
+		angle := startAngle + directionFactor*float64(i)*angleInc
+		pt := geom.NewCoordinateXY(p.X+radius*math.Cos(angle), p.Y+radius*math.Sin(angle))
+		osg.segList.AddPt(pt)
+	}
+}
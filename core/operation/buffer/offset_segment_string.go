@@ -0,0 +1,86 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A dynamic list of the vertices in a constructed offset curve.
+ * Automatically removes adjacent vertices
+ * which are closer than a given tolerance.
+ */
+type OffsetSegmentString struct {
+	ptList []geom.Coordinate
+	/**
+	 * The distance below which two adjacent points on the curve
+	 * are considered to be coincident.
+	 * This is chosen to be a small fraction of the offset distance.
+	 */
+	minimumVertexDistance float64
+}
+
+func DefaultOffsetSegmentString() *OffsetSegmentString {
+	oss := new(OffsetSegmentString)
+	oss.ptList = make([]geom.Coordinate, 0)
+	return oss
+}
+
+func (oss *OffsetSegmentString) SetMinimumVertexDistance(minimumVertexDistance float64) {
+	oss.minimumVertexDistance = minimumVertexDistance
+}
+
+func (oss *OffsetSegmentString) AddPt(pt *geom.Coordinate) {
+	bufPt := geom.NewCoordinateFromCoordinate(pt)
+	// don't add duplicate (or near-duplicate) points
+	if oss.isRedundant(bufPt) {
+		return
+	}
+	oss.ptList = append(oss.ptList, *bufPt)
+}
+
+func (oss *OffsetSegmentString) AddPts(pts []geom.Coordinate, isForward bool) {
+	if isForward {
+		for i := 0; i < len(pts); i++ {
+			oss.AddPt(&pts[i])
+		}
+	} else {
+		for i := len(pts) - 1; i >= 0; i-- {
+			oss.AddPt(&pts[i])
+		}
+	}
+}
+
+/**
+ * Tests whether the given point is redundant
+ * relative to the previous
+ * point in the list (up to tolerance).
+ *
+ * @param pt
+ * @return true if the point is redundant
+ */
+func (oss *OffsetSegmentString) isRedundant(pt *geom.Coordinate) bool {
+	if len(oss.ptList) < 1 {
+		return false
+	}
+	lastPt := oss.ptList[len(oss.ptList)-1]
+	ptDist := pt.Distance(&lastPt)
+	return ptDist < oss.minimumVertexDistance
+}
+
+func (oss *OffsetSegmentString) CloseRing() {
+	if len(oss.ptList) < 1 {
+		return
+	}
+	startPt := oss.ptList[0]
+	lastPt := oss.ptList[len(oss.ptList)-1]
+	if startPt.Equals2D(&lastPt) {
+		return
+	}
+	oss.ptList = append(oss.ptList, startPt)
+}
+
+func (oss *OffsetSegmentString) GetCoordinates() []geom.Coordinate {
+	coords := make([]geom.Coordinate, len(oss.ptList))
+	copy(coords, oss.ptList)
+	return coords
+}
@@ -0,0 +1,531 @@
+package geos
+
+import (
+	"errors"
+	"math"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const variableBuffer_SNAP_TRIG_TOL = 1e-6
+
+/**
+ * Creates a buffer polygon with a varying buffer distance
+ * at each vertex along a line.
+ * <p>
+ * Only single lines are supported as input, since buffer widths
+ * are generally specified for a single line.
+ * <p>
+ * The buffer is formed from the union of a buffer polygon
+ * for each line segment. Each segment buffer is the convex hull of the
+ * circles (approximated by the quadrant segments) at the segment
+ * endpoints. The segment buffers are unioned by noding their boundaries
+ * against each other and keeping the boundary pieces which do not lie
+ * inside another segment buffer.
+ * <p>
+ * The result is a list of polygons, each given as a list of rings
+ * with the shell first, followed by any holes.
+ * Shells are oriented clockwise and holes counter-clockwise.
+ */
+type VariableBuffer struct {
+	line         []geom.Coordinate
+	distance     []float64
+	quadrantSegs int
+}
+
+/**
+ * Creates a buffer polygon along a line with the buffer distance interpolated
+ * between a start distance and an end distance.
+ *
+ * @param line the line to buffer
+ * @param startDistance the buffer width at the start of the line
+ * @param endDistance the buffer width at the end of the line
+ * @return the variable-distance buffer polygons
+ */
+func VariableBufferStartEnd(line []geom.Coordinate, startDistance float64, endDistance float64) [][][]geom.Coordinate {
+	distance := variableBufferInterpolate(line, startDistance, endDistance)
+	vb := &VariableBuffer{line, distance, constants.BUFFER_DEFAULT_QUADRANT_SEGMENTS}
+	return vb.GetResult()
+}
+
+/**
+ * Creates a buffer polygon along a line with the buffer distance interpolated
+ * between a start distance, a middle distance and an end distance.
+ * The middle distance is attained at
+ * the vertex at or just past the half-length of the line.
+ * For smooth buffering of a {@link LinearRing} (or the rings of a {@link Polygon})
+ * the start distance and end distance should be equal.
+ *
+ * @param line the line to buffer
+ * @param startDistance the buffer width at the start of the line
+ * @param midDistance the buffer width at the middle vertex of the line
+ * @param endDistance the buffer width at the end of the line
+ * @return the variable-distance buffer polygons
+ */
+func VariableBufferStartMidEnd(line []geom.Coordinate, startDistance float64, midDistance float64, endDistance float64) [][][]geom.Coordinate {
+	distance := variableBufferInterpolateMid(line, startDistance, midDistance, endDistance)
+	vb := &VariableBuffer{line, distance, constants.BUFFER_DEFAULT_QUADRANT_SEGMENTS}
+	return vb.GetResult()
+}
+
+/**
+ * Creates a buffer polygon along a line with the distance specified
+ * at each vertex.
+ *
+ * @param line the line to buffer
+ * @param distance the buffer distance for each vertex of the line
+ * @return the variable-distance buffer polygons
+ */
+func VariableBufferDistances(line []geom.Coordinate, distance []float64) ([][][]geom.Coordinate, error) {
+	vb, err := NewVariableBuffer(line, distance)
+	if err != nil {
+		return nil, err
+	}
+	return vb.GetResult(), nil
+}
+
+/**
+ * Creates a buffer polygon along a line with the distance at each vertex
+ * taken from its M ordinate.
+ * Vertices with no M value (NaN) have a distance of zero.
+ * <p>
+ * Note that the M value is used as the buffer distance,
+ * which is half of the width of the resulting polygon.
+ *
+ * @param line the line to buffer
+ * @return the variable-distance buffer polygons
+ */
+func VariableBufferMeasures(line []geom.Coordinate) [][][]geom.Coordinate {
+	distance := make([]float64, len(line))
+	for i := range line {
+		if !math.IsNaN(line[i].M) {
+			distance[i] = line[i].M
+		}
+	}
+	vb := &VariableBuffer{line, distance, constants.BUFFER_DEFAULT_QUADRANT_SEGMENTS}
+	return vb.GetResult()
+}
+
+/**
+ * Computes a list of values for the points along a line by
+ * interpolating between values for the start and end point.
+ * The interpolation is
+ * based on the distance of each point along the line
+ * relative to the total line length.
+ *
+ * @param line the line to interpolate along
+ * @param startValue the start value
+ * @param endValue the end value
+ * @return the array of interpolated values
+ */
+func variableBufferInterpolate(line []geom.Coordinate, startValue float64, endValue float64) []float64 {
+	startValue = math.Abs(startValue)
+	endValue = math.Abs(endValue)
+	values := make([]float64, len(line))
+	if len(line) == 0 {
+		return values
+	}
+	values[0] = startValue
+	values[len(values)-1] = endValue
+
+	totalLen := variableBufferLength(line, 0, len(line)-1)
+	currLen := 0.0
+	for i := 1; i < len(values)-1; i++ {
+		segLen := line[i].Distance(&line[i-1])
+		currLen += segLen
+		lenFrac := variableBufferFraction(currLen, totalLen)
+		delta := lenFrac * (endValue - startValue)
+		values[i] = startValue + delta
+	}
+	return values
+}
+
+/**
+ * Computes a list of values for the points along a line by
+ * interpolating between values for the start, middle and end points.
+ * The interpolation is
+ * based on the distance of each point along the line
+ * relative to the total line length.
+ * The middle distance is attained at
+ * the vertex at or just past the half-length of the line.
+ *
+ * @param line the line to interpolate along
+ * @param startValue the start value
+ * @param midValue the start value
+ * @param endValue the end value
+ * @return the array of interpolated values
+ */
+func variableBufferInterpolateMid(line []geom.Coordinate, startValue float64, midValue float64, endValue float64) []float64 {
+	startValue = math.Abs(startValue)
+	midValue = math.Abs(midValue)
+	endValue = math.Abs(endValue)
+
+	values := make([]float64, len(line))
+	if len(line) == 0 {
+		return values
+	}
+	values[0] = startValue
+	values[len(values)-1] = endValue
+
+	lineLen := variableBufferLength(line, 0, len(line)-1)
+	midIndex := variableBufferIndexAtLength(line, lineLen/2)
+
+	delMS := midValue - startValue
+	delEM := endValue - midValue
+
+	lenSM := variableBufferLength(line, 0, midIndex)
+	currLen := 0.0
+	for i := 1; i <= midIndex && i < len(values)-1; i++ {
+		segLen := line[i].Distance(&line[i-1])
+		currLen += segLen
+		lenFrac := variableBufferFraction(currLen, lenSM)
+		values[i] = startValue + lenFrac*delMS
+	}
+
+	lenME := variableBufferLength(line, midIndex, len(line)-1)
+	currLen = 0
+	for i := midIndex + 1; i < len(values)-1; i++ {
+		segLen := line[i].Distance(&line[i-1])
+		currLen += segLen
+		lenFrac := variableBufferFraction(currLen, lenME)
+		values[i] = midValue + lenFrac*delEM
+	}
+	return values
+}
+
+func variableBufferFraction(currLen float64, totalLen float64) float64 {
+	if totalLen <= 0 {
+		return 0
+	}
+	return currLen / totalLen
+}
+
+func variableBufferIndexAtLength(pts []geom.Coordinate, targetLen float64) int {
+	currLen := 0.0
+	for i := 1; i < len(pts); i++ {
+		currLen += pts[i].Distance(&pts[i-1])
+		if currLen > targetLen {
+			return i
+		}
+	}
+	return len(pts) - 1
+}
+
+func variableBufferLength(pts []geom.Coordinate, i1 int, i2 int) float64 {
+	totalLen := 0.0
+	for i := i1 + 1; i <= i2; i++ {
+		totalLen += pts[i].Distance(&pts[i-1])
+	}
+	return totalLen
+}
+
+/**
+ * Creates a generator for a variable-distance line buffer.
+ *
+ * @param line the linestring to buffer
+ * @param distance the buffer distance for each vertex of the line
+ * @return an error if the number of distances does not match the number of vertices
+ */
+func NewVariableBuffer(line []geom.Coordinate, distance []float64) (*VariableBuffer, error) {
+	if len(distance) != len(line) {
+		return nil, errors.New("number of distances is not equal to number of vertices")
+	}
+	vb := new(VariableBuffer)
+	vb.line = line
+	vb.distance = distance
+	vb.quadrantSegs = constants.BUFFER_DEFAULT_QUADRANT_SEGMENTS
+	return vb, nil
+}
+
+/**
+ * Computes the buffer polygons.
+ *
+ * @return the buffer polygons, or nil if the buffer is empty
+ */
+func (vb *VariableBuffer) GetResult() [][][]geom.Coordinate {
+	parts := make([][]geom.Coordinate, 0)
+
+	pts := vb.line
+	// construct segment buffers
+	for i := 1; i < len(pts); i++ {
+		dist0 := vb.distance[i-1]
+		dist1 := vb.distance[i]
+		if dist0 > 0 || dist1 > 0 {
+			poly := vb.segmentBuffer(&pts[i-1], &pts[i], dist0, dist1)
+			if poly != nil {
+				parts = append(parts, poly)
+			}
+		}
+	}
+	//-- ensure an empty result is returned if needed
+	if len(parts) == 0 {
+		return nil
+	}
+	return unionConvexParts(parts)
+}
+
+/**
+ * Computes a variable buffer polygon for a single segment,
+ * with the given endpoints and buffer distances.
+ * The individual segment buffers are unioned
+ * to form the final buffer.
+ * If one distance is zero, the end cap at that
+ * segment end is the endpoint of the segment.
+ * If both distances are zero, no polygon is returned.
+ *
+ * @param p0 the segment start point
+ * @param p1 the segment end point
+ * @param dist0 the buffer distance at the start point
+ * @param dist1 the buffer distance at the end point
+ * @return the segment buffer ring, or nil if empty
+ */
+func (vb *VariableBuffer) segmentBuffer(p0 *geom.Coordinate, p1 *geom.Coordinate, dist0 float64, dist1 float64) []geom.Coordinate {
+	/**
+	 * Skip buffer polygon if both distances are zero
+	 */
+	if dist0 <= 0 && dist1 <= 0 {
+		return nil
+	}
+
+	/**
+	 * Generation algorithm requires increasing distance, so flip if needed
+	 */
+	if dist0 > dist1 {
+		return vb.segmentBufferOriented(p1, p0, dist1, dist0)
+	}
+	return vb.segmentBufferOriented(p0, p1, dist0, dist1)
+}
+
+func (vb *VariableBuffer) segmentBufferOriented(p0 *geom.Coordinate, p1 *geom.Coordinate, dist0 float64, dist1 float64) []geom.Coordinate {
+	//-- Assert: dist0 <= dist1
+
+	//-- forward tangent line
+	tangent := variableBufferOuterTangent(p0, dist0, p1, dist1)
+
+	//-- if tangent is nil then compute a buffer for largest circle
+	if tangent == nil {
+		center := p0
+		dist := dist0
+		if dist1 > dist0 {
+			center = p1
+			dist = dist1
+		}
+		return vb.circle(center, dist)
+	}
+
+	//-- reverse tangent line on other side of segment
+	tangentReflect := variableBufferReflect(tangent, p0, p1, dist0)
+
+	coords := geom.DefaultCoordinateList()
+	//-- end cap
+	vb.addCap(p1, dist1, &tangent[1], &tangentReflect[1], coords)
+	//-- start cap
+	vb.addCap(p0, dist0, &tangentReflect[0], &tangent[0], coords)
+
+	coords.CloseRing()
+	return coords.ToCoordinateArray()
+}
+
+func variableBufferReflect(seg *[2]geom.Coordinate, p0 *geom.Coordinate, p1 *geom.Coordinate, dist0 float64) *[2]geom.Coordinate {
+	r0 := variableBufferReflectPoint(p0, p1, &seg[0])
+	r1 := variableBufferReflectPoint(p0, p1, &seg[1])
+	//-- avoid numeric jitter if first distance is zero (second dist must be > 0)
+	if dist0 == 0 {
+		r0 = p0.Clone()
+	}
+	return &[2]geom.Coordinate{*r0, *r1}
+}
+
+/**
+ * Computes the reflection of a point in the line defined
+ * by a segment.
+ */
+func variableBufferReflectPoint(p0 *geom.Coordinate, p1 *geom.Coordinate, p *geom.Coordinate) *geom.Coordinate {
+	// general line equation
+	A := p1.Y - p0.Y
+	B := p0.X - p1.X
+	C := p0.Y*(p1.X-p0.X) - p0.X*(p1.Y-p0.Y)
+
+	// compute reflected point
+	A2plusB2 := A*A + B*B
+	A2subB2 := A*A - B*B
+
+	x := p.X
+	y := p.Y
+	rx := (-A2subB2*x - 2*A*B*y - 2*A*C) / A2plusB2
+	ry := (A2subB2*y - 2*A*B*x - 2*B*C) / A2plusB2
+
+	return geom.NewCoordinateXY(rx, ry)
+}
+
+/**
+ * Returns a circular polygon, oriented clockwise.
+ *
+ * @param center the circle center point
+ * @param radius the radius
+ * @return a ring, or nil if the radius is 0
+ */
+func (vb *VariableBuffer) circle(center *geom.Coordinate, radius float64) []geom.Coordinate {
+	if radius <= 0 {
+		return nil
+	}
+	nPts := 4 * vb.quadrantSegs
+	pts := make([]geom.Coordinate, nPts+1)
+	for i := 0; i < nPts; i++ {
+		//-- use negative index to create points CW
+		pts[i] = *variableBufferProjectPolar(center, radius, vb.capAngle(-i))
+	}
+	pts[len(pts)-1] = pts[0]
+	return pts
+}
+
+/**
+ * Adds a semi-circular cap CW around the buffer endpoint,
+ * between two tangent points.
+ *
+ * @param p the centre point of the cap
+ * @param r the cap radius
+ * @param t1 the starting point of the cap
+ * @param t2 the ending point of the cap
+ * @param coords the coordinate list to add to
+ */
+func (vb *VariableBuffer) addCap(p *geom.Coordinate, r float64, t1 *geom.Coordinate, t2 *geom.Coordinate, coords *geom.CoordinateList) {
+	//-- if radius is zero just copy the vertex
+	if r == 0 {
+		coords.AddCoordinateRepeated(p.Clone(), false)
+		return
+	}
+
+	coords.AddCoordinateRepeated(t1, false)
+
+	angStart := algorithm.AngleOf(p, t1)
+	angEnd := algorithm.AngleOf(p, t2)
+	if angStart < angEnd {
+		angStart += 2 * math.Pi
+	}
+
+	indexStart := vb.capAngleIndex(angStart)
+	indexEnd := vb.capAngleIndex(angEnd)
+
+	for i := indexStart; i > indexEnd; i-- {
+		//-- use negative increment to create points CW
+		ang := vb.capAngle(i)
+		coords.AddCoordinateRepeated(variableBufferProjectPolar(p, r, ang), false)
+	}
+
+	coords.AddCoordinateRepeated(t2, false)
+}
+
+/**
+ * Computes the angle for the given cap point index.
+ * The index is normalized to the range of points around the circle,
+ * so that overlapping caps of adjacent segments
+ * produce identical points.
+ *
+ * @param index the fillet angle index
+ * @return the angle of the cap point
+ */
+func (vb *VariableBuffer) capAngle(index int) float64 {
+	nPts := 4 * vb.quadrantSegs
+	index = ((index % nPts) + nPts) % nPts
+	capSegAng := math.Pi / 2 / float64(vb.quadrantSegs)
+	return float64(index) * capSegAng
+}
+
+/**
+ * Computes the canonical cap point index for a given angle.
+ * The angle is rounded down to the next lower
+ * index.
+ * <p>
+ * In order to reduce the number of points created by overlapping end caps,
+ * cap points are generated at the same locations around a circle.
+ * The index is the index of the points around the circle,
+ * with 0 being the point at (1,0).
+ * The total number of points around the circle is
+ * <code>4 * quadrantSegs</code>.
+ *
+ * @param ang the angle
+ * @return the index for the angle.
+ */
+func (vb *VariableBuffer) capAngleIndex(ang float64) int {
+	capSegAng := math.Pi / 2 / float64(vb.quadrantSegs)
+	return int(math.Floor(ang / capSegAng))
+}
+
+/**
+ * Computes the two circumference points defining the outer tangent line
+ * between two circles.
+ * The tangent line may be nil if one circle mostly overlaps the other.
+ * <p>
+ * For the algorithm see <a href='https://en.wikipedia.org/wiki/Tangent_lines_to_circles#Outer_tangent'>Wikipedia</a>.
+ *
+ * @param c1 the centre of circle 1
+ * @param r1 the radius of circle 1
+ * @param c2 the centre of circle 2
+ * @param r2 the center of circle 2
+ * @return the outer tangent line segment, or nil if none exists
+ */
+func variableBufferOuterTangent(c1 *geom.Coordinate, r1 float64, c2 *geom.Coordinate, r2 float64) *[2]geom.Coordinate {
+	/**
+	 * If distances are inverted then flip to compute and flip result back.
+	 */
+	if r1 > r2 {
+		seg := variableBufferOuterTangent(c2, r2, c1, r1)
+		if seg == nil {
+			return nil
+		}
+		return &[2]geom.Coordinate{seg[1], seg[0]}
+	}
+	x1 := c1.X
+	y1 := c1.Y
+	x2 := c2.X
+	y2 := c2.Y
+	// TODO: handle r1 == r2?
+	a3 := -math.Atan2(y2-y1, x2-x1)
+
+	dr := r2 - r1
+	d := math.Sqrt((x2-x1)*(x2-x1) + (y2-y1)*(y2-y1))
+
+	a2 := math.Asin(dr / d)
+	// check if no tangent exists
+	if math.IsNaN(a2) {
+		return nil
+	}
+
+	a1 := a3 - a2
+
+	aa := math.Pi/2 - a1
+	x3 := x1 + r1*math.Cos(aa)
+	y3 := y1 + r1*math.Sin(aa)
+	x4 := x2 + r2*math.Cos(aa)
+	y4 := y2 + r2*math.Sin(aa)
+
+	return &[2]geom.Coordinate{*geom.NewCoordinateXY(x3, y3), *geom.NewCoordinateXY(x4, y4)}
+}
+
+func variableBufferProjectPolar(p *geom.Coordinate, r float64, ang float64) *geom.Coordinate {
+	x := p.X + r*variableBufferSnapTrig(math.Cos(ang))
+	y := p.Y + r*variableBufferSnapTrig(math.Sin(ang))
+	return geom.NewCoordinateXY(x, y)
+}
+
+/**
+ * Snap trig values to integer values for better consistency.
+ *
+ * @param x the result of a trigonometric function
+ * @return x snapped to the integer interval
+ */
+func variableBufferSnapTrig(x float64) float64 {
+	if x > (1 - variableBuffer_SNAP_TRIG_TOL) {
+		return 1
+	}
+	if x < (-1 + variableBuffer_SNAP_TRIG_TOL) {
+		return -1
+	}
+	if math.Abs(x) < variableBuffer_SNAP_TRIG_TOL {
+		return 0
+	}
+	return x
+}
@@ -0,0 +1,61 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A sequence of {@link lineMergeDirectedEdge}s forming one of the lines that will
+ * be output by the line-merging process.
+ */
+type edgeString struct {
+	directedEdges []*lineMergeDirectedEdge
+	coordinates   []geom.Coordinate
+}
+
+/**
+ * Constructs an edgeString.
+ */
+func newEdgeString() *edgeString {
+	es := new(edgeString)
+	es.directedEdges = make([]*lineMergeDirectedEdge, 0)
+	return es
+}
+
+/**
+ * Adds a directed edge which is known to form part of this line.
+ */
+func (es *edgeString) add(directedEdge *lineMergeDirectedEdge) {
+	es.directedEdges = append(es.directedEdges, directedEdge)
+}
+
+/**
+ * Computes the coordinates of the line formed by the directed edges.
+ * The line is oriented in the direction of the majority of its edges.
+ */
+func (es *edgeString) getCoordinates() []geom.Coordinate {
+	if es.coordinates == nil {
+		forwardDirectedEdges := 0
+		reverseDirectedEdges := 0
+		coordinateList := geom.DefaultCoordinateList()
+		for _, directedEdge := range es.directedEdges {
+			line := directedEdge.GetEdge().GetLine()
+			if directedEdge.GetEdgeDirection() {
+				forwardDirectedEdges++
+				for i := 0; i < len(line); i++ {
+					coordinateList.AddCoordinateRepeated(&line[i], false)
+				}
+			} else {
+				reverseDirectedEdges++
+				for i := len(line) - 1; i >= 0; i-- {
+					coordinateList.AddCoordinateRepeated(&line[i], false)
+				}
+			}
+		}
+		es.coordinates = coordinateList.ToCoordinateArray()
+		if reverseDirectedEdges > forwardDirectedEdges {
+			geom.ReverseCoordinates(es.coordinates)
+		}
+	}
+	return es.coordinates
+}
@@ -0,0 +1,45 @@
+package geos
+
+import (
+	planargraph "github.com/UltimateThread/geos-go/core/planargraph"
+)
+
+/**
+ * A planar graph of edges that is analyzed to sew the edges together. The
+ * <code>marked</code> flag on edges
+ * and nodes indicates whether they have been logically deleted from the graph.
+ * The directed edges carry no data.
+ */
+type lineMergeGraph = planargraph.PlanarGraph[struct{}]
+
+type lineMergeNode = planargraph.Node[struct{}]
+
+type lineMergeDirectedEdge = planargraph.DirectedEdge[struct{}]
+
+func newLineMergeGraph() *lineMergeGraph {
+	return planargraph.NewPlanarGraph[struct{}]()
+}
+
+/**
+ * Returns the directed edge that starts at a directed edge's end point, or nil
+ * if there are zero or multiple directed edges starting there.
+ *
+ * @param checkDirection whether the next edge must have the same
+ * direction as its parent edge
+ * @return the next directed edge, or nil
+ */
+func lineMergeDirectedEdgeGetNext(de *lineMergeDirectedEdge, checkDirection bool) *lineMergeDirectedEdge {
+	toNode := de.GetToNode()
+	if toNode.GetDegree() != 2 {
+		return nil
+	}
+	outEdges := toNode.GetOutEdges()
+	next := outEdges[0]
+	if next == de.GetSym() {
+		next = outEdges[1]
+	}
+	if checkDirection && !next.GetEdgeDirection() {
+		return nil
+	}
+	return next
+}
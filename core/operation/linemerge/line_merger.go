@@ -0,0 +1,164 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Merges a collection of linear components to form maximal-length lines.
+ * <p>
+ * Merging stops at nodes of degree 1 or degree 3 or more.
+ * In other words, all nodes of degree 2 are merged together.
+ * The exception is in the case of an isolated loop, which only has degree-2 nodes.
+ * In this case one of the nodes is chosen as a starting point.
+ * <p>
+ * The direction of each
+ * merged line will be that of the majority of the lines from which it
+ * was derived.
+ * <p>
+ * If the merger is directed, lines are only merged
+ * where they join end-to-start,
+ * so that the merged lines preserve the direction of their parts.
+ * <p>
+ * Any dimension of input is handled
+ * (Z values are carried through from the input lines).
+ * Repeated points and lines which collapse to a point are removed.
+ * Noding is not performed on the input lines,
+ * so lines which cross are not merged at the crossing point.
+ */
+type LineMerger struct {
+	graph             *lineMergeGraph
+	mergedLineStrings [][]geom.Coordinate
+	edgeStrings       []*edgeString
+	isDirected        bool
+}
+
+/**
+ * Creates a new line merger,
+ * which ignores the direction of the input lines.
+ */
+func NewLineMerger() *LineMerger {
+	return NewLineMergerWithDirected(false)
+}
+
+/**
+ * Creates a new line merger,
+ * specifying whether the direction of the input lines is respected.
+ *
+ * @param isDirected true if lines are only merged end-to-start
+ */
+func NewLineMergerWithDirected(isDirected bool) *LineMerger {
+	merger := new(LineMerger)
+	merger.graph = newLineMergeGraph()
+	merger.isDirected = isDirected
+	return merger
+}
+
+/**
+ * Adds a collection of lines to be merged.
+ *
+ * @param lines the lines to be merged
+ */
+func (merger *LineMerger) Add(lines [][]geom.Coordinate) {
+	for _, line := range lines {
+		merger.AddLine(line)
+	}
+}
+
+/**
+ * Adds a line to be merged.
+ * Lines may be added after the merged lines have been computed,
+ * in which case the merge is recomputed.
+ *
+ * @param line the line to be merged
+ */
+func (merger *LineMerger) AddLine(line []geom.Coordinate) {
+	merger.graph.AddEdge(line)
+	merger.mergedLineStrings = nil
+}
+
+func (merger *LineMerger) merge() {
+	if merger.mergedLineStrings != nil {
+		return
+	}
+
+	// reset marks (this allows incremental processing)
+	for _, node := range merger.graph.GetNodes() {
+		node.SetMarked(false)
+	}
+	for _, edge := range merger.graph.GetEdges() {
+		edge.SetMarked(false)
+	}
+
+	merger.edgeStrings = make([]*edgeString, 0)
+	merger.buildEdgeStringsForObviousStartNodes()
+	merger.buildEdgeStringsForIsolatedLoops()
+
+	merger.mergedLineStrings = make([][]geom.Coordinate, 0, len(merger.edgeStrings))
+	for _, es := range merger.edgeStrings {
+		merger.mergedLineStrings = append(merger.mergedLineStrings, es.getCoordinates())
+	}
+}
+
+func (merger *LineMerger) buildEdgeStringsForObviousStartNodes() {
+	merger.buildEdgeStringsForNonDegree2Nodes()
+}
+
+func (merger *LineMerger) buildEdgeStringsForIsolatedLoops() {
+	merger.buildEdgeStringsForUnprocessedNodes()
+}
+
+func (merger *LineMerger) buildEdgeStringsForUnprocessedNodes() {
+	for _, node := range merger.graph.GetNodes() {
+		if !node.IsMarked() {
+			merger.buildEdgeStringsStartingAt(node)
+			node.SetMarked(true)
+		}
+	}
+}
+
+func (merger *LineMerger) buildEdgeStringsForNonDegree2Nodes() {
+	for _, node := range merger.graph.GetNodes() {
+		if node.GetDegree() != 2 {
+			merger.buildEdgeStringsStartingAt(node)
+			node.SetMarked(true)
+		}
+	}
+}
+
+func (merger *LineMerger) buildEdgeStringsStartingAt(node *lineMergeNode) {
+	for _, directedEdge := range node.GetOutEdges() {
+		if directedEdge.GetEdge().IsMarked() {
+			continue
+		}
+		//-- a directed merge only follows edges in their own direction
+		if merger.isDirected && !directedEdge.GetEdgeDirection() {
+			continue
+		}
+		merger.edgeStrings = append(merger.edgeStrings, merger.buildEdgeStringStartingWith(directedEdge))
+	}
+}
+
+func (merger *LineMerger) buildEdgeStringStartingWith(start *lineMergeDirectedEdge) *edgeString {
+	es := newEdgeString()
+	current := start
+	for {
+		es.add(current)
+		current.GetEdge().SetMarked(true)
+		current = lineMergeDirectedEdgeGetNext(current, merger.isDirected)
+		if current == nil || current == start || current.GetEdge().IsMarked() {
+			break
+		}
+	}
+	return es
+}
+
+/**
+ * Gets the lines created by the merging process.
+ *
+ * @return the list of merged lines
+ */
+func (merger *LineMerger) GetMergedLineStrings() [][]geom.Coordinate {
+	merger.merge()
+	return merger.mergedLineStrings
+}
@@ -0,0 +1,390 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Builds a sequence from a set of lines,
+ * so that they are ordered end to end.
+ * A sequence is a complete non-repeating list of the linear
+ * components of the input.  Each line is oriented
+ * so that identical endpoints are adjacent in the list.
+ * <p>
+ * A typical use case is to convert a set of
+ * unoriented geometric links
+ * from a linear network
+ * (e.g. such as block faces on a bus route)
+ * into a continuous oriented path through the network.
+ * <p>
+ * The input linestrings may form one or more connected sets.
+ * The input linestrings should be correctly noded, or the results may
+ * not be what is expected.
+ * The computed output is a list of lines,
+ * with the lines of each connected set forming a contiguous run.
+ * <p>
+ * The sequencing employs the classic <b>Eulerian path</b> graph algorithm.
+ * Since Eulerian paths are not uniquely determined,
+ * further rules are used to
+ * make the computed sequence preserve as much as possible of the input
+ * ordering.
+ * Within a connected subset of lines, the ordering rules are:
+ * <ul>
+ * <li>If there is degree-1 node which is the start
+ * node of an linestring, use that node as the start of the sequence
+ * <li>If there is a degree-1 node which is the end
+ * node of an linestring, use that node as the end of the sequence
+ * <li>If the sequence has no degree-1 nodes, use any node as the start
+ * </ul>
+ *
+ * Note that not all arrangements of lines can be sequenced.
+ * For a connected set of edges in a graph,
+ * <i>Euler's Theorem</i> states that there is a sequence containing each edge once
+ * <b>if and only if</b> there are no more than 2 nodes of odd degree.
+ * If it is not possible to find a sequence, the {@link #IsSequenceable} method
+ * will return <code>false</code>.
+ */
+type LineSequencer struct {
+	graph *lineMergeGraph
+
+	isRun          bool
+	sequencedLines [][]geom.Coordinate
+	isSequenceable bool
+}
+
+/**
+ * Sequences a list of lines, if possible.
+ *
+ * @param lines the lines to sequence
+ * @return the sequenced lines, or nil if no sequence exists
+ */
+func LineSequencerSequence(lines [][]geom.Coordinate) [][]geom.Coordinate {
+	sequencer := NewLineSequencer()
+	sequencer.Add(lines)
+	return sequencer.GetSequencedLineStrings()
+}
+
+/**
+ * Tests whether a list of lines is in sequenced form.
+ * The lines are in sequence if the lines of each connected set
+ * form a contiguous run with identical endpoints adjacent,
+ * and no connected set is visited more than once.
+ *
+ * @param lines the lines to test
+ * @return true if the lines are sequenced
+ */
+func LineSequencerIsSequenced(lines [][]geom.Coordinate) bool {
+	// the nodes in all subgraphs which have been completely scanned
+	prevSubgraphNodes := make(map[[2]float64]bool)
+
+	var lastNode *geom.Coordinate
+	currNodes := make([]*geom.Coordinate, 0)
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		startNode := &line[0]
+		endNode := &line[len(line)-1]
+
+		/**
+		 * If this line is connected to a previous subgraph, the lines are not sequenced
+		 */
+		if prevSubgraphNodes[[2]float64{startNode.X, startNode.Y}] {
+			return false
+		}
+		if prevSubgraphNodes[[2]float64{endNode.X, endNode.Y}] {
+			return false
+		}
+
+		if lastNode != nil {
+			if !startNode.Equals2D(lastNode) {
+				// start new connected sequence
+				for _, node := range currNodes {
+					prevSubgraphNodes[[2]float64{node.X, node.Y}] = true
+				}
+				currNodes = currNodes[:0]
+			}
+		}
+		currNodes = append(currNodes, startNode, endNode)
+		lastNode = endNode
+	}
+	return true
+}
+
+/**
+ * Creates a new line sequencer.
+ */
+func NewLineSequencer() *LineSequencer {
+	sequencer := new(LineSequencer)
+	sequencer.graph = newLineMergeGraph()
+	return sequencer
+}
+
+/**
+ * Adds a collection of lines to be sequenced.
+ * Lines must be added before the sequence is computed.
+ *
+ * @param lines the lines to be sequenced
+ */
+func (sequencer *LineSequencer) Add(lines [][]geom.Coordinate) {
+	for _, line := range lines {
+		sequencer.AddLine(line)
+	}
+}
+
+/**
+ * Adds a line to be sequenced.
+ * Lines which collapse to a point are ignored.
+ *
+ * @param line the line to be sequenced
+ */
+func (sequencer *LineSequencer) AddLine(line []geom.Coordinate) {
+	sequencer.graph.AddEdge(line)
+}
+
+/**
+ * Tests whether the arrangement of lines has a valid sequence.
+ *
+ * @return <code>true</code> if a valid sequence exists.
+ */
+func (sequencer *LineSequencer) IsSequenceable() bool {
+	sequencer.computeSequence()
+	return sequencer.isSequenceable
+}
+
+/**
+ * Returns the sequenced lines, if a sequence exists.
+ * Lines which are reversed in the sequence have their coordinates reversed.
+ *
+ * @return the sequenced lines, or nil if no sequence exists
+ */
+func (sequencer *LineSequencer) GetSequencedLineStrings() [][]geom.Coordinate {
+	sequencer.computeSequence()
+	return sequencer.sequencedLines
+}
+
+func (sequencer *LineSequencer) computeSequence() {
+	if sequencer.isRun {
+		return
+	}
+	sequencer.isRun = true
+
+	sequences := sequencer.findSequences()
+	if sequences == nil {
+		return
+	}
+
+	sequencer.sequencedLines = lineSequencerBuildSequencedLines(sequences)
+	sequencer.isSequenceable = true
+}
+
+func (sequencer *LineSequencer) findSequences() [][]*lineMergeDirectedEdge {
+	sequences := make([][]*lineMergeDirectedEdge, 0)
+	for _, edge := range sequencer.graph.GetEdges() {
+		edge.SetVisited(false)
+	}
+	for _, subgraph := range sequencer.graph.GetConnectedSubgraphs() {
+		if !lineSequencerHasSequence(subgraph) {
+			// if any subgraph cannot be sequenced, abort
+			return nil
+		}
+		sequences = append(sequences, lineSequencerFindSequence(subgraph))
+	}
+	return sequences
+}
+
+/**
+ * Tests whether a complete unique path exists in a graph
+ * using Euler's Theorem.
+ *
+ * @param subgraph the nodes of the subgraph containing the edges
+ * @return <code>true</code> if a sequence exists
+ */
+func lineSequencerHasSequence(subgraph []*lineMergeNode) bool {
+	oddDegreeCount := 0
+	for _, node := range subgraph {
+		if node.GetDegree()%2 == 1 {
+			oddDegreeCount++
+		}
+	}
+	return oddDegreeCount <= 2
+}
+
+func lineSequencerFindSequence(subgraph []*lineMergeNode) []*lineMergeDirectedEdge {
+	startNode := lineSequencerFindLowestDegreeNode(subgraph)
+	startDE := startNode.GetOutEdges()[0]
+	startDESym := startDE.GetSym()
+
+	seq := make([]*lineMergeDirectedEdge, 0)
+	cursor := 0
+	seq, cursor = lineSequencerAddReverseSubpath(startDESym, seq, cursor)
+	for cursor > 0 {
+		cursor--
+		prev := seq[cursor]
+		unvisitedOutDE := lineSequencerFindUnvisitedBestOrientedDE(prev.GetFromNode())
+		if unvisitedOutDE != nil {
+			seq, cursor = lineSequencerAddReverseSubpath(unvisitedOutDE.GetSym(), seq, cursor)
+		}
+	}
+
+	/**
+	 * At this point, we have a valid sequence of graph DirectedEdges, but it
+	 * is not necessarily appropriately oriented relative to the underlying
+	 * geometry.
+	 */
+	return lineSequencerOrient(seq)
+}
+
+/**
+ * Finds an unvisited directed edge out of a node,
+ * preferring one which has the same orientation as its parent edge.
+ *
+ * @param node the node to examine
+ * @return the dirEdge found, or nil if none were unvisited
+ */
+func lineSequencerFindUnvisitedBestOrientedDE(node *lineMergeNode) *lineMergeDirectedEdge {
+	var wellOrientedDE *lineMergeDirectedEdge
+	var unvisitedDE *lineMergeDirectedEdge
+	for _, de := range node.GetOutEdges() {
+		if !de.GetEdge().IsVisited() {
+			unvisitedDE = de
+			if de.GetEdgeDirection() {
+				wellOrientedDE = de
+			}
+		}
+	}
+	if wellOrientedDE != nil {
+		return wellOrientedDE
+	}
+	return unvisitedDE
+}
+
+/**
+ * Traces an unvisited path backwards from a directed edge,
+ * inserting the (forward) path edges into the sequence at the cursor position.
+ *
+ * @return the updated sequence and cursor position
+ */
+func lineSequencerAddReverseSubpath(de *lineMergeDirectedEdge, seq []*lineMergeDirectedEdge, cursor int) ([]*lineMergeDirectedEdge, int) {
+	for {
+		seq = slices.Insert(seq, cursor, de.GetSym())
+		cursor++
+		de.GetEdge().SetVisited(true)
+		fromNode := de.GetFromNode()
+		unvisitedOutDE := lineSequencerFindUnvisitedBestOrientedDE(fromNode)
+		// this must terminate, since we are continually marking edges as visited
+		if unvisitedOutDE == nil {
+			break
+		}
+		de = unvisitedOutDE.GetSym()
+	}
+	return seq, cursor
+}
+
+func lineSequencerFindLowestDegreeNode(subgraph []*lineMergeNode) *lineMergeNode {
+	var minDegreeNode *lineMergeNode
+	for _, node := range subgraph {
+		if minDegreeNode == nil || node.GetDegree() < minDegreeNode.GetDegree() {
+			minDegreeNode = node
+		}
+	}
+	return minDegreeNode
+}
+
+/**
+ * Computes a version of the sequence which is optimally
+ * oriented relative to the underlying geometry.
+ * <p>
+ * Heuristics used are:
+ * <ul>
+ * <li>If the path has a degree-1 node which is the start
+ * node of an linestring, use that node as the start of the sequence
+ * <li>If the path has a degree-1 node which is the end
+ * node of an linestring, use that node as the end of the sequence
+ * <li>If the sequence has no degree-1 nodes, use any node as the start
+ * </ul>
+ *
+ * @param seq a list of directed edges
+ * @return the oriented sequence
+ */
+func lineSequencerOrient(seq []*lineMergeDirectedEdge) []*lineMergeDirectedEdge {
+	startEdge := seq[0]
+	endEdge := seq[len(seq)-1]
+	startNode := startEdge.GetFromNode()
+	endNode := endEdge.GetToNode()
+
+	flipSeq := false
+	hasDegree1Node := startNode.GetDegree() == 1 || endNode.GetDegree() == 1
+
+	if hasDegree1Node {
+		hasObviousStartNode := false
+
+		// test end edge before start edge, to make result stable
+		// (ie. if both are good starts, pick the actual start
+		if endEdge.GetToNode().GetDegree() == 1 && !endEdge.GetEdgeDirection() {
+			hasObviousStartNode = true
+			flipSeq = true
+		}
+		if startEdge.GetFromNode().GetDegree() == 1 && startEdge.GetEdgeDirection() {
+			hasObviousStartNode = true
+			flipSeq = false
+		}
+
+		// since there is no obvious start node, use any node of degree 1
+		if !hasObviousStartNode {
+			// check if the start node should actually be the end node
+			if startEdge.GetFromNode().GetDegree() == 1 {
+				flipSeq = true
+			}
+			// if the end node is of degree 1, it is properly the end node
+		}
+	}
+
+	// if there is no degree 1 node, just use the sequence as is
+	if flipSeq {
+		return lineSequencerReverse(seq)
+	}
+	return seq
+}
+
+/**
+ * Reverse the sequence.
+ * This requires reversing the order of the dirEdges, and flipping
+ * each dirEdge as well
+ *
+ * @param seq a list of DirectedEdges, in sequential order
+ * @return the reversed sequence
+ */
+func lineSequencerReverse(seq []*lineMergeDirectedEdge) []*lineMergeDirectedEdge {
+	newSeq := make([]*lineMergeDirectedEdge, len(seq))
+	for i, de := range seq {
+		newSeq[len(seq)-1-i] = de.GetSym()
+	}
+	return newSeq
+}
+
+/**
+ * Builds the sequenced lines,
+ * reversing any lines which are traversed against their direction.
+ *
+ * @param sequences a list of sequences of directed edges
+ * @return the sequenced lines
+ */
+func lineSequencerBuildSequencedLines(sequences [][]*lineMergeDirectedEdge) [][]geom.Coordinate {
+	lines := make([][]geom.Coordinate, 0)
+	for _, seq := range sequences {
+		for _, de := range seq {
+			line := de.GetEdge().GetLine()
+			lineToAdd := line
+			isClosed := line[0].Equals2D(&line[len(line)-1])
+			if !de.GetEdgeDirection() && !isClosed {
+				lineToAdd = slices.Clone(line)
+				geom.ReverseCoordinates(lineToAdd)
+			}
+			lines = append(lines, lineToAdd)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,327 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+/**
+ * Represents a ring of {@link polygonizeDirectedEdge}s which form
+ * a ring of a polygon.  The ring may be either an outer shell or a hole.
+ */
+type edgeRing struct {
+	deList        []*polygonizeDirectedEdge
+	ringPts       []geom.Coordinate
+	ringEnv       *geom.Envelope
+	holes         [][]geom.Coordinate
+	shell         *edgeRing
+	isHole        bool
+	isValid       bool
+	isProcessed   bool
+	isIncludedSet bool
+	isIncluded    bool
+}
+
+func newEdgeRing() *edgeRing {
+	er := new(edgeRing)
+	er.deList = make([]*polygonizeDirectedEdge, 0)
+	return er
+}
+
+/**
+ * Traverses a ring of DirectedEdges, accumulating them into a list.
+ * This assumes that all dangling directed edges have been removed
+ * from the graph, so that there is always a next dirEdge.
+ *
+ * @param startDE the DirectedEdge to start traversing at
+ * @return a List of DirectedEdges that form a ring
+ */
+func edgeRingFindDirEdgesInRing(startDE *polygonizeDirectedEdge) []*polygonizeDirectedEdge {
+	de := startDE
+	edges := make([]*polygonizeDirectedEdge, 0)
+	for {
+		edges = append(edges, de)
+		de = de.GetData().next
+		if de == startDE {
+			break
+		}
+	}
+	return edges
+}
+
+func (er *edgeRing) build(startDE *polygonizeDirectedEdge) {
+	de := startDE
+	for {
+		er.deList = append(er.deList, de)
+		de.GetData().edgeRing = er
+		de = de.GetData().next
+		if de == startDE {
+			break
+		}
+	}
+}
+
+/**
+ * Computes whether this ring is a hole.
+ * Due to the way the edges in the polygonization graph are linked,
+ * a ring is a hole if it is oriented counter-clockwise.
+ */
+func (er *edgeRing) computeHole() {
+	er.isHole = algorithm.OrientationIsCCW(er.getCoordinates())
+}
+
+/**
+ * Adds a hole to the polygon formed by this ring.
+ *
+ * @param hole the hole ring to add
+ */
+func (er *edgeRing) addHole(holeER *edgeRing) {
+	holeER.shell = er
+	er.holes = append(er.holes, holeER.getCoordinates())
+}
+
+/**
+ * Computes the polygon formed by this ring and any contained holes.
+ *
+ * @return the polygon formed by this ring and its holes
+ */
+func (er *edgeRing) getPolygon() [][]geom.Coordinate {
+	polygon := make([][]geom.Coordinate, 0, len(er.holes)+1)
+	polygon = append(polygon, er.getCoordinates())
+	return append(polygon, er.holes...)
+}
+
+/**
+ * Computes the validity of the ring.
+ * Must be called prior to calling {@link #isValid}.
+ */
+func (er *edgeRing) computeValid() {
+	pts := er.getCoordinates()
+	if len(pts) <= 3 {
+		er.isValid = false
+		return
+	}
+	er.isValid = valid.NewIsValidOpLinearRing(pts).IsValid()
+}
+
+/**
+ * Tests if the {@link geom.Coordinate}s in the ring are contained
+ * in the ring or on its boundary.
+ */
+func (er *edgeRing) isInRing(pt *geom.Coordinate) bool {
+	return algorithm.PointLocationLocateInRing(pt, er.getCoordinates()) != constants.LOCATION_EXTERIOR
+}
+
+/**
+ * Computes the list of coordinates which are contained in this ring.
+ * The coordinates are computed once only and cached.
+ *
+ * @return an array of the {@link geom.Coordinate}s in this ring
+ */
+func (er *edgeRing) getCoordinates() []geom.Coordinate {
+	if er.ringPts == nil {
+		coordList := geom.DefaultCoordinateList()
+		for _, de := range er.deList {
+			edgeRingAddEdge(de.GetEdge().GetLine(), de.GetEdgeDirection(), coordList)
+		}
+		er.ringPts = coordList.ToCoordinateArray()
+	}
+	return er.ringPts
+}
+
+func edgeRingAddEdge(coords []geom.Coordinate, isForward bool, coordList *geom.CoordinateList) {
+	if isForward {
+		for i := 0; i < len(coords); i++ {
+			coordList.AddCoordinateRepeated(&coords[i], false)
+		}
+	} else {
+		for i := len(coords) - 1; i >= 0; i-- {
+			coordList.AddCoordinateRepeated(&coords[i], false)
+		}
+	}
+}
+
+func (er *edgeRing) getEnvelope() *geom.Envelope {
+	if er.ringEnv == nil {
+		er.ringEnv = geom.NewEnvelopeFromCoordinateArray(er.getCoordinates())
+	}
+	return er.ringEnv
+}
+
+/**
+ * Finds the innermost enclosing shell edgeRing
+ * containing this ring, if any.
+ * The innermost enclosing ring is the <i>smallest</i> enclosing ring.
+ * The algorithm used depends on the fact that:
+ * <br>
+ *  ring A contains ring B if envelope(ring A) contains envelope(ring B)
+ * <br>
+ * This routine is only safe to use if the chosen point of the hole
+ * is known to be properly contained in a shell
+ * (which is guaranteed to be the case if the hole does not touch its shell)
+ * <p>
+ * To improve performance of this function the caller should
+ * make the passed shellList as small as possible (e.g.
+ * by using a spatial index filter beforehand).
+ *
+ * @return containing edgeRing, if there is one
+ * or nil if no containing edgeRing is found
+ */
+func (er *edgeRing) findEdgeRingContaining(erList []*edgeRing) *edgeRing {
+	testEnv := er.getEnvelope()
+
+	var minRing *edgeRing
+	var minRingEnv *geom.Envelope
+	for _, tryEdgeRing := range erList {
+		tryShellEnv := tryEdgeRing.getEnvelope()
+		// the hole envelope cannot equal the shell envelope
+		// (also guards against testing rings against themselves)
+		if tryShellEnv.Equals(testEnv) {
+			continue
+		}
+		// hole must be contained in shell
+		if !tryShellEnv.CoversEnvelope(testEnv) {
+			continue
+		}
+
+		testPt := edgeRingPtNotInList(er.getCoordinates(), tryEdgeRing.getCoordinates())
+		if testPt == nil {
+			continue
+		}
+		// check if the new containing ring is smaller than the current minimum ring
+		if tryEdgeRing.isInRing(testPt) {
+			if minRing == nil || minRingEnv.CoversEnvelope(tryShellEnv) {
+				minRing = tryEdgeRing
+				minRingEnv = tryShellEnv
+			}
+		}
+	}
+	return minRing
+}
+
+/**
+ * Finds a point in a list of points which is not contained in another list of points
+ *
+ * @param testPts the {@link geom.Coordinate}s to test
+ * @param pts an array of {@link geom.Coordinate}s to test the input points against
+ * @return a {@link geom.Coordinate} from <code>testPts</code> which is not in <code>pts</code>,
+ * or nil if there is no such point
+ */
+func edgeRingPtNotInList(testPts []geom.Coordinate, pts []geom.Coordinate) *geom.Coordinate {
+	for i := range testPts {
+		if !edgeRingIsInList(&testPts[i], pts) {
+			return &testPts[i]
+		}
+	}
+	return nil
+}
+
+func edgeRingIsInList(pt *geom.Coordinate, pts []geom.Coordinate) bool {
+	for i := range pts {
+		if pt.Equals2D(&pts[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether this ring is an outer hole.
+ * A hole is an outer hole if it is not contained by a shell.
+ */
+func (er *edgeRing) isOuterHole() bool {
+	if !er.isHole {
+		return false
+	}
+	return er.shell == nil
+}
+
+/**
+ * Gets the outer hole of a shell, if it has one.
+ * An outer hole is one that is not contained
+ * in any other shell.
+ * Each disjoint connected group of shells
+ * is surrounded by an outer hole.
+ *
+ * @return the outer hole edge ring, or nil
+ */
+func (er *edgeRing) getOuterHole() *edgeRing {
+	/*
+	 * Only shells can have outer holes
+	 */
+	if er.isHole {
+		return nil
+	}
+	/*
+	 * A shell is an outer shell if any edge is also in an outer hole.
+	 * A hole is an outer hole if it is not contained by a shell.
+	 */
+	for _, de := range er.deList {
+		adjRing := de.GetSym().GetData().edgeRing
+		if adjRing != nil && adjRing.isOuterHole() {
+			return adjRing
+		}
+	}
+	return nil
+}
+
+/**
+ * Gets the shell for this ring.  The shell is the ring itself if it is not a hole, otherwise its parent shell.
+ *
+ * @return the shell for this ring
+ */
+func (er *edgeRing) getShell() *edgeRing {
+	if er.isHole {
+		return er.shell
+	}
+	return er
+}
+
+/**
+ * Updates the included status for currently non-included shells
+ * based on whether they are adjacent to an included shell.
+ */
+func (er *edgeRing) updateIncluded() {
+	if er.isHole {
+		return
+	}
+	for _, de := range er.deList {
+		adjRing := de.GetSym().GetData().edgeRing
+		if adjRing == nil {
+			continue
+		}
+		adjShell := adjRing.getShell()
+		if adjShell != nil && adjShell.isIncludedSet {
+			// adjacent ring has been processed, so set included to inverse of adjacent included
+			er.setIncluded(!adjShell.isIncluded)
+			return
+		}
+	}
+}
+
+func (er *edgeRing) setIncluded(isIncluded bool) {
+	er.isIncluded = isIncluded
+	er.isIncludedSet = true
+}
+
+/**
+ * Assigns hole rings to shell rings,
+ * using a spatial index on the shell envelopes
+ * to find the candidate containing shells.
+ */
+func edgeRingAssignHolesToShells(holes []*edgeRing, shells []*edgeRing) {
+	shellIndex := strtree.NewSTRtree[*edgeRing]()
+	for _, shell := range shells {
+		shellIndex.Insert(shell.getEnvelope(), shell)
+	}
+	for _, hole := range holes {
+		candidateShells := shellIndex.Query(hole.getEnvelope())
+		shell := hole.findEdgeRingContaining(candidateShells)
+		if shell != nil {
+			shell.addHole(hole)
+		}
+	}
+}
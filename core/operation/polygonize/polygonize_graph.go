@@ -0,0 +1,344 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	planargraph "github.com/UltimateThread/geos-go/core/planargraph"
+)
+
+/**
+ * The polygonization state of a directed edge of a {@link polygonizeGraph}.
+ * It carries the information needed to form
+ * the graph edges into rings.
+ */
+type polygonizeDirectedEdgeData struct {
+	edgeRing *edgeRing
+	next     *polygonizeDirectedEdge
+	label    int
+}
+
+type polygonizeDirectedEdge = planargraph.DirectedEdge[polygonizeDirectedEdgeData]
+
+type polygonizeNode = planargraph.Node[polygonizeDirectedEdgeData]
+
+/**
+ * Tests whether a directed edge has been assigned to an edge ring.
+ */
+func polygonizeDirectedEdgeIsInRing(de *polygonizeDirectedEdge) bool {
+	return de.GetData().edgeRing != nil
+}
+
+/**
+ * Gets the number of edges incident on a node which have not been deleted.
+ */
+func polygonizeNodeGetDegreeNonDeleted(node *polygonizeNode) int {
+	degree := 0
+	for _, de := range node.GetOutEdges() {
+		if !de.IsMarked() {
+			degree++
+		}
+	}
+	return degree
+}
+
+/**
+ * Gets the number of edges incident on a node which have a given label.
+ */
+func polygonizeNodeGetDegreeWithLabel(node *polygonizeNode, label int) int {
+	degree := 0
+	for _, de := range node.GetOutEdges() {
+		if de.GetData().label == label {
+			degree++
+		}
+	}
+	return degree
+}
+
+/**
+ * Represents a planar graph of edges that can be used to compute a
+ * polygonization, and implements the algorithms to compute the
+ * {@link edgeRing}s formed by the graph.
+ * <p>
+ * The marked flag on {@link polygonizeDirectedEdge}s is used to indicate that a directed edge
+ * has been logically deleted from the graph.
+ */
+type polygonizeGraph struct {
+	*planargraph.PlanarGraph[polygonizeDirectedEdgeData]
+}
+
+/**
+ * Create a new polygonization graph.
+ */
+func newPolygonizeGraph() *polygonizeGraph {
+	graph := new(polygonizeGraph)
+	graph.PlanarGraph = planargraph.NewPlanarGraph[polygonizeDirectedEdgeData]()
+	return graph
+}
+
+/**
+ * Add a line to the graph as an edge.
+ * Empty lines and lines which collapse to a point are ignored.
+ *
+ * @param line the line to add
+ */
+func (graph *polygonizeGraph) addEdge(line []geom.Coordinate) {
+	de := graph.AddEdge(line)
+	if de == nil {
+		return
+	}
+	de.GetData().label = -1
+	de.GetSym().GetData().label = -1
+}
+
+func (graph *polygonizeGraph) computeNextCWEdges() {
+	// set the next pointers for the edges around each node
+	for _, node := range graph.GetNodes() {
+		polygonizeGraphComputeNextCWEdges(node)
+	}
+}
+
+/**
+ * Convert the maximal edge rings found by the initial graph traversal
+ * into the minimal edge rings required by the polygon topology rules.
+ *
+ * @param ringEdges
+ *          the list of start edges for the edgeRings to convert.
+ */
+func (graph *polygonizeGraph) convertMaximalToMinimalEdgeRings(ringEdges []*polygonizeDirectedEdge) {
+	for _, de := range ringEdges {
+		label := de.GetData().label
+		intNodes := polygonizeGraphFindIntersectionNodes(de, label)
+		for _, node := range intNodes {
+			polygonizeGraphComputeNextCCWEdges(node, label)
+		}
+	}
+}
+
+/**
+ * Finds all nodes in a maximal edgering which are self-intersection nodes
+ *
+ * @param startDE
+ * @param label
+ * @return the list of intersection nodes found,
+ * or an empty list if no intersection nodes were found
+ */
+func polygonizeGraphFindIntersectionNodes(startDE *polygonizeDirectedEdge, label int) []*polygonizeNode {
+	intNodes := make([]*polygonizeNode, 0)
+	de := startDE
+	for {
+		node := de.GetFromNode()
+		if polygonizeNodeGetDegreeWithLabel(node, label) > 1 {
+			intNodes = append(intNodes, node)
+		}
+		de = de.GetData().next
+		if de == startDE {
+			break
+		}
+	}
+	return intNodes
+}
+
+/**
+ * Computes the minimal EdgeRings formed by the edges in this graph.
+ *
+ * @return a list of the {@link edgeRing}s found by the polygonization process.
+ */
+func (graph *polygonizeGraph) getEdgeRings() []*edgeRing {
+	// maybe could optimize this, since most of these pointers should be set correctly already
+	// by deleteCutEdges()
+	graph.computeNextCWEdges()
+	// clear labels of all edges in graph
+	polygonizeGraphLabel(graph.GetDirEdges(), -1)
+	maximalRings := polygonizeGraphFindLabeledEdgeRings(graph.GetDirEdges())
+	graph.convertMaximalToMinimalEdgeRings(maximalRings)
+
+	// find all edgerings (which will now be minimal ones, as required)
+	edgeRingList := make([]*edgeRing, 0)
+	for _, de := range graph.GetDirEdges() {
+		if de.IsMarked() {
+			continue
+		}
+		if polygonizeDirectedEdgeIsInRing(de) {
+			continue
+		}
+		er := newEdgeRing()
+		er.build(de)
+		edgeRingList = append(edgeRingList, er)
+	}
+	return edgeRingList
+}
+
+/**
+ * Finds and labels all edgerings in the graph.
+ * The edge rings are labelling with unique integers.
+ * The labelling allows detecting cut edges.
+ *
+ * @param dirEdges a List of the DirectedEdges in the graph
+ * @return a List of DirectedEdges, one for each edge ring found
+ */
+func polygonizeGraphFindLabeledEdgeRings(dirEdges []*polygonizeDirectedEdge) []*polygonizeDirectedEdge {
+	edgeRingStarts := make([]*polygonizeDirectedEdge, 0)
+	// label the edge rings formed
+	currLabel := 1
+	for _, de := range dirEdges {
+		if de.IsMarked() {
+			continue
+		}
+		if de.GetData().label >= 0 {
+			continue
+		}
+
+		edgeRingStarts = append(edgeRingStarts, de)
+		edges := edgeRingFindDirEdgesInRing(de)
+
+		polygonizeGraphLabel(edges, currLabel)
+		currLabel++
+	}
+	return edgeRingStarts
+}
+
+/**
+ * Finds and removes all cut edges from the graph.
+ *
+ * @return a list of the lines forming the removed cut edges
+ */
+func (graph *polygonizeGraph) deleteCutEdges() [][]geom.Coordinate {
+	graph.computeNextCWEdges()
+	// label the current set of edgerings
+	polygonizeGraphFindLabeledEdgeRings(graph.GetDirEdges())
+
+	/**
+	 * Cut Edges are edges where both dirEdges have the same label.
+	 * Delete them, and record them
+	 */
+	cutLines := make([][]geom.Coordinate, 0)
+	for _, de := range graph.GetDirEdges() {
+		if de.IsMarked() {
+			continue
+		}
+		sym := de.GetSym()
+		if de.GetData().label == sym.GetData().label {
+			de.SetMarked(true)
+			sym.SetMarked(true)
+			cutLines = append(cutLines, de.GetEdge().GetLine())
+		}
+	}
+	return cutLines
+}
+
+func polygonizeGraphLabel(dirEdges []*polygonizeDirectedEdge, label int) {
+	for _, de := range dirEdges {
+		de.GetData().label = label
+	}
+}
+
+func polygonizeGraphComputeNextCWEdges(node *polygonizeNode) {
+	var startDE *polygonizeDirectedEdge
+	var prevDE *polygonizeDirectedEdge
+
+	// the edges are stored in CCW order around the star
+	for _, outDE := range node.GetOutEdges() {
+		if outDE.IsMarked() {
+			continue
+		}
+		if startDE == nil {
+			startDE = outDE
+		}
+		if prevDE != nil {
+			prevDE.GetSym().GetData().next = outDE
+		}
+		prevDE = outDE
+	}
+	if prevDE != nil {
+		prevDE.GetSym().GetData().next = startDE
+	}
+}
+
+/**
+ * Computes the next edge pointers going CCW around the given node, for the
+ * given edgering label.
+ * This algorithm has the effect of converting maximal edgerings into minimal edgerings
+ */
+func polygonizeGraphComputeNextCCWEdges(node *polygonizeNode, label int) {
+	var firstOutDE *polygonizeDirectedEdge
+	var prevInDE *polygonizeDirectedEdge
+
+	// the edges are stored in CCW order around the star
+	edges := node.GetOutEdges()
+	for i := len(edges) - 1; i >= 0; i-- {
+		de := edges[i]
+		sym := de.GetSym()
+
+		var outDE *polygonizeDirectedEdge
+		if de.GetData().label == label {
+			outDE = de
+		}
+		var inDE *polygonizeDirectedEdge
+		if sym.GetData().label == label {
+			inDE = sym
+		}
+
+		if outDE == nil && inDE == nil {
+			continue // this edge is not in edgering
+		}
+
+		if inDE != nil {
+			prevInDE = inDE
+		}
+
+		if outDE != nil {
+			if prevInDE != nil {
+				prevInDE.GetData().next = outDE
+				prevInDE = nil
+			}
+			if firstOutDE == nil {
+				firstOutDE = outDE
+			}
+		}
+	}
+	if prevInDE != nil {
+		prevInDE.GetData().next = firstOutDE
+	}
+}
+
+/**
+ * Marks all edges from the graph which are "dangles".
+ * Dangles are which are incident on a node with degree 1.
+ * This process is recursive, since removing a dangling edge
+ * may result in another edge becoming a dangle.
+ * In order to handle large recursion depths efficiently,
+ * an explicit recursion stack is used
+ *
+ * @return a list containing the lines that formed dangles
+ */
+func (graph *polygonizeGraph) deleteDangles() [][]geom.Coordinate {
+	nodeStack := make([]*polygonizeNode, 0)
+	for _, node := range graph.GetNodes() {
+		if node.GetDegree() == 1 {
+			nodeStack = append(nodeStack, node)
+		}
+	}
+
+	dangleLines := make([][]geom.Coordinate, 0)
+	for len(nodeStack) > 0 {
+		node := nodeStack[len(nodeStack)-1]
+		nodeStack = nodeStack[:len(nodeStack)-1]
+
+		for _, de := range node.GetOutEdges() {
+			if de.IsMarked() {
+				continue
+			}
+			// delete this edge and its sym
+			de.SetMarked(true)
+			de.GetSym().SetMarked(true)
+			// save the line as a dangle
+			dangleLines = append(dangleLines, de.GetEdge().GetLine())
+
+			toNode := de.GetToNode()
+			// add the toNode to the list to be processed, if it is now a dangle
+			if polygonizeNodeGetDegreeNonDeleted(toNode) == 1 {
+				nodeStack = append(nodeStack, toNode)
+			}
+		}
+	}
+	return dangleLines
+}
@@ -0,0 +1,262 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Polygonizes a set of lines which contain linework that
+ * represents the edges of a planar graph.
+ * All types of lines are accepted as input.
+ * The input linework must be correctly noded,
+ * so that lines only meet at their endpoints.
+ * The Polygonizer will run on incorrectly noded input
+ * but will not form polygons from non-noded edges,
+ * and will report them as errors.
+ * <p>
+ * The Polygonizer reports the following kinds of errors:
+ * <ul>
+ * <li><b>Dangles</b> - edges which have one or both ends which are not incident on another edge endpoint
+ * <li><b>Cut Edges</b> - edges which are connected at both ends but which do not form part of polygon
+ * <li><b>Invalid Ring Lines</b> - edges which form rings which are invalid
+ * (e.g. the component lines contain a self-intersection)
+ * </ul>
+ * The Polygonizer constructor allows
+ * extracting only polygons which form a valid polygonal result.
+ * The set of extracted polygons is guaranteed to be edge-disjoint.
+ * This is useful where it is known that the input lines form a
+ * valid polygonal geometry (which may include holes or nested polygons).
+ * <p>
+ * Polygons are returned as a shell followed by holes,
+ * with the shell oriented clockwise and the holes counter-clockwise.
+ */
+type Polygonizer struct {
+	graph *polygonizeGraph
+
+	dangles          [][]geom.Coordinate
+	cutEdges         [][]geom.Coordinate
+	invalidRingLines [][]geom.Coordinate
+
+	holeList  []*edgeRing
+	shellList []*edgeRing
+	polyList  [][][]geom.Coordinate
+
+	isCheckingRingsValid bool
+	extractOnlyPolygonal bool
+}
+
+/**
+ * Creates a polygonizer that extracts all polygons.
+ */
+func NewPolygonizer() *Polygonizer {
+	return NewPolygonizerWithOnlyPolygonal(false)
+}
+
+/**
+ * Creates a polygonizer, specifying whether a valid polygonal geometry must be created.
+ * If the argument is <code>true</code>
+ * then areas may be discarded in order to
+ * ensure that the extracted geometry is a valid polygonal geometry.
+ *
+ * @param extractOnlyPolygonal true if a valid polygonal geometry should be extracted
+ */
+func NewPolygonizerWithOnlyPolygonal(extractOnlyPolygonal bool) *Polygonizer {
+	polygonizer := new(Polygonizer)
+	polygonizer.dangles = make([][]geom.Coordinate, 0)
+	polygonizer.cutEdges = make([][]geom.Coordinate, 0)
+	polygonizer.invalidRingLines = make([][]geom.Coordinate, 0)
+	polygonizer.isCheckingRingsValid = true
+	polygonizer.extractOnlyPolygonal = extractOnlyPolygonal
+	return polygonizer
+}
+
+/**
+ * Adds a collection of lines to be polygonized.
+ *
+ * @param lines a list of lines
+ */
+func (polygonizer *Polygonizer) Add(lines [][]geom.Coordinate) {
+	for _, line := range lines {
+		polygonizer.AddLine(line)
+	}
+}
+
+/**
+ * Adds a line to the graph of polygon edges.
+ * Lines must be added before any results are requested.
+ *
+ * @param line the line to add
+ */
+func (polygonizer *Polygonizer) AddLine(line []geom.Coordinate) {
+	// create a new graph on the first line added
+	if polygonizer.graph == nil {
+		polygonizer.graph = newPolygonizeGraph()
+	}
+	polygonizer.graph.addEdge(line)
+}
+
+/**
+ * Allows disabling the valid ring checking,
+ * to optimize situations where invalid rings are not expected.
+ * <p>
+ * The default is <code>true</code>.
+ *
+ * @param isCheckingRingsValid true if generated rings should be checked for validity
+ */
+func (polygonizer *Polygonizer) SetCheckRingsValid(isCheckingRingsValid bool) {
+	polygonizer.isCheckingRingsValid = isCheckingRingsValid
+}
+
+/**
+ * Gets the list of polygons formed by the polygonization.
+ * If the polygonizer was created to extract only polygonal output,
+ * the polygons are edge-disjoint and form a valid polygonal geometry.
+ *
+ * @return a list of polygons, each a shell followed by holes
+ */
+func (polygonizer *Polygonizer) GetPolygons() [][][]geom.Coordinate {
+	polygonizer.polygonize()
+	return polygonizer.polyList
+}
+
+/**
+ * Gets the list of dangling lines found during polygonization.
+ *
+ * @return a list of the input lines which are dangles
+ */
+func (polygonizer *Polygonizer) GetDangles() [][]geom.Coordinate {
+	polygonizer.polygonize()
+	return polygonizer.dangles
+}
+
+/**
+ * Gets the list of cut edges found during polygonization.
+ *
+ * @return a list of the input lines which are cut edges
+ */
+func (polygonizer *Polygonizer) GetCutEdges() [][]geom.Coordinate {
+	polygonizer.polygonize()
+	return polygonizer.cutEdges
+}
+
+/**
+ * Gets the list of lines forming invalid rings found during polygonization.
+ *
+ * @return a list of the closed lines forming invalid rings
+ */
+func (polygonizer *Polygonizer) GetInvalidRingLines() [][]geom.Coordinate {
+	polygonizer.polygonize()
+	return polygonizer.invalidRingLines
+}
+
+/**
+ * Performs the polygonization, if it has not already been carried out.
+ */
+func (polygonizer *Polygonizer) polygonize() {
+	// check if already computed
+	if polygonizer.polyList != nil {
+		return
+	}
+	polygonizer.polyList = make([][][]geom.Coordinate, 0)
+
+	// if no geometries were supplied it's possible that graph is nil
+	if polygonizer.graph == nil {
+		return
+	}
+
+	polygonizer.dangles = polygonizer.graph.deleteDangles()
+	polygonizer.cutEdges = polygonizer.graph.deleteCutEdges()
+	edgeRingList := polygonizer.graph.getEdgeRings()
+
+	validEdgeRingList := edgeRingList
+	polygonizer.invalidRingLines = make([][]geom.Coordinate, 0)
+	if polygonizer.isCheckingRingsValid {
+		validEdgeRingList = polygonizer.findValidRings(edgeRingList)
+	}
+
+	polygonizer.findShellsAndHoles(validEdgeRingList)
+	edgeRingAssignHolesToShells(polygonizer.holeList, polygonizer.shellList)
+
+	includeAll := true
+	if polygonizer.extractOnlyPolygonal {
+		polygonizerFindDisjointShells(polygonizer.shellList)
+		includeAll = false
+	}
+	polygonizer.polyList = polygonizerExtractPolygons(polygonizer.shellList, includeAll)
+}
+
+func (polygonizer *Polygonizer) findValidRings(edgeRingList []*edgeRing) []*edgeRing {
+	validEdgeRingList := make([]*edgeRing, 0, len(edgeRingList))
+	for _, er := range edgeRingList {
+		er.computeValid()
+		if er.isValid {
+			validEdgeRingList = append(validEdgeRingList, er)
+		} else {
+			polygonizer.invalidRingLines = append(polygonizer.invalidRingLines, er.getCoordinates())
+		}
+	}
+	return validEdgeRingList
+}
+
+func (polygonizer *Polygonizer) findShellsAndHoles(edgeRingList []*edgeRing) {
+	polygonizer.holeList = make([]*edgeRing, 0)
+	polygonizer.shellList = make([]*edgeRing, 0)
+	for _, er := range edgeRingList {
+		er.computeHole()
+		if er.isHole {
+			polygonizer.holeList = append(polygonizer.holeList, er)
+		} else {
+			polygonizer.shellList = append(polygonizer.shellList, er)
+		}
+	}
+}
+
+func polygonizerFindDisjointShells(shellList []*edgeRing) {
+	polygonizerFindOuterShells(shellList)
+
+	for {
+		isMoreToScan := false
+		isProgress := false
+		for _, er := range shellList {
+			if er.isIncludedSet {
+				continue
+			}
+			er.updateIncluded()
+			if er.isIncludedSet {
+				isProgress = true
+			} else {
+				isMoreToScan = true
+			}
+		}
+		//-- stop if no shell can be reached from an included shell
+		if !isMoreToScan || !isProgress {
+			return
+		}
+	}
+}
+
+/**
+ * For each outer hole finds and includes a single outer shell.
+ * This seeds the traversal algorithm for finding only polygonal shells.
+ *
+ * @param shellList the list of shell EdgeRings
+ */
+func polygonizerFindOuterShells(shellList []*edgeRing) {
+	for _, er := range shellList {
+		outerHoleER := er.getOuterHole()
+		if outerHoleER != nil && !outerHoleER.isProcessed {
+			er.setIncluded(true)
+			outerHoleER.isProcessed = true
+		}
+	}
+}
+
+func polygonizerExtractPolygons(shellList []*edgeRing, includeAll bool) [][][]geom.Coordinate {
+	polyList := make([][][]geom.Coordinate, 0)
+	for _, er := range shellList {
+		if includeAll || er.isIncluded {
+			polyList = append(polyList, er.getPolygon())
+		}
+	}
+	return polyList
+}
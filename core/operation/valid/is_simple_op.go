@@ -0,0 +1,375 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	chain "github.com/UltimateThread/geos-go/core/index/chain"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+/**
+ * Tests whether a geometry is simple,
+ * and optionally reports the locations where it is not.
+ * In general, the SFS specification of simplicity
+ * follows the rule:
+ * <ul>
+ *    <li> A Geometry is simple if and only if the only self-intersections are at
+ *    boundary points.
+ * </ul>
+ * <p>
+ * Simplicity is defined for each geometry type as follows:
+ * <ul>
+ * <li><b>Linear</b> geometries are simple if they do not self-intersect at interior points
+ * (i.e. points other than the endpoints).
+ * Closed linestrings which intersect only at their endpoints are simple
+ * (i.e. valid <b>LinearRing</b>s.
+ * <li><b>Polygonal</b> geometries are simple if their rings are simple
+ * (i.e. their rings do not self-intersect).
+ * <li><b>Zero-dimensional</b> (point) geometries are simple if and only if they have no
+ * repeated points.
+ * </ul>
+ * <p>
+ * For linear geometries, the evaluation of simplicity
+ * can be customized by supplying a {@link BoundaryNodeRule}
+ * to define how boundary points are determined.
+ * The default is the SFS-standard {@link BoundaryNodeRuleMod2}.
+ * Note that under the <tt>Mod-2</tt> rule, closed <tt>LineString</tt>s (rings)
+ * have no boundary.
+ * This means that an intersection at the endpoints of
+ * two closed LineStrings makes the geometry non-simple.
+ * If it is required to test whether a set of <code>LineString</code>s touch
+ * only at their endpoints, use {@link BoundaryNodeRuleEndPoint}.
+ * For example, this can be used to validate that a collection of lines
+ * form a topologically valid linear network.
+ * <p>
+ * By default this class finds a single non-simple location.
+ * To find all non-simple locations, set {@link #SetFindAllLocations(bool)}
+ * before calling {@link #IsSimple()}, and retrieve the locations
+ * via {@link #GetNonSimpleLocations()}.
+ * This can be used to find all intersection points in a linear network.
+ * <p>
+ * Repeated points are ignored.
+ */
+type IsSimpleOp struct {
+	points                      []geom.Coordinate
+	lines                       [][]geom.Coordinate
+	isPointwise                 bool
+	isRings                     bool
+	isClosedEndpointsInInterior bool
+	isFindAllLocations          bool
+	isSimple                    bool
+	isChecked                   bool
+	nonSimplePts                []geom.Coordinate
+}
+
+/**
+ * Creates a simplicity checker for a multipoint.
+ *
+ * @param pts the points to test
+ */
+func NewIsSimpleOpMultiPoint(pts []geom.Coordinate) *IsSimpleOp {
+	iso := new(IsSimpleOp)
+	iso.points = pts
+	iso.isPointwise = true
+	iso.isClosedEndpointsInInterior = true
+	return iso
+}
+
+/**
+ * Creates a simplicity checker for a linestring.
+ *
+ * @param line the points of the line to test
+ */
+func NewIsSimpleOpLineString(line []geom.Coordinate) *IsSimpleOp {
+	return NewIsSimpleOpMultiLineString([][]geom.Coordinate{line})
+}
+
+/**
+ * Creates a simplicity checker for a linear ring.
+ * A ring is simple if it touches itself only at its endpoints.
+ *
+ * @param ring the points of the ring to test
+ */
+func NewIsSimpleOpLinearRing(ring []geom.Coordinate) *IsSimpleOp {
+	return NewIsSimpleOpMultiLineString([][]geom.Coordinate{ring})
+}
+
+/**
+ * Creates a simplicity checker for a multilinestring
+ * using the default SFS Mod-2 Boundary Node Rule.
+ *
+ * @param lines the lines to test
+ */
+func NewIsSimpleOpMultiLineString(lines [][]geom.Coordinate) *IsSimpleOp {
+	return NewIsSimpleOpMultiLineStringBoundaryNodeRule(lines, algorithm.BoundaryNodeRuleMod2)
+}
+
+/**
+ * Creates a simplicity checker for a multilinestring
+ * using a given {@link BoundaryNodeRule}.
+ *
+ * @param lines the lines to test
+ * @param boundaryNodeRule the boundary node rule to use
+ */
+func NewIsSimpleOpMultiLineStringBoundaryNodeRule(lines [][]geom.Coordinate, boundaryNodeRule algorithm.BoundaryNodeRule) *IsSimpleOp {
+	iso := new(IsSimpleOp)
+	iso.lines = lines
+	iso.isClosedEndpointsInInterior = !boundaryNodeRule(2)
+	return iso
+}
+
+/**
+ * Creates a simplicity checker for a polygon.
+ * A polygon is simple if each of its rings is simple.
+ *
+ * @param polygon the rings of the polygon, with the shell first
+ */
+func NewIsSimpleOpPolygon(polygon [][]geom.Coordinate) *IsSimpleOp {
+	return NewIsSimpleOpMultiPolygon([][][]geom.Coordinate{polygon})
+}
+
+/**
+ * Creates a simplicity checker for a multipolygon.
+ * A multipolygon is simple if each of its rings is simple.
+ *
+ * @param polygons the polygons, each as a shell followed by its holes
+ */
+func NewIsSimpleOpMultiPolygon(polygons [][][]geom.Coordinate) *IsSimpleOp {
+	iso := new(IsSimpleOp)
+	iso.lines = make([][]geom.Coordinate, 0)
+	for _, poly := range polygons {
+		iso.lines = append(iso.lines, poly...)
+	}
+	iso.isRings = true
+	return iso
+}
+
+/**
+ * Sets whether all non-simple intersection points
+ * will be found.
+ *
+ * @param isFindAll whether to find all non-simple points
+ */
+func (iso *IsSimpleOp) SetFindAllLocations(isFindAll bool) {
+	iso.isFindAllLocations = isFindAll
+}
+
+/**
+ * Tests whether the geometry is simple.
+ *
+ * @return true if the geometry is simple
+ */
+func (iso *IsSimpleOp) IsSimple() bool {
+	iso.compute()
+	return iso.isSimple
+}
+
+/**
+ * Gets the coordinate for an location where the geometry
+ * fails to be simple.
+ * (i.e. where it has a non-boundary self-intersection).
+ *
+ * @return a coordinate for the location of the non-boundary self-intersection
+ * or nil if the geometry is simple
+ */
+func (iso *IsSimpleOp) GetNonSimpleLocation() *geom.Coordinate {
+	iso.compute()
+	if len(iso.nonSimplePts) == 0 {
+		return nil
+	}
+	return &iso.nonSimplePts[0]
+}
+
+/**
+ * Gets all non-simple intersection locations.
+ *
+ * @return a list of the coordinates of non-simple locations
+ */
+func (iso *IsSimpleOp) GetNonSimpleLocations() []geom.Coordinate {
+	iso.compute()
+	return iso.nonSimplePts
+}
+
+func (iso *IsSimpleOp) compute() {
+	if iso.isChecked {
+		return
+	}
+	iso.isChecked = true
+	iso.nonSimplePts = make([]geom.Coordinate, 0)
+	if iso.isPointwise {
+		iso.isSimple = iso.isSimpleMultiPoint()
+	} else if iso.isRings {
+		iso.isSimple = iso.isSimpleRings()
+	} else {
+		iso.isSimple = iso.isSimpleLinearGeometry(iso.lines)
+	}
+}
+
+func (iso *IsSimpleOp) isSimpleMultiPoint() bool {
+	type pointKey struct {
+		x, y float64
+	}
+	isSimple := true
+	points := make(map[pointKey]bool, len(iso.points))
+	for _, p := range iso.points {
+		key := pointKey{p.X, p.Y}
+		if points[key] {
+			iso.nonSimplePts = append(iso.nonSimplePts, p)
+			isSimple = false
+			if !iso.isFindAllLocations {
+				break
+			}
+		} else {
+			points[key] = true
+		}
+	}
+	return isSimple
+}
+
+/**
+ * Computes simplicity for polygonal geometries.
+ * Polygonal geometries are simple if and only if
+ * all of their component rings are simple.
+ */
+func (iso *IsSimpleOp) isSimpleRings() bool {
+	isSimple := true
+	for _, ring := range iso.lines {
+		if !iso.isSimpleLinearGeometry([][]geom.Coordinate{ring}) {
+			isSimple = false
+			if !iso.isFindAllLocations {
+				break
+			}
+		}
+	}
+	return isSimple
+}
+
+func (iso *IsSimpleOp) isSimpleLinearGeometry(lines [][]geom.Coordinate) bool {
+	segStrings := make([][]geom.Coordinate, 0, len(lines))
+	for _, line := range lines {
+		pts := geom.RemoveRepeatedPoints(line)
+		if len(pts) < 2 {
+			continue
+		}
+		segStrings = append(segStrings, pts)
+	}
+
+	index := strtree.NewSTRtree[*chain.MonotoneChain]()
+	chains := make([]*chain.MonotoneChain, 0)
+	for i, pts := range segStrings {
+		for _, mc := range chain.MonotoneChainBuilderGetChainsWithContext(pts, i) {
+			mc.SetId(len(chains))
+			chains = append(chains, mc)
+			index.Insert(mc.GetEnvelope(), mc)
+		}
+	}
+
+	li := algorithm.NewLineIntersector()
+	hasIntersection := false
+	isDone := false
+	action := func(mc1 *chain.MonotoneChain, start1 int, mc2 *chain.MonotoneChain, start2 int) {
+		if isDone {
+			return
+		}
+		ssIndex0 := mc1.GetContext().(int)
+		ssIndex1 := mc2.GetContext().(int)
+		isSameSegString := ssIndex0 == ssIndex1
+		if iso.findIntersection(li, segStrings[ssIndex0], start1, segStrings[ssIndex1], start2, isSameSegString) {
+			hasIntersection = true
+			iso.nonSimplePts = append(iso.nonSimplePts, *li.GetIntersection(0))
+			isDone = !iso.isFindAllLocations
+		}
+	}
+	for _, queryChain := range chains {
+		index.QueryVisitor(queryChain.GetEnvelope(), func(testChain *chain.MonotoneChain) bool {
+			//-- compare each pair of chains once; a chain cannot self-intersect
+			if testChain.GetId() > queryChain.GetId() {
+				queryChain.ComputeOverlaps(testChain, action)
+			}
+			return !isDone
+		})
+		if isDone {
+			break
+		}
+	}
+	return !hasIntersection
+}
+
+/**
+ * Tests whether an intersection between two segments
+ * makes the geometry non-simple.
+ */
+func (iso *IsSimpleOp) findIntersection(li *algorithm.LineIntersector, ss0 []geom.Coordinate, segIndex0 int, ss1 []geom.Coordinate, segIndex1 int, isSameSegString bool) bool {
+	p00 := &ss0[segIndex0]
+	p01 := &ss0[segIndex0+1]
+	p10 := &ss1[segIndex1]
+	p11 := &ss1[segIndex1+1]
+
+	li.ComputeIntersection(p00, p01, p10, p11)
+	if !li.HasIntersection() {
+		return false
+	}
+
+	/**
+	 * Check for an intersection in the interior of a segment.
+	 */
+	if li.IsInteriorIntersection() {
+		return true
+	}
+
+	/**
+	 * Check for equal segments (which will produce two intersection points).
+	 * These also intersect in interior points, so are non-simple.
+	 * (This is not triggered by zero-length segments, since repeated points are removed).
+	 */
+	if li.GetIntersectionNum() >= 2 {
+		return true
+	}
+
+	//-- following tests assume non-adjacent segments
+	isAdjacentSegment := isSameSegString && isSimpleOpAbs(segIndex1-segIndex0) <= 1
+	if isAdjacentSegment {
+		return false
+	}
+
+	/**
+	 * At this point there is a single intersection point
+	 * which is a vertex in each segString.
+	 * Classify them as endpoints or interior
+	 */
+	intPt := li.GetIntersection(0)
+	isIntersectionEndpt0 := isIntersectionEndpoint(ss0, segIndex0, intPt)
+	isIntersectionEndpt1 := isIntersectionEndpoint(ss1, segIndex1, intPt)
+
+	hasInteriorVertexInt := !(isIntersectionEndpt0 && isIntersectionEndpt1)
+	if hasInteriorVertexInt {
+		return true
+	}
+
+	/**
+	 * Both intersection vertices must be endpoints.
+	 * Final check is if boundary rule would create a non-simple endpoint.
+	 */
+	if iso.isClosedEndpointsInInterior && !isSameSegString {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests whether an intersection vertex is an endpoint of a segment string.
+ * The intersection vertex is known to be one of the segment endpoints.
+ */
+func isIntersectionEndpoint(ss []geom.Coordinate, segIndex int, intPt *geom.Coordinate) bool {
+	if intPt.Equals2D(&ss[segIndex]) {
+		return segIndex == 0
+	}
+	return segIndex+2 == len(ss)
+}
+
+func isSimpleOpAbs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
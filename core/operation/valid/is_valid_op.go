@@ -0,0 +1,558 @@
+package geos
+
+import (
+	locate "github.com/UltimateThread/geos-go/core/algorithm/locate"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+const (
+	isValidOp_MIN_SIZE_LINESTRING = 2
+	isValidOp_MIN_SIZE_RING       = 4
+)
+
+const (
+	isValidOp_POINTS = iota
+	isValidOp_LINES
+	isValidOp_RING
+	isValidOp_POLYGONS
+)
+
+/**
+ * Implements the algorithms required to compute the <code>isValid()</code> method
+ * for geometries.
+ * See the documentation for the various geometry types for a specification of validity.
+ * <p>
+ * Geometries are given as coordinate arrays:
+ * a point or multipoint as an array of points,
+ * a linestring or linear ring as an array of points,
+ * a polygon as an array of rings with the shell first,
+ * and a multipolygon as an array of polygons.
+ * <p>
+ * The following checks are made, in this order:
+ * <ul>
+ * <li>all coordinates have finite X and Y ordinates
+ * <li>rings are closed
+ * <li>linestrings and rings have enough distinct points
+ * <li>rings do not self-intersect, and rings do not cross each other
+ * <li>holes lie inside their shell
+ * <li>holes are not nested
+ * <li>the shells of a multipolygon are not nested
+ * <li>polygon interiors are connected
+ * </ul>
+ * The first error found is reported as a {@link TopologyValidationError},
+ * giving the error type and a location at or near the error.
+ * Empty components are valid.
+ */
+type IsValidOp struct {
+	kind      int
+	points    []geom.Coordinate
+	lines     [][]geom.Coordinate
+	polygons  [][][]geom.Coordinate
+	validErr  *TopologyValidationError
+	isChecked bool
+}
+
+func newIsValidOp(kind int) *IsValidOp {
+	ivo := new(IsValidOp)
+	ivo.kind = kind
+	return ivo
+}
+
+/**
+ * Creates a new validator for a point.
+ *
+ * @param pt the point to validate
+ */
+func NewIsValidOpPoint(pt *geom.Coordinate) *IsValidOp {
+	ivo := newIsValidOp(isValidOp_POINTS)
+	ivo.points = []geom.Coordinate{*pt}
+	return ivo
+}
+
+/**
+ * Creates a new validator for a multipoint.
+ *
+ * @param pts the points to validate
+ */
+func NewIsValidOpMultiPoint(pts []geom.Coordinate) *IsValidOp {
+	ivo := newIsValidOp(isValidOp_POINTS)
+	ivo.points = pts
+	return ivo
+}
+
+/**
+ * Creates a new validator for a linestring.
+ *
+ * @param line the points of the linestring to validate
+ */
+func NewIsValidOpLineString(line []geom.Coordinate) *IsValidOp {
+	return NewIsValidOpMultiLineString([][]geom.Coordinate{line})
+}
+
+/**
+ * Creates a new validator for a multilinestring.
+ *
+ * @param lines the linestrings to validate
+ */
+func NewIsValidOpMultiLineString(lines [][]geom.Coordinate) *IsValidOp {
+	ivo := newIsValidOp(isValidOp_LINES)
+	ivo.lines = lines
+	return ivo
+}
+
+/**
+ * Creates a new validator for a linear ring.
+ *
+ * @param ring the points of the ring to validate
+ */
+func NewIsValidOpLinearRing(ring []geom.Coordinate) *IsValidOp {
+	ivo := newIsValidOp(isValidOp_RING)
+	ivo.lines = [][]geom.Coordinate{ring}
+	return ivo
+}
+
+/**
+ * Creates a new validator for a polygon.
+ *
+ * @param polygon the rings of the polygon, with the shell first
+ */
+func NewIsValidOpPolygon(polygon [][]geom.Coordinate) *IsValidOp {
+	return NewIsValidOpMultiPolygon([][][]geom.Coordinate{polygon})
+}
+
+/**
+ * Creates a new validator for a multipolygon.
+ *
+ * @param polygons the polygons, each as a shell followed by its holes
+ */
+func NewIsValidOpMultiPolygon(polygons [][][]geom.Coordinate) *IsValidOp {
+	ivo := newIsValidOp(isValidOp_POLYGONS)
+	ivo.polygons = polygons
+	return ivo
+}
+
+/**
+ * Tests the validity of the input geometry.
+ *
+ * @return true if the geometry is valid
+ */
+func (ivo *IsValidOp) IsValid() bool {
+	return ivo.GetValidationError() == nil
+}
+
+/**
+ * Computes the validity of the geometry,
+ * and if not valid returns the validation error for the geometry,
+ * or nil if the geometry is valid.
+ *
+ * @return the validation error, if the geometry is invalid
+ * or nil if the geometry is valid
+ */
+func (ivo *IsValidOp) GetValidationError() *TopologyValidationError {
+	if !ivo.isChecked {
+		ivo.isChecked = true
+		switch ivo.kind {
+		case isValidOp_POINTS:
+			ivo.checkCoordinatesValid(ivo.points)
+		case isValidOp_LINES:
+			ivo.isValidLines()
+		case isValidOp_RING:
+			ivo.isValidRing()
+		case isValidOp_POLYGONS:
+			ivo.isValidPolygons()
+		}
+	}
+	return ivo.validErr
+}
+
+func (ivo *IsValidOp) logInvalid(code int, pt *geom.Coordinate) {
+	ivo.validErr = NewTopologyValidationError(code, pt)
+}
+
+func (ivo *IsValidOp) hasInvalidError() bool {
+	return ivo.validErr != nil
+}
+
+func (ivo *IsValidOp) isValidLines() {
+	for _, line := range ivo.lines {
+		ivo.checkCoordinatesValid(line)
+		if ivo.hasInvalidError() {
+			return
+		}
+		ivo.checkPointSize(line, isValidOp_MIN_SIZE_LINESTRING)
+		if ivo.hasInvalidError() {
+			return
+		}
+	}
+}
+
+func (ivo *IsValidOp) isValidRing() {
+	ring := ivo.lines[0]
+	ivo.checkCoordinatesValid(ring)
+	if ivo.hasInvalidError() {
+		return
+	}
+	ivo.checkRingClosed(ring)
+	if ivo.hasInvalidError() {
+		return
+	}
+	ivo.checkRingPointSize(ring)
+	if ivo.hasInvalidError() {
+		return
+	}
+	if len(ring) == 0 {
+		return
+	}
+	intPt := PolygonTopologyAnalyzerFindSelfIntersection(ring)
+	if intPt != nil {
+		ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_RING_SELF_INTERSECTION, intPt)
+	}
+}
+
+func (ivo *IsValidOp) isValidPolygons() {
+	for _, poly := range ivo.polygons {
+		for _, ring := range poly {
+			ivo.checkCoordinatesValid(ring)
+			if ivo.hasInvalidError() {
+				return
+			}
+		}
+	}
+	for _, poly := range ivo.polygons {
+		for _, ring := range poly {
+			ivo.checkRingClosed(ring)
+			if ivo.hasInvalidError() {
+				return
+			}
+		}
+	}
+	for _, poly := range ivo.polygons {
+		for _, ring := range poly {
+			ivo.checkRingPointSize(ring)
+			if ivo.hasInvalidError() {
+				return
+			}
+		}
+	}
+
+	areaAnalyzer := newPolygonTopologyAnalyzer(ivo.polygons)
+	if areaAnalyzer.hasInvalidIntersection() {
+		ivo.logInvalid(areaAnalyzer.invalidCode, areaAnalyzer.invalidLocation)
+		return
+	}
+
+	for _, poly := range ivo.polygons {
+		ivo.checkHolesInShell(poly)
+		if ivo.hasInvalidError() {
+			return
+		}
+	}
+	for _, poly := range ivo.polygons {
+		ivo.checkHolesNotNested(poly)
+		if ivo.hasInvalidError() {
+			return
+		}
+	}
+	ivo.checkShellsNotNested(ivo.polygons)
+	if ivo.hasInvalidError() {
+		return
+	}
+
+	disconnectedPt := areaAnalyzer.findDisconnectedInteriorLocation()
+	if disconnectedPt != nil {
+		ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_DISCONNECTED_INTERIOR, disconnectedPt)
+	}
+}
+
+func (ivo *IsValidOp) checkCoordinatesValid(coords []geom.Coordinate) {
+	for i := range coords {
+		if !coords[i].IsValid() {
+			ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_INVALID_COORDINATE, &coords[i])
+			return
+		}
+	}
+}
+
+func (ivo *IsValidOp) checkRingClosed(ring []geom.Coordinate) {
+	if len(ring) == 0 {
+		return
+	}
+	if !ring[0].Equals2D(&ring[len(ring)-1]) {
+		ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_RING_NOT_CLOSED, &ring[0])
+	}
+}
+
+func (ivo *IsValidOp) checkRingPointSize(ring []geom.Coordinate) {
+	if len(ring) == 0 {
+		return
+	}
+	ivo.checkPointSize(ring, isValidOp_MIN_SIZE_RING)
+}
+
+/**
+ * Check the number of non-repeated points is at least a given size.
+ */
+func (ivo *IsValidOp) checkPointSize(line []geom.Coordinate, minSize int) {
+	if len(line) == 0 {
+		return
+	}
+	if !isNonRepeatedSizeAtLeast(line, minSize) {
+		ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_TOO_FEW_POINTS, &line[0])
+	}
+}
+
+func isNonRepeatedSizeAtLeast(line []geom.Coordinate, minSize int) bool {
+	numPts := 0
+	var prevPt *geom.Coordinate
+	for i := range line {
+		if numPts >= minSize {
+			return true
+		}
+		pt := &line[i]
+		if prevPt == nil || !pt.Equals2D(prevPt) {
+			numPts++
+		}
+		prevPt = pt
+	}
+	return numPts >= minSize
+}
+
+/**
+ * Tests that each hole is inside the polygon shell.
+ * This routine assumes that the holes have previously been tested
+ * to ensure that all vertices lie on the shell or on the same side of it
+ * (i.e. that the hole rings do not cross the shell ring).
+ * Given this, a simple point-in-polygon test of a single point in the hole can be used,
+ * provided the point is chosen such that it does not lie on the shell.
+ */
+func (ivo *IsValidOp) checkHolesInShell(poly [][]geom.Coordinate) {
+	// skip test if no holes are present
+	if len(poly) <= 1 {
+		return
+	}
+	shell := geom.RemoveRepeatedPoints(poly[0])
+	isShellEmpty := len(shell) == 0
+	for _, hole := range poly[1:] {
+		if len(hole) == 0 {
+			continue
+		}
+		var invalidPt *geom.Coordinate
+		if isShellEmpty {
+			invalidPt = &hole[0]
+		} else {
+			invalidPt = findHoleOutsideShellPoint(geom.RemoveRepeatedPoints(hole), shell)
+		}
+		if invalidPt != nil {
+			ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_HOLE_OUTSIDE_SHELL, invalidPt)
+			return
+		}
+	}
+}
+
+/**
+ * Checks if a polygon hole lies inside its shell
+ * and if not returns a point indicating this.
+ * The hole is known to be wholly inside or outside the shell,
+ * so it suffices to find a single point which is interior or exterior,
+ * or check the edge topology at a point on the boundary of the shell.
+ *
+ * @param hole the hole to test
+ * @param shell the polygon shell to test against
+ * @return a hole point outside the shell, or nil if it is inside
+ */
+func findHoleOutsideShellPoint(hole []geom.Coordinate, shell []geom.Coordinate) *geom.Coordinate {
+	holePt0 := &hole[0]
+	/**
+	 * If hole envelope is not covered by shell, it must be outside
+	 */
+	if !geom.NewEnvelopeFromCoordinateArray(shell).CoversEnvelope(geom.NewEnvelopeFromCoordinateArray(hole)) {
+		return holePt0
+	}
+	if PolygonTopologyAnalyzerIsRingNested(hole, shell) {
+		return nil
+	}
+	return holePt0
+}
+
+/**
+ * Checks if any polygon hole is nested inside another.
+ * Assumes that holes do not cross (overlap),
+ * This is checked earlier.
+ */
+func (ivo *IsValidOp) checkHolesNotNested(poly [][]geom.Coordinate) {
+	//-- skip test if less than 2 holes
+	if len(poly) <= 2 {
+		return
+	}
+	holes := make([][]geom.Coordinate, 0, len(poly)-1)
+	holeEnvs := make([]*geom.Envelope, 0, len(poly)-1)
+	index := strtree.NewSTRtree[int]()
+	for _, hole := range poly[1:] {
+		if len(hole) == 0 {
+			continue
+		}
+		holeEnv := geom.NewEnvelopeFromCoordinateArray(hole)
+		index.Insert(holeEnv, len(holes))
+		holes = append(holes, geom.RemoveRepeatedPoints(hole))
+		holeEnvs = append(holeEnvs, holeEnv)
+	}
+
+	for i, hole := range holes {
+		var nestedPt *geom.Coordinate
+		index.QueryVisitor(holeEnvs[i], func(j int) bool {
+			if i == j {
+				return true
+			}
+			/**
+			 * Hole is not fully covered by test hole, so cannot be nested
+			 */
+			if !holeEnvs[j].CoversEnvelope(holeEnvs[i]) {
+				return true
+			}
+			if PolygonTopologyAnalyzerIsRingNested(hole, holes[j]) {
+				nestedPt = &hole[0]
+				return false
+			}
+			return true
+		})
+		if nestedPt != nil {
+			ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_NESTED_HOLES, nestedPt)
+			return
+		}
+	}
+}
+
+/**
+ * Checks that no element polygon is in the interior of another element polygon.
+ * <p>
+ * Preconditions:
+ * <ul>
+ * <li>shells do not partially overlap
+ * <li>shells do not touch along an edge
+ * <li>no duplicate rings exist
+ * </ul>
+ * These have been confirmed by the {@link PolygonTopologyAnalyzer}.
+ */
+func (ivo *IsValidOp) checkShellsNotNested(polygons [][][]geom.Coordinate) {
+	//-- skip test if only one shell present
+	if len(polygons) <= 1 {
+		return
+	}
+	polyEnvs := make([]*geom.Envelope, len(polygons))
+	locators := make([]*locate.IndexedPointInAreaLocator, len(polygons))
+	index := strtree.NewSTRtree[int]()
+	for i, poly := range polygons {
+		if len(poly) == 0 || len(poly[0]) == 0 {
+			continue
+		}
+		polyEnvs[i] = geom.NewEnvelopeFromCoordinateArray(poly[0])
+		index.Insert(polyEnvs[i], i)
+	}
+
+	for i, poly := range polygons {
+		if polyEnvs[i] == nil {
+			continue
+		}
+		shell := geom.RemoveRepeatedPoints(poly[0])
+		var nestedPt *geom.Coordinate
+		index.QueryVisitor(polyEnvs[i], func(j int) bool {
+			if i == j {
+				return true
+			}
+			/**
+			 * If polygon is not fully covered by candidate polygon it cannot be nested
+			 */
+			if !polyEnvs[j].CoversEnvelope(polyEnvs[i]) {
+				return true
+			}
+			if locators[j] == nil {
+				locators[j] = locate.NewIndexedPointInAreaLocator(polygons[j])
+			}
+			nestedPt = findNestedPoint(shell, polygons[j], locators[j])
+			return nestedPt == nil
+		})
+		if nestedPt != nil {
+			ivo.logInvalid(constants.TOPOLOGYVALIDATIONERROR_NESTED_SHELLS, nestedPt)
+			return
+		}
+	}
+}
+
+/**
+ * Finds a point of a shell segment which lies inside a polygon, if any.
+ * The shell is assumed to touch the polygon only at shell vertices,
+ * and does not cross the polygon.
+ *
+ * @param shell the shell to test
+ * @param possibleOuterPoly the polygon which may contain the shell
+ * @param locator the locator for the outer polygon
+ * @return an interior segment point, or nil if the shell is nested correctly
+ */
+func findNestedPoint(shell []geom.Coordinate, possibleOuterPoly [][]geom.Coordinate, locator *locate.IndexedPointInAreaLocator) *geom.Coordinate {
+	/**
+	 * Try checking two points, since checking point location is fast.
+	 */
+	shellPt0 := &shell[0]
+	loc0 := locator.Locate(shellPt0)
+	if loc0 == constants.LOCATION_EXTERIOR {
+		return nil
+	}
+	if loc0 == constants.LOCATION_INTERIOR {
+		return shellPt0
+	}
+
+	shellPt1 := &shell[1]
+	loc1 := locator.Locate(shellPt1)
+	if loc1 == constants.LOCATION_EXTERIOR {
+		return nil
+	}
+	if loc1 == constants.LOCATION_INTERIOR {
+		return shellPt1
+	}
+
+	/**
+	 * The shell points both lie on the boundary of
+	 * the polygon.
+	 * Nesting can be checked via the topology of the incident edges.
+	 */
+	return findIncidentSegmentNestedPoint(shell, possibleOuterPoly)
+}
+
+/**
+ * Finds a point of a shell segment which lies inside a polygon, if any.
+ * The shell is assumed to touch the polygon only at shell vertices,
+ * and does not cross the polygon.
+ *
+ * @param shell the shell to test
+ * @param poly the polygon to test against
+ * @return an interior segment point, or nil if the shell is nested correctly
+ */
+func findIncidentSegmentNestedPoint(shell []geom.Coordinate, poly [][]geom.Coordinate) *geom.Coordinate {
+	polyShell := geom.RemoveRepeatedPoints(poly[0])
+	if !PolygonTopologyAnalyzerIsRingNested(shell, polyShell) {
+		return nil
+	}
+
+	/**
+	 * Check if the shell is inside a hole (if there are any).
+	 * If so this is valid.
+	 */
+	shellEnv := geom.NewEnvelopeFromCoordinateArray(shell)
+	for _, hole := range poly[1:] {
+		if len(hole) == 0 {
+			continue
+		}
+		if geom.NewEnvelopeFromCoordinateArray(hole).CoversEnvelope(shellEnv) &&
+			PolygonTopologyAnalyzerIsRingNested(shell, geom.RemoveRepeatedPoints(hole)) {
+			return nil
+		}
+	}
+
+	/**
+	 * The shell is contained in the polygon, but is not contained in a hole.
+	 * This is invalid.
+	 */
+	return &shell[0]
+}
@@ -0,0 +1,411 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	chain "github.com/UltimateThread/geos-go/core/index/chain"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+const polygonTopologyAnalyzer_NO_INVALID_INTERSECTION = -1
+
+/**
+ * A ring of a polygonal geometry being analyzed,
+ * with repeated points removed.
+ */
+type analyzedRing struct {
+	pts  []geom.Coordinate
+	poly int
+}
+
+/**
+ * Analyzes the topology of polygonal geometry
+ * to determine whether it is valid.
+ * <p>
+ * Analyzing polygons with inverted rings (shells or exverted holes)
+ * is not supported: self-touching rings are reported as invalid.
+ * <p>
+ * Rings are noded against each other using monotone chains,
+ * and the intersections are checked for:
+ * <ul>
+ * <li>proper or collinear intersections, which are self-intersections
+ * <li>non-adjacent vertex touches within a ring, which are ring self-intersections
+ * <li>touches at which rings cross, which are self-intersections
+ * </ul>
+ * Valid touches between rings of the same polygon are recorded
+ * in order to check that the polygon interior is connected.
+ */
+type polygonTopologyAnalyzer struct {
+	rings           []*analyzedRing
+	li              *algorithm.LineIntersector
+	invalidCode     int
+	invalidLocation *geom.Coordinate
+	touches         *ringTouchGraph
+}
+
+/**
+ * Tests whether a ring is nested inside another ring.
+ * <p>
+ * Preconditions:
+ * <ul>
+ * <li>The rings do not cross (i.e. the test is wholly inside or outside the target)
+ * <li>The rings may touch at discrete points only
+ * <li>The target ring does not self-cross, but it may self-touch
+ * </ul>
+ * If the test ring start point is properly inside or outside, that provides the result.
+ * Otherwise the start point is on the target ring,
+ * and the incident start segment (accounting for repeated points) is
+ * tested for its topology relative to the target ring.
+ *
+ * @param test the ring to test
+ * @param target the ring to test against
+ * @return true if the test ring lies inside the target ring
+ */
+func PolygonTopologyAnalyzerIsRingNested(test []geom.Coordinate, target []geom.Coordinate) bool {
+	p0 := &test[0]
+	loc := algorithm.PointLocationLocateInRing(p0, target)
+	if loc == constants.LOCATION_EXTERIOR {
+		return false
+	}
+	if loc == constants.LOCATION_INTERIOR {
+		return true
+	}
+
+	/**
+	 * The start point is on the boundary of the ring.
+	 * Use the topology at the node to check if the segment
+	 * is inside or outside the ring.
+	 */
+	p1 := findNonEqualVertex(test, p0)
+	return isIncidentSegmentInRing(p0, p1, target)
+}
+
+/**
+ * Finds a self-intersection (if any) in a ring.
+ *
+ * @param ring the ring to analyze
+ * @return a self-intersection point if one exists, or nil
+ */
+func PolygonTopologyAnalyzerFindSelfIntersection(ring []geom.Coordinate) *geom.Coordinate {
+	ata := newPolygonTopologyAnalyzer([][][]geom.Coordinate{{ring}})
+	if ata.hasInvalidIntersection() {
+		return ata.invalidLocation
+	}
+	return nil
+}
+
+func findNonEqualVertex(ring []geom.Coordinate, p *geom.Coordinate) *geom.Coordinate {
+	i := 1
+	next := &ring[i]
+	for next.Equals2D(p) && i < len(ring)-1 {
+		i += 1
+		next = &ring[i]
+	}
+	return next
+}
+
+/**
+ * Tests whether a touching segment is interior to a ring.
+ * <p>
+ * Preconditions:
+ * <ul>
+ * <li>The segment does not intersect the ring other than at the endpoints
+ * <li>The segment vertex p0 lies on the ring
+ * <li>The ring does not self-cross, but it may self-touch
+ * </ul>
+ * This works for both shells and holes, but the caller must know
+ * the ring role.
+ *
+ * @param p0 the touching vertex of the segment
+ * @param p1 the second vertex of the segment
+ * @param ringPts the points of the ring
+ * @return true if the segment is inside the ring.
+ */
+func isIncidentSegmentInRing(p0 *geom.Coordinate, p1 *geom.Coordinate, ringPts []geom.Coordinate) bool {
+	index := intersectingSegIndex(ringPts, p0)
+	if index < 0 {
+		return false
+	}
+	rPrev := findRingVertexPrev(ringPts, index, p0)
+	rNext := findRingVertexNext(ringPts, index, p0)
+	/**
+	 * If ring orientation is not normalized, flip the corner orientation
+	 */
+	isInteriorOnRight := !algorithm.OrientationIsCCW(ringPts)
+	if !isInteriorOnRight {
+		rPrev, rNext = rNext, rPrev
+	}
+	return algorithm.PolygonNodeTopologyIsInteriorSegment(p0, rPrev, rNext, p1)
+}
+
+/**
+ * Finds the ring vertex previous to a node point on a ring
+ * (which is contained in the index'th segment,
+ * as either the start vertex or an interior point).
+ * Repeated points are skipped over.
+ */
+func findRingVertexPrev(ringPts []geom.Coordinate, index int, node *geom.Coordinate) *geom.Coordinate {
+	iPrev := index
+	prev := &ringPts[iPrev]
+	for node.Equals2D(prev) {
+		iPrev = ringIndexPrev(ringPts, iPrev)
+		prev = &ringPts[iPrev]
+	}
+	return prev
+}
+
+/**
+ * Finds the ring vertex next from a node point on a ring
+ * (which is contained in the index'th segment,
+ * as either the start vertex or an interior point).
+ * Repeated points are skipped over.
+ */
+func findRingVertexNext(ringPts []geom.Coordinate, index int, node *geom.Coordinate) *geom.Coordinate {
+	//-- safe, since index is always the start of a ring segment
+	iNext := index + 1
+	next := &ringPts[iNext]
+	for node.Equals2D(next) {
+		iNext = ringIndexNext(ringPts, iNext)
+		next = &ringPts[iNext]
+	}
+	return next
+}
+
+func ringIndexPrev(ringPts []geom.Coordinate, index int) int {
+	if index == 0 {
+		return len(ringPts) - 2
+	}
+	return index - 1
+}
+
+func ringIndexNext(ringPts []geom.Coordinate, index int) int {
+	if index >= len(ringPts)-2 {
+		return 0
+	}
+	return index + 1
+}
+
+/**
+ * Computes the index of the segment starting at a given point
+ * or containing it in its interior.
+ *
+ * @return the index of the segment containing the point, or -1 if none
+ */
+func intersectingSegIndex(ringPts []geom.Coordinate, pt *geom.Coordinate) int {
+	for i := 0; i < len(ringPts)-1; i++ {
+		if algorithm.PointLocationIsOnSegment(pt, &ringPts[i], &ringPts[i+1]) {
+			//-- check if pt is the start point of the next segment
+			if pt.Equals2D(&ringPts[i+1]) {
+				return i + 1
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+/**
+ * Creates a new analyzer for a set of polygons.
+ *
+ * @param polygons the polygons to analyze, each as a shell followed by its holes
+ */
+func newPolygonTopologyAnalyzer(polygons [][][]geom.Coordinate) *polygonTopologyAnalyzer {
+	ata := new(polygonTopologyAnalyzer)
+	ata.li = algorithm.NewLineIntersector()
+	ata.invalidCode = polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+	ata.touches = newRingTouchGraph()
+	for i, poly := range polygons {
+		for _, ring := range poly {
+			if len(ring) == 0 {
+				continue
+			}
+			ata.rings = append(ata.rings, &analyzedRing{geom.RemoveRepeatedPoints(ring), i})
+		}
+	}
+	ata.analyze()
+	return ata
+}
+
+func (ata *polygonTopologyAnalyzer) hasInvalidIntersection() bool {
+	return ata.invalidCode >= 0
+}
+
+/**
+ * Gets the location of a touch cycle which disconnects
+ * the interior of a polygon, if any.
+ *
+ * @return the location of the disconnection, or nil if the interiors are connected
+ */
+func (ata *polygonTopologyAnalyzer) findDisconnectedInteriorLocation() *geom.Coordinate {
+	return ata.touches.cycleLocation
+}
+
+func (ata *polygonTopologyAnalyzer) analyze() {
+	index := strtree.NewSTRtree[*chain.MonotoneChain]()
+	chains := make([]*chain.MonotoneChain, 0)
+	for i, ring := range ata.rings {
+		for _, mc := range chain.MonotoneChainBuilderGetChainsWithContext(ring.pts, i) {
+			mc.SetId(len(chains))
+			chains = append(chains, mc)
+			index.Insert(mc.GetEnvelope(), mc)
+		}
+	}
+
+	action := func(mc1 *chain.MonotoneChain, start1 int, mc2 *chain.MonotoneChain, start2 int) {
+		if ata.hasInvalidIntersection() {
+			return
+		}
+		ring0 := mc1.GetContext().(int)
+		ring1 := mc2.GetContext().(int)
+		code := ata.findInvalidIntersection(ring0, start1, ring1, start2)
+		if code != polygonTopologyAnalyzer_NO_INVALID_INTERSECTION {
+			ata.invalidCode = code
+		}
+	}
+	for _, queryChain := range chains {
+		index.QueryVisitor(queryChain.GetEnvelope(), func(testChain *chain.MonotoneChain) bool {
+			/**
+			 * Only compare each pair of chains once,
+			 * and never compare a chain with itself
+			 * (since the segments of a chain do not intersect).
+			 */
+			if testChain.GetId() > queryChain.GetId() {
+				queryChain.ComputeOverlaps(testChain, action)
+			}
+			return !ata.hasInvalidIntersection()
+		})
+		if ata.hasInvalidIntersection() {
+			return
+		}
+	}
+}
+
+func (ata *polygonTopologyAnalyzer) findInvalidIntersection(ringIndex0 int, segIndex0 int, ringIndex1 int, segIndex1 int) int {
+	ring0 := ata.rings[ringIndex0]
+	ring1 := ata.rings[ringIndex1]
+	p00 := &ring0.pts[segIndex0]
+	p01 := &ring0.pts[segIndex0+1]
+	p10 := &ring1.pts[segIndex1]
+	p11 := &ring1.pts[segIndex1+1]
+
+	isSameRing := ringIndex0 == ringIndex1
+	isSameSegment := isSameRing && segIndex0 == segIndex1
+	if isSameSegment {
+		return polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+	}
+
+	ata.li.ComputeIntersection(p00, p01, p10, p11)
+	if !ata.li.HasIntersection() {
+		return polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+	}
+
+	/**
+	 * Check for an intersection in the interior of both segments.
+	 * Collinear intersections by definition contain an interior intersection.
+	 */
+	if ata.li.IsProper() || ata.li.GetIntersectionNum() >= 2 {
+		ata.invalidLocation = ata.li.GetIntersection(0).Clone()
+		return constants.TOPOLOGYVALIDATIONERROR_SELF_INTERSECTION
+	}
+
+	/**
+	 * Now know there is exactly one intersection,
+	 * at a vertex of at least one segment.
+	 */
+	intPt := ata.li.GetIntersection(0)
+
+	/**
+	 * If segments are adjacent the intersection must be their common endpoint.
+	 * (since they are not collinear).
+	 * This is valid.
+	 */
+	isAdjacentSegments := isSameRing && isAdjacentInRing(ring0.pts, segIndex0, segIndex1)
+	if isAdjacentSegments {
+		return polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+	}
+
+	/**
+	 * Under OGC semantics, rings cannot self-intersect.
+	 * So the intersection is invalid.
+	 */
+	if isSameRing {
+		ata.invalidLocation = intPt.Clone()
+		return constants.TOPOLOGYVALIDATIONERROR_RING_SELF_INTERSECTION
+	}
+
+	/**
+	 * Optimization: don't analyze intPts at the endpoint of a segment.
+	 * This is because they are also start points, so don't need to be
+	 * evaluated twice.
+	 * This simplifies following logic, by removing the segment endpoint case.
+	 */
+	if intPt.Equals2D(p01) || intPt.Equals2D(p11) {
+		return polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+	}
+
+	/**
+	 * Check topology of a vertex intersection.
+	 * The ring(s) must not cross.
+	 */
+	e00 := p00
+	e01 := p01
+	if intPt.Equals2D(p00) {
+		e00 = prevCoordinateInRing(ring0.pts, segIndex0)
+		e01 = p01
+	}
+	e10 := p10
+	e11 := p11
+	if intPt.Equals2D(p10) {
+		e10 = prevCoordinateInRing(ring1.pts, segIndex1)
+		e11 = p11
+	}
+	hasCrossing := algorithm.PolygonNodeTopologyIsCrossing(intPt, e00, e01, e10, e11)
+	if hasCrossing {
+		ata.invalidLocation = intPt.Clone()
+		return constants.TOPOLOGYVALIDATIONERROR_SELF_INTERSECTION
+	}
+
+	/**
+	 * If the rings are in the same polygon
+	 * then record the touch to support connected interior checking.
+	 */
+	if ring0.poly == ring1.poly {
+		ata.touches.addTouch(ringIndex0, ringIndex1, intPt)
+	}
+	return polygonTopologyAnalyzer_NO_INVALID_INTERSECTION
+}
+
+/**
+ * For a segment string for a ring, gets the coordinate
+ * previous to the given index (wrapping if the index is 0)
+ */
+func prevCoordinateInRing(ringPts []geom.Coordinate, segIndex int) *geom.Coordinate {
+	prevIndex := segIndex - 1
+	if prevIndex < 0 {
+		prevIndex = len(ringPts) - 2
+	}
+	return &ringPts[prevIndex]
+}
+
+/**
+ * Tests if two segments in a closed ring are adjacent.
+ */
+func isAdjacentInRing(ringPts []geom.Coordinate, segIndex0 int, segIndex1 int) bool {
+	delta := segIndex1 - segIndex0
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta <= 1 {
+		return true
+	}
+	/**
+	 * A string with N vertices has maximum segment index of N-2.
+	 * If the delta is at least N-2, the segments must be
+	 * at the start and end of the string and thus adjacent.
+	 */
+	if delta >= len(ringPts)-2 {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A node of a ring touch graph, which is either a ring
+ * or a touch point.
+ */
+type ringTouchNode struct {
+	ring int
+	x    float64
+	y    float64
+}
+
+/**
+ * Records the touches between the rings of polygons,
+ * in order to determine whether the touches disconnect a polygon interior.
+ * <p>
+ * The graph links each ring to the points at which it touches other rings.
+ * The interior of a polygon is disconnected exactly when
+ * the touches form a cycle, for instance
+ * when two rings touch at more than one point,
+ * or a chain of holes touches the shell at each end.
+ * Several rings touching at a single point do not form a cycle.
+ * Cycles are detected using a union-find structure over the graph nodes.
+ */
+type ringTouchGraph struct {
+	parent        map[ringTouchNode]ringTouchNode
+	edges         map[[2]ringTouchNode]bool
+	cycleLocation *geom.Coordinate
+}
+
+func newRingTouchGraph() *ringTouchGraph {
+	graph := new(ringTouchGraph)
+	graph.parent = make(map[ringTouchNode]ringTouchNode)
+	graph.edges = make(map[[2]ringTouchNode]bool)
+	return graph
+}
+
+/**
+ * Records a touch between two distinct rings of a polygon.
+ */
+func (graph *ringTouchGraph) addTouch(ring0 int, ring1 int, pt *geom.Coordinate) {
+	ptNode := ringTouchNode{-1, pt.X, pt.Y}
+	graph.addEdge(ringTouchNode{ring: ring0}, ptNode, pt)
+	graph.addEdge(ringTouchNode{ring: ring1}, ptNode, pt)
+}
+
+func (graph *ringTouchGraph) addEdge(ringNode ringTouchNode, ptNode ringTouchNode, pt *geom.Coordinate) {
+	edge := [2]ringTouchNode{ringNode, ptNode}
+	if graph.edges[edge] {
+		return
+	}
+	graph.edges[edge] = true
+
+	root0 := graph.find(ringNode)
+	root1 := graph.find(ptNode)
+	if root0 == root1 {
+		if graph.cycleLocation == nil {
+			graph.cycleLocation = pt.Clone()
+		}
+		return
+	}
+	graph.parent[root0] = root1
+}
+
+func (graph *ringTouchGraph) find(node ringTouchNode) ringTouchNode {
+	root := node
+	for {
+		parent, ok := graph.parent[root]
+		if !ok {
+			break
+		}
+		root = parent
+	}
+	//-- compress the path to the root
+	for node != root {
+		next := graph.parent[node]
+		graph.parent[node] = root
+		node = next
+	}
+	return root
+}
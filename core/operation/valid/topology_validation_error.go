@@ -0,0 +1,112 @@
+package geos
+
+import (
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Messages corresponding to error codes
+ */
+var topologyValidationErrorMessages = []string{
+	"Topology Validation Error",
+	"Repeated Point",
+	"Hole lies outside shell",
+	"Holes are nested",
+	"Interior is disconnected",
+	"Self-intersection",
+	"Ring Self-intersection",
+	"Nested shells",
+	"Duplicate Rings",
+	"Too few distinct points in geometry component",
+	"Invalid Coordinate",
+	"Ring is not closed",
+}
+
+/**
+ * Contains information about the nature and location of a {@link Geometry}
+ * validation error
+ * <p>
+ * The error type is one of the <code>TOPOLOGYVALIDATIONERROR_</code> constants.
+ * TopologyValidationError implements the <code>error</code> interface,
+ * so it can be returned directly by functions which reject invalid input.
+ */
+type TopologyValidationError struct {
+	errorType int
+	pt        *geom.Coordinate
+}
+
+/**
+ * Creates a validation error with the given type and location
+ *
+ * @param errorType the type of the error
+ * @param pt the location of the error, or nil if it is not known
+ */
+func NewTopologyValidationError(errorType int, pt *geom.Coordinate) *TopologyValidationError {
+	tve := new(TopologyValidationError)
+	tve.errorType = errorType
+	if pt != nil {
+		tve.pt = pt.Clone()
+	}
+	return tve
+}
+
+/**
+ * Creates a validation error of the given type with a null location
+ *
+ * @param errorType the type of the error
+ */
+func NewTopologyValidationErrorType(errorType int) *TopologyValidationError {
+	return NewTopologyValidationError(errorType, nil)
+}
+
+/**
+ * Returns the location of this error (on the {@link Geometry} containing the error).
+ *
+ * @return a {@link Coordinate} on the input geometry, or nil if the location is not known
+ */
+func (tve *TopologyValidationError) GetCoordinate() *geom.Coordinate {
+	return tve.pt
+}
+
+/**
+ * Gets the type of this error.
+ *
+ * @return the error type
+ */
+func (tve *TopologyValidationError) GetErrorType() int {
+	return tve.errorType
+}
+
+/**
+ * Gets an error message describing this error.
+ * The error message does not describe the location of the error.
+ *
+ * @return the error message
+ */
+func (tve *TopologyValidationError) GetMessage() string {
+	if tve.errorType < 0 || tve.errorType >= len(topologyValidationErrorMessages) {
+		return topologyValidationErrorMessages[constants.TOPOLOGYVALIDATIONERROR_ERROR]
+	}
+	return topologyValidationErrorMessages[tve.errorType]
+}
+
+/**
+ * Gets a message describing the type and location of this error.
+ * @return the error message
+ */
+func (tve *TopologyValidationError) ToString() string {
+	locStr := ""
+	if tve.pt != nil {
+		locStr = " at or near point " + tve.pt.ToString()
+	}
+	return tve.GetMessage() + locStr
+}
+
+/**
+ * Gets a message describing the type and location of this error,
+ * as required by the <code>error</code> interface.
+ */
+func (tve *TopologyValidationError) Error() string {
+	return tve.ToString()
+}
@@ -0,0 +1,147 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents a directed edge in a {@link PlanarGraph},
+ * traversing its parent {@link Edge} in one direction.
+ * <p>
+ * Each directed edge carries a value of type D,
+ * which applications can use to store their own state.
+ */
+type DirectedEdge[D any] struct {
+	GraphComponent
+	edge          *Edge
+	from          *Node[D]
+	to            *Node[D]
+	sym           *DirectedEdge[D]
+	edgeDirection bool
+
+	p0       geom.Coordinate
+	p1       geom.Coordinate
+	quadrant int
+
+	data D
+}
+
+/**
+ * Constructs a DirectedEdge connecting the <code>from</code> node to the
+ * <code>to</code> node.
+ *
+ * @param directionPt
+ *                  specifies this DirectedEdge's direction (given by an imaginary
+ *                  line from the <code>from</code> node to <code>directionPt</code>)
+ * @param edgeDirection
+ *                  whether this DirectedEdge's direction is the same as or
+ *                  opposite to that of the parent Edge
+ */
+func NewDirectedEdge[D any](from *Node[D], to *Node[D], directionPt *geom.Coordinate, edgeDirection bool) *DirectedEdge[D] {
+	de := new(DirectedEdge[D])
+	de.from = from
+	de.to = to
+	de.edgeDirection = edgeDirection
+	de.p0 = from.pt
+	de.p1 = *directionPt
+	//-- the direction point is never equal to the start point, since repeated points are removed
+	de.quadrant, _ = geom.QuadrantOfDisplacement(de.p1.X-de.p0.X, de.p1.Y-de.p0.Y)
+	return de
+}
+
+/**
+ * Returns this DirectedEdge's parent Edge.
+ */
+func (de *DirectedEdge[D]) GetEdge() *Edge {
+	return de.edge
+}
+
+/**
+ * Returns the node from which this DirectedEdge leaves.
+ */
+func (de *DirectedEdge[D]) GetFromNode() *Node[D] {
+	return de.from
+}
+
+/**
+ * Returns the node to which this DirectedEdge goes.
+ */
+func (de *DirectedEdge[D]) GetToNode() *Node[D] {
+	return de.to
+}
+
+/**
+ * Returns the symmetric DirectedEdge -- the other DirectedEdge associated with
+ * this DirectedEdge's parent Edge.
+ */
+func (de *DirectedEdge[D]) GetSym() *DirectedEdge[D] {
+	return de.sym
+}
+
+/**
+ * Returns whether the direction of the parent Edge is the same as that
+ * of this Directed Edge.
+ */
+func (de *DirectedEdge[D]) GetEdgeDirection() bool {
+	return de.edgeDirection
+}
+
+/**
+ * Returns the coordinate of the from-node.
+ */
+func (de *DirectedEdge[D]) GetCoordinate() *geom.Coordinate {
+	return &de.p0
+}
+
+/**
+ * Returns a point to which an imaginary line is drawn from the from-node to
+ * specify this DirectedEdge's orientation.
+ */
+func (de *DirectedEdge[D]) GetDirectionPt() *geom.Coordinate {
+	return &de.p1
+}
+
+/**
+ * Returns 0, 1, 2, or 3, indicating the quadrant in which this DirectedEdge's
+ * orientation lies.
+ */
+func (de *DirectedEdge[D]) GetQuadrant() int {
+	return de.quadrant
+}
+
+/**
+ * Gets the application data of this DirectedEdge.
+ * The data is returned by reference, so that it can be updated.
+ */
+func (de *DirectedEdge[D]) GetData() *D {
+	return &de.data
+}
+
+/**
+ * Returns 1 if this DirectedEdge has a greater angle with the
+ * positive x-axis than e, 0 if the DirectedEdges are collinear, and -1 otherwise.
+ * <p>
+ * Using the obvious algorithm of simply computing the angle is not robust,
+ * since the angle calculation is susceptible to roundoff. A robust algorithm
+ * is:
+ * <ul>
+ * <li>first compare the quadrants. If the quadrants are different, it is
+ * trivial to determine which vector is "greater".
+ * <li>if the vectors lie in the same quadrant, the robust
+ * {@link algorithm.OrientationIndex(Coordinate, Coordinate, Coordinate)}
+ * function can be used to decide the relative orientation of the vectors.
+ * </ul>
+ */
+func (de *DirectedEdge[D]) CompareDirection(e *DirectedEdge[D]) int {
+	// if the rays are in different quadrants, determining the ordering is trivial
+	if de.quadrant > e.quadrant {
+		return 1
+	}
+	if de.quadrant < e.quadrant {
+		return -1
+	}
+	// vectors are in the same quadrant - check relative orientation of direction vectors
+	// this is > e if it is CCW of e
+	return algorithm.OrientationIndex(&e.p0, &e.p1, &de.p1)
+}
@@ -0,0 +1,33 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents an undirected edge of a {@link PlanarGraph},
+ * formed by a line between two nodes.
+ * The edge is traversed in each direction by a {@link DirectedEdge}.
+ */
+type Edge struct {
+	GraphComponent
+	line []geom.Coordinate
+}
+
+/**
+ * Constructs an Edge for a line.
+ *
+ * @param line the line of the edge
+ */
+func NewEdge(line []geom.Coordinate) *Edge {
+	edge := new(Edge)
+	edge.line = line
+	return edge
+}
+
+/**
+ * Gets the line of this edge.
+ */
+func (edge *Edge) GetLine() []geom.Coordinate {
+	return edge.line
+}
@@ -0,0 +1,57 @@
+package geos
+
+/**
+ * The base type for all graph component types.
+ * Maintains flags of use in generic graph algorithms.
+ * Provides two flags:
+ * <ul>
+ * <li><b>marked</b> - typically this is used to indicate a state that persists
+ * for the course of the graph's lifetime.  For instance, it can be
+ * used to indicate that a component has been logically deleted from the graph.
+ * <li><b>visited</b> - this is used to indicate that a component has been processed
+ * or visited by an single graph algorithm.  For instance, a breadth-first traversal of the
+ * graph might use this to indicate that a node has already been traversed.
+ * The visited flag may be set and cleared many times during the lifetime of a graph.
+ * </ul>
+ */
+type GraphComponent struct {
+	isMarked  bool
+	isVisited bool
+}
+
+/**
+ * Tests if a component has been visited during the course of a graph algorithm
+ *
+ * @return <code>true</code> if the component has been visited
+ */
+func (gc *GraphComponent) IsVisited() bool {
+	return gc.isVisited
+}
+
+/**
+ * Sets the visited flag for this component.
+ *
+ * @param isVisited the desired value of the visited flag
+ */
+func (gc *GraphComponent) SetVisited(isVisited bool) {
+	gc.isVisited = isVisited
+}
+
+/**
+ * Tests if a component has been marked at some point during the processing
+ * involving this graph.
+ *
+ * @return <code>true</code> if the component has been marked
+ */
+func (gc *GraphComponent) IsMarked() bool {
+	return gc.isMarked
+}
+
+/**
+ * Sets the marked flag for this component.
+ *
+ * @param isMarked the desired value of the marked flag
+ */
+func (gc *GraphComponent) SetMarked(isMarked bool) {
+	gc.isMarked = isMarked
+}
@@ -0,0 +1,69 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A node in a {@link PlanarGraph} is a location where 0 or more {@link Edge}s
+ * meet. A node is connected to each of its incident Edges via an outgoing
+ * DirectedEdge. The outgoing edges are kept in CCW order around the node.
+ */
+type Node[D any] struct {
+	GraphComponent
+	pt       geom.Coordinate
+	outEdges []*DirectedEdge[D]
+	isSorted bool
+}
+
+/**
+ * Constructs a Node with the given location.
+ *
+ * @param pt the location of the node
+ */
+func NewNode[D any](pt *geom.Coordinate) *Node[D] {
+	node := new(Node[D])
+	node.pt = *pt
+	node.outEdges = make([]*DirectedEdge[D], 0)
+	return node
+}
+
+/**
+ * Returns the location of this Node.
+ */
+func (node *Node[D]) GetCoordinate() *geom.Coordinate {
+	return &node.pt
+}
+
+/**
+ * Adds an outgoing DirectedEdge to this Node.
+ *
+ * @param de a DirectedEdge whose from node is this Node
+ */
+func (node *Node[D]) AddOutEdge(de *DirectedEdge[D]) {
+	node.outEdges = append(node.outEdges, de)
+	node.isSorted = false
+}
+
+/**
+ * Gets the outgoing DirectedEdges of the node,
+ * sorted in CCW order starting from the positive X axis.
+ */
+func (node *Node[D]) GetOutEdges() []*DirectedEdge[D] {
+	if !node.isSorted {
+		slices.SortStableFunc(node.outEdges, func(a *DirectedEdge[D], b *DirectedEdge[D]) int {
+			return a.CompareDirection(b)
+		})
+		node.isSorted = true
+	}
+	return node.outEdges
+}
+
+/**
+ * Returns the number of edges connected to this node.
+ */
+func (node *Node[D]) GetDegree() int {
+	return len(node.outEdges)
+}
@@ -0,0 +1,159 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents a directed graph which is embeddable in a planar surface,
+ * built from a set of lines.
+ * Each line forms an {@link Edge} between the {@link Node}s at its endpoints,
+ * which is traversed in each direction by a {@link DirectedEdge}.
+ * <p>
+ * The graph is a building block for algorithms which operate on
+ * the linework of a geometry, such as polygonization and line merging.
+ * The type parameter D is the type of the application data
+ * carried by each directed edge.
+ */
+type PlanarGraph[D any] struct {
+	nodeMap  map[[2]float64]*Node[D]
+	nodes    []*Node[D]
+	edges    []*Edge
+	dirEdges []*DirectedEdge[D]
+}
+
+/**
+ * Constructs an empty graph.
+ */
+func NewPlanarGraph[D any]() *PlanarGraph[D] {
+	graph := new(PlanarGraph[D])
+	graph.nodeMap = make(map[[2]float64]*Node[D])
+	graph.nodes = make([]*Node[D], 0)
+	graph.edges = make([]*Edge, 0)
+	graph.dirEdges = make([]*DirectedEdge[D], 0)
+	return graph
+}
+
+/**
+ * Adds a line to the graph as an Edge,
+ * with DirectedEdges in each direction and Nodes at its endpoints.
+ * Repeated points are removed from the line.
+ * Empty lines and lines which collapse to a point are ignored.
+ *
+ * @param line the line to add
+ * @return the DirectedEdge in the direction of the line, or nil if the line is ignored
+ */
+func (graph *PlanarGraph[D]) AddEdge(line []geom.Coordinate) *DirectedEdge[D] {
+	linePts := geom.RemoveRepeatedPoints(line)
+	if len(linePts) < 2 {
+		return nil
+	}
+
+	nStart := graph.getNode(&linePts[0])
+	nEnd := graph.getNode(&linePts[len(linePts)-1])
+
+	de0 := NewDirectedEdge(nStart, nEnd, &linePts[1], true)
+	de1 := NewDirectedEdge(nEnd, nStart, &linePts[len(linePts)-2], false)
+	edge := NewEdge(linePts)
+	de0.edge = edge
+	de1.edge = edge
+	de0.sym = de1
+	de1.sym = de0
+
+	nStart.AddOutEdge(de0)
+	nEnd.AddOutEdge(de1)
+	graph.edges = append(graph.edges, edge)
+	graph.dirEdges = append(graph.dirEdges, de0, de1)
+	return de0
+}
+
+func (graph *PlanarGraph[D]) getNode(pt *geom.Coordinate) *Node[D] {
+	key := [2]float64{pt.X, pt.Y}
+	node, ok := graph.nodeMap[key]
+	if !ok {
+		node = NewNode[D](pt)
+		graph.nodeMap[key] = node
+		graph.nodes = append(graph.nodes, node)
+	}
+	return node
+}
+
+/**
+ * Gets the Nodes of the graph, sorted in XY order.
+ */
+func (graph *PlanarGraph[D]) GetNodes() []*Node[D] {
+	PlanarGraphSortNodes(graph.nodes)
+	return graph.nodes
+}
+
+/**
+ * Gets the Edges of the graph, in the order in which they were added.
+ */
+func (graph *PlanarGraph[D]) GetEdges() []*Edge {
+	return graph.edges
+}
+
+/**
+ * Gets the DirectedEdges of the graph, in the order in which they were added.
+ */
+func (graph *PlanarGraph[D]) GetDirEdges() []*DirectedEdge[D] {
+	return graph.dirEdges
+}
+
+/**
+ * Sorts a list of nodes in XY order.
+ *
+ * @param nodes the nodes to sort
+ */
+func PlanarGraphSortNodes[D any](nodes []*Node[D]) {
+	slices.SortFunc(nodes, func(a *Node[D], b *Node[D]) int {
+		return a.pt.CompareTo(&b.pt)
+	})
+}
+
+/**
+ * Finds the connected components of the graph,
+ * each as the list of its nodes in XY order.
+ * The visited flags of the nodes are used and updated.
+ *
+ * @return the node lists of the connected subgraphs
+ */
+func (graph *PlanarGraph[D]) GetConnectedSubgraphs() [][]*Node[D] {
+	subgraphs := make([][]*Node[D], 0)
+	for _, node := range graph.GetNodes() {
+		node.SetVisited(false)
+	}
+	for _, node := range graph.nodes {
+		if node.IsVisited() {
+			continue
+		}
+		subgraph := planarGraphFindReachable(node)
+		PlanarGraphSortNodes(subgraph)
+		subgraphs = append(subgraphs, subgraph)
+	}
+	return subgraphs
+}
+
+/**
+ * Finds all nodes reachable from a node,
+ * using an explicit stack to avoid deep recursion.
+ */
+func planarGraphFindReachable[D any](startNode *Node[D]) []*Node[D] {
+	reachable := make([]*Node[D], 0)
+	startNode.SetVisited(true)
+	nodeStack := []*Node[D]{startNode}
+	for len(nodeStack) > 0 {
+		node := nodeStack[len(nodeStack)-1]
+		nodeStack = nodeStack[:len(nodeStack)-1]
+		reachable = append(reachable, node)
+		for _, de := range node.outEdges {
+			if !de.to.IsVisited() {
+				de.to.SetVisited(true)
+				nodeStack = append(nodeStack, de.to)
+			}
+		}
+	}
+	return reachable
+}
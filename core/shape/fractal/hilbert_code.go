@@ -0,0 +1,138 @@
+package geos
+
+import (
+	"math"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+)
+
+/**
+ * The number of points in the curve for the given level.
+ * The number of points is 2<sup>2 * level</sup>.
+ * The level must be in the range 0 - 16.
+ *
+ * @param level the level of the curve
+ * @return the number of points
+ */
+func HilbertCodeSize(level int) int {
+	return int(math.Pow(2, float64(2*level)))
+}
+
+/**
+ * The maximum ordinate value for points
+ * in the curve for the given level.
+ * The maximum ordinate is 2<sup>level</sup> - 1.
+ * The level must be in the range 0 - 16.
+ *
+ * @param level the level of the curve
+ * @return the maximum ordinate value
+ */
+func HilbertCodeMaxOrdinate(level int) int {
+	return int(math.Pow(2, float64(level))) - 1
+}
+
+/**
+ * Encodes a point (x,y)
+ * in the range of the Hilbert curve at a given level
+ * as the index of the point along the curve.
+ * The index will lie in the range [0, 2<sup>2 * level</sup> - 1].
+ * <p>
+ * The Hilbert curve at a level fills the square of side 2<sup>level</sup>,
+ * and orders the points in it so that points which are close along the curve
+ * are close in the plane.
+ * Codes are computed as 32-bit integers, so levels 1 to 16 are supported.
+ * <p>
+ * The algorithm used is based on the C code in
+ * <a href="https://github.com/rawrunprotected/hilbert_curves">rawrunprotected/hilbert_curves</a>.
+ *
+ * @param level the level of the Hilbert curve
+ * @param x the x ordinate of the point
+ * @param y the y ordinate of the point
+ * @return the index of the point along the Hilbert curve
+ */
+func HilbertCodeEncode(level int, x int, y int) int {
+	// Fast Hilbert curve algorithm by http://threadlocalmutex.com/
+	// Ported from C++ https://github.com/rawrunprotected/hilbert_curves (public
+	// domain)
+
+	lvl := hilbertCodeLevelClamp(level)
+
+	xs := uint32(x) << (16 - lvl)
+	ys := uint32(y) << (16 - lvl)
+
+	a := xs ^ ys
+	b := 0xFFFF ^ a
+	c := 0xFFFF ^ (xs | ys)
+	d := xs & (ys ^ 0xFFFF)
+
+	A := a | (b >> 1)
+	B := (a >> 1) ^ a
+	C := ((c >> 1) ^ (b & (d >> 1))) ^ c
+	D := ((a & (c >> 1)) ^ (d >> 1)) ^ d
+
+	a = A
+	b = B
+	c = C
+	d = D
+	A = (a & (a >> 2)) ^ (b & (b >> 2))
+	B = (a & (b >> 2)) ^ (b & ((a ^ b) >> 2))
+	C ^= (a & (c >> 2)) ^ (b & (d >> 2))
+	D ^= (b & (c >> 2)) ^ ((a ^ b) & (d >> 2))
+
+	a = A
+	b = B
+	c = C
+	d = D
+	A = (a & (a >> 4)) ^ (b & (b >> 4))
+	B = (a & (b >> 4)) ^ (b & ((a ^ b) >> 4))
+	C ^= (a & (c >> 4)) ^ (b & (d >> 4))
+	D ^= (b & (c >> 4)) ^ ((a ^ b) & (d >> 4))
+
+	a = A
+	b = B
+	c = C
+	d = D
+	C ^= (a & (c >> 8)) ^ (b & (d >> 8))
+	D ^= (b & (c >> 8)) ^ ((a ^ b) & (d >> 8))
+
+	// Undo transformation prefix scan
+	a = C ^ (C >> 1)
+	b = D ^ (D >> 1)
+
+	// Recover index bits
+	i0 := xs ^ ys
+	i1 := b | (0xFFFF ^ (i0 | a))
+
+	i0 = hilbertCodeInterleave(i0)
+	i1 = hilbertCodeInterleave(i1)
+
+	index := ((i1 << 1) | i0) >> (32 - 2*lvl)
+	return int(index)
+}
+
+/**
+ * Clamps a level to the range valid for
+ * the index algorithm used.
+ *
+ * @param level the level of a Hilbert curve
+ * @return a valid level
+ */
+func hilbertCodeLevelClamp(level int) int {
+	// clamp order to [1, 16]
+	lvl := level
+	if lvl < 1 {
+		lvl = 1
+	}
+	if lvl > constants.HILBERTCODE_MAX_LEVEL {
+		lvl = constants.HILBERTCODE_MAX_LEVEL
+	}
+	return lvl
+}
+
+func hilbertCodeInterleave(x uint32) uint32 {
+	x = (x | (x << 8)) & 0x00FF00FF
+	x = (x | (x << 4)) & 0x0F0F0F0F
+	x = (x | (x << 2)) & 0x33333333
+	x = (x | (x << 1)) & 0x55555555
+	return x
+}
@@ -0,0 +1,113 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Checks if simplifying (flattening) line sections or segments
+ * would cause them to "jump" over other components in the geometry.
+ */
+type componentJumpChecker struct {
+	components []*taggedLineString
+}
+
+func newComponentJumpChecker(taggedLines []*taggedLineString) *componentJumpChecker {
+	cjc := new(componentJumpChecker)
+	cjc.components = taggedLines
+	return cjc
+}
+
+/**
+ * Checks if a line section jumps a component if flattened.
+ *
+ * Assumes start <= end.
+ *
+ * @param line the line containing the section being flattened
+ * @param start start index of the section
+ * @param end end index of the section
+ * @param seg the flattening segment
+ * @return true if the flattened section jumps a component
+ */
+func (cjc *componentJumpChecker) hasJump(line *taggedLineString, start int, end int, seg *taggedLineSegment) bool {
+	sectionEnv := computeSectionEnvelope(line, start, end)
+	for _, comp := range cjc.components {
+		//-- don't test component against itself
+		if comp == line {
+			continue
+		}
+
+		compPt := comp.getComponentPoint()
+		if sectionEnv.IntersectsCoordinate(compPt) {
+			if hasJumpAtComponent(compPt, line, start, end, seg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * Checks if two consecutive segments jumps a component if flattened.
+ * The segments are assumed to be consecutive.
+ * (so the seg1.p1 = seg2.p0).
+ * The flattening segment must be the segment between seg1.p0 and seg2.p1.
+ *
+ * @param line the line containing the section being flattened
+ * @param seg1 the first replaced segment
+ * @param seg2 the next replaced segment
+ * @param seg the flattening segment
+ * @return true if the flattened segment jumps a component
+ */
+func (cjc *componentJumpChecker) hasJumpSegments(line *taggedLineString, seg1 *taggedLineSegment, seg2 *taggedLineSegment, seg *taggedLineSegment) bool {
+	sectionEnv := geom.NewEnvelopeFromCoordinates(&seg1.p0, &seg1.p1)
+	sectionEnv.ExpandToIncludeCoordinate(&seg2.p0)
+	sectionEnv.ExpandToIncludeCoordinate(&seg2.p1)
+	for _, comp := range cjc.components {
+		//-- don't test component against itself
+		if comp == line {
+			continue
+		}
+
+		compPt := comp.getComponentPoint()
+		if sectionEnv.IntersectsCoordinate(compPt) {
+			sectionCount := crossingCount(compPt, seg1, seg2)
+			segCount := crossingCount(compPt, seg)
+			if sectionCount%2 != segCount%2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * Tests if a component point is "jumped" by a section being flattened,
+ * by comparing the parity of the ray crossings of the section and the flattening segment.
+ */
+func hasJumpAtComponent(compPt *geom.Coordinate, line *taggedLineString, start int, end int, seg *taggedLineSegment) bool {
+	rcc := algorithm.NewRayCrossingCounter(compPt)
+	for i := start; i < end; i++ {
+		rcc.CountSegment(line.getCoordinate(i), line.getCoordinate(i+1))
+	}
+	sectionCount := rcc.GetCount()
+	segCount := crossingCount(compPt, seg)
+	return sectionCount%2 != segCount%2
+}
+
+func crossingCount(compPt *geom.Coordinate, segs ...*taggedLineSegment) int {
+	rcc := algorithm.NewRayCrossingCounter(compPt)
+	for _, seg := range segs {
+		rcc.CountSegment(&seg.p0, &seg.p1)
+	}
+	return rcc.GetCount()
+}
+
+func computeSectionEnvelope(line *taggedLineString, start int, end int) *geom.Envelope {
+	env := geom.DefaultEnvelope()
+	for i := start; i <= end; i++ {
+		env.ExpandToIncludeCoordinate(line.getCoordinate(i))
+	}
+	return env
+}
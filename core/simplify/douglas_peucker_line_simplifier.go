@@ -0,0 +1,130 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies a linestring (sequence of points) using
+ * the standard Douglas-Peucker algorithm.
+ * <p>
+ * If the input is a ring and endpoints are not preserved,
+ * the ring endpoint is also removed if it lies within tolerance
+ * of the segment joining its neighbours.
+ */
+type DouglasPeuckerLineSimplifier struct {
+	pts                []geom.Coordinate
+	usePt              []bool
+	distanceTolerance  float64
+	isPreserveEndpoint bool
+}
+
+/**
+ * Simplifies a sequence of points using the Douglas-Peucker algorithm.
+ *
+ * @param pts the points to simplify
+ * @param distanceTolerance the simplification distance tolerance
+ * @param isPreserveEndpoint true if the endpoint of a ring must be kept
+ * @return the simplified points
+ */
+func DouglasPeuckerLineSimplify(pts []geom.Coordinate, distanceTolerance float64, isPreserveEndpoint bool) []geom.Coordinate {
+	simp := NewDouglasPeuckerLineSimplifier(pts)
+	simp.SetDistanceTolerance(distanceTolerance)
+	simp.SetPreserveEndpoint(isPreserveEndpoint)
+	return simp.Simplify()
+}
+
+func NewDouglasPeuckerLineSimplifier(pts []geom.Coordinate) *DouglasPeuckerLineSimplifier {
+	dpls := new(DouglasPeuckerLineSimplifier)
+	dpls.pts = pts
+	dpls.isPreserveEndpoint = false
+	return dpls
+}
+
+/**
+ * Sets the distance tolerance for the simplification.
+ * All vertices in the simplified linestring will be within this
+ * distance of the original linestring.
+ *
+ * @param distanceTolerance the approximation tolerance to use
+ */
+func (dpls *DouglasPeuckerLineSimplifier) SetDistanceTolerance(distanceTolerance float64) {
+	dpls.distanceTolerance = distanceTolerance
+}
+
+/**
+ * Sets whether the endpoint of a ring is preserved.
+ *
+ * @param isPreserveEndpoint true if a ring endpoint must be kept
+ */
+func (dpls *DouglasPeuckerLineSimplifier) SetPreserveEndpoint(isPreserveEndpoint bool) {
+	dpls.isPreserveEndpoint = isPreserveEndpoint
+}
+
+/**
+ * Computes the simplified points.
+ *
+ * @return the simplified points
+ */
+func (dpls *DouglasPeuckerLineSimplifier) Simplify() []geom.Coordinate {
+	if len(dpls.pts) == 0 {
+		return []geom.Coordinate{}
+	}
+	dpls.usePt = make([]bool, len(dpls.pts))
+	for i := range dpls.pts {
+		dpls.usePt[i] = true
+	}
+	dpls.simplifySection(0, len(dpls.pts)-1)
+
+	coordList := geom.DefaultCoordinateList()
+	for i := range dpls.pts {
+		if dpls.usePt[i] {
+			coordList.AddCoordinateRepeated(dpls.pts[i].Clone(), true)
+		}
+	}
+
+	if !dpls.isPreserveEndpoint && geom.IsRing(dpls.pts) {
+		dpls.simplifyRingEndpoint(coordList)
+	}
+	return coordList.ToCoordinateArray()
+}
+
+func (dpls *DouglasPeuckerLineSimplifier) simplifyRingEndpoint(coordList *geom.CoordinateList) {
+	pts := coordList.Coordinates
+	//-- avoid collapsing triangles
+	if len(pts) < 4 {
+		return
+	}
+	//-- base segment for endpoint
+	distance := algorithm.DistancePointToSegment(&pts[0], &pts[1], &pts[len(pts)-2])
+	if distance <= dpls.distanceTolerance {
+		coordList.Coordinates = pts[1 : len(pts)-1]
+		coordList.CloseRing()
+	}
+}
+
+func (dpls *DouglasPeuckerLineSimplifier) simplifySection(i int, j int) {
+	if (i + 1) >= j {
+		return
+	}
+	p0 := &dpls.pts[i]
+	p1 := &dpls.pts[j]
+	maxDistance := -1.0
+	maxIndex := i
+	for k := i + 1; k < j; k++ {
+		distance := algorithm.DistancePointToSegment(&dpls.pts[k], p0, p1)
+		if distance > maxDistance {
+			maxDistance = distance
+			maxIndex = k
+		}
+	}
+	if maxDistance <= dpls.distanceTolerance {
+		for k := i + 1; k < j; k++ {
+			dpls.usePt[k] = false
+		}
+	} else {
+		dpls.simplifySection(i, maxIndex)
+		dpls.simplifySection(maxIndex, j)
+	}
+}
@@ -0,0 +1,128 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies lines, rings and polygons using the Douglas-Peucker algorithm.
+ * Ensures that any polygonal rings have the correct minimum
+ * number of vertices; rings which collapse are removed.
+ * Lines keep their endpoints, and are never reduced below
+ * two vertices.
+ * <p>
+ * Note that in general D-P does not preserve topology -
+ * e.g. polygons can be split, collapse to lines or disappear
+ * holes can be created or disappear,
+ * and lines can cross.
+ * The rings of a simplified polygon are not repaired,
+ * so the result may be invalid.
+ * To simplify geometry while preserving topology use {@link TopologyPreservingSimplifier}.
+ * (However, using D-P is significantly faster).
+ * <p>
+ * Polygons are given as a list of rings, with the shell first,
+ * followed by any holes.
+ */
+type DouglasPeuckerSimplifier struct {
+	distanceTolerance float64
+}
+
+/**
+ * Simplifies a line using a given tolerance.
+ *
+ * @param line the line to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return a simplified version of the line
+ */
+func DouglasPeuckerSimplifyLine(line []geom.Coordinate, distanceTolerance float64) []geom.Coordinate {
+	return NewDouglasPeuckerSimplifier(distanceTolerance).SimplifyLine(line)
+}
+
+/**
+ * Simplifies a polygon using a given tolerance.
+ *
+ * @param polygon the shell and hole rings of the polygon to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return the simplified rings, or nil if the shell collapses
+ */
+func DouglasPeuckerSimplifyPolygon(polygon [][]geom.Coordinate, distanceTolerance float64) [][]geom.Coordinate {
+	return NewDouglasPeuckerSimplifier(distanceTolerance).SimplifyPolygon(polygon)
+}
+
+/**
+ * Simplifies the coordinates of a {@link CoordinateList} using a given tolerance.
+ * If the list forms a ring, the ring endpoint may be removed as well.
+ *
+ * @param coordinateList the list of coordinates to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return a new list containing the simplified coordinates
+ */
+func DouglasPeuckerSimplifyCoordinateList(coordinateList *geom.CoordinateList, distanceTolerance float64) *geom.CoordinateList {
+	pts := coordinateList.ToCoordinateArray()
+	simpPts := DouglasPeuckerLineSimplify(pts, distanceTolerance, !geom.IsRing(pts))
+	return geom.NewCoordinateList(simpPts)
+}
+
+/**
+ * Creates a simplifier with a given distance tolerance.
+ * The tolerance must be non-negative.
+ *
+ * @param distanceTolerance the approximation tolerance to use
+ */
+func NewDouglasPeuckerSimplifier(distanceTolerance float64) *DouglasPeuckerSimplifier {
+	dps := new(DouglasPeuckerSimplifier)
+	dps.distanceTolerance = distanceTolerance
+	return dps
+}
+
+/**
+ * Simplifies a line.
+ * The endpoints of the line are preserved.
+ *
+ * @param line the line to simplify
+ * @return the simplified line
+ */
+func (dps *DouglasPeuckerSimplifier) SimplifyLine(line []geom.Coordinate) []geom.Coordinate {
+	return DouglasPeuckerLineSimplify(line, dps.distanceTolerance, true)
+}
+
+/**
+ * Simplifies a ring.
+ * The ring endpoint may be removed if it lies within tolerance
+ * of the segment joining its neighbours.
+ *
+ * @param ring the ring to simplify
+ * @return the simplified ring, or nil if the ring collapses
+ */
+func (dps *DouglasPeuckerSimplifier) SimplifyRing(ring []geom.Coordinate) []geom.Coordinate {
+	simpRing := DouglasPeuckerLineSimplify(ring, dps.distanceTolerance, false)
+	if len(simpRing) < 4 {
+		return nil
+	}
+	return simpRing
+}
+
+/**
+ * Simplifies a polygon.
+ * Holes which collapse are removed.
+ *
+ * @param polygon the shell and hole rings of the polygon
+ * @return the simplified rings, or nil if the polygon is empty or the shell collapses
+ */
+func (dps *DouglasPeuckerSimplifier) SimplifyPolygon(polygon [][]geom.Coordinate) [][]geom.Coordinate {
+	if len(polygon) == 0 {
+		return nil
+	}
+	shell := dps.SimplifyRing(polygon[0])
+	if shell == nil {
+		return nil
+	}
+	rings := [][]geom.Coordinate{shell}
+	for _, hole := range polygon[1:] {
+		simpHole := dps.SimplifyRing(hole)
+		if simpHole != nil {
+			rings = append(rings, simpHole)
+		}
+	}
+	return rings
+}
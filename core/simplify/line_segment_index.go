@@ -0,0 +1,46 @@
+package geos
+
+import (
+	quadtree "github.com/UltimateThread/geos-go/core/index/quadtree"
+)
+
+/**
+ * An index of line segments, supporting queries for
+ * the segments whose envelopes intersect the envelope of a query segment.
+ */
+type lineSegmentIndex struct {
+	index *quadtree.Quadtree[*taggedLineSegment]
+}
+
+func newLineSegmentIndex() *lineSegmentIndex {
+	index := new(lineSegmentIndex)
+	index.index = quadtree.NewQuadtree[*taggedLineSegment]()
+	return index
+}
+
+func (index *lineSegmentIndex) addLine(line *taggedLineString) {
+	for _, seg := range line.segs {
+		index.add(seg)
+	}
+}
+
+func (index *lineSegmentIndex) add(seg *taggedLineSegment) {
+	index.index.Insert(seg.getEnvelope(), seg)
+}
+
+func (index *lineSegmentIndex) remove(seg *taggedLineSegment) {
+	index.index.Remove(seg.getEnvelope(), seg)
+}
+
+func (index *lineSegmentIndex) query(querySeg *taggedLineSegment) []*taggedLineSegment {
+	env := querySeg.getEnvelope()
+	result := make([]*taggedLineSegment, 0)
+	index.index.QueryVisitor(env, func(seg *taggedLineSegment) bool {
+		// the quadtree is only a primary filter, so check the segment envelope
+		if seg.getEnvelope().IntersectsEnvelope(env) {
+			result = append(result, seg)
+		}
+		return true
+	})
+	return result
+}
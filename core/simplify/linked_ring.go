@@ -0,0 +1,114 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const linkedRing_NO_COORD_INDEX = -1
+
+/**
+ * A ring of vertices which are linked in both directions,
+ * allowing vertices to be removed efficiently.
+ * The vertices are identified by their index in the original ring.
+ */
+type linkedRing struct {
+	coord []geom.Coordinate
+	next  []int
+	prev  []int
+	size  int
+}
+
+/**
+ * Creates a linked ring over the vertices of a closed ring.
+ *
+ * @param pts the coordinates of the ring (with the first point repeated at the end)
+ */
+func newLinkedRing(pts []geom.Coordinate) *linkedRing {
+	lr := new(linkedRing)
+	lr.coord = pts
+	lr.size = max(len(pts)-1, 0)
+	lr.next = linkedRingCreateNextLinks(lr.size)
+	lr.prev = linkedRingCreatePrevLinks(lr.size)
+	return lr
+}
+
+func linkedRingCreateNextLinks(size int) []int {
+	next := make([]int, size)
+	for i := 0; i < size; i++ {
+		next[i] = i + 1
+	}
+	if size > 0 {
+		next[size-1] = 0
+	}
+	return next
+}
+
+func linkedRingCreatePrevLinks(size int) []int {
+	prev := make([]int, size)
+	for i := 0; i < size; i++ {
+		prev[i] = i - 1
+	}
+	if size > 0 {
+		prev[0] = size - 1
+	}
+	return prev
+}
+
+func (lr *linkedRing) Size() int {
+	return lr.size
+}
+
+func (lr *linkedRing) Next(i int) int {
+	return lr.next[i]
+}
+
+func (lr *linkedRing) Prev(i int) int {
+	return lr.prev[i]
+}
+
+func (lr *linkedRing) GetCoordinate(index int) *geom.Coordinate {
+	return &lr.coord[index]
+}
+
+func (lr *linkedRing) PrevCoordinate(index int) *geom.Coordinate {
+	return &lr.coord[lr.Prev(index)]
+}
+
+func (lr *linkedRing) NextCoordinate(index int) *geom.Coordinate {
+	return &lr.coord[lr.Next(index)]
+}
+
+/**
+ * Tests whether a vertex is still present in the ring.
+ */
+func (lr *linkedRing) HasCoordinate(index int) bool {
+	return index >= 0 && index < len(lr.prev) && lr.prev[index] != linkedRing_NO_COORD_INDEX
+}
+
+/**
+ * Removes a vertex from the ring, linking its neighbours.
+ */
+func (lr *linkedRing) Remove(index int) {
+	iprev := lr.prev[index]
+	inext := lr.next[index]
+	lr.next[iprev] = inext
+	lr.prev[inext] = iprev
+	lr.prev[index] = linkedRing_NO_COORD_INDEX
+	lr.next[index] = linkedRing_NO_COORD_INDEX
+	lr.size--
+}
+
+/**
+ * Gets the coordinates of the vertices remaining in the ring,
+ * in their original order, as a closed ring.
+ */
+func (lr *linkedRing) GetCoordinates() []geom.Coordinate {
+	coords := geom.DefaultCoordinateList()
+	for i := 0; i < len(lr.coord)-1; i++ {
+		if lr.prev[i] != linkedRing_NO_COORD_INDEX {
+			coords.AddCoordinateRepeated(&lr.coord[i], false)
+		}
+	}
+	coords.CloseRing()
+	return coords.ToCoordinateArray()
+}
@@ -0,0 +1,278 @@
+package geos
+
+import (
+	"math"
+	"slices"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Computes topology-preserving simplified hulls of polygonal geometry.
+ * Both outer and inner hulls can be computed.
+ * Outer hulls contain the input geometry and are larger in area.
+ * Inner hulls are contained by the input geometry and are smaller in area.
+ * In both the hull vertices are a subset of the input vertices.
+ * The hull construction attempts to minimize the area difference
+ * with the input geometry.
+ * Hulls are generally concave if the input is.
+ * Computed hulls are topology-preserving:
+ * they do not contain any self-intersections or overlaps,
+ * so the result polygonal geometry is valid.
+ * <p>
+ * Polygons with holes and multipolygons are supported.
+ * The result has the same number of polygons and holes as the input.
+ * <p>
+ * The number of vertices in the computed hull is determined by a target parameter.
+ * Two parameters are supported:
+ * <ul>
+ * <li><b>Vertex Number fraction</b>: the fraction of the input vertices retained in the result.
+ * Value 1 produces the original geometry.
+ * Smaller values produce less concave results.
+ * For outer hulls, value 0 produces the convex hull (with triangles for any holes).
+ * For inner hulls, value 0 produces a triangle (if no holes are present).
+ * <li><b>Area Delta ratio</b>: the ratio of the change in area to the input area.
+ * Value 0 produces the original geometry.
+ * Larger values produce less concave results.
+ * </ul>
+ * The algorithm ensures that the result does not cause the target parameter
+ * to be exceeded.  This allows computing outer or inner hulls
+ * with a small area delta ratio as an effective way of removing
+ * narrow gores and spikes.
+ * <p>
+ * Polygons are given as a list of rings, with the shell first,
+ * followed by any holes.
+ * The result rings are oriented with shells clockwise
+ * and holes counter-clockwise.
+ */
+type PolygonHullSimplifier struct {
+	inputPolygons     [][][]geom.Coordinate
+	isOuter           bool
+	vertexNumFraction float64
+	areaDeltaRatio    float64
+}
+
+/**
+ * Computes a topology-preserving simplified hull of polygonal geometry,
+ * with hull shape determined by a target parameter
+ * specifying the fraction of the input vertices retained in the result.
+ * Smaller values compute less concave results.
+ * A value of 1 produces the original geometry;
+ * for outer hulls a value of 0 produces the convex hull.
+ * Either outer or inner hulls can be computed.
+ *
+ * @param polygons the polygons to process
+ * @param isOuter indicates whether to compute an outer or inner hull
+ * @param vertexNumFraction the target fraction of number of input vertices in result
+ * @return the hull polygons
+ */
+func PolygonHullSimplifierHull(polygons [][][]geom.Coordinate, isOuter bool, vertexNumFraction float64) [][][]geom.Coordinate {
+	hull := NewPolygonHullSimplifier(polygons, isOuter)
+	hull.SetVertexNumFraction(math.Abs(vertexNumFraction))
+	return hull.GetResult()
+}
+
+/**
+ * Computes a topology-preserving simplified hull of polygonal geometry,
+ * with hull shape determined by a target parameter
+ * specifying the ratio of maximum difference in area to original area.
+ * Larger values compute less concave results.
+ * A value of 0 produces the original geometry.
+ * Either outer or inner hulls can be computed.
+ *
+ * @param polygons the polygons to process
+ * @param isOuter indicates whether to compute an outer or inner hull
+ * @param areaDeltaRatio the target ratio of area difference to original area
+ * @return the hull polygons
+ */
+func PolygonHullSimplifierHullByAreaDelta(polygons [][][]geom.Coordinate, isOuter bool, areaDeltaRatio float64) [][][]geom.Coordinate {
+	hull := NewPolygonHullSimplifier(polygons, isOuter)
+	hull.SetAreaDeltaRatio(math.Abs(areaDeltaRatio))
+	return hull.GetResult()
+}
+
+/**
+ * Creates a new instance
+ * to compute a simplified hull of polygonal geometry.
+ * An outer or inner hull is computed
+ * depending on the value of <code>isOuter</code>.
+ *
+ * @param polygons the polygons to process
+ * @param isOuter indicates whether to compute an outer or inner hull
+ */
+func NewPolygonHullSimplifier(polygons [][][]geom.Coordinate, isOuter bool) *PolygonHullSimplifier {
+	hull := new(PolygonHullSimplifier)
+	hull.inputPolygons = polygons
+	hull.isOuter = isOuter
+	hull.vertexNumFraction = -1
+	hull.areaDeltaRatio = -1
+	return hull
+}
+
+/**
+ * Sets the target fraction of input vertices
+ * which are retained in the result.
+ * The value should be in the range [0,1].
+ *
+ * @param vertexNumFraction a fraction of the number of input vertices
+ */
+func (hull *PolygonHullSimplifier) SetVertexNumFraction(vertexNumFraction float64) {
+	hull.vertexNumFraction = min(max(vertexNumFraction, 0), 1)
+}
+
+/**
+ * Sets the target maximum ratio of the change in area of the result to the input area.
+ * The value must be 0 or greater.
+ *
+ * @param areaDeltaRatio a ratio of the change in area of the result
+ */
+func (hull *PolygonHullSimplifier) SetAreaDeltaRatio(areaDeltaRatio float64) {
+	hull.areaDeltaRatio = areaDeltaRatio
+}
+
+/**
+ * Gets the result polygonal hull geometry.
+ *
+ * @return the polygonal geometry for the hull
+ */
+func (hull *PolygonHullSimplifier) GetResult() [][][]geom.Coordinate {
+	//-- handle trivial parameter values
+	if hull.vertexNumFraction == 1 || hull.areaDeltaRatio == 0 {
+		return polygonHullSimplifierCopy(hull.inputPolygons)
+	}
+
+	/**
+	 * Only outer hulls where there is more than one polygon
+	 * can potentially overlap.
+	 * Shell outer hulls could overlap adjacent shell hulls
+	 * or hole hulls surrounding them;
+	 * hole outer hulls could overlap contained shell hulls.
+	 */
+	isOverlapPossible := hull.isOuter && len(hull.inputPolygons) > 1
+	if isOverlapPossible {
+		return hull.computeMultiPolygonAll(hull.inputPolygons)
+	}
+	return hull.computeMultiPolygonEach(hull.inputPolygons)
+}
+
+/**
+ * Computes hulls for all polygons together,
+ * checking corners against the rings of every polygon.
+ */
+func (hull *PolygonHullSimplifier) computeMultiPolygonAll(polygons [][][]geom.Coordinate) [][][]geom.Coordinate {
+	hullIndex := newRingHullIndex()
+
+	//-- prepare element polygon hulls and index
+	polyHulls := make([][]*ringHull, len(polygons))
+	for i, poly := range polygons {
+		polyHulls[i] = hull.initPolygon(poly, hullIndex)
+	}
+
+	//-- compute hull polygons
+	result := make([][][]geom.Coordinate, len(polygons))
+	for i, poly := range polygons {
+		result[i] = hull.polygonHull(poly, polyHulls[i], hullIndex)
+	}
+	return result
+}
+
+func (hull *PolygonHullSimplifier) computeMultiPolygonEach(polygons [][][]geom.Coordinate) [][][]geom.Coordinate {
+	result := make([][][]geom.Coordinate, len(polygons))
+	for i, poly := range polygons {
+		result[i] = hull.computePolygon(poly)
+	}
+	return result
+}
+
+func (hull *PolygonHullSimplifier) computePolygon(poly [][]geom.Coordinate) [][]geom.Coordinate {
+	/**
+	 * For a single polygon overlaps are only possible for inner hulls
+	 * and where holes are present.
+	 */
+	var hullIndex *ringHullIndex
+	isOverlapPossible := !hull.isOuter && len(poly) > 1
+	if isOverlapPossible {
+		hullIndex = newRingHullIndex()
+	}
+	hulls := hull.initPolygon(poly, hullIndex)
+	return hull.polygonHull(poly, hulls, hullIndex)
+}
+
+/**
+ * Create all ring hulls for the rings of a polygon,
+ * so that all are in the hull index if required.
+ */
+func (hull *PolygonHullSimplifier) initPolygon(poly [][]geom.Coordinate, hullIndex *ringHullIndex) []*ringHull {
+	hulls := make([]*ringHull, 0, len(poly))
+	if len(poly) == 0 {
+		return hulls
+	}
+
+	areaTotal := 0.0
+	if hull.areaDeltaRatio >= 0 {
+		areaTotal = polygonHullSimplifierRingArea(poly)
+	}
+	hulls = append(hulls, hull.createRingHull(poly[0], hull.isOuter, areaTotal, hullIndex))
+	for _, hole := range poly[1:] {
+		hulls = append(hulls, hull.createRingHull(hole, !hull.isOuter, areaTotal, hullIndex))
+	}
+	return hulls
+}
+
+func polygonHullSimplifierRingArea(poly [][]geom.Coordinate) float64 {
+	area := 0.0
+	for _, ring := range poly {
+		area += algorithm.AreaOfRing(ring)
+	}
+	return area
+}
+
+func (hull *PolygonHullSimplifier) createRingHull(ring []geom.Coordinate, isOuter bool, areaTotal float64, hullIndex *ringHullIndex) *ringHull {
+	ringHull := newRingHull(ring, isOuter)
+	if hull.vertexNumFraction >= 0 {
+		targetVertexCount := int(math.Ceil(hull.vertexNumFraction * float64(len(ring)-1)))
+		ringHull.SetMinVertexNum(targetVertexCount)
+	} else if hull.areaDeltaRatio >= 0 {
+		ringArea := algorithm.AreaOfRing(ring)
+		ringWeight := ringArea / areaTotal
+		maxAreaDelta := ringWeight * hull.areaDeltaRatio * ringArea
+		ringHull.SetMaxAreaDelta(maxAreaDelta)
+	}
+	if hullIndex != nil {
+		hullIndex.Add(ringHull)
+	}
+	return ringHull
+}
+
+func (hull *PolygonHullSimplifier) polygonHull(poly [][]geom.Coordinate, ringHulls []*ringHull, hullIndex *ringHullIndex) [][]geom.Coordinate {
+	if len(poly) == 0 {
+		return [][]geom.Coordinate{}
+	}
+	result := make([][]geom.Coordinate, len(ringHulls))
+	for i, ringHull := range ringHulls {
+		result[i] = polygonHullSimplifierOrient(ringHull.GetHull(hullIndex), i == 0)
+	}
+	return result
+}
+
+/**
+ * Orients a ring in place, with shells clockwise and holes counter-clockwise.
+ */
+func polygonHullSimplifierOrient(ring []geom.Coordinate, isShell bool) []geom.Coordinate {
+	if len(ring) > 3 && isShell == algorithm.OrientationIsCCW(ring) {
+		geom.ReverseCoordinates(ring)
+	}
+	return ring
+}
+
+func polygonHullSimplifierCopy(polygons [][][]geom.Coordinate) [][][]geom.Coordinate {
+	result := make([][][]geom.Coordinate, len(polygons))
+	for i, poly := range polygons {
+		result[i] = make([][]geom.Coordinate, len(poly))
+		for j, ring := range poly {
+			result[i][j] = polygonHullSimplifierOrient(slices.Clone(ring), j == 0)
+		}
+	}
+	return result
+}
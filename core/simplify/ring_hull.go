@@ -0,0 +1,343 @@
+package geos
+
+import (
+	"container/heap"
+	"slices"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadtree "github.com/UltimateThread/geos-go/core/index/quadtree"
+)
+
+/**
+ * Computes the outer or inner hull of a ring.
+ * The hull is formed by removing the apexes of concave (or flat) corners
+ * in order of increasing area, as long as the corner triangle
+ * does not contain any other vertices of the ring (or of other rings
+ * in a {@link ringHullIndex}).
+ * This ensures that the hull does not self-intersect,
+ * and does not cross the hulls of other rings.
+ */
+type ringHull struct {
+	envelope        *geom.Envelope
+	targetVertexNum int
+	targetAreaDelta float64
+
+	/**
+	 * The ring vertices are oriented so that the removable corners
+	 * are those which are not in CW orientation.
+	 */
+	vertexRing *linkedRing
+	areaDelta  float64
+
+	/**
+	 * Indexing vertices improves corner intersection testing performance.
+	 */
+	vertexIndex *quadtree.Quadtree[int]
+
+	cornerQueue *ringHullCornerQueue
+}
+
+/**
+ * Creates a new instance.
+ *
+ * @param ring the ring vertices to process
+ * @param isOuter whether the hull is outer or inner
+ */
+func newRingHull(ring []geom.Coordinate, isOuter bool) *ringHull {
+	rh := new(ringHull)
+	rh.envelope = geom.NewEnvelopeFromCoordinateArray(ring)
+	rh.targetVertexNum = -1
+	rh.targetAreaDelta = -1
+	rh.init(ring, isOuter)
+	return rh
+}
+
+func (rh *ringHull) SetMinVertexNum(minVertexNum int) {
+	rh.targetVertexNum = minVertexNum
+}
+
+func (rh *ringHull) SetMaxAreaDelta(maxAreaDelta float64) {
+	rh.targetAreaDelta = maxAreaDelta
+}
+
+func (rh *ringHull) GetEnvelope() *geom.Envelope {
+	return rh.envelope
+}
+
+func (rh *ringHull) init(ring []geom.Coordinate, isOuter bool) {
+	/**
+	 * Ensure ring is oriented according to outer/inner:
+	 * - outer: CW
+	 * - inner: CCW
+	 */
+	if isOuter == algorithm.OrientationIsCCW(ring) {
+		ring = slices.Clone(ring)
+		geom.ReverseCoordinates(ring)
+	}
+
+	rh.vertexRing = newLinkedRing(ring)
+	rh.vertexIndex = quadtree.NewQuadtree[int]()
+	for i := 0; i < rh.vertexRing.Size(); i++ {
+		rh.vertexIndex.Insert(geom.NewEnvelopeFromCoordinate(&ring[i]), i)
+	}
+
+	rh.cornerQueue = &ringHullCornerQueue{}
+	for i := 0; i < rh.vertexRing.Size(); i++ {
+		rh.addCorner(i)
+	}
+}
+
+func (rh *ringHull) addCorner(i int) {
+	//-- convex corners are left untouched
+	if ringHullIsConvex(rh.vertexRing, i) {
+		return
+	}
+	//-- corner is concave or flat - both can be removed
+	corner := ringHullCorner{
+		index: i,
+		prev:  rh.vertexRing.Prev(i),
+		next:  rh.vertexRing.Next(i),
+		area:  ringHullArea(rh.vertexRing, i),
+	}
+	heap.Push(rh.cornerQueue, corner)
+}
+
+func ringHullIsConvex(vertexRing *linkedRing, index int) bool {
+	pp := vertexRing.PrevCoordinate(index)
+	p := vertexRing.GetCoordinate(index)
+	pn := vertexRing.NextCoordinate(index)
+	return constants.ORIENTATION_CLOCKWISE == algorithm.OrientationIndex(pp, p, pn)
+}
+
+func ringHullArea(vertexRing *linkedRing, index int) float64 {
+	pp := vertexRing.PrevCoordinate(index)
+	p := vertexRing.GetCoordinate(index)
+	pn := vertexRing.NextCoordinate(index)
+	return triangleArea(pp, p, pn)
+}
+
+/**
+ * Removes corners until the target is reached,
+ * or no more corners can be removed.
+ *
+ * @param hullIndex the index of other ring hulls to check, or nil
+ */
+func (rh *ringHull) compute(hullIndex *ringHullIndex) {
+	for rh.cornerQueue.Len() > 0 && rh.vertexRing.Size() > 3 {
+		corner := heap.Pop(rh.cornerQueue).(ringHullCorner)
+		//-- a corner may no longer be valid due to removal of adjacent corners
+		if corner.isRemoved(rh.vertexRing) {
+			continue
+		}
+		if rh.isAtTarget(corner) {
+			return
+		}
+		/**
+		 * Corner is concave or flat - remove it if possible.
+		 */
+		if rh.isRemovable(corner, hullIndex) {
+			rh.removeCorner(corner)
+		}
+	}
+}
+
+func (rh *ringHull) isAtTarget(corner ringHullCorner) bool {
+	if rh.targetVertexNum >= 0 {
+		return rh.vertexRing.Size() <= rh.targetVertexNum
+	}
+	if rh.targetAreaDelta >= 0 {
+		//-- include candidate corner to avoid overshooting target
+		// (important for very small target area deltas)
+		return rh.areaDelta+corner.area > rh.targetAreaDelta
+	}
+	//-- no target set
+	return true
+}
+
+/**
+ * Removes a corner by removing the apex vertex from the ring.
+ * Two new corners are created with apexes
+ * at the other vertices of the corner
+ * (if they are non-convex and thus removable).
+ */
+func (rh *ringHull) removeCorner(corner ringHullCorner) {
+	index := corner.index
+	prev := rh.vertexRing.Prev(index)
+	next := rh.vertexRing.Next(index)
+	rh.vertexRing.Remove(index)
+	rh.vertexIndex.Remove(geom.NewEnvelopeFromCoordinate(rh.vertexRing.GetCoordinate(index)), index)
+	rh.areaDelta += corner.area
+
+	//-- potentially add the new corners created
+	rh.addCorner(prev)
+	rh.addCorner(next)
+}
+
+func (rh *ringHull) isRemovable(corner ringHullCorner, hullIndex *ringHullIndex) bool {
+	cornerEnv := corner.envelope(rh.vertexRing)
+	if rh.hasIntersectingVertex(corner, cornerEnv, rh) {
+		return false
+	}
+	//-- no other rings to check
+	if hullIndex == nil {
+		return true
+	}
+	//-- check other rings for intersections
+	for _, hull := range hullIndex.Query(cornerEnv) {
+		//-- this hull was already checked above
+		if hull == rh {
+			continue
+		}
+		if rh.hasIntersectingVertex(corner, cornerEnv, hull) {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+ * Tests if any vertices in a hull intersect the corner triangle.
+ * Uses the vertex spatial index for efficiency.
+ */
+func (rh *ringHull) hasIntersectingVertex(corner ringHullCorner, cornerEnv *geom.Envelope, hull *ringHull) bool {
+	for _, index := range hull.vertexIndex.Query(cornerEnv) {
+		//-- skip vertices of corner
+		if hull == rh && corner.isVertex(index) {
+			continue
+		}
+		v := hull.vertexRing.GetCoordinate(index)
+		//-- the query may return items which do not match the envelope
+		if !cornerEnv.IntersectsCoordinate(v) {
+			continue
+		}
+		//--- does corner triangle contain vertex?
+		if corner.intersects(v, rh.vertexRing) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Computes the hull of the ring.
+ *
+ * @param hullIndex the index of other ring hulls to check, or nil
+ * @return the hull ring
+ */
+func (rh *ringHull) GetHull(hullIndex *ringHullIndex) []geom.Coordinate {
+	rh.compute(hullIndex)
+	return rh.vertexRing.GetCoordinates()
+}
+
+/**
+ * A removable corner of a ring hull, recording the vertices
+ * adjacent to its apex when it was created.
+ */
+type ringHullCorner struct {
+	index int
+	prev  int
+	next  int
+	area  float64
+}
+
+func (c ringHullCorner) isVertex(index int) bool {
+	return index == c.index || index == c.prev || index == c.next
+}
+
+func (c ringHullCorner) envelope(ring *linkedRing) *geom.Envelope {
+	env := geom.NewEnvelopeFromCoordinates(ring.GetCoordinate(c.prev), ring.GetCoordinate(c.next))
+	env.ExpandToIncludeCoordinate(ring.GetCoordinate(c.index))
+	return env
+}
+
+func (c ringHullCorner) intersects(v *geom.Coordinate, ring *linkedRing) bool {
+	pp := ring.GetCoordinate(c.prev)
+	p := ring.GetCoordinate(c.index)
+	pn := ring.GetCoordinate(c.next)
+	return ringHullTriangleIntersects(pp, p, pn, v)
+}
+
+/**
+ * Tests whether the corner is stale, because a vertex
+ * adjacent to its apex (or the apex itself) has been removed.
+ */
+func (c ringHullCorner) isRemoved(ring *linkedRing) bool {
+	return ring.Prev(c.index) != c.prev || ring.Next(c.index) != c.next
+}
+
+/**
+ * Tests whether a triangle intersects a point.
+ */
+func ringHullTriangleIntersects(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate, p *geom.Coordinate) bool {
+	exteriorIndex := constants.ORIENTATION_COUNTERCLOCKWISE
+	if algorithm.OrientationIndex(a, b, c) == constants.ORIENTATION_COUNTERCLOCKWISE {
+		exteriorIndex = constants.ORIENTATION_CLOCKWISE
+	}
+	if exteriorIndex == algorithm.OrientationIndex(a, b, p) {
+		return false
+	}
+	if exteriorIndex == algorithm.OrientationIndex(b, c, p) {
+		return false
+	}
+	if exteriorIndex == algorithm.OrientationIndex(c, a, p) {
+		return false
+	}
+	return true
+}
+
+/**
+ * A priority queue of corners, ordered by increasing area.
+ */
+type ringHullCornerQueue []ringHullCorner
+
+func (q ringHullCornerQueue) Len() int {
+	return len(q)
+}
+
+func (q ringHullCornerQueue) Less(i, j int) bool {
+	return q[i].area < q[j].area
+}
+
+func (q ringHullCornerQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *ringHullCornerQueue) Push(x any) {
+	*q = append(*q, x.(ringHullCorner))
+}
+
+func (q *ringHullCornerQueue) Pop() any {
+	old := *q
+	n := len(old)
+	corner := old[n-1]
+	*q = old[:n-1]
+	return corner
+}
+
+/**
+ * An index of the ring hulls being computed together,
+ * so that the hulls do not cross each other.
+ */
+type ringHullIndex struct {
+	hulls []*ringHull
+}
+
+func newRingHullIndex() *ringHullIndex {
+	return new(ringHullIndex)
+}
+
+func (index *ringHullIndex) Add(ringHull *ringHull) {
+	index.hulls = append(index.hulls, ringHull)
+}
+
+func (index *ringHullIndex) Query(queryEnv *geom.Envelope) []*ringHull {
+	result := make([]*ringHull, 0)
+	for _, hull := range index.hulls {
+		if queryEnv.IntersectsEnvelope(hull.GetEnvelope()) {
+			result = append(result, hull)
+		}
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A line segment which is tagged with its location
+ * in a parent line.
+ * Segments created during simplification are not tagged
+ * (their parent is nil).
+ */
+type taggedLineSegment struct {
+	p0     geom.Coordinate
+	p1     geom.Coordinate
+	parent *taggedLineString
+	index  int
+}
+
+func newTaggedLineSegment(p0 *geom.Coordinate, p1 *geom.Coordinate, parent *taggedLineString, index int) *taggedLineSegment {
+	seg := new(taggedLineSegment)
+	seg.p0 = *p0
+	seg.p1 = *p1
+	seg.parent = parent
+	seg.index = index
+	return seg
+}
+
+func newLineSegment(p0 *geom.Coordinate, p1 *geom.Coordinate) *taggedLineSegment {
+	return newTaggedLineSegment(p0, p1, nil, -1)
+}
+
+/**
+ * Tests whether the segment is equal to another segment,
+ * in either orientation.
+ */
+func (seg *taggedLineSegment) equalsTopo(other *taggedLineSegment) bool {
+	return (seg.p0.Equals2D(&other.p0) && seg.p1.Equals2D(&other.p1)) ||
+		(seg.p0.Equals2D(&other.p1) && seg.p1.Equals2D(&other.p0))
+}
+
+func (seg *taggedLineSegment) getEnvelope() *geom.Envelope {
+	return geom.NewEnvelopeFromCoordinates(&seg.p0, &seg.p1)
+}
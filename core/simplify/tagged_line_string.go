@@ -0,0 +1,100 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents a line or ring to be simplified, together with its
+ * segments and the segments of the simplified result.
+ */
+type taggedLineString struct {
+	parentLine  []geom.Coordinate
+	segs        []*taggedLineSegment
+	resultSegs  []*taggedLineSegment
+	minimumSize int
+	isRing      bool
+}
+
+func newTaggedLineString(parentLine []geom.Coordinate, minimumSize int, isRing bool) *taggedLineString {
+	tls := new(taggedLineString)
+	tls.parentLine = parentLine
+	tls.minimumSize = minimumSize
+	tls.isRing = isRing
+	tls.resultSegs = make([]*taggedLineSegment, 0)
+	tls.init()
+	return tls
+}
+
+func (tls *taggedLineString) init() {
+	pts := tls.parentLine
+	tls.segs = make([]*taggedLineSegment, len(pts)-1)
+	for i := 0; i < len(pts)-1; i++ {
+		tls.segs[i] = newTaggedLineSegment(&pts[i], &pts[i+1], tls, i)
+	}
+}
+
+func (tls *taggedLineString) getCoordinate(i int) *geom.Coordinate {
+	return &tls.parentLine[i]
+}
+
+func (tls *taggedLineString) size() int {
+	return len(tls.parentLine)
+}
+
+/**
+ * Gets a point of the line which is used to detect
+ * whether simplifying another component makes it jump over this one.
+ */
+func (tls *taggedLineString) getComponentPoint() *geom.Coordinate {
+	return &tls.parentLine[1]
+}
+
+func (tls *taggedLineString) getResultSize() int {
+	resultSegsSize := len(tls.resultSegs)
+	if resultSegsSize == 0 {
+		return 0
+	}
+	return resultSegsSize + 1
+}
+
+/**
+ * Gets a segment of the result list.
+ * Negative indexes can be used to retrieve from the end of the list.
+ */
+func (tls *taggedLineString) getResultSegment(i int) *taggedLineSegment {
+	index := i
+	if i < 0 {
+		index = len(tls.resultSegs) + i
+	}
+	return tls.resultSegs[index]
+}
+
+func (tls *taggedLineString) addToResult(seg *taggedLineSegment) {
+	tls.resultSegs = append(tls.resultSegs, seg)
+}
+
+func (tls *taggedLineString) getResultCoordinates() []geom.Coordinate {
+	pts := make([]geom.Coordinate, len(tls.resultSegs)+1)
+	for i, seg := range tls.resultSegs {
+		pts[i] = seg.p0
+	}
+	// add last point
+	pts[len(pts)-1] = tls.resultSegs[len(tls.resultSegs)-1].p1
+	return pts
+}
+
+/**
+ * Removes the ring endpoint by replacing the first and last result segments
+ * with a single segment.
+ *
+ * @return the segment replacing the first and last segments
+ */
+func (tls *taggedLineString) removeRingEndpoint() *taggedLineSegment {
+	firstSeg := tls.resultSegs[0]
+	lastSeg := tls.resultSegs[len(tls.resultSegs)-1]
+	flatSeg := newLineSegment(&lastSeg.p0, &firstSeg.p1)
+	tls.resultSegs[0] = flatSeg
+	tls.resultSegs = tls.resultSegs[:len(tls.resultSegs)-1]
+	return flatSeg
+}
@@ -0,0 +1,269 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies a taggedLineString, preserving topology
+ * (in the sense that no new intersections are introduced).
+ * Uses the recursive Douglas-Peucker algorithm.
+ */
+type taggedLineStringSimplifier struct {
+	li          *algorithm.LineIntersector
+	inputIndex  *lineSegmentIndex
+	outputIndex *lineSegmentIndex
+	jumpChecker *componentJumpChecker
+	line        *taggedLineString
+	linePts     []geom.Coordinate
+}
+
+func newTaggedLineStringSimplifier(inputIndex *lineSegmentIndex, outputIndex *lineSegmentIndex, jumpChecker *componentJumpChecker) *taggedLineStringSimplifier {
+	tlss := new(taggedLineStringSimplifier)
+	tlss.li = algorithm.NewLineIntersector()
+	tlss.inputIndex = inputIndex
+	tlss.outputIndex = outputIndex
+	tlss.jumpChecker = jumpChecker
+	return tlss
+}
+
+/**
+ * Simplifies the given {@link taggedLineString}
+ * using the distance tolerance specified.
+ *
+ * @param line the linestring to simplify
+ * @param distanceTolerance the simplification distance tolerance
+ */
+func (tlss *taggedLineStringSimplifier) simplify(line *taggedLineString, distanceTolerance float64) {
+	tlss.line = line
+	tlss.linePts = line.parentLine
+	tlss.simplifySection(0, len(tlss.linePts)-1, 0, distanceTolerance)
+
+	if line.isRing && geom.IsRing(tlss.linePts) {
+		tlss.simplifyRingEndpoint(distanceTolerance)
+	}
+}
+
+func (tlss *taggedLineStringSimplifier) simplifySection(i int, j int, depth int, distanceTolerance float64) {
+	depth += 1
+	//-- if section has only one segment just keep the segment
+	if (i + 1) == j {
+		newSeg := tlss.line.segs[i]
+		tlss.line.addToResult(newSeg)
+		// leave this segment in the input index, for efficiency
+		return
+	}
+
+	isValidToSimplify := true
+
+	/**
+	 * Following logic ensures that there is enough points in the output line.
+	 * If there is already more points than the minimum, there's nothing to check.
+	 * Otherwise, if in the worst case there wouldn't be enough points,
+	 * don't flatten this segment (which avoids the worst case scenario)
+	 */
+	if tlss.line.getResultSize() < tlss.line.minimumSize {
+		worstCaseSize := depth + 1
+		if worstCaseSize < tlss.line.minimumSize {
+			isValidToSimplify = false
+		}
+	}
+
+	furthestPtIndex, distance := findFurthestPoint(tlss.linePts, i, j)
+	//-- flattening must be less than distanceTolerance
+	if distance > distanceTolerance {
+		isValidToSimplify = false
+	}
+
+	if isValidToSimplify {
+		//-- test if flattened section would cause intersection or jump
+		flatSeg := newLineSegment(&tlss.linePts[i], &tlss.linePts[j])
+		isValidToSimplify = tlss.isTopologyValid(i, j, flatSeg)
+	}
+
+	if isValidToSimplify {
+		newSeg := tlss.flatten(i, j)
+		tlss.line.addToResult(newSeg)
+		return
+	}
+	tlss.simplifySection(i, furthestPtIndex, depth, distanceTolerance)
+	tlss.simplifySection(furthestPtIndex, j, depth, distanceTolerance)
+}
+
+/**
+ * Simplifies the result segments on either side of a ring endpoint
+ * (which has not been checked for removal by the general simplification algorithm).
+ */
+func (tlss *taggedLineStringSimplifier) simplifyRingEndpoint(distanceTolerance float64) {
+	if tlss.line.getResultSize() > tlss.line.minimumSize {
+		firstSeg := tlss.line.getResultSegment(0)
+		lastSeg := tlss.line.getResultSegment(-1)
+
+		simpSeg := newLineSegment(&lastSeg.p0, &firstSeg.p1)
+		endPt := &firstSeg.p0
+		if algorithm.DistancePointToSegment(endPt, &simpSeg.p0, &simpSeg.p1) <= distanceTolerance &&
+			tlss.isTopologyValidSegments(firstSeg, lastSeg, simpSeg) {
+			//-- don't know if segments are original or new, so remove from all indexes
+			tlss.inputIndex.remove(firstSeg)
+			tlss.inputIndex.remove(lastSeg)
+			tlss.outputIndex.remove(firstSeg)
+			tlss.outputIndex.remove(lastSeg)
+
+			flatSeg := tlss.line.removeRingEndpoint()
+			tlss.outputIndex.add(flatSeg)
+		}
+	}
+}
+
+func findFurthestPoint(pts []geom.Coordinate, i int, j int) (int, float64) {
+	maxDist := -1.0
+	maxIndex := i
+	for k := i + 1; k < j; k++ {
+		distance := algorithm.DistancePointToSegment(&pts[k], &pts[i], &pts[j])
+		if distance > maxDist {
+			maxDist = distance
+			maxIndex = k
+		}
+	}
+	return maxIndex, maxDist
+}
+
+/**
+ * Flattens a section of the line between
+ * indexes <code>start</code> and <code>end</code>,
+ * replacing them with a line between the endpoints.
+ * The input and output indexes are updated
+ * to reflect this.
+ *
+ * @param start the start index of the flattened section
+ * @param end the end index of the flattened section
+ * @return the new segment created
+ */
+func (tlss *taggedLineStringSimplifier) flatten(start int, end int) *taggedLineSegment {
+	// make a new segment for the simplified geometry
+	newSeg := newLineSegment(&tlss.linePts[start], &tlss.linePts[end])
+	// update the indexes
+	tlss.outputIndex.add(newSeg)
+	for i := start; i < end; i++ {
+		tlss.inputIndex.remove(tlss.line.segs[i])
+	}
+	return newSeg
+}
+
+/**
+ * Tests if line topology remains valid after flattening a section of the line.
+ * The topology is valid if the flattened section does not intersect
+ * any other linework, and does not jump over other components.
+ *
+ * @param sectionStart the start index of the section being flattened
+ * @param sectionEnd the end index of the section being flattened
+ * @param flatSeg the segment which will replace the section
+ * @return true if the flattened section would be valid
+ */
+func (tlss *taggedLineStringSimplifier) isTopologyValid(sectionStart int, sectionEnd int, flatSeg *taggedLineSegment) bool {
+	if tlss.hasOutputIntersection(flatSeg) {
+		return false
+	}
+	if tlss.hasInputIntersection(tlss.line, sectionStart, sectionEnd, flatSeg) {
+		return false
+	}
+	if tlss.jumpChecker.hasJump(tlss.line, sectionStart, sectionEnd, flatSeg) {
+		return false
+	}
+	return true
+}
+
+/**
+ * Tests if line topology remains valid after flattening
+ * the two segments at the endpoint of a ring.
+ */
+func (tlss *taggedLineStringSimplifier) isTopologyValidSegments(seg1 *taggedLineSegment, seg2 *taggedLineSegment, flatSeg *taggedLineSegment) bool {
+	//-- if segments are already flat, topology is unchanged
+	if algorithm.OrientationIndex(&flatSeg.p0, &flatSeg.p1, &seg1.p0) == constants.ORIENTATION_COLLINEAR {
+		return true
+	}
+	if tlss.hasOutputIntersection(flatSeg) {
+		return false
+	}
+	if tlss.hasInputIntersection(nil, -1, -1, flatSeg) {
+		return false
+	}
+	if tlss.jumpChecker.hasJumpSegments(tlss.line, seg1, seg2, flatSeg) {
+		return false
+	}
+	return true
+}
+
+func (tlss *taggedLineStringSimplifier) hasOutputIntersection(flatSeg *taggedLineSegment) bool {
+	for _, querySeg := range tlss.outputIndex.query(flatSeg) {
+		if tlss.hasInvalidIntersection(querySeg, flatSeg) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests if a flattening segment intersects a line
+ * (which may be the line being simplified).
+ * Intersections with the segments of the section being flattened
+ * are ignored.
+ */
+func (tlss *taggedLineStringSimplifier) hasInputIntersection(line *taggedLineString, excludeStart int, excludeEnd int, flatSeg *taggedLineSegment) bool {
+	for _, querySeg := range tlss.inputIndex.query(flatSeg) {
+		if tlss.hasInvalidIntersection(querySeg, flatSeg) {
+			/**
+			 * Ignore the intersection if the intersecting segment is part of the section being collapsed
+			 * to the candidate segment
+			 */
+			if line != nil && isInLineSection(line, excludeStart, excludeEnd, querySeg) {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether a segment is in a section of a taggedLineString.
+ * Sections may wrap around the endpoint of the line,
+ * to support ring endpoint simplification.
+ * This is indicated by excludedStart > excludedEnd
+ *
+ * @param line the taggedLineString containing the section segments
+ * @param excludeStart  the index of the first segment in the excluded section
+ * @param excludeEnd the index of the last segment in the excluded section
+ * @param seg the segment to test
+ * @return true if the test segment is located in the section
+ */
+func isInLineSection(line *taggedLineString, excludeStart int, excludeEnd int, seg *taggedLineSegment) bool {
+	//-- not in this line
+	if seg.parent != line {
+		return false
+	}
+	segIndex := seg.index
+	if excludeStart <= excludeEnd {
+		//-- section is contiguous
+		if segIndex >= excludeStart && segIndex < excludeEnd {
+			return true
+		}
+	} else {
+		//-- section wraps around the end of a ring
+		if segIndex >= excludeStart || segIndex <= excludeEnd {
+			return true
+		}
+	}
+	return false
+}
+
+func (tlss *taggedLineStringSimplifier) hasInvalidIntersection(seg0 *taggedLineSegment, seg1 *taggedLineSegment) bool {
+	//-- segments must not be equal
+	if seg0.equalsTopo(seg1) {
+		return true
+	}
+	tlss.li.ComputeIntersection(&seg0.p0, &seg0.p1, &seg1.p0, &seg1.p1)
+	return tlss.li.IsInteriorIntersection()
+}
@@ -0,0 +1,196 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies lines and rings, ensuring that
+ * the result has the same topology as the input.
+ * The simplification uses a maximum-distance difference algorithm
+ * similar to the Douglas-Peucker algorithm.
+ * <p>
+ * In particular, for polygonal input:
+ * <ul>
+ * <li>The result has the same number of shells and holes as the input,
+ *     with the same topological structure
+ * <li>The result rings touch at <b>no more</b> than the number of touching points in the input
+ *     (although they may touch at fewer points).
+ *     The key implication of this statement is that if the
+ *     input is topologically valid, so is the simplified output.
+ * </ul>
+ * For linear input:
+ * <ul>
+ * <li>The result has the same number of lines
+ * <li>The endpoints of lines are preserved
+ * <li>The result lines do not intersect each other,
+ *     unless the input lines intersect
+ * <li>Lines do not jump over other components
+ * </ul>
+ * All components are simplified together, so a line or ring is
+ * prevented from crossing any other line or ring added to the simplifier.
+ * This makes it suitable for generalizing a map layer as a whole.
+ * Rings keep at least 4 vertices, and lines at least 2.
+ * <p>
+ * The simplification algorithm provides the following guarantees:
+ * <ul>
+ * <li>No vertices are moved; the result vertices are a subset of the input vertices
+ * <li>The result lines are within the distance tolerance of the input lines
+ * </ul>
+ * <p>
+ * <b>KNOWN BUGS</b>
+ * <ul>
+ * <li>May create invalid topology if there are components which are
+ * small relative to the tolerance value.
+ * In particular, if a small hole is very near an edge, it is possible for the edge to be moved by
+ * a relatively large tolerance value and end up with the hole outside the result shell
+ * (or inside another hole).
+ * Similarly, it is possible for a small polygon component to end up inside
+ * a nearby larger polygon.
+ * A workaround is to test for this situation in post-processing and remove
+ * any invalid holes or polygons.
+ * </ul>
+ */
+type TopologyPreservingSimplifier struct {
+	distanceTolerance float64
+	taggedLines       []*taggedLineString
+	components        [][]geom.Coordinate
+}
+
+/**
+ * Simplifies a set of lines, preserving their topology.
+ * Lines which are closed are simplified as rings.
+ *
+ * @param lines the lines to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return the simplified lines, in the same order
+ */
+func TopologyPreservingSimplifyLines(lines [][]geom.Coordinate, distanceTolerance float64) [][]geom.Coordinate {
+	tps := NewTopologyPreservingSimplifier(distanceTolerance)
+	for _, line := range lines {
+		if geom.IsRing(line) {
+			tps.AddRing(line)
+		} else {
+			tps.AddLine(line)
+		}
+	}
+	return tps.Simplify()
+}
+
+/**
+ * Simplifies a set of polygons, preserving their topology.
+ * Each polygon is given as a list of rings, with the shell first.
+ *
+ * @param polygons the polygons to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return the simplified polygons, in the same order
+ */
+func TopologyPreservingSimplifyPolygons(polygons [][][]geom.Coordinate, distanceTolerance float64) [][][]geom.Coordinate {
+	tps := NewTopologyPreservingSimplifier(distanceTolerance)
+	for _, polygon := range polygons {
+		for _, ring := range polygon {
+			tps.AddRing(ring)
+		}
+	}
+	rings := tps.Simplify()
+
+	result := make([][][]geom.Coordinate, len(polygons))
+	index := 0
+	for i, polygon := range polygons {
+		result[i] = rings[index : index+len(polygon)]
+		index += len(polygon)
+	}
+	return result
+}
+
+/**
+ * Creates a simplifier with a given distance tolerance.
+ * The tolerance must be non-negative.
+ *
+ * @param distanceTolerance the approximation tolerance to use
+ */
+func NewTopologyPreservingSimplifier(distanceTolerance float64) *TopologyPreservingSimplifier {
+	tps := new(TopologyPreservingSimplifier)
+	tps.distanceTolerance = distanceTolerance
+	tps.taggedLines = make([]*taggedLineString, 0)
+	tps.components = make([][]geom.Coordinate, 0)
+	return tps
+}
+
+/**
+ * Adds a line to be simplified.
+ * The endpoints of the line are preserved.
+ *
+ * @param line the line to add
+ * @return the index of the component in the result
+ */
+func (tps *TopologyPreservingSimplifier) AddLine(line []geom.Coordinate) int {
+	return tps.addComponent(line, 2, false)
+}
+
+/**
+ * Adds a ring to be simplified.
+ * The ring endpoint may be removed by the simplification.
+ *
+ * @param ring the ring to add
+ * @return the index of the component in the result
+ */
+func (tps *TopologyPreservingSimplifier) AddRing(ring []geom.Coordinate) int {
+	return tps.addComponent(ring, 4, true)
+}
+
+func (tps *TopologyPreservingSimplifier) addComponent(pts []geom.Coordinate, minSize int, isRing bool) int {
+	index := len(tps.components)
+	tps.components = append(tps.components, pts)
+	// skip empty and degenerate components
+	if len(pts) < 2 {
+		tps.taggedLines = append(tps.taggedLines, nil)
+		return index
+	}
+	tps.taggedLines = append(tps.taggedLines, newTaggedLineString(pts, minSize, isRing))
+	return index
+}
+
+/**
+ * Simplifies all the components added to the simplifier.
+ * Components with fewer than 2 points are returned unchanged.
+ *
+ * @return the simplified components, in the order they were added
+ */
+func (tps *TopologyPreservingSimplifier) Simplify() [][]geom.Coordinate {
+	lines := make([]*taggedLineString, 0, len(tps.taggedLines))
+	for _, line := range tps.taggedLines {
+		if line != nil {
+			lines = append(lines, line)
+		}
+	}
+	simplifyTaggedLines(lines, tps.distanceTolerance)
+
+	result := make([][]geom.Coordinate, len(tps.components))
+	for i, line := range tps.taggedLines {
+		if line == nil {
+			result[i] = tps.components[i]
+			continue
+		}
+		result[i] = line.getResultCoordinates()
+	}
+	return result
+}
+
+/**
+ * Simplifies a collection of taggedLineStrings,
+ * preserving topology (in the sense that no new intersections are introduced).
+ */
+func simplifyTaggedLines(taggedLines []*taggedLineString, distanceTolerance float64) {
+	inputIndex := newLineSegmentIndex()
+	outputIndex := newLineSegmentIndex()
+	jumpChecker := newComponentJumpChecker(taggedLines)
+
+	for _, line := range taggedLines {
+		inputIndex.addLine(line)
+	}
+	for _, line := range taggedLines {
+		tlss := newTaggedLineStringSimplifier(inputIndex, outputIndex, jumpChecker)
+		tlss.simplify(line, distanceTolerance)
+	}
+}
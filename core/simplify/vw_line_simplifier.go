@@ -0,0 +1,227 @@
+package geos
+
+import (
+	"container/heap"
+	"math"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies a linestring (sequence of points) using the
+ * Visvalingam-Whyatt algorithm.
+ * The Visvalingam-Whyatt algorithm simplifies geometry
+ * by removing vertices while trying to minimize the area changed.
+ * <p>
+ * Vertices are removed in order of increasing effective area,
+ * which is the area of the triangle formed by a vertex and its current neighbours.
+ * The effective area of each vertex is recorded when it is removed.
+ * As in the original algorithm, the recorded area is never less than that of
+ * a previously removed vertex, so that the vertices of the result of simplifying
+ * with a given tolerance are exactly those whose effective area is not less than
+ * the square of the tolerance.
+ * The line endpoints are never removed, and have an infinite effective area.
+ */
+type VWLineSimplifier struct {
+	pts       []geom.Coordinate
+	tolerance float64
+}
+
+/**
+ * Simplifies a sequence of points using the Visvalingam-Whyatt algorithm.
+ * Vertices are removed while their effective area is less than
+ * the square of the distance tolerance.
+ *
+ * @param pts the points to simplify
+ * @param distanceTolerance the simplification distance tolerance
+ * @return the simplified points
+ */
+func VWLineSimplify(pts []geom.Coordinate, distanceTolerance float64) []geom.Coordinate {
+	simp := NewVWLineSimplifier(pts, distanceTolerance)
+	return simp.Simplify()
+}
+
+/**
+ * Computes the effective area of each point in a sequence.
+ * The endpoints have an infinite effective area.
+ *
+ * @param pts the points of the line
+ * @return the effective area of each point
+ */
+func VWLineEffectiveAreas(pts []geom.Coordinate) []float64 {
+	simp := NewVWLineSimplifier(pts, math.Inf(1))
+	return simp.computeEffectiveAreas(math.Inf(1))
+}
+
+func NewVWLineSimplifier(pts []geom.Coordinate, distanceTolerance float64) *VWLineSimplifier {
+	vwls := new(VWLineSimplifier)
+	vwls.pts = pts
+	vwls.tolerance = distanceTolerance * distanceTolerance
+	return vwls
+}
+
+/**
+ * Computes the simplified points.
+ *
+ * @return the simplified points
+ */
+func (vwls *VWLineSimplifier) Simplify() []geom.Coordinate {
+	if len(vwls.pts) == 0 {
+		return []geom.Coordinate{}
+	}
+	areas := vwls.computeEffectiveAreas(vwls.tolerance)
+	coordList := vwSelectVertices(vwls.pts, areas, vwls.tolerance)
+	simp := coordList.ToCoordinateArray()
+	// ensure computed value is a valid line
+	if len(simp) < 2 {
+		return []geom.Coordinate{simp[0], simp[0]}
+	}
+	return simp
+}
+
+/**
+ * Builds a list of the vertices with an effective area
+ * not less than the given area.
+ */
+func vwSelectVertices(pts []geom.Coordinate, areas []float64, minArea float64) *geom.CoordinateList {
+	coordList := geom.DefaultCoordinateList()
+	for i := range pts {
+		if areas[i] >= minArea {
+			coordList.AddCoordinateRepeated(pts[i].Clone(), true)
+		}
+	}
+	return coordList
+}
+
+/**
+ * Removes vertices in order of increasing area until the smallest area
+ * is not less than the given maximum, recording the effective area of each
+ * removed vertex.
+ * Vertices which are not removed have an infinite effective area.
+ */
+func (vwls *VWLineSimplifier) computeEffectiveAreas(maxArea float64) []float64 {
+	n := len(vwls.pts)
+	areas := make([]float64, n)
+	vertexQueue := &vwVertexQueue{}
+	vertexQueue.init(vwls.pts)
+
+	lastArea := 0.0
+	for vertexQueue.Len() > 0 {
+		v := (*vertexQueue).vertices[0]
+		if v.area >= maxArea || math.IsInf(v.area, 1) {
+			break
+		}
+		heap.Pop(vertexQueue)
+		//-- the effective area never decreases
+		lastArea = math.Max(lastArea, v.area)
+		areas[v.index] = lastArea
+		vertexQueue.remove(v)
+	}
+	for vertexQueue.Len() > 0 {
+		v := heap.Pop(vertexQueue).(*vwVertex)
+		areas[v.index] = math.Inf(1)
+	}
+	return areas
+}
+
+type vwVertex struct {
+	index     int
+	area      float64
+	prev      *vwVertex
+	next      *vwVertex
+	heapIndex int
+}
+
+/**
+ * A priority queue of the live vertices of a line, ordered by area.
+ * Vertices with equal areas are ordered by their position in the line.
+ */
+type vwVertexQueue struct {
+	pts      []geom.Coordinate
+	vertices []*vwVertex
+}
+
+func (vq *vwVertexQueue) init(pts []geom.Coordinate) {
+	vq.pts = pts
+	vq.vertices = make([]*vwVertex, len(pts))
+	var prev *vwVertex
+	for i := range pts {
+		v := &vwVertex{index: i, prev: prev, heapIndex: i}
+		if prev != nil {
+			prev.next = v
+		}
+		vq.vertices[i] = v
+		prev = v
+	}
+	for _, v := range vq.vertices {
+		vq.updateArea(v)
+	}
+	heap.Init(vq)
+}
+
+func (vq *vwVertexQueue) updateArea(v *vwVertex) {
+	if v.prev == nil || v.next == nil {
+		v.area = math.Inf(1)
+		return
+	}
+	v.area = triangleArea(&vq.pts[v.prev.index], &vq.pts[v.index], &vq.pts[v.next.index])
+}
+
+/**
+ * Unlinks a vertex from the line,
+ * updating the areas of its neighbours.
+ */
+func (vq *vwVertexQueue) remove(v *vwVertex) {
+	if v.prev != nil {
+		v.prev.next = v.next
+	}
+	if v.next != nil {
+		v.next.prev = v.prev
+	}
+	for _, nbr := range []*vwVertex{v.prev, v.next} {
+		if nbr != nil {
+			vq.updateArea(nbr)
+			heap.Fix(vq, nbr.heapIndex)
+		}
+	}
+}
+
+func (vq *vwVertexQueue) Len() int {
+	return len(vq.vertices)
+}
+
+func (vq *vwVertexQueue) Less(i, j int) bool {
+	vi := vq.vertices[i]
+	vj := vq.vertices[j]
+	if vi.area != vj.area {
+		return vi.area < vj.area
+	}
+	return vi.index < vj.index
+}
+
+func (vq *vwVertexQueue) Swap(i, j int) {
+	vq.vertices[i], vq.vertices[j] = vq.vertices[j], vq.vertices[i]
+	vq.vertices[i].heapIndex = i
+	vq.vertices[j].heapIndex = j
+}
+
+func (vq *vwVertexQueue) Push(x any) {
+	v := x.(*vwVertex)
+	v.heapIndex = len(vq.vertices)
+	vq.vertices = append(vq.vertices, v)
+}
+
+func (vq *vwVertexQueue) Pop() any {
+	n := len(vq.vertices)
+	v := vq.vertices[n-1]
+	vq.vertices = vq.vertices[:n-1]
+	v.heapIndex = -1
+	return v
+}
+
+/**
+ * Computes the (unsigned) area of a triangle.
+ */
+func triangleArea(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate) float64 {
+	return math.Abs(((c.X-a.X)*(b.Y-a.Y) - (b.X-a.X)*(c.Y-a.Y)) / 2)
+}
@@ -0,0 +1,174 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Simplifies lines, rings and polygons using the Visvalingam-Whyatt area-based algorithm.
+ * Ensures that any polygonal rings have the correct minimum
+ * number of vertices; rings which collapse are removed.
+ * Lines keep their endpoints, and are never reduced below
+ * two vertices.
+ * <p>
+ * The simplification tolerance is specified as a distance.
+ * This is converted to an area tolerance by squaring it.
+ * <p>
+ * Note that in general this algorithm does not preserve topology -
+ * e.g. polygons can be split, collapse to lines or disappear
+ * holes can be created or disappear,
+ * and lines can cross.
+ * The rings of a simplified polygon are not repaired,
+ * so the result may be invalid.
+ * <p>
+ * The effective area of each vertex can be computed once
+ * with {@link VWEffectiveAreas}, and used to simplify
+ * to any tolerance with {@link VWSimplifyByEffectiveArea}.
+ * <p>
+ * Polygons are given as a list of rings, with the shell first,
+ * followed by any holes.
+ */
+type VWSimplifier struct {
+	distanceTolerance float64
+}
+
+/**
+ * Simplifies a line using a given tolerance.
+ *
+ * @param line the line to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return a simplified version of the line
+ */
+func VWSimplifyLine(line []geom.Coordinate, distanceTolerance float64) []geom.Coordinate {
+	return NewVWSimplifier(distanceTolerance).SimplifyLine(line)
+}
+
+/**
+ * Simplifies a polygon using a given tolerance.
+ *
+ * @param polygon the shell and hole rings of the polygon to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return the simplified rings, or nil if the shell collapses
+ */
+func VWSimplifyPolygon(polygon [][]geom.Coordinate, distanceTolerance float64) [][]geom.Coordinate {
+	return NewVWSimplifier(distanceTolerance).SimplifyPolygon(polygon)
+}
+
+/**
+ * Simplifies the coordinates of a {@link CoordinateList} using a given tolerance.
+ * If the list forms a ring the result is kept closed.
+ *
+ * @param coordinateList the list of coordinates to simplify
+ * @param distanceTolerance the tolerance to use
+ * @return a new list containing the simplified coordinates
+ */
+func VWSimplifyCoordinateList(coordinateList *geom.CoordinateList, distanceTolerance float64) *geom.CoordinateList {
+	pts := coordinateList.ToCoordinateArray()
+	simpList := geom.NewCoordinateList(VWLineSimplify(pts, distanceTolerance))
+	if geom.IsRing(pts) {
+		simpList.CloseRing()
+	}
+	return simpList
+}
+
+/**
+ * Computes the effective area of each vertex in a {@link CoordinateList}.
+ * The effective area of a vertex is the area of the triangle it forms
+ * with its neighbours at the point it is removed by the simplification
+ * (but no less than the effective area of any vertex removed before it).
+ * The endpoints have an infinite effective area.
+ *
+ * @param coordinateList the list of coordinates
+ * @return the effective area of each vertex
+ */
+func VWEffectiveAreas(coordinateList *geom.CoordinateList) []float64 {
+	return VWLineEffectiveAreas(coordinateList.Coordinates)
+}
+
+/**
+ * Simplifies the coordinates of a {@link CoordinateList} using
+ * previously computed effective areas.
+ * The vertices kept are those with an effective area not less than the square of
+ * the distance tolerance. This gives the same result as simplifying
+ * the list with {@link VWSimplifyCoordinateList} using the tolerance.
+ * If the list forms a ring the result is kept closed.
+ *
+ * @param coordinateList the list of coordinates to simplify
+ * @param areas the effective areas of the vertices, as computed by {@link VWEffectiveAreas}
+ * @param distanceTolerance the tolerance to use
+ * @return a new list containing the simplified coordinates, or nil if the number of areas does not match
+ */
+func VWSimplifyByEffectiveArea(coordinateList *geom.CoordinateList, areas []float64, distanceTolerance float64) *geom.CoordinateList {
+	pts := coordinateList.Coordinates
+	if len(areas) != len(pts) {
+		return nil
+	}
+	simpList := vwSelectVertices(pts, areas, distanceTolerance*distanceTolerance)
+	if geom.IsRing(pts) {
+		simpList.CloseRing()
+	}
+	return simpList
+}
+
+/**
+ * Creates a simplifier with a given distance tolerance.
+ * The tolerance must be non-negative.
+ *
+ * @param distanceTolerance the approximation tolerance to use
+ */
+func NewVWSimplifier(distanceTolerance float64) *VWSimplifier {
+	vws := new(VWSimplifier)
+	vws.distanceTolerance = distanceTolerance
+	return vws
+}
+
+/**
+ * Simplifies a line.
+ * The endpoints of the line are preserved.
+ *
+ * @param line the line to simplify
+ * @return the simplified line
+ */
+func (vws *VWSimplifier) SimplifyLine(line []geom.Coordinate) []geom.Coordinate {
+	return VWLineSimplify(line, vws.distanceTolerance)
+}
+
+/**
+ * Simplifies a ring.
+ *
+ * @param ring the ring to simplify
+ * @return the simplified ring, or nil if the ring collapses
+ */
+func (vws *VWSimplifier) SimplifyRing(ring []geom.Coordinate) []geom.Coordinate {
+	simpList := VWSimplifyCoordinateList(geom.NewCoordinateList(ring), vws.distanceTolerance)
+	simpRing := simpList.ToCoordinateArray()
+	if len(simpRing) < 4 {
+		return nil
+	}
+	return simpRing
+}
+
+/**
+ * Simplifies a polygon.
+ * Holes which collapse are removed.
+ *
+ * @param polygon the shell and hole rings of the polygon
+ * @return the simplified rings, or nil if the polygon is empty or the shell collapses
+ */
+func (vws *VWSimplifier) SimplifyPolygon(polygon [][]geom.Coordinate) [][]geom.Coordinate {
+	if len(polygon) == 0 {
+		return nil
+	}
+	shell := vws.SimplifyRing(polygon[0])
+	if shell == nil {
+		return nil
+	}
+	rings := [][]geom.Coordinate{shell}
+	for _, hole := range polygon[1:] {
+		simpHole := vws.SimplifyRing(hole)
+		if simpHole != nil {
+			rings = append(rings, simpHole)
+		}
+	}
+	return rings
+}
@@ -0,0 +1,222 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * A utility class which creates Conforming Delaunay Triangulations
+ * from collections of points and linear constraints, and extract the resulting
+ * triangulation edges or triangles as geometries.
+ * <p>
+ * Each constraint line is present in the triangulation
+ * as a union of triangulation edges.
+ * Constraint segments are split where necessary
+ * to preserve the Delaunay property,
+ * with the Z values of the split points interpolated along the segment.
+ */
+type ConformingDelaunayTriangulationBuilder struct {
+	siteCoords  []geom.Coordinate
+	constraints [][]geom.Coordinate
+	tolerance   float64
+	subdiv      *quadedge.QuadEdgeSubdivision
+}
+
+/**
+ * Creates a new conforming triangulation builder.
+ */
+func NewConformingDelaunayTriangulationBuilder() *ConformingDelaunayTriangulationBuilder {
+	builder := new(ConformingDelaunayTriangulationBuilder)
+	return builder
+}
+
+/**
+ * Sets the sites (point or vertices) which will be triangulated.
+ * Repeated points are removed.
+ *
+ * @param coords the points to triangulate
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) SetSites(coords []geom.Coordinate) {
+	builder.siteCoords = DelaunayTriangulationBuilderExtractUniqueCoordinates(coords)
+	builder.subdiv = nil
+}
+
+/**
+ * Sets the linear constraints to be conformed to.
+ * All constraints are assumed to be valid and non-crossing.
+ * Constraint vertices are added to the triangulation as sites.
+ *
+ * @param constraintLines the lines to constrain the triangulation to
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) SetConstraints(constraintLines [][]geom.Coordinate) {
+	builder.constraints = constraintLines
+	builder.subdiv = nil
+}
+
+/**
+ * Sets the snapping tolerance which will be used
+ * to improved the robustness of the triangulation computation.
+ * A tolerance of 0.0 specifies that no snapping will take place.
+ *
+ * @param tolerance the tolerance distance to use
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) SetTolerance(tolerance float64) {
+	builder.tolerance = tolerance
+	builder.subdiv = nil
+}
+
+func (builder *ConformingDelaunayTriangulationBuilder) create() error {
+	if builder.subdiv != nil {
+		return nil
+	}
+	constraintVertexMap := make(map[[2]float64]*ConstraintVertex)
+	segments := builder.createConstraintSegments(constraintVertexMap)
+	constraintVertices := conformingDelaunayTriangulationBuilderSortedVertices(constraintVertexMap)
+
+	sites := builder.createSiteVertices(constraintVertexMap)
+
+	cdt := NewConformingDelaunayTriangulator(sites, builder.tolerance)
+	cdt.SetConstraints(segments, constraintVertices)
+	if err := cdt.FormInitialDelaunay(); err != nil {
+		return err
+	}
+	if err := cdt.EnforceConstraints(); err != nil {
+		return err
+	}
+	builder.subdiv = cdt.GetSubdivision()
+	return nil
+}
+
+/**
+ * Creates the vertices for the sites,
+ * skipping any which coincide with a constraint vertex.
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) createSiteVertices(constraintVertexMap map[[2]float64]*ConstraintVertex) []*ConstraintVertex {
+	verts := make([]*ConstraintVertex, 0, len(builder.siteCoords))
+	for i := range builder.siteCoords {
+		if _, ok := constraintVertexMap[conformingDelaunayTriangulationBuilderKey(&builder.siteCoords[i])]; ok {
+			continue
+		}
+		verts = append(verts, NewConstraintVertex(&builder.siteCoords[i]))
+	}
+	return verts
+}
+
+/**
+ * Creates the constraint segments,
+ * recording the unique constraint vertices in the map.
+ * Zero-length and repeated segments are skipped.
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) createConstraintSegments(constraintVertexMap map[[2]float64]*ConstraintVertex) []*Segment {
+	segments := make([]*Segment, 0)
+	segKeys := make(map[[2][2]float64]bool)
+	for _, line := range builder.constraints {
+		for i := 0; i < len(line)-1; i++ {
+			p0 := &line[i]
+			p1 := &line[i+1]
+			if p0.Equals2D(p1) {
+				continue
+			}
+			v0 := conformingDelaunayTriangulationBuilderAddVertex(constraintVertexMap, p0)
+			v1 := conformingDelaunayTriangulationBuilderAddVertex(constraintVertexMap, p1)
+
+			//-- key the segment by its endpoints in XY order, so that orientation is ignored
+			k0 := conformingDelaunayTriangulationBuilderKey(v0.GetCoordinate())
+			k1 := conformingDelaunayTriangulationBuilderKey(v1.GetCoordinate())
+			if v1.GetCoordinate().CompareTo(v0.GetCoordinate()) < 0 {
+				k0, k1 = k1, k0
+			}
+			segKey := [2][2]float64{k0, k1}
+			if segKeys[segKey] {
+				continue
+			}
+			segKeys[segKey] = true
+			segments = append(segments, NewSegment(v0.GetCoordinate(), v1.GetCoordinate()))
+		}
+	}
+	return segments
+}
+
+func conformingDelaunayTriangulationBuilderAddVertex(constraintVertexMap map[[2]float64]*ConstraintVertex, p *geom.Coordinate) *ConstraintVertex {
+	key := conformingDelaunayTriangulationBuilderKey(p)
+	if v, ok := constraintVertexMap[key]; ok {
+		return v
+	}
+	v := NewConstraintVertex(p)
+	v.SetOnConstraint(true)
+	constraintVertexMap[key] = v
+	return v
+}
+
+/**
+ * Gets the unique constraint vertices, sorted in XY order
+ * so that the triangulation is deterministic.
+ */
+func conformingDelaunayTriangulationBuilderSortedVertices(constraintVertexMap map[[2]float64]*ConstraintVertex) []*ConstraintVertex {
+	verts := make([]*ConstraintVertex, 0, len(constraintVertexMap))
+	for _, v := range constraintVertexMap {
+		verts = append(verts, v)
+	}
+	slices.SortFunc(verts, func(a *ConstraintVertex, b *ConstraintVertex) int {
+		return a.GetCoordinate().CompareTo(b.GetCoordinate())
+	})
+	return verts
+}
+
+/**
+ * Computes the key identifying a location in the XY plane.
+ */
+func conformingDelaunayTriangulationBuilderKey(p *geom.Coordinate) [2]float64 {
+	return [2]float64{p.X, p.Y}
+}
+
+/**
+ * Gets the {@link quadedge.QuadEdgeSubdivision} which models the computed triangulation.
+ * An error is returned if the triangulation cannot be computed,
+ * or if the constraints cannot be enforced.
+ *
+ * @return the subdivision containing the triangulation
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) GetSubdivision() (*quadedge.QuadEdgeSubdivision, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	return builder.subdiv, nil
+}
+
+/**
+ * Gets the edges of the computed triangulation,
+ * each as a line of 2 coordinates.
+ * An error is returned if the triangulation cannot be computed,
+ * or if the constraints cannot be enforced.
+ *
+ * @return the edges of the triangulation
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) GetEdges() ([][]geom.Coordinate, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	return builder.subdiv.GetEdgeCoordinates(), nil
+}
+
+/**
+ * Gets the triangles of the computed triangulation,
+ * each as a closed clockwise ring of 4 coordinates.
+ * An error is returned if the triangulation cannot be computed,
+ * or if the constraints cannot be enforced.
+ *
+ * @return the triangles of the triangulation
+ */
+func (builder *ConformingDelaunayTriangulationBuilder) GetTriangles() ([][]geom.Coordinate, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	triPts := builder.subdiv.GetTriangleCoordinates(false)
+	for _, pts := range triPts {
+		geom.ReverseCoordinates(pts)
+	}
+	return triPts, nil
+}
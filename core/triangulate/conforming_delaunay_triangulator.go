@@ -0,0 +1,463 @@
+package geos
+
+import (
+	"math"
+	"slices"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	kdtree "github.com/UltimateThread/geos-go/core/index/kdtree"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+const conformingDelaunayTriangulator_MAX_SPLIT_ITER = 99
+
+/**
+ * Computes a Conforming Delaunay Triangulation over a set of sites and a set of
+ * linear constraints.
+ * <p>
+ * A conforming Delaunay triangulation is a true Delaunay triangulation. In it
+ * each constraint segment is present as a union of one or more triangulation
+ * edges. Constraint segments may be subdivided into two or more triangulation
+ * edges by the insertion of additional sites. The additional sites are called
+ * Steiner points, and are necessary to allow the segments to be faithfully
+ * reflected in the triangulation while maintaining the Delaunay property.
+ * Another way of stating this is that in a conforming Delaunay triangulation
+ * every constraint segment will be the union of a subset of the triangulation
+ * edges (up to tolerance).
+ * <p>
+ * A Conforming Delaunay triangulation is distinct from a Constrained Delaunay triangulation.
+ * A Constrained Delaunay triangulation is not necessarily fully Delaunay,
+ * and it contains the constraint segments exactly as edges of the triangulation.
+ * <p>
+ * A typical usage pattern for the triangulator is:
+ * <pre>
+ * 	 cdt := NewConformingDelaunayTriangulator(sites, tolerance)
+ *
+ *   // optional
+ *   cdt.SetSplitPointFinder(splitPointFinder)
+ *   cdt.SetVertexFactory(vertexFactory)
+ *
+ *	 cdt.SetConstraints(segments, segVertices)
+ *	 cdt.FormInitialDelaunay()
+ *	 cdt.EnforceConstraints()
+ *	 subdiv := cdt.GetSubdivision()
+ * </pre>
+ * The Z values of split points are interpolated
+ * from the constraint segment endpoints.
+ */
+type ConformingDelaunayTriangulator struct {
+	initialVertices []*ConstraintVertex
+	segVertices     []*ConstraintVertex
+
+	segments      []*Segment
+	subdiv        *quadedge.QuadEdgeSubdivision
+	incDel        *IncrementalDelaunayTriangulator
+	convexHull    []geom.Coordinate
+	splitFinder   ConstraintSplitPointFinder
+	kdt           *kdtree.KdTree
+	vertexFactory ConstraintVertexFactory
+
+	// allPointsEnv expanded by a small buffer
+	computeAreaEnv *geom.Envelope
+	// records the last split point computed, for error reporting
+	splitPt *geom.Coordinate
+
+	// defines if two sites are the same.
+	tolerance float64
+}
+
+func conformingDelaunayTriangulatorComputeVertexEnvelope(vertices []*ConstraintVertex) *geom.Envelope {
+	env := geom.DefaultEnvelope()
+	for _, v := range vertices {
+		env.ExpandToIncludeCoordinate(v.GetCoordinate())
+	}
+	return env
+}
+
+/**
+ * Creates a Conforming Delaunay Triangulation based on the given
+ * unconstrained initial vertices. The initial vertex set should not contain
+ * any vertices which appear in the constraint set.
+ *
+ * @param initialVertices
+ *          a collection of {@link ConstraintVertex}
+ * @param tolerance
+ *          the distance tolerance below which points are considered identical
+ */
+func NewConformingDelaunayTriangulator(initialVertices []*ConstraintVertex, tolerance float64) *ConformingDelaunayTriangulator {
+	cdt := new(ConformingDelaunayTriangulator)
+	cdt.initialVertices = slices.Clone(initialVertices)
+	cdt.segVertices = make([]*ConstraintVertex, 0)
+	cdt.segments = make([]*Segment, 0)
+	cdt.tolerance = tolerance
+	cdt.splitFinder = NonEncroachingSplitPointFinderFindSplitPoint
+	cdt.kdt = kdtree.NewKdTree(tolerance)
+	return cdt
+}
+
+/**
+ * Sets the constraints to be conformed to by the computed triangulation.
+ * The constraints must not contain duplicate segments (up to orientation).
+ * The unique set of vertices (as {@link ConstraintVertex}es)
+ * forming the constraints must also be supplied.
+ * Supplying it explicitly allows the ConstraintVertexes to be initialized
+ * appropriately (e.g. with external data), and avoids re-computing the unique set
+ * if it is already available.
+ *
+ * @param segments a list of the constraint {@link Segment}s
+ * @param segVertices the set of unique {@link ConstraintVertex}es referenced by the segments
+ */
+func (cdt *ConformingDelaunayTriangulator) SetConstraints(segments []*Segment, segVertices []*ConstraintVertex) {
+	cdt.segments = segments
+	cdt.segVertices = segVertices
+}
+
+/**
+ * Sets the {@link ConstraintSplitPointFinder} to be
+ * used during constraint enforcement.
+ * Different splitting strategies may be appropriate
+ * for special situations.
+ *
+ * @param splitFinder the ConstraintSplitPointFinder to be used
+ */
+func (cdt *ConformingDelaunayTriangulator) SetSplitPointFinder(splitFinder ConstraintSplitPointFinder) {
+	cdt.splitFinder = splitFinder
+}
+
+/**
+ * Gets the tolerance value used to construct the triangulation.
+ *
+ * @return a tolerance value
+ */
+func (cdt *ConformingDelaunayTriangulator) GetTolerance() float64 {
+	return cdt.tolerance
+}
+
+/**
+ * Gets the <tt>ConstraintVertexFactory</tt> used to create new constraint vertices at split points.
+ *
+ * @return a new constraint vertex
+ */
+func (cdt *ConformingDelaunayTriangulator) GetVertexFactory() ConstraintVertexFactory {
+	return cdt.vertexFactory
+}
+
+/**
+ * Sets a custom {@link ConstraintVertexFactory} to be used
+ * to allow vertices carrying extra information to be created.
+ *
+ * @param vertexFactory the ConstraintVertexFactory to be used
+ */
+func (cdt *ConformingDelaunayTriangulator) SetVertexFactory(vertexFactory ConstraintVertexFactory) {
+	cdt.vertexFactory = vertexFactory
+}
+
+/**
+ * Gets the {@link quadedge.QuadEdgeSubdivision} which represents the triangulation.
+ *
+ * @return a subdivision
+ */
+func (cdt *ConformingDelaunayTriangulator) GetSubdivision() *quadedge.QuadEdgeSubdivision {
+	return cdt.subdiv
+}
+
+/**
+ * Gets the {@link kdtree.KdTree} which contains the vertices of the triangulation.
+ *
+ * @return a KdTree
+ */
+func (cdt *ConformingDelaunayTriangulator) GetKDT() *kdtree.KdTree {
+	return cdt.kdt
+}
+
+/**
+ * Gets the sites (vertices) used to initialize the triangulation.
+ *
+ * @return a list of Vertex
+ */
+func (cdt *ConformingDelaunayTriangulator) GetInitialVertices() []*ConstraintVertex {
+	return cdt.initialVertices
+}
+
+/**
+ * Gets the {@link Segment}s which represent the constraints.
+ * Once the constraints have been enforced these are the split segments.
+ *
+ * @return a collection of Segments
+ */
+func (cdt *ConformingDelaunayTriangulator) GetConstraintSegments() []*Segment {
+	return cdt.segments
+}
+
+/**
+ * Gets the convex hull of all the sites in the triangulation,
+ * including constraint vertices.
+ * Only valid after the constraints have been enforced.
+ *
+ * @return the convex hull of the sites
+ */
+func (cdt *ConformingDelaunayTriangulator) GetConvexHull() []geom.Coordinate {
+	return cdt.convexHull
+}
+
+// ==================================================================
+
+func (cdt *ConformingDelaunayTriangulator) computeBoundingBox() {
+	vertexEnv := conformingDelaunayTriangulatorComputeVertexEnvelope(cdt.initialVertices)
+	segEnv := conformingDelaunayTriangulatorComputeVertexEnvelope(cdt.segVertices)
+
+	allPointsEnv := vertexEnv.Copy()
+	allPointsEnv.ExpandToIncludeEnvelope(segEnv)
+
+	deltaX := allPointsEnv.GetWidth() * 0.2
+	deltaY := allPointsEnv.GetHeight() * 0.2
+	delta := max(deltaX, deltaY)
+
+	cdt.computeAreaEnv = allPointsEnv.Copy()
+	cdt.computeAreaEnv.ExpandBy(delta)
+}
+
+func (cdt *ConformingDelaunayTriangulator) computeConvexHull() {
+	cdt.convexHull = algorithm.ConvexHullOfPoints(cdt.getPointArray())
+}
+
+func (cdt *ConformingDelaunayTriangulator) getPointArray() []geom.Coordinate {
+	pts := make([]geom.Coordinate, 0, len(cdt.initialVertices)+len(cdt.segVertices))
+	for _, v := range cdt.initialVertices {
+		pts = append(pts, *v.GetCoordinate())
+	}
+	for _, v := range cdt.segVertices {
+		pts = append(pts, *v.GetCoordinate())
+	}
+	return pts
+}
+
+func (cdt *ConformingDelaunayTriangulator) createVertex(p *geom.Coordinate, seg *Segment) *ConstraintVertex {
+	var v *ConstraintVertex
+	if cdt.vertexFactory != nil {
+		v = cdt.vertexFactory(p, seg)
+	} else {
+		v = NewConstraintVertex(p)
+	}
+	v.SetOnConstraint(true)
+	return v
+}
+
+func (cdt *ConformingDelaunayTriangulator) insertSites(vertices []*ConstraintVertex) error {
+	for _, v := range vertices {
+		if _, err := cdt.insertConstraintVertex(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Inserts a vertex into the triangulation, unless there is already
+ * a vertex within the tolerance distance of it.
+ * In that case the existing vertex is returned,
+ * with the constraint information of the new vertex merged into it.
+ */
+func (cdt *ConformingDelaunayTriangulator) insertConstraintVertex(v *ConstraintVertex) (*ConstraintVertex, error) {
+	kdnode := cdt.kdt.InsertWithData(v.GetCoordinate(), v)
+	if kdnode.IsRepeated() {
+		snappedV := kdnode.GetData().(*ConstraintVertex)
+		snappedV.merge(v)
+		return snappedV, nil
+	}
+	if _, err := cdt.incDel.InsertSite(v.Vertex); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+/**
+ * Inserts a site into the triangulation, maintaining the conformal Delaunay property.
+ * This can be used to further refine the triangulation if required
+ * (e.g. to approximate the medial axis of the constraints,
+ * or to improve the grading of the triangulation).
+ *
+ * @param p the location of the site to insert
+ * @return an error if the site cannot be inserted
+ */
+func (cdt *ConformingDelaunayTriangulator) InsertSite(p *geom.Coordinate) error {
+	var v *ConstraintVertex
+	if cdt.vertexFactory != nil {
+		v = cdt.vertexFactory(p, nil)
+	} else {
+		v = NewConstraintVertex(p)
+	}
+	_, err := cdt.insertConstraintVertex(v)
+	return err
+}
+
+// ==================================================================
+
+/**
+ * Computes the Delaunay triangulation of the initial sites.
+ *
+ * @return an error if the triangulation cannot be computed
+ */
+func (cdt *ConformingDelaunayTriangulator) FormInitialDelaunay() error {
+	cdt.computeBoundingBox()
+	cdt.subdiv = quadedge.NewQuadEdgeSubdivision(cdt.computeAreaEnv, cdt.tolerance)
+	cdt.incDel = NewIncrementalDelaunayTriangulator(cdt.subdiv)
+	//-- forcing convexity can make locating split points on the hull fail
+	cdt.incDel.ForceConvex(false)
+	return cdt.insertSites(cdt.initialVertices)
+}
+
+// ==================================================================
+
+/**
+ * Enforces the supplied constraints into the triangulation.
+ * A {@link ConstraintEnforcementError} is returned if the constraints
+ * cannot be enforced.
+ *
+ * @return an error if the constraints cannot be enforced
+ */
+func (cdt *ConformingDelaunayTriangulator) EnforceConstraints() error {
+	if err := cdt.addConstraintVertices(); err != nil {
+		return err
+	}
+
+	count := 0
+	for {
+		splits, err := cdt.enforceGabriel()
+		if err != nil {
+			return err
+		}
+		count++
+		if splits == 0 || count >= conformingDelaunayTriangulator_MAX_SPLIT_ITER {
+			break
+		}
+	}
+	if count == conformingDelaunayTriangulator_MAX_SPLIT_ITER {
+		return NewConstraintEnforcementError("Too many splitting iterations while enforcing constraints.  Last split point was at: ", cdt.splitPt)
+	}
+	return nil
+}
+
+func (cdt *ConformingDelaunayTriangulator) addConstraintVertices() error {
+	cdt.computeConvexHull()
+	// insert constraint vertices as sites
+	return cdt.insertSites(cdt.segVertices)
+}
+
+func (cdt *ConformingDelaunayTriangulator) enforceGabriel() (int, error) {
+	segments := make([]*Segment, 0, len(cdt.segments))
+	splits := 0
+
+	/**
+	 * On each iteration must always scan all constraint (sub)segments, since
+	 * some constraints may be rebroken by Delaunay triangle flipping caused by
+	 * insertion of another constraint. However, this process must converge
+	 * eventually, with no splits remaining to find.
+	 */
+	newSegments := make([]*Segment, 0)
+	for _, seg := range cdt.segments {
+		encroachPt := cdt.findNonGabrielPoint(seg)
+		// no encroachment found - segment must already be in subdivision
+		if encroachPt == nil {
+			segments = append(segments, seg)
+			continue
+		}
+
+		// compute split point
+		cdt.splitPt = cdt.splitFinder(seg, encroachPt)
+		conformingDelaunayTriangulatorInterpolateZ(seg, cdt.splitPt)
+		splitVertex := cdt.createVertex(cdt.splitPt, seg)
+
+		/**
+		 * Check whether the inserted point still equals the split pt. This will
+		 * not be the case if the split pt was too close to an existing site. If
+		 * the point was snapped, the triangulation will not respect the inserted
+		 * constraint - this is a failure. This can be caused by:
+		 * <ul>
+		 * <li>An initial site that lies very close to a constraint segment The
+		 * cure for this is to remove any initial sites which are close to
+		 * constraint segments in a preprocessing phase.
+		 * <li>A narrow constraint angle which causing repeated splitting until
+		 * the split segments are too small. The cure for this is to either choose
+		 * better split points or "guard" narrow angles by cracking the segments
+		 * equidistant from the corner.
+		 * </ul>
+		 */
+		if _, err := cdt.insertConstraintVertex(splitVertex); err != nil {
+			return 0, err
+		}
+
+		// split segment and record the new halves
+		s1 := NewSegmentWithData(seg.GetStart(), splitVertex.GetCoordinate(), seg.GetData())
+		s2 := NewSegmentWithData(splitVertex.GetCoordinate(), seg.GetEnd(), seg.GetData())
+		newSegments = append(newSegments, s1, s2)
+		splits++
+	}
+	cdt.segments = append(segments, newSegments...)
+	return splits, nil
+}
+
+/**
+ * Sets the Z value of a split point by interpolating
+ * along the segment, if it is not already set.
+ */
+func conformingDelaunayTriangulatorInterpolateZ(seg *Segment, pt *geom.Coordinate) {
+	if !math.IsNaN(pt.Z) {
+		return
+	}
+	segLen := seg.GetLength()
+	if segLen == 0 {
+		pt.Z = seg.GetStart().Z
+		return
+	}
+	frac := pt.Distance(seg.GetStart()) / segLen
+	pt.Z = seg.GetStart().Z + frac*(seg.GetEnd().Z-seg.GetStart().Z)
+}
+
+/**
+ * Given a set of points stored in the kd-tree and a line segment defined by
+ * two points in this set, finds a {@link Coordinate} in the circumcircle of
+ * the line segment, if one exists. This is called the Gabriel point - if none
+ * exists then the segment is said to have the Gabriel condition. Uses the
+ * heuristic of finding the non-Gabriel point closest to the midpoint of the
+ * segment.
+ *
+ * @param seg the line segment
+ * @return a point which is non-Gabriel, or nil if no point is non-Gabriel
+ */
+func (cdt *ConformingDelaunayTriangulator) findNonGabrielPoint(seg *Segment) *geom.Coordinate {
+	p := seg.GetStart()
+	q := seg.GetEnd()
+	// Find the mid point on the line and compute the radius of enclosing circle
+	midPt := geom.NewCoordinateXY((p.X+q.X)/2.0, (p.Y+q.Y)/2.0)
+	segRadius := p.Distance(midPt)
+
+	// compute envelope of circumcircle
+	env := geom.NewEnvelopeFromCoordinate(midPt)
+	env.ExpandBy(segRadius)
+	// Find all points in envelope
+	result := cdt.kdt.Query(env)
+
+	// For each point found, test if it falls strictly in the circle
+	// find closest point
+	var closestNonGabriel *geom.Coordinate
+	minDist := math.MaxFloat64
+	for _, nextNode := range result {
+		testPt := nextNode.GetCoordinate()
+		// ignore segment endpoints
+		if testPt.Equals2D(p) || testPt.Equals2D(q) {
+			continue
+		}
+
+		testRadius := midPt.Distance(testPt)
+		if testRadius < segRadius {
+			testDist := testRadius
+			if closestNonGabriel == nil || testDist < minDist {
+				closestNonGabriel = testPt
+				minDist = testDist
+			}
+		}
+	}
+	return closestNonGabriel
+}
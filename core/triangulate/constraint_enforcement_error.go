@@ -0,0 +1,50 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Indicates a failure during constraint enforcement.
+ * ConstraintEnforcementError implements the <code>error</code> interface,
+ * and records the location of the failure if it is known.
+ */
+type ConstraintEnforcementError struct {
+	msg string
+	pt  *geom.Coordinate
+}
+
+/**
+ * Creates a new instance with a given message and coordinate.
+ *
+ * @param msg a string
+ * @param pt the location of the error, or nil if it is not known
+ */
+func NewConstraintEnforcementError(msg string, pt *geom.Coordinate) *ConstraintEnforcementError {
+	cee := new(ConstraintEnforcementError)
+	cee.msg = msg
+	if pt != nil {
+		cee.pt = pt.Clone()
+	}
+	return cee
+}
+
+/**
+ * Gets the approximate location of this error.
+ *
+ * @return a location, or nil if it is not known
+ */
+func (cee *ConstraintEnforcementError) GetCoordinate() *geom.Coordinate {
+	return cee.pt
+}
+
+/**
+ * Gets a message describing this error and its location,
+ * as required by the <code>error</code> interface.
+ */
+func (cee *ConstraintEnforcementError) Error() string {
+	if cee.pt == nil {
+		return cee.msg
+	}
+	return cee.msg + " [ " + cee.pt.ToString() + " ]"
+}
@@ -0,0 +1,90 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * A vertex in a Constrained Delaunay Triangulation.
+ * The vertex may or may not lie on a constraint.
+ * If it does it may carry extra information about the original constraint.
+ */
+type ConstraintVertex struct {
+	*quadedge.Vertex
+	isOnConstraint bool
+	constraint     any
+}
+
+/**
+ * A function which creates {@link ConstraintVertex}es.
+ * This allows clients to attach their own data to the vertices
+ * created during constraint enforcement.
+ *
+ * @param p the location of the vertex
+ * @param constraintSeg the constraint segment the vertex lies on, or nil
+ * @return the new vertex
+ */
+type ConstraintVertexFactory func(p *geom.Coordinate, constraintSeg *Segment) *ConstraintVertex
+
+/**
+ * Creates a new constraint vertex
+ *
+ * @param p the location of the vertex
+ */
+func NewConstraintVertex(p *geom.Coordinate) *ConstraintVertex {
+	cv := new(ConstraintVertex)
+	cv.Vertex = quadedge.NewVertexFromCoordinate(p)
+	return cv
+}
+
+/**
+ * Sets whether this vertex lies on a constraint.
+ *
+ * @param isOnConstraint true if this vertex lies on a constraint
+ */
+func (cv *ConstraintVertex) SetOnConstraint(isOnConstraint bool) {
+	cv.isOnConstraint = isOnConstraint
+}
+
+/**
+ * Tests whether this vertex lies on a constraint.
+ *
+ * @return true if the vertex lies on a constraint
+ */
+func (cv *ConstraintVertex) IsOnConstraint() bool {
+	return cv.isOnConstraint
+}
+
+/**
+ * Sets the external constraint information
+ *
+ * @param constraint an object which carries information about the constraint this vertex lies on
+ */
+func (cv *ConstraintVertex) SetConstraint(constraint any) {
+	cv.isOnConstraint = true
+	cv.constraint = constraint
+}
+
+/**
+ * Gets the external constraint object
+ *
+ * @return the external constraint object
+ */
+func (cv *ConstraintVertex) GetConstraint() any {
+	return cv.constraint
+}
+
+/**
+ * Merges the constraint data in the vertex <tt>other</tt> into this vertex.
+ * This method is called when an inserted vertex is
+ * very close to an existing vertex in the triangulation.
+ *
+ * @param other the constraint vertex to merge
+ */
+func (cv *ConstraintVertex) merge(other *ConstraintVertex) {
+	if other.isOnConstraint {
+		cv.isOnConstraint = true
+		cv.constraint = other.constraint
+	}
+}
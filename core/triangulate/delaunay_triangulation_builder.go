@@ -0,0 +1,166 @@
+package geos
+
+import (
+	"slices"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * A utility class which creates Delaunay Triangulations
+ * from collections of points and extract the resulting
+ * triangulation edges or triangles as geometries.
+ * <p>
+ * The Z values of the input sites are carried through
+ * to the output edges and triangles,
+ * so the result can be used as a TIN.
+ */
+type DelaunayTriangulationBuilder struct {
+	siteCoords []geom.Coordinate
+	tolerance  float64
+	subdiv     *quadedge.QuadEdgeSubdivision
+}
+
+/**
+ * Extracts the unique {@link Coordinate}s from the given points,
+ * sorted in XY order.
+ *
+ * @param pts the points to extract from
+ * @return the unique coordinates, sorted
+ */
+func DelaunayTriangulationBuilderExtractUniqueCoordinates(pts []geom.Coordinate) []geom.Coordinate {
+	return DelaunayTriangulationBuilderUnique(slices.Clone(pts))
+}
+
+/**
+ * Sorts an array of coordinates in place and removes the repeated points.
+ * Where points are repeated the first occurrence is kept.
+ *
+ * @param coords the coordinates to process
+ * @return the unique coordinates, sorted
+ */
+func DelaunayTriangulationBuilderUnique(coords []geom.Coordinate) []geom.Coordinate {
+	slices.SortStableFunc(coords, func(a geom.Coordinate, b geom.Coordinate) int {
+		return a.CompareTo(&b)
+	})
+	return slices.CompactFunc(coords, func(a geom.Coordinate, b geom.Coordinate) bool {
+		return a.Equals2D(&b)
+	})
+}
+
+/**
+ * Converts all {@link Coordinate}s in a collection to {@link quadedge.Vertex}es.
+ *
+ * @param coords the coordinates to convert
+ * @return a list of Vertex objects
+ */
+func DelaunayTriangulationBuilderToVertices(coords []geom.Coordinate) []*quadedge.Vertex {
+	verts := make([]*quadedge.Vertex, 0, len(coords))
+	for i := range coords {
+		verts = append(verts, quadedge.NewVertexFromCoordinate(&coords[i]))
+	}
+	return verts
+}
+
+/**
+ * Computes the {@link Envelope} of a collection of {@link Coordinate}s.
+ *
+ * @param coords a list of Coordinates
+ * @return the envelope of the set of coordinates
+ */
+func DelaunayTriangulationBuilderEnvelope(coords []geom.Coordinate) *geom.Envelope {
+	return geom.NewEnvelopeFromCoordinateArray(coords)
+}
+
+/**
+ * Creates a new triangulation builder.
+ */
+func NewDelaunayTriangulationBuilder() *DelaunayTriangulationBuilder {
+	builder := new(DelaunayTriangulationBuilder)
+	return builder
+}
+
+/**
+ * Sets the sites (vertices) which will be triangulated.
+ * Repeated points are removed.
+ *
+ * @param coords the points to triangulate
+ */
+func (builder *DelaunayTriangulationBuilder) SetSites(coords []geom.Coordinate) {
+	// remove any duplicate points (they will cause the triangulation to fail)
+	builder.siteCoords = DelaunayTriangulationBuilderExtractUniqueCoordinates(coords)
+	builder.subdiv = nil
+}
+
+/**
+ * Sets the snapping tolerance which will be used
+ * to improved the robustness of the triangulation computation.
+ * A tolerance of 0.0 specifies that no snapping will take place.
+ *
+ * @param tolerance the tolerance distance to use
+ */
+func (builder *DelaunayTriangulationBuilder) SetTolerance(tolerance float64) {
+	builder.tolerance = tolerance
+	builder.subdiv = nil
+}
+
+func (builder *DelaunayTriangulationBuilder) create() error {
+	if builder.subdiv != nil {
+		return nil
+	}
+	siteEnv := DelaunayTriangulationBuilderEnvelope(builder.siteCoords)
+	vertices := DelaunayTriangulationBuilderToVertices(builder.siteCoords)
+	subdiv := quadedge.NewQuadEdgeSubdivision(siteEnv, builder.tolerance)
+	triangulator := NewIncrementalDelaunayTriangulator(subdiv)
+	if err := triangulator.InsertSites(vertices); err != nil {
+		return err
+	}
+	builder.subdiv = subdiv
+	return nil
+}
+
+/**
+ * Gets the {@link quadedge.QuadEdgeSubdivision} which models the computed triangulation.
+ * An error is returned if the triangulation cannot be computed.
+ *
+ * @return the subdivision containing the triangulation
+ */
+func (builder *DelaunayTriangulationBuilder) GetSubdivision() (*quadedge.QuadEdgeSubdivision, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	return builder.subdiv, nil
+}
+
+/**
+ * Gets the edges of the computed triangulation,
+ * each as a line of 2 coordinates.
+ * An error is returned if the triangulation cannot be computed.
+ *
+ * @return the edges of the triangulation
+ */
+func (builder *DelaunayTriangulationBuilder) GetEdges() ([][]geom.Coordinate, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	return builder.subdiv.GetEdgeCoordinates(), nil
+}
+
+/**
+ * Gets the triangles of the computed triangulation,
+ * each as a closed clockwise ring of 4 coordinates.
+ * An error is returned if the triangulation cannot be computed.
+ *
+ * @return the triangles of the triangulation
+ */
+func (builder *DelaunayTriangulationBuilder) GetTriangles() ([][]geom.Coordinate, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	triPts := builder.subdiv.GetTriangleCoordinates(false)
+	for _, pts := range triPts {
+		geom.ReverseCoordinates(pts)
+	}
+	return triPts, nil
+}
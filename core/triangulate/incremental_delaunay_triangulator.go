@@ -0,0 +1,203 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * Computes a Delaunay Triangulation of a set of {@link quadedge.Vertex}es,
+ * using an incremental insertion algorithm.
+ * <p>
+ * By default the triangulation boundary is convex.
+ * This may produce triangles with small area which are not
+ * wanted by some clients. {@link #ForceConvex(bool)} can be
+ * used to disable convexity enforcement.
+ */
+type IncrementalDelaunayTriangulator struct {
+	subdiv           *quadedge.QuadEdgeSubdivision
+	isUsingTolerance bool
+	isForceConvex    bool
+}
+
+/**
+ * Creates a new triangulator using the given {@link quadedge.QuadEdgeSubdivision}.
+ * The triangulator uses the tolerance of the supplied subdivision.
+ *
+ * @param subdiv
+ *          a subdivision in which to build the TIN
+ */
+func NewIncrementalDelaunayTriangulator(subdiv *quadedge.QuadEdgeSubdivision) *IncrementalDelaunayTriangulator {
+	idt := new(IncrementalDelaunayTriangulator)
+	idt.subdiv = subdiv
+	idt.isUsingTolerance = subdiv.GetTolerance() > 0.0
+	idt.isForceConvex = true
+	return idt
+}
+
+/**
+ * Sets whether the triangulation is forced to have a convex boundary.
+ * Because of the use of a finite-size frame, this condition requires
+ * special logic to enforce.
+ * The default is true, since this is a requirement for some uses of
+ * Delaunay Triangulations (such as Concave Hull generation).
+ * However, forcing the triangulation boundary to be convex
+ * may cause the triangulation to contain very small triangles.
+ *
+ * @param isForceConvex true if the triangulation boundary is forced to be convex
+ */
+func (idt *IncrementalDelaunayTriangulator) ForceConvex(isForceConvex bool) {
+	idt.isForceConvex = isForceConvex
+}
+
+/**
+ * Inserts all sites in a collection. The inserted vertices <b>MUST</b> be
+ * unique up to the provided tolerance value. (i.e. no two vertices should be
+ * closer than the provided tolerance value). They do not have to be rounded
+ * to the tolerance grid, however.
+ *
+ * @param vertices a list of Vertex
+ * @return an error if a location failure occurs
+ */
+func (idt *IncrementalDelaunayTriangulator) InsertSites(vertices []*quadedge.Vertex) error {
+	for _, v := range vertices {
+		if _, err := idt.InsertSite(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Inserts a new point into a subdivision representing a Delaunay
+ * triangulation, and fixes the affected edges so that the result is still a
+ * Delaunay triangulation.
+ * <p>
+ *
+ * @return a quadedge containing the inserted vertex
+ */
+func (idt *IncrementalDelaunayTriangulator) InsertSite(v *quadedge.Vertex) (*quadedge.QuadEdge, error) {
+	/**
+	 * This code is based on Guibas and Stolfi (1985), with minor modifications
+	 * and a bug fix from Dani Lischinski (Graphic Gems 1993). (The modification
+	 * I believe is the test for the inserted site falling exactly on an
+	 * existing edge. Without this test zero-width triangles have been observed
+	 * to be created)
+	 */
+	e, err := idt.subdiv.Locate(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if idt.subdiv.IsVertexOfEdge(e, v) {
+		// point is already in subdivision.
+		return e, nil
+	} else if idt.subdiv.IsOnEdge(e, v.GetCoordinate()) {
+		// the point lies exactly on an edge, so delete the edge
+		// (it will be replaced by a pair of edges which have the point as a vertex)
+		e = e.OPrev()
+		idt.subdiv.Delete(e.ONext())
+	}
+
+	/**
+	 * Connect the new point to the vertices of the containing triangle
+	 * (or quadrilateral, if the new point fell on an existing edge.)
+	 */
+	base := idt.subdiv.MakeEdge(e.Orig(), v)
+	quadedge.QuadEdgeSplice(base, e)
+	startEdge := base
+	for {
+		base = idt.subdiv.Connect(e, base.Sym())
+		e = base.OPrev()
+		if e.LNext() == startEdge {
+			break
+		}
+	}
+
+	/**
+	 * Examine suspect edges to ensure that the Delaunay condition is satisfied.
+	 * If it is not, flip the edge and continue scanning.
+	 *
+	 * Since the frame is not infinitely far away,
+	 * edges which touch the frame or are adjacent to it require special logic
+	 * to ensure the inner triangulation maintains a convex boundary.
+	 */
+	for {
+		//-- general case - flip if vertex is in circumcircle
+		t := e.OPrev()
+		doFlip := t.Dest().RightOf(e) &&
+			v.IsInCircle(e.Orig(), t.Dest(), e.Dest())
+
+		if idt.isForceConvex {
+			//-- special cases to ensure triangulation boundary is convex
+			if idt.isConcaveBoundary(e) {
+				//-- flip if the triangulation boundary is concave
+				doFlip = true
+			} else if idt.isBetweenFrameAndInserted(e, v) {
+				//-- don't flip if edge lies between the inserted vertex and a frame vertex
+				doFlip = false
+			}
+		}
+
+		if doFlip {
+			//-- flip the edge within its quadrilateral
+			quadedge.QuadEdgeSwap(e)
+			e = e.OPrev()
+			continue
+		}
+
+		if e.ONext() == startEdge {
+			return base, nil // no more suspect edges.
+		}
+		//-- check next edge
+		e = e.ONext().LPrev()
+	}
+}
+
+/**
+ * Tests if a edge touching a frame vertex
+ * creates a concavity in the triangulation boundary.
+ *
+ * @param e the edge to test
+ * @return true if the triangulation boundary is concave at the edge
+ */
+func (idt *IncrementalDelaunayTriangulator) isConcaveBoundary(e *quadedge.QuadEdge) bool {
+	if idt.subdiv.IsFrameVertex(e.Dest()) {
+		return incrementalDelaunayIsConcaveAtOrigin(e)
+	}
+	if idt.subdiv.IsFrameVertex(e.Orig()) {
+		return incrementalDelaunayIsConcaveAtOrigin(e.Sym())
+	}
+	return false
+}
+
+/**
+ * Tests if the quadrilateral surrounding an edge is concave at the edge origin.
+ * Used to determine if the triangulation boundary has a concavity.
+ *
+ * @param e the edge to test
+ * @return true if the quadrilateral is concave at the edge origin
+ */
+func incrementalDelaunayIsConcaveAtOrigin(e *quadedge.QuadEdge) bool {
+	p := e.Orig().GetCoordinate()
+	pp := e.OPrev().Dest().GetCoordinate()
+	pn := e.ONext().Dest().GetCoordinate()
+	isConcave := constants.ORIENTATION_COUNTERCLOCKWISE == algorithm.OrientationIndex(pp, pn, p)
+	return isConcave
+}
+
+/**
+ * Edges whose adjacent triangles contain
+ * a frame vertex and the inserted vertex must not be flipped.
+ *
+ * @param e the edge to test
+ * @param vInsert the inserted vertex
+ * @return true if the edge is between the frame and inserted vertex
+ */
+func (idt *IncrementalDelaunayTriangulator) isBetweenFrameAndInserted(e *quadedge.QuadEdge, vInsert *quadedge.Vertex) bool {
+	v1 := e.ONext().Dest()
+	v2 := e.OPrev().Dest()
+	return (v1 == vInsert && idt.subdiv.IsFrameVertex(v2)) ||
+		(v2 == vInsert && idt.subdiv.IsFrameVertex(v1))
+}
@@ -0,0 +1,95 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Computes the Constrained Delaunay Triangulation of polygons.
+ * The Constrained Delaunay Triangulation of a polygon is a set of triangles
+ * covering the polygon, with the maximum total interior angle over all
+ * possible triangulations.  It provides the "best quality" triangulation
+ * of the polygon.
+ * <p>
+ * Holes are supported.
+ */
+type ConstrainedDelaunayTriangulator struct {
+	inputPolygons [][][]geom.Coordinate
+	triList       []*tri.Tri
+}
+
+/**
+ * Computes the Constrained Delaunay Triangulation of each polygon in a list.
+ *
+ * @param polygons the input polygons, each a shell followed by holes
+ * @return the triangles, each as a closed ring
+ */
+func ConstrainedDelaunayTriangulatorTriangulate(polygons [][][]geom.Coordinate) ([][]geom.Coordinate, error) {
+	cdt := NewConstrainedDelaunayTriangulator(polygons)
+	return cdt.GetResult()
+}
+
+/**
+ * Computes the triangulation of a single polygon,
+ * and returns the triangles linked into a triangulation.
+ *
+ * @param polygon the polygon, as a shell followed by holes
+ * @return the triangles of the triangulation
+ */
+func ConstrainedDelaunayTriangulatorTriangulatePolygon(polygon [][]geom.Coordinate) ([]*tri.Tri, error) {
+	polyShell, err := PolygonHoleJoinerJoin(polygon)
+	if err != nil {
+		return nil, err
+	}
+	triList, err := PolygonEarClipperTriangulate(polyShell)
+	if err != nil {
+		return nil, err
+	}
+	tri.TriangulationBuilderBuild(triList)
+	TriDelaunayImproverImprove(triList)
+	return triList, nil
+}
+
+/**
+ * Constructs a new Constrained Delaunay triangulator.
+ *
+ * @param polygons the input polygons, each a shell followed by holes
+ */
+func NewConstrainedDelaunayTriangulator(polygons [][][]geom.Coordinate) *ConstrainedDelaunayTriangulator {
+	cdt := new(ConstrainedDelaunayTriangulator)
+	cdt.inputPolygons = polygons
+	return cdt
+}
+
+/**
+ * Gets the triangulation as a list of triangles,
+ * each a closed clockwise ring.
+ *
+ * @return the triangles of the triangulation
+ */
+func (cdt *ConstrainedDelaunayTriangulator) GetResult() ([][]geom.Coordinate, error) {
+	if err := cdt.compute(); err != nil {
+		return nil, err
+	}
+	return tri.TriToRings(cdt.triList), nil
+}
+
+func (cdt *ConstrainedDelaunayTriangulator) compute() error {
+	if cdt.triList != nil {
+		return nil
+	}
+	triList := make([]*tri.Tri, 0)
+	for _, polygon := range cdt.inputPolygons {
+		if len(polygon) == 0 || len(polygon[0]) == 0 {
+			continue
+		}
+		polyTriList, err := ConstrainedDelaunayTriangulatorTriangulatePolygon(polygon)
+		if err != nil {
+			return err
+		}
+		triList = append(triList, polyTriList...)
+	}
+	cdt.triList = triList
+	return nil
+}
@@ -0,0 +1,146 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Triangulates a polygon via ear-clipping,
+ * and provides the triangles as triples of indices
+ * into the vertex array of the polygon.
+ * This is suitable for rendering pipelines which
+ * consume indexed vertex buffers.
+ * <p>
+ * The vertex array is formed by concatenating the rings of the polygon
+ * in order (shell first), omitting the closing point of each ring.
+ * Where a vertex location occurs more than once
+ * the index of the first occurrence is used.
+ * If a hole touches the shell or another hole in the interior of a segment,
+ * the touch point is appended to the end of the vertex array.
+ * <p>
+ * Holes are supported, by joining them to the shell with {@link PolygonHoleJoiner}.
+ * The triangles have clockwise orientation.
+ */
+type IndexedPolygonTriangulator struct {
+	inputPolygon         [][]geom.Coordinate
+	isFlatCornersSkipped bool
+
+	vertices []geom.Coordinate
+	triIndex [][3]int
+}
+
+/**
+ * Computes the indexed triangulation of a polygon.
+ *
+ * @param polygon the polygon, as a shell followed by holes
+ * @return the triangles, as triples of vertex indices
+ */
+func IndexedPolygonTriangulatorTriangulate(polygon [][]geom.Coordinate) ([][3]int, error) {
+	triangulator := NewIndexedPolygonTriangulator(polygon)
+	return triangulator.GetResult()
+}
+
+/**
+ * Constructs a new indexed triangulator for a polygon.
+ *
+ * @param polygon the polygon, as a shell followed by holes
+ */
+func NewIndexedPolygonTriangulator(polygon [][]geom.Coordinate) *IndexedPolygonTriangulator {
+	triangulator := new(IndexedPolygonTriangulator)
+	triangulator.inputPolygon = polygon
+	return triangulator
+}
+
+/**
+ * Sets whether flat corners formed by collinear adjacent line segments
+ * are included in the triangulation.
+ * Skipping flat corners reduces the number of triangles in the output,
+ * but some vertices are then not referenced by any triangle.
+ *
+ * @param isFlatCornersSkipped whether to skip collinear vertices
+ * @see PolygonEarClipper#SetSkipFlatCorners
+ */
+func (triangulator *IndexedPolygonTriangulator) SetSkipFlatCorners(isFlatCornersSkipped bool) {
+	triangulator.isFlatCornersSkipped = isFlatCornersSkipped
+	triangulator.triIndex = nil
+}
+
+/**
+ * Gets the triangles of the triangulation,
+ * each as a triple of indices into the vertex array
+ * in clockwise order.
+ * An error is returned if the polygon cannot be triangulated.
+ *
+ * @return the triangles, as triples of vertex indices
+ */
+func (triangulator *IndexedPolygonTriangulator) GetResult() ([][3]int, error) {
+	if err := triangulator.compute(); err != nil {
+		return nil, err
+	}
+	return triangulator.triIndex, nil
+}
+
+/**
+ * Gets the vertex array indexed by the triangles.
+ * This contains the polygon ring vertices without the closing points,
+ * followed by any touch points added by hole joining.
+ * An error is returned if the polygon cannot be triangulated.
+ *
+ * @return the vertices of the triangulation
+ */
+func (triangulator *IndexedPolygonTriangulator) GetVertices() ([]geom.Coordinate, error) {
+	if err := triangulator.compute(); err != nil {
+		return nil, err
+	}
+	return triangulator.vertices, nil
+}
+
+func (triangulator *IndexedPolygonTriangulator) compute() error {
+	if triangulator.triIndex != nil {
+		return nil
+	}
+	vertices := make([]geom.Coordinate, 0)
+	vertexIndex := make(map[[2]float64]int)
+	for _, ring := range triangulator.inputPolygon {
+		for i := 0; i < len(ring)-1; i++ {
+			key := [2]float64{ring[i].X, ring[i].Y}
+			if _, ok := vertexIndex[key]; !ok {
+				vertexIndex[key] = len(vertices)
+			}
+			vertices = append(vertices, ring[i])
+		}
+	}
+
+	triIndex := make([][3]int, 0)
+	if len(triangulator.inputPolygon) > 0 && len(triangulator.inputPolygon[0]) > 0 {
+		polyShell, err := PolygonHoleJoinerJoin(triangulator.inputPolygon)
+		if err != nil {
+			return err
+		}
+		clipper := NewPolygonEarClipper(polyShell)
+		clipper.SetSkipFlatCorners(triangulator.isFlatCornersSkipped)
+		triList, err := clipper.Compute()
+		if err != nil {
+			return err
+		}
+		for _, t := range triList {
+			var index [3]int
+			for i := 0; i < 3; i++ {
+				p := t.GetCoordinate(i)
+				key := [2]float64{p.X, p.Y}
+				vi, ok := vertexIndex[key]
+				if !ok {
+					//-- a touch point created by noding the rings
+					vi = len(vertices)
+					vertexIndex[key] = vi
+					vertices = append(vertices, *p)
+				}
+				index[i] = vi
+			}
+			triIndex = append(triIndex, index)
+		}
+	}
+	triangulator.vertices = vertices
+	triangulator.triIndex = triIndex
+	return nil
+}
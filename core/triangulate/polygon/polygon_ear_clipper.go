@@ -0,0 +1,406 @@
+package geos
+
+import (
+	"errors"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadtree "github.com/UltimateThread/geos-go/core/index/quadtree"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+const polygonEarClipper_NO_VERTEX_INDEX = -1
+
+/**
+ * Triangulates a polygon using the Ear-Clipping technique.
+ * The polygon is provided as a closed list of contiguous vertices
+ * defining its boundary.
+ * The vertices must have clockwise orientation.
+ * <p>
+ * The polygon boundary must not self-cross,
+ * but may self-touch at points or along an edge.
+ * It may contain repeated points, which are treated as a single vertex.
+ * By default every vertex is triangulated,
+ * including ones which are "flat" (the adjacent segments are collinear).
+ * These can be removed by setting {@link #SetSkipFlatCorners(bool)}
+ * <p>
+ * The polygon representation does not allow holes.
+ * Polygons with holes can be triangulated by preparing them
+ * with {@link PolygonHoleJoiner}.
+ */
+type PolygonEarClipper struct {
+	isFlatCornersSkipped bool
+
+	/**
+	 * The polygon vertices are provided in CW orientation.
+	 * Thus for convex interior angles
+	 * the vertices forming the angle are in CW orientation.
+	 */
+	vertex []geom.Coordinate
+
+	vertexNext []int
+	vertexSize int
+	// first available vertex index
+	vertexFirst int
+
+	// indices for current corner
+	cornerIndex [3]int
+
+	/**
+	 * Indexing vertices improves ear intersection testing performance.
+	 * The polyShell vertices are contiguous, so are suitable for an SPRtree.
+	 */
+	vertexCoordIndex *quadtree.Quadtree[int]
+}
+
+/**
+ * Triangulates a polygon via ear-clipping.
+ *
+ * @param polyShell the vertices of the polygon
+ * @return a list of the Tris
+ */
+func PolygonEarClipperTriangulate(polyShell []geom.Coordinate) ([]*tri.Tri, error) {
+	clipper := NewPolygonEarClipper(polyShell)
+	return clipper.Compute()
+}
+
+/**
+ * Creates a new ear-clipper instance.
+ *
+ * @param polyShell the polygon vertices to process
+ */
+func NewPolygonEarClipper(polyShell []geom.Coordinate) *PolygonEarClipper {
+	clipper := new(PolygonEarClipper)
+	clipper.vertex = polyShell
+
+	// init working storage
+	clipper.vertexSize = len(polyShell) - 1
+	clipper.vertexNext = polygonEarClipperCreateNextLinks(clipper.vertexSize)
+	clipper.vertexFirst = 0
+
+	clipper.vertexCoordIndex = quadtree.NewQuadtree[int]()
+	for i := 0; i < clipper.vertexSize; i++ {
+		clipper.vertexCoordIndex.Insert(geom.NewEnvelopeFromCoordinate(&polyShell[i]), i)
+	}
+	return clipper
+}
+
+func polygonEarClipperCreateNextLinks(size int) []int {
+	next := make([]int, max(size, 0))
+	for i := 0; i < size; i++ {
+		next[i] = i + 1
+	}
+	if size > 0 {
+		next[size-1] = 0
+	}
+	return next
+}
+
+/**
+ * Sets whether flat corners formed by collinear adjacent line segments
+ * are included in the triangulation.
+ * Skipping flat corners reduces the number of triangles in the output.
+ * However, it produces a triangulation which does not include
+ * all input vertices.  This may be undesirable for downstream processes
+ * (such as computing a Constrained Delaunay Triangulation for
+ * purposes of computing the medial axis).
+ * <p>
+ * The default is to include all vertices in the result triangulation.
+ * This still produces a valid triangulation, with no zero-area triangles.
+ * <p>
+ * Note that repeated vertices are always skipped.
+ *
+ * @param isFlatCornersSkipped whether to skip collinear vertices
+ */
+func (clipper *PolygonEarClipper) SetSkipFlatCorners(isFlatCornersSkipped bool) {
+	clipper.isFlatCornersSkipped = isFlatCornersSkipped
+}
+
+/**
+ * Computes the triangulation of the polygon.
+ * An error is returned if the polygon ring is invalid,
+ * so that no triangulation can be found.
+ *
+ * @return the triangles of the triangulation
+ */
+func (clipper *PolygonEarClipper) Compute() ([]*tri.Tri, error) {
+	triList := make([]*tri.Tri, 0)
+	if clipper.vertexSize < 3 {
+		return triList, nil
+	}
+
+	/**
+	 * Count scanned corners, to catch infinite loops
+	 * (which indicate an algorithm bug)
+	 */
+	cornerScanCount := 0
+
+	clipper.initCornerIndex()
+	corner := make([]geom.Coordinate, 3)
+	clipper.fetchCorner(corner)
+
+	/**
+	 * Scan continuously around vertex ring,
+	 * until all ears have been found.
+	 */
+	for {
+		/**
+		 * Non-convex corner- remove if flat, or skip
+		 * (a concave corner will turn into a convex corner
+		 * after enough ears are removed)
+		 */
+		if !polygonEarClipperIsConvex(corner) {
+			// remove the corner if it is invalid or flat (if required)
+			isCornerRemoved := polygonEarClipperIsCornerInvalid(corner) ||
+				(clipper.isFlatCornersSkipped && polygonEarClipperIsFlat(corner))
+			if isCornerRemoved {
+				clipper.removeCorner()
+			}
+			cornerScanCount++
+			if cornerScanCount > 2*clipper.vertexSize {
+				return nil, errors.New("unable to find a convex corner")
+			}
+		} else if clipper.isValidEar(clipper.cornerIndex[1], corner) {
+			/**
+			 * Convex corner - check if it is a valid ear
+			 */
+			triList = append(triList, tri.NewTriFromCoordinates(corner))
+			clipper.removeCorner()
+			cornerScanCount = 0
+		}
+		if cornerScanCount > 2*clipper.vertexSize {
+			return nil, errors.New("unable to find a valid ear")
+		}
+
+		//--- done when all corners are processed and removed
+		if clipper.vertexSize < 3 {
+			return triList, nil
+		}
+
+		/**
+		 * Skip to next corner.
+		 * This is done even after an ear is removed,
+		 * since that creates fewer skinny triangles.
+		 */
+		clipper.nextCorner(corner)
+	}
+}
+
+func (clipper *PolygonEarClipper) isValidEar(cornerIdx int, corner []geom.Coordinate) bool {
+	intApexIndex := clipper.findIntersectingVertex(cornerIdx, corner)
+	//--- no intersections found
+	if intApexIndex == polygonEarClipper_NO_VERTEX_INDEX {
+		return true
+	}
+	//--- check for duplicate corner apex vertex
+	if clipper.vertex[intApexIndex].Equals2D(&corner[1]) {
+		//--- a duplicate corner vertex requires a full scan
+		return clipper.isValidEarScan(cornerIdx, corner)
+	}
+	return false
+}
+
+/**
+ * Finds another vertex intersecting the corner triangle, if any.
+ * Uses the vertex spatial index for efficiency.
+ * <p>
+ * Also finds any vertex which is a duplicate of the corner apex vertex,
+ * which then requires a full scan of the vertices to confirm ear is valid.
+ * This is usually a rare situation, so has little impact on performance.
+ *
+ * @param cornerIdx the index of the corner apex vertex
+ * @param corner the corner vertices
+ * @return the index of an intersecting or duplicate vertex, or {@link #NO_VERTEX_INDEX} if none
+ */
+func (clipper *PolygonEarClipper) findIntersectingVertex(cornerIdx int, corner []geom.Coordinate) int {
+	cornerEnv := geom.NewEnvelopeFromCoordinateArray(corner)
+
+	dupApexIndex := polygonEarClipper_NO_VERTEX_INDEX
+	intIndex := polygonEarClipper_NO_VERTEX_INDEX
+	clipper.vertexCoordIndex.QueryVisitor(cornerEnv, func(vertIndex int) bool {
+		if vertIndex == cornerIdx || clipper.isRemoved(vertIndex) {
+			return true
+		}
+		v := &clipper.vertex[vertIndex]
+		if !cornerEnv.IntersectsCoordinate(v) {
+			return true
+		}
+		/**
+		 * If another vertex at the corner is found,
+		 * need to do a full scan to check the incident segments.
+		 * This happens when the polygon ring self-intersects,
+		 * usually due to hole joining.
+		 * But only report this if no properly intersecting vertex is found,
+		 * for efficiency.
+		 */
+		if v.Equals2D(&corner[1]) {
+			dupApexIndex = vertIndex
+		} else if v.Equals2D(&corner[0]) || v.Equals2D(&corner[2]) {
+			//--- don't need to check other corner vertices
+			return true
+		} else if polygonEarClipperTriangleIntersects(&corner[0], &corner[1], &corner[2], v) {
+			//--- this is a properly intersecting vertex
+			intIndex = vertIndex
+			return false
+		}
+		return true
+	})
+	if intIndex != polygonEarClipper_NO_VERTEX_INDEX {
+		return intIndex
+	}
+	return dupApexIndex
+}
+
+/**
+ * Scan all vertices in current ring to check if any are duplicates
+ * of the corner apex vertex, and if so whether the corner ear
+ * intersects the adjacent segments and thus is invalid.
+ *
+ * @param cornerIdx the index of the corner apex
+ * @param corner the corner vertices
+ * @return true if the corner ia a valid ear
+ */
+func (clipper *PolygonEarClipper) isValidEarScan(cornerIdx int, corner []geom.Coordinate) bool {
+	cornerAngle := algorithm.AngleBetweenOriented(&corner[0], &corner[1], &corner[2])
+
+	currIndex := clipper.nextIndex(clipper.vertexFirst)
+	prevIndex := clipper.vertexFirst
+	vPrev := &clipper.vertex[prevIndex]
+	for i := 0; i < clipper.vertexSize; i++ {
+		v := &clipper.vertex[currIndex]
+		/**
+		 * Because of hole-joining vertices can occur more than once.
+		 * If vertex is same as corner[1],
+		 * check whether either adjacent edge lies inside the ear corner.
+		 * If so the ear is invalid.
+		 */
+		if currIndex != cornerIdx && v.Equals2D(&corner[1]) {
+			vNext := &clipper.vertex[clipper.nextIndex(currIndex)]
+
+			//TODO: for robustness use segment orientation instead
+			aOut := algorithm.AngleBetweenOriented(&corner[0], &corner[1], vNext)
+			aIn := algorithm.AngleBetweenOriented(&corner[0], &corner[1], vPrev)
+			if aOut > 0 && aOut < cornerAngle {
+				return false
+			}
+			if aIn > 0 && aIn < cornerAngle {
+				return false
+			}
+			if aOut == 0 && aIn == cornerAngle {
+				return false
+			}
+		}
+
+		//--- move to next vertex
+		vPrev = v
+		currIndex = clipper.nextIndex(currIndex)
+	}
+	return true
+}
+
+/**
+ * Remove the corner apex vertex and update the candidate corner location.
+ */
+func (clipper *PolygonEarClipper) removeCorner() {
+	cornerApexIndex := clipper.cornerIndex[1]
+	if clipper.vertexFirst == cornerApexIndex {
+		clipper.vertexFirst = clipper.vertexNext[cornerApexIndex]
+	}
+	clipper.vertexNext[clipper.cornerIndex[0]] = clipper.vertexNext[cornerApexIndex]
+	clipper.vertexCoordIndex.Remove(geom.NewEnvelopeFromCoordinate(&clipper.vertex[cornerApexIndex]), cornerApexIndex)
+	clipper.vertexNext[cornerApexIndex] = polygonEarClipper_NO_VERTEX_INDEX
+	clipper.vertexSize--
+	//-- adjust following corner indexes
+	clipper.cornerIndex[1] = clipper.nextIndex(clipper.cornerIndex[0])
+	clipper.cornerIndex[2] = clipper.nextIndex(clipper.cornerIndex[1])
+}
+
+func (clipper *PolygonEarClipper) isRemoved(vertexIndex int) bool {
+	return polygonEarClipper_NO_VERTEX_INDEX == clipper.vertexNext[vertexIndex]
+}
+
+func (clipper *PolygonEarClipper) initCornerIndex() {
+	clipper.cornerIndex[0] = 0
+	clipper.cornerIndex[1] = 1
+	clipper.cornerIndex[2] = 2
+}
+
+/**
+ * Fetch the corner vertices from the indices.
+ *
+ * @param cornerVertex an array for the corner vertices
+ */
+func (clipper *PolygonEarClipper) fetchCorner(cornerVertex []geom.Coordinate) {
+	cornerVertex[0] = clipper.vertex[clipper.cornerIndex[0]]
+	cornerVertex[1] = clipper.vertex[clipper.cornerIndex[1]]
+	cornerVertex[2] = clipper.vertex[clipper.cornerIndex[2]]
+}
+
+/**
+ * Move to next corner.
+ */
+func (clipper *PolygonEarClipper) nextCorner(cornerVertex []geom.Coordinate) {
+	if clipper.vertexSize < 3 {
+		return
+	}
+	clipper.cornerIndex[0] = clipper.nextIndex(clipper.cornerIndex[0])
+	clipper.cornerIndex[1] = clipper.nextIndex(clipper.cornerIndex[0])
+	clipper.cornerIndex[2] = clipper.nextIndex(clipper.cornerIndex[1])
+	clipper.fetchCorner(cornerVertex)
+}
+
+/**
+ * Get the index of the next available shell coordinate starting from the given
+ * index.
+ *
+ * @param index candidate position
+ * @return index of the next available shell coordinate
+ */
+func (clipper *PolygonEarClipper) nextIndex(index int) int {
+	return clipper.vertexNext[index]
+}
+
+func polygonEarClipperIsConvex(pts []geom.Coordinate) bool {
+	return constants.ORIENTATION_CLOCKWISE == algorithm.OrientationIndex(&pts[0], &pts[1], &pts[2])
+}
+
+func polygonEarClipperIsFlat(pts []geom.Coordinate) bool {
+	return constants.ORIENTATION_COLLINEAR == algorithm.OrientationIndex(&pts[0], &pts[1], &pts[2])
+}
+
+/**
+ * Detects if a corner has repeated points (AAB or ABB), or is collapsed (ABA).
+ *
+ * @param pts the corner points
+ * @return true if the corner is flat or collapsed
+ */
+func polygonEarClipperIsCornerInvalid(pts []geom.Coordinate) bool {
+	return pts[1].Equals2D(&pts[0]) || pts[1].Equals2D(&pts[2]) || pts[0].Equals2D(&pts[2])
+}
+
+/**
+ * Tests whether a triangle intersects a point.
+ *
+ * @param a a vertex of the triangle
+ * @param b a vertex of the triangle
+ * @param c a vertex of the triangle
+ * @param p the point to test
+ * @return true if the triangle intersects the point
+ */
+func polygonEarClipperTriangleIntersects(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate, p *geom.Coordinate) bool {
+	exteriorIndex := constants.ORIENTATION_COUNTERCLOCKWISE
+	if algorithm.OrientationIndex(a, b, c) == constants.ORIENTATION_COUNTERCLOCKWISE {
+		exteriorIndex = constants.ORIENTATION_CLOCKWISE
+	}
+	if exteriorIndex == algorithm.OrientationIndex(a, b, p) {
+		return false
+	}
+	if exteriorIndex == algorithm.OrientationIndex(b, c, p) {
+		return false
+	}
+	if exteriorIndex == algorithm.OrientationIndex(c, a, p) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,459 @@
+package geos
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+/**
+ * Transforms a polygon with holes into a single self-touching (invalid) ring
+ * by joining holes to the exterior shell or to another hole
+ * with out-and-back line segments.
+ * The holes are added in order of their envelopes (leftmost/lowest first).
+ * As the resulting shell develops, a hole may be added to what was
+ * originally another hole.
+ * <p>
+ * There is no attempt to optimize the quality of the join lines.
+ * In particular, holes may be joined by lines longer than is optimal.
+ * However, holes which touch the shell or other holes are joined at the touch point.
+ * <p>
+ * The class does not require the input polygon to have normal
+ * orientation (shell CW and rings CCW).
+ * The output ring is always CW.
+ */
+type PolygonHoleJoiner struct {
+	inputPolygon [][]geom.Coordinate
+
+	//-- normalized, sorted and noded polygon rings
+	shellRing []geom.Coordinate
+	holeRings [][]geom.Coordinate
+
+	//-- indicates whether a hole should be testing for touching
+	isHoleTouchingHint []bool
+
+	joinedRing []geom.Coordinate
+	// a sorted and searchable version of the joinedRing
+	joinedPts []geom.Coordinate
+
+	boundarySegs  [][2]geom.Coordinate
+	boundaryIndex *strtree.STRtree[int]
+}
+
+/**
+ * Joins the shell and holes of a polygon
+ * and returns the result as a single ring,
+ * oriented clockwise.
+ *
+ * @param polygon the polygon to join, as a shell followed by holes
+ * @return the result ring
+ */
+func PolygonHoleJoinerJoin(polygon [][]geom.Coordinate) ([]geom.Coordinate, error) {
+	joiner := NewPolygonHoleJoiner(polygon)
+	return joiner.Compute()
+}
+
+/**
+ * Creates a new hole joiner.
+ *
+ * @param polygon the polygon to join, as a shell followed by holes
+ */
+func NewPolygonHoleJoiner(polygon [][]geom.Coordinate) *PolygonHoleJoiner {
+	joiner := new(PolygonHoleJoiner)
+	joiner.inputPolygon = polygon
+	return joiner
+}
+
+/**
+ * Computes the joined ring.
+ *
+ * @return the points in the joined ring
+ */
+func (joiner *PolygonHoleJoiner) Compute() ([]geom.Coordinate, error) {
+	joiner.extractOrientedRings(joiner.inputPolygon)
+	if len(joiner.holeRings) > 0 {
+		joiner.nodeRings()
+	}
+	joiner.joinedRing = slices.Clone(joiner.shellRing)
+	if len(joiner.holeRings) > 0 {
+		if err := joiner.joinHoles(); err != nil {
+			return nil, err
+		}
+	}
+	return joiner.joinedRing, nil
+}
+
+func (joiner *PolygonHoleJoiner) extractOrientedRings(polygon [][]geom.Coordinate) {
+	joiner.shellRing = polygonHoleJoinerExtractOrientedRing(polygon[0], true)
+	holes := polygonHoleJoinerSortHoles(polygon[1:])
+	joiner.holeRings = make([][]geom.Coordinate, 0, len(holes))
+	for _, hole := range holes {
+		joiner.holeRings = append(joiner.holeRings, polygonHoleJoinerExtractOrientedRing(hole, false))
+	}
+}
+
+func polygonHoleJoinerExtractOrientedRing(ring []geom.Coordinate, isCW bool) []geom.Coordinate {
+	isRingCW := !algorithm.OrientationIsCCW(ring)
+	if isCW == isRingCW {
+		return ring
+	}
+	//-- reverse a copy of the points
+	ptsRev := slices.Clone(ring)
+	slices.Reverse(ptsRev)
+	return ptsRev
+}
+
+func (joiner *PolygonHoleJoiner) nodeRings() {
+	noder := newPolygonNoder(joiner.shellRing, joiner.holeRings)
+	noder.node()
+	joiner.isHoleTouchingHint = noder.getHolesTouching()
+	if noder.isShellNoded() {
+		joiner.shellRing = noder.getNodedShell()
+	}
+	for i := range joiner.holeRings {
+		if noder.isHoleNoded(i) {
+			joiner.holeRings[i] = noder.getNodedHole(i)
+		}
+	}
+}
+
+func (joiner *PolygonHoleJoiner) joinHoles() error {
+	joiner.createBoundaryIndex()
+
+	joiner.joinedPts = make([]geom.Coordinate, 0, len(joiner.joinedRing))
+	joiner.addJoinedPts(joiner.joinedRing)
+
+	for i, hole := range joiner.holeRings {
+		if err := joiner.joinHole(i, hole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (joiner *PolygonHoleJoiner) joinHole(index int, holeCoords []geom.Coordinate) error {
+	//-- check if hole is touching
+	if joiner.isHoleTouchingHint[index] {
+		isTouching, err := joiner.joinTouchingHole(holeCoords)
+		if err != nil {
+			return err
+		}
+		if isTouching {
+			return nil
+		}
+	}
+	return joiner.joinNonTouchingHole(holeCoords)
+}
+
+/**
+ * Joins a hole to the shell only if the hole touches the shell.
+ * Otherwise, reports the hole is non-touching.
+ *
+ * @param holeCoords the hole to join
+ * @return true if the hole was touching, false if not
+ */
+func (joiner *PolygonHoleJoiner) joinTouchingHole(holeCoords []geom.Coordinate) (bool, error) {
+	holeTouchIndex := joiner.findHoleTouchIndex(holeCoords)
+
+	//-- hole does not touch
+	if holeTouchIndex < 0 {
+		return false, nil
+	}
+
+	/**
+	 * Find shell corner which contains the hole,
+	 * by finding corner which has a hole segment at the join pt in interior
+	 */
+	joinPt := &holeCoords[holeTouchIndex]
+	holeSegPt := &holeCoords[polygonHoleJoinerPrev(holeTouchIndex, len(holeCoords))]
+
+	joinIndex, err := joiner.findJoinIndex(joinPt, holeSegPt)
+	if err != nil {
+		return false, err
+	}
+	joiner.addJoinedHole(joinIndex, holeCoords, holeTouchIndex)
+	return true, nil
+}
+
+/**
+ * Finds the vertex index of a hole where it touches the
+ * current shell (if it does).
+ * If a hole does touch, it must touch at a single vertex
+ * (otherwise, the polygon is invalid).
+ *
+ * @param holeCoords the hole
+ * @return the index of the touching vertex, or -1 if no touch
+ */
+func (joiner *PolygonHoleJoiner) findHoleTouchIndex(holeCoords []geom.Coordinate) int {
+	for i := range holeCoords {
+		if joiner.containsJoinedPt(&holeCoords[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+/**
+ * Joins a single non-touching hole to the current joined ring.
+ *
+ * @param holeCoords the hole to join
+ */
+func (joiner *PolygonHoleJoiner) joinNonTouchingHole(holeCoords []geom.Coordinate) error {
+	holeJoinIndex := polygonHoleJoinerFindLowestLeftVertexIndex(holeCoords)
+	holeJoinCoord := &holeCoords[holeJoinIndex]
+	joinCoord, err := joiner.findJoinableVertex(holeJoinCoord)
+	if err != nil {
+		return err
+	}
+	joinIndex, err := joiner.findJoinIndex(joinCoord, holeJoinCoord)
+	if err != nil {
+		return err
+	}
+	joiner.addJoinedHole(joinIndex, holeCoords, holeJoinIndex)
+	return nil
+}
+
+/**
+ * Finds a shell vertex that is joinable to the hole join vertex.
+ * One must always exist, since the hole join vertex is on the left
+ * of the hole, and thus must always have at least one shell vertex visible to it.
+ * <p>
+ * There is no attempt to optimize the selection of shell vertex
+ * to join to (e.g. by choosing one with shortest distance)
+ *
+ * @param holeJoinCoord the hole join vertex
+ * @return the shell vertex to join to
+ */
+func (joiner *PolygonHoleJoiner) findJoinableVertex(holeJoinCoord *geom.Coordinate) (*geom.Coordinate, error) {
+	//-- find highest shell vertex in half-plane left of hole pt
+	index := sort.Search(len(joiner.joinedPts), func(i int) bool {
+		return joiner.joinedPts[i].X > holeJoinCoord.X
+	})
+	//-- find rightmost joinable shell vertex
+	for i := index - 1; i >= 0; i-- {
+		candidate := &joiner.joinedPts[i]
+		if !joiner.intersectsBoundary(holeJoinCoord, candidate) {
+			return candidate, nil
+		}
+	}
+	return nil, errors.New("unable to find joinable vertex")
+}
+
+/**
+ * Gets the join ring vertex index that the hole is joined after.
+ * A vertex can occur multiple times in the join ring, so it is necessary
+ * to choose the one which forms a corner having a
+ * join line in the ring interior.
+ *
+ * @param joinCoord the join ring vertex
+ * @param holeJoinCoord the hole join vertex
+ * @return the join ring vertex index to join after
+ */
+func (joiner *PolygonHoleJoiner) findJoinIndex(joinCoord *geom.Coordinate, holeJoinCoord *geom.Coordinate) (int, error) {
+	//-- linear scan is slow but only done once per hole
+	for i := 0; i < len(joiner.joinedRing)-1; i++ {
+		if joinCoord.Equals2D(&joiner.joinedRing[i]) {
+			if polygonHoleJoinerIsLineInterior(joiner.joinedRing, i, holeJoinCoord) {
+				return i, nil
+			}
+		}
+	}
+	return -1, errors.New("unable to find shell join index with interior join line")
+}
+
+/**
+ * Tests if a line between a ring corner vertex and a given point
+ * is interior to the ring corner.
+ *
+ * @param ring a ring of points
+ * @param ringIndex the index of a ring vertex
+ * @param linePt the point to be joined to the ring
+ * @return true if the line to the point is interior to the ring corner
+ */
+func polygonHoleJoinerIsLineInterior(ring []geom.Coordinate, ringIndex int, linePt *geom.Coordinate) bool {
+	nodePt := &ring[ringIndex]
+	shell0 := &ring[polygonHoleJoinerPrev(ringIndex, len(ring))]
+	shell1 := &ring[polygonHoleJoinerNext(ringIndex, len(ring))]
+	return algorithm.PolygonNodeTopologyIsInteriorSegment(nodePt, shell0, shell1, linePt)
+}
+
+func polygonHoleJoinerPrev(i int, size int) int {
+	prev := i - 1
+	if prev < 0 {
+		return size - 2
+	}
+	return prev
+}
+
+func polygonHoleJoinerNext(i int, size int) int {
+	next := i + 1
+	if next > size-2 {
+		return 0
+	}
+	return next
+}
+
+/**
+ * Add hole vertices at proper position in shell vertex list.
+ * This code assumes that if hole touches (shell or other hole),
+ * it touches at a node.  This requires an initial noding step.
+ * In this case, the code avoids duplicating join vertices.
+ *
+ * Also adds hole points to ordered coordinates.
+ *
+ * @param joinIndex index of join vertex and insertion point
+ * @param holeCoords the hole coordinates
+ * @param holeJoinIndex the index of the hole join vertex
+ */
+func (joiner *PolygonHoleJoiner) addJoinedHole(joinIndex int, holeCoords []geom.Coordinate, holeJoinIndex int) {
+	joinPt := joiner.joinedRing[joinIndex]
+	holeJoinPt := &holeCoords[holeJoinIndex]
+
+	//-- check for touching (zero-length) join to avoid inserting duplicate vertices
+	isVertexTouch := joinPt.Equals2D(holeJoinPt)
+
+	//-- create new section of vertices to insert in shell
+	newSection := polygonHoleJoinerCreateHoleSection(holeCoords, holeJoinIndex, &joinPt, !isVertexTouch)
+
+	//-- add section after shell join vertex
+	addIndex := joinIndex + 1
+	joiner.joinedRing = slices.Insert(joiner.joinedRing, addIndex, newSection...)
+	joiner.addJoinedPts(newSection)
+}
+
+/**
+ * Creates the new section of vertices for ad added hole,
+ * including any required vertices from the shell at the join point,
+ * and ensuring join vertices are not duplicated.
+ *
+ * @param holeCoords the hole coordinates
+ * @param holeJoinIndex the index of the join vertex
+ * @param joinPt the shell join vertex
+ * @param isNonTouchingHole whether the join line has non-zero length
+ * @return a list of new vertices to be added
+ */
+func polygonHoleJoinerCreateHoleSection(holeCoords []geom.Coordinate, holeJoinIndex int, joinPt *geom.Coordinate, isNonTouchingHole bool) []geom.Coordinate {
+	section := make([]geom.Coordinate, 0, len(holeCoords)+2)
+
+	/**
+	 * Add all hole vertices, including duplicate at hole join vertex
+	 * Except if hole DOES touch, join vertex is already in shell ring
+	 */
+	if isNonTouchingHole {
+		section = append(section, holeCoords[holeJoinIndex])
+	}
+
+	holeSize := len(holeCoords) - 1
+	index := holeJoinIndex
+	for i := 0; i < holeSize; i++ {
+		index = (index + 1) % holeSize
+		section = append(section, holeCoords[index])
+	}
+	/**
+	 * Add duplicate shell vertex at end of the return join line.
+	 * Except if hole DOES touch, join line is zero-length so do not need dup
+	 */
+	if isNonTouchingHole {
+		section = append(section, *joinPt)
+	}
+	return section
+}
+
+/**
+ * Sort the hole rings by minimum X, minimum Y.
+ *
+ * @param holes the hole rings
+ * @return a sorted copy of the hole rings
+ */
+func polygonHoleJoinerSortHoles(holes [][]geom.Coordinate) [][]geom.Coordinate {
+	type holeEnv struct {
+		hole []geom.Coordinate
+		env  *geom.Envelope
+	}
+	holeEnvs := make([]holeEnv, 0, len(holes))
+	for _, hole := range holes {
+		if len(hole) == 0 {
+			continue
+		}
+		holeEnvs = append(holeEnvs, holeEnv{hole, geom.NewEnvelopeFromCoordinateArray(hole)})
+	}
+	sort.SliceStable(holeEnvs, func(i, j int) bool {
+		return holeEnvs[i].env.CompareTo(holeEnvs[j].env) < 0
+	})
+	sorted := make([][]geom.Coordinate, len(holeEnvs))
+	for i, he := range holeEnvs {
+		sorted[i] = he.hole
+	}
+	return sorted
+}
+
+func polygonHoleJoinerFindLowestLeftVertexIndex(coords []geom.Coordinate) int {
+	lowestLeftIndex := -1
+	for i := 0; i < len(coords)-1; i++ {
+		if lowestLeftIndex < 0 || coords[i].CompareTo(&coords[lowestLeftIndex]) < 0 {
+			lowestLeftIndex = i
+		}
+	}
+	return lowestLeftIndex
+}
+
+/**
+ * Adds points to the ordered set of joined ring points.
+ */
+func (joiner *PolygonHoleJoiner) addJoinedPts(pts []geom.Coordinate) {
+	for _, pt := range pts {
+		i, found := slices.BinarySearchFunc(joiner.joinedPts, pt, func(a geom.Coordinate, b geom.Coordinate) int {
+			return a.CompareTo(&b)
+		})
+		if !found {
+			joiner.joinedPts = slices.Insert(joiner.joinedPts, i, pt)
+		}
+	}
+}
+
+func (joiner *PolygonHoleJoiner) containsJoinedPt(pt *geom.Coordinate) bool {
+	_, found := slices.BinarySearchFunc(joiner.joinedPts, *pt, func(a geom.Coordinate, b geom.Coordinate) int {
+		return a.CompareTo(&b)
+	})
+	return found
+}
+
+/**
+ * Determines whether a line between two vertices intersects
+ * the polygon boundary in the interior of the line.
+ * Touching the boundary at the line endpoints is allowed.
+ *
+ * @param p0 the first vertex of the line
+ * @param p1 the second vertex of the line
+ * @return true if the line intersects the polygon boundary
+ */
+func (joiner *PolygonHoleJoiner) intersectsBoundary(p0 *geom.Coordinate, p1 *geom.Coordinate) bool {
+	li := algorithm.NewLineIntersector()
+	isIntersecting := false
+	joiner.boundaryIndex.QueryVisitor(geom.NewEnvelopeFromCoordinates(p0, p1), func(i int) bool {
+		seg := &joiner.boundarySegs[i]
+		li.ComputeIntersection(p0, p1, &seg[0], &seg[1])
+		if li.IsInteriorIntersectionIndex(0) {
+			isIntersecting = true
+			return false
+		}
+		return true
+	})
+	return isIntersecting
+}
+
+func (joiner *PolygonHoleJoiner) createBoundaryIndex() {
+	joiner.boundarySegs = make([][2]geom.Coordinate, 0)
+	joiner.boundaryIndex = strtree.NewSTRtree[int]()
+	rings := append([][]geom.Coordinate{joiner.shellRing}, joiner.holeRings...)
+	for _, ring := range rings {
+		for i := 0; i < len(ring)-1; i++ {
+			joiner.boundaryIndex.Insert(geom.NewEnvelopeFromCoordinates(&ring[i], &ring[i+1]), len(joiner.boundarySegs))
+			joiner.boundarySegs = append(joiner.boundarySegs, [2]geom.Coordinate{ring[i], ring[i+1]})
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package geos
+
+import (
+	"sort"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Adds node vertices to the rings of a polygon
+ * where holes touch the shell or each other.
+ * The structure of the polygon is preserved.
+ * <p>
+ * This does not fix invalid polygon topology
+ * (such as self-touching or crossing rings).
+ * Invalid input remains invalid after noding,
+ * and does not trigger an error.
+ */
+type polygonNoder struct {
+	rings          [][]geom.Coordinate
+	isHoleTouching []bool
+	isNoded        []bool
+}
+
+/**
+ * Creates a noder for the rings of a polygon.
+ * Ring 0 is the shell, and the remaining rings are the holes.
+ */
+func newPolygonNoder(shellRing []geom.Coordinate, holeRings [][]geom.Coordinate) *polygonNoder {
+	noder := new(polygonNoder)
+	noder.rings = make([][]geom.Coordinate, 0, len(holeRings)+1)
+	noder.rings = append(noder.rings, shellRing)
+	noder.rings = append(noder.rings, holeRings...)
+	noder.isHoleTouching = make([]bool, len(holeRings))
+	noder.isNoded = make([]bool, len(noder.rings))
+	return noder
+}
+
+type polygonNoderNode struct {
+	pt   geom.Coordinate
+	dist float64
+}
+
+func (noder *polygonNoder) node() {
+	envs := make([]*geom.Envelope, len(noder.rings))
+	for i, ring := range noder.rings {
+		envs[i] = geom.NewEnvelopeFromCoordinateArray(ring)
+	}
+	segNodes := make([][][]polygonNoderNode, len(noder.rings))
+	for i, ring := range noder.rings {
+		segNodes[i] = make([][]polygonNoderNode, max(len(ring)-1, 0))
+	}
+
+	li := algorithm.NewLineIntersector()
+	for i := 0; i < len(noder.rings); i++ {
+		for j := i + 1; j < len(noder.rings); j++ {
+			//-- input is assumed valid, so rings do not self-intersect
+			if !envs[i].IntersectsEnvelope(envs[j]) {
+				continue
+			}
+			ri := noder.rings[i]
+			rj := noder.rings[j]
+			for a := 0; a < len(ri)-1; a++ {
+				for b := 0; b < len(rj)-1; b++ {
+					if !geom.EnvelopeIntersectsSegments(&ri[a], &ri[a+1], &rj[b], &rj[b+1]) {
+						continue
+					}
+					li.ComputeIntersection(&ri[a], &ri[a+1], &rj[b], &rj[b+1])
+					/**
+					 * There should never be 2 intersection points, since
+					 * that would imply collinear segments, and an invalid polygon
+					 */
+					if li.GetIntersectionNum() != 1 {
+						continue
+					}
+					noder.addTouch(i)
+					noder.addTouch(j)
+					intPt := *li.GetIntersection(0)
+					if li.IsInteriorIntersectionIndex(0) {
+						segNodes[i][a] = append(segNodes[i][a], polygonNoderNode{intPt, intPt.Distance(&ri[a])})
+					} else if li.IsInteriorIntersectionIndex(1) {
+						segNodes[j][b] = append(segNodes[j][b], polygonNoderNode{intPt, intPt.Distance(&rj[b])})
+					}
+				}
+			}
+		}
+	}
+
+	for i, ring := range noder.rings {
+		noder.rings[i] = noder.addNodes(i, ring, segNodes[i])
+	}
+}
+
+func (noder *polygonNoder) addTouch(ringIndex int) {
+	if ringIndex > 0 {
+		noder.isHoleTouching[ringIndex-1] = true
+	}
+}
+
+func (noder *polygonNoder) addNodes(ringIndex int, ring []geom.Coordinate, segNodes [][]polygonNoderNode) []geom.Coordinate {
+	hasNodes := false
+	for _, nodes := range segNodes {
+		if len(nodes) > 0 {
+			hasNodes = true
+			break
+		}
+	}
+	if !hasNodes {
+		return ring
+	}
+	noder.isNoded[ringIndex] = true
+
+	nodedRing := make([]geom.Coordinate, 0, len(ring))
+	for i := 0; i < len(ring)-1; i++ {
+		nodedRing = append(nodedRing, ring[i])
+		nodes := segNodes[i]
+		sort.Slice(nodes, func(m, n int) bool {
+			return nodes[m].dist < nodes[n].dist
+		})
+		for _, node := range nodes {
+			if node.pt.Equals2D(&nodedRing[len(nodedRing)-1]) {
+				continue
+			}
+			nodedRing = append(nodedRing, node.pt)
+		}
+	}
+	nodedRing = append(nodedRing, ring[len(ring)-1])
+	return nodedRing
+}
+
+func (noder *polygonNoder) isShellNoded() bool {
+	return noder.isNoded[0]
+}
+
+func (noder *polygonNoder) getNodedShell() []geom.Coordinate {
+	return noder.rings[0]
+}
+
+func (noder *polygonNoder) isHoleNoded(i int) bool {
+	return noder.isNoded[i+1]
+}
+
+func (noder *polygonNoder) getNodedHole(i int) []geom.Coordinate {
+	return noder.rings[i+1]
+}
+
+func (noder *polygonNoder) getHolesTouching() []bool {
+	return noder.isHoleTouching
+}
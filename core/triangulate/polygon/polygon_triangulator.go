@@ -0,0 +1,88 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Computes a triangulation of each polygon in a list.
+ * The triangulation is formed by joining the holes to the shell
+ * and ear-clipping the resulting ring.
+ * It is faster than the {@link ConstrainedDelaunayTriangulator},
+ * but the triangles are not optimised for quality.
+ * <p>
+ * Holes are supported.
+ */
+type PolygonTriangulator struct {
+	inputPolygons [][][]geom.Coordinate
+	triList       []*tri.Tri
+}
+
+/**
+ * Computes a triangulation of each polygon in a list.
+ *
+ * @param polygons the input polygons, each a shell followed by holes
+ * @return the triangles, each as a closed ring
+ */
+func PolygonTriangulatorTriangulate(polygons [][][]geom.Coordinate) ([][]geom.Coordinate, error) {
+	triangulator := NewPolygonTriangulator(polygons)
+	return triangulator.GetResult()
+}
+
+/**
+ * Computes the triangulation of a single polygon.
+ *
+ * @param polygon the polygon, as a shell followed by holes
+ * @return the triangles of the triangulation
+ */
+func PolygonTriangulatorTriangulatePolygon(polygon [][]geom.Coordinate) ([]*tri.Tri, error) {
+	polyShell, err := PolygonHoleJoinerJoin(polygon)
+	if err != nil {
+		return nil, err
+	}
+	return PolygonEarClipperTriangulate(polyShell)
+}
+
+/**
+ * Constructs a new polygon triangulator.
+ *
+ * @param polygons the input polygons, each a shell followed by holes
+ */
+func NewPolygonTriangulator(polygons [][][]geom.Coordinate) *PolygonTriangulator {
+	triangulator := new(PolygonTriangulator)
+	triangulator.inputPolygons = polygons
+	return triangulator
+}
+
+/**
+ * Gets the triangulation as a list of triangles,
+ * each a closed clockwise ring.
+ *
+ * @return the triangles of the triangulation
+ */
+func (triangulator *PolygonTriangulator) GetResult() ([][]geom.Coordinate, error) {
+	if err := triangulator.compute(); err != nil {
+		return nil, err
+	}
+	return tri.TriToRings(triangulator.triList), nil
+}
+
+func (triangulator *PolygonTriangulator) compute() error {
+	if triangulator.triList != nil {
+		return nil
+	}
+	triList := make([]*tri.Tri, 0)
+	for _, polygon := range triangulator.inputPolygons {
+		if len(polygon) == 0 || len(polygon[0]) == 0 {
+			continue
+		}
+		polyTriList, err := PolygonTriangulatorTriangulatePolygon(polygon)
+		if err != nil {
+			return err
+		}
+		triList = append(triList, polyTriList...)
+	}
+	triangulator.triList = triList
+	return nil
+}
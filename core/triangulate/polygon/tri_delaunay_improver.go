@@ -0,0 +1,153 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * The maximum number of scans of a triangulation
+ * performed while flipping edges to meet the Delaunay condition.
+ */
+const triDelaunayImprover_MAX_ITERATION = 200
+
+/**
+ * Improves the quality of a triangulation of {@link tri.Tri}s via
+ * iterated Delaunay flipping.
+ * This produces a Constrained Delaunay Triangulation
+ * with the constraints being the boundary of the input triangulation.
+ * The Tris are assumed to be linked into a Triangulation
+ * (e.g. via {@link tri.TriangulationBuilderBuild}).
+ *
+ * @param triList the list of Tris to flip.
+ */
+func TriDelaunayImproverImprove(triList []*tri.Tri) {
+	for i := 0; i < triDelaunayImprover_MAX_ITERATION; i++ {
+		improveCount := triDelaunayImproverImproveScan(triList)
+		if improveCount == 0 {
+			return
+		}
+	}
+}
+
+/**
+ * Improves a triangulation by examining pairs of adjacent triangles
+ * (forming a quadrilateral) and testing if flipping the diagonal of
+ * the quadrilateral would produce two new triangles with larger minimum
+ * interior angles.
+ *
+ * @return the number of flips that were made
+ */
+func triDelaunayImproverImproveScan(triList []*tri.Tri) int {
+	improveCount := 0
+	for _, t := range triList {
+		for j := 0; j < 3; j++ {
+			if triDelaunayImproverImproveNonDelaunay(t, j) {
+				improveCount++
+			}
+		}
+	}
+	return improveCount
+}
+
+/**
+ * Does a flip of the common edge of two Tris if the Delaunay condition is not met.
+ *
+ * @param t a Tri
+ * @param index the index of the edge to test
+ * @return true if the triangles were flipped
+ */
+func triDelaunayImproverImproveNonDelaunay(t *tri.Tri, index int) bool {
+	if t == nil {
+		return false
+	}
+	tri1 := t.GetAdjacent(index)
+	if tri1 == nil {
+		return false
+	}
+	index1 := tri1.GetIndexOfTri(t)
+
+	adj0 := t.GetCoordinate(index)
+	adj1 := t.GetCoordinate(tri.TriNext(index))
+	opp0 := t.GetCoordinate(tri.TriOppVertex(index))
+	opp1 := tri1.GetCoordinate(tri.TriOppVertex(index1))
+
+	/**
+	 * The candidate new edge is opp0 - opp1.
+	 * Check if it is inside the quadrilateral formed by the two triangles.
+	 * This is the case if the quadrilateral is convex.
+	 */
+	if !triDelaunayImproverIsConvex(adj0, adj1, opp0, opp1) {
+		return false
+	}
+
+	/**
+	 * The candidate edge is inside the quadrilateral. Check to see if the flipping
+	 * criteria is met. The flipping criteria is to flip if the two triangles are
+	 * not Delaunay (i.e. one of the opposite vertices is in the circumcircle of the
+	 * other triangle).
+	 */
+	if !triDelaunayImproverIsDelaunay(adj0, adj1, opp0, opp1) {
+		t.Flip(index)
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests if the quadrilateral formed by two adjacent triangles is convex.
+ * opp0-adj0-adj1 and opp1-adj1-adj0 are the triangle corners
+ * and hence are known to be convex.
+ * The quadrilateral is convex if the other corners opp0-adj0-opp1
+ * and opp1-adj1-opp0 have the same orientation (since at least one must be convex).
+ *
+ * @param adj0 adjacent edge vertex 0
+ * @param adj1 adjacent edge vertex 1
+ * @param opp0 corner vertex of triangle 0
+ * @param opp1 corner vertex of triangle 1
+ * @return true if the quadrilateral is convex
+ */
+func triDelaunayImproverIsConvex(adj0 *geom.Coordinate, adj1 *geom.Coordinate, opp0 *geom.Coordinate, opp1 *geom.Coordinate) bool {
+	dir0 := algorithm.OrientationIndex(opp0, adj0, opp1)
+	dir1 := algorithm.OrientationIndex(opp1, adj1, opp0)
+	isConvex := dir0 == dir1
+	return isConvex
+}
+
+/**
+ * Tests if either of a pair of adjacent triangles satisfy the Delaunay condition.
+ * The triangles are opp0-adj0-adj1 and opp1-adj1-adj0.
+ * The Delaunay condition is not met if one opposite vertex
+ * lies is in the circumcircle of the other triangle.
+ *
+ * @param adj0 adjacent edge vertex 0
+ * @param adj1 adjacent edge vertex 1
+ * @param opp0 corner vertex of triangle 0
+ * @param opp1 corner vertex of triangle 1
+ * @return true if the triangles are Delaunay
+ */
+func triDelaunayImproverIsDelaunay(adj0 *geom.Coordinate, adj1 *geom.Coordinate, opp0 *geom.Coordinate, opp1 *geom.Coordinate) bool {
+	if triDelaunayImproverIsInCircle(adj0, adj1, opp0, opp1) {
+		return false
+	}
+	if triDelaunayImproverIsInCircle(adj1, adj0, opp1, opp0) {
+		return false
+	}
+	return true
+}
+
+/**
+ * Tests whether a point p is in the circumcircle of a triangle abc
+ * (oriented clockwise).
+ *
+ * @param a a vertex of the triangle
+ * @param b a vertex of the triangle
+ * @param c a vertex of the triangle
+ * @param p the point
+ * @return true if the point is in the circumcircle
+ */
+func triDelaunayImproverIsInCircle(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate, p *geom.Coordinate) bool {
+	return quadedge.TrianglePredicateIsInCircleRobust(a, c, b, p)
+}
@@ -0,0 +1,354 @@
+package geos
+
+/**
+ * A class that represents the edge data structure which implements the quadedge algebra.
+ * The quadedge algebra was described in a well-known paper by Guibas and Stolfi,
+ * "Primitives for the manipulation of general subdivisions and the computation of Voronoi diagrams",
+ * <i>ACM Transactions on Graphics</i>, 4(2), 1985, 75-123.
+ * <p>
+ * Each edge object is part of a quartet of 4 edges,
+ * linked via their <tt>rot</tt> references.
+ * Any edge in the group may be accessed using a series of {@link #Rot()} operations.
+ * Quadedges in a subdivision are linked together via their <tt>next</tt> references.
+ * The linkage between the quadedge quartets determines the topology
+ * of the subdivision.
+ * <p>
+ * The edge class does not contain separate information for vertices or faces; a vertex is implicitly
+ * defined as a ring of edges (created using the <tt>next</tt> field).
+ */
+type QuadEdge struct {
+	// the dual of this edge, directed from right to left
+	rot    *QuadEdge
+	vertex *Vertex // The vertex that this edge represents
+	next   *QuadEdge
+	data   any
+	isLive bool
+}
+
+/**
+ * Creates a new QuadEdge quartet from {@link Vertex} o to {@link Vertex} d.
+ *
+ * @param o the origin Vertex
+ * @param d the destination Vertex
+ * @return the new QuadEdge quartet
+ */
+func QuadEdgeMakeEdge(o *Vertex, d *Vertex) *QuadEdge {
+	q0 := &QuadEdge{isLive: true}
+	q1 := &QuadEdge{isLive: true}
+	q2 := &QuadEdge{isLive: true}
+	q3 := &QuadEdge{isLive: true}
+
+	q0.rot = q1
+	q1.rot = q2
+	q2.rot = q3
+	q3.rot = q0
+
+	q0.next = q0
+	q1.next = q3
+	q2.next = q2
+	q3.next = q1
+
+	base := q0
+	base.SetOrig(o)
+	base.SetDest(d)
+	return base
+}
+
+/**
+ * Creates a new QuadEdge connecting the destination of a to the origin of
+ * b, in such a way that all three have the same left face after the
+ * connection is complete. Additionally, the data pointers of the new edge
+ * are set.
+ *
+ * @return the connected edge.
+ */
+func QuadEdgeConnect(a *QuadEdge, b *QuadEdge) *QuadEdge {
+	e := QuadEdgeMakeEdge(a.Dest(), b.Orig())
+	QuadEdgeSplice(e, a.LNext())
+	QuadEdgeSplice(e.Sym(), b)
+	return e
+}
+
+/**
+ * Splices two edges together or apart.
+ * Splice affects the two edge rings around the origins of a and b, and, independently, the two
+ * edge rings around the left faces of <tt>a</tt> and <tt>b</tt>.
+ * In each case, (i) if the two rings are distinct,
+ * Splice will combine them into one, or (ii) if the two are the same ring, Splice will break it
+ * into two separate pieces. Thus, Splice can be used both to attach the two edges together, and
+ * to break them apart.
+ *
+ * @param a an edge to splice
+ * @param b an edge to splice
+ */
+func QuadEdgeSplice(a *QuadEdge, b *QuadEdge) {
+	alpha := a.ONext().Rot()
+	beta := b.ONext().Rot()
+
+	t1 := b.ONext()
+	t2 := a.ONext()
+	t3 := beta.ONext()
+	t4 := alpha.ONext()
+
+	a.next = t1
+	b.next = t2
+	alpha.next = t3
+	beta.next = t4
+}
+
+/**
+ * Turns an edge counterclockwise inside its enclosing quadrilateral.
+ *
+ * @param e the quadedge to turn
+ */
+func QuadEdgeSwap(e *QuadEdge) {
+	a := e.OPrev()
+	b := e.Sym().OPrev()
+	QuadEdgeSplice(e, a)
+	QuadEdgeSplice(e.Sym(), b)
+	QuadEdgeSplice(e, a.LNext())
+	QuadEdgeSplice(e.Sym(), b.LNext())
+	e.SetOrig(a.Dest())
+	e.SetDest(b.Dest())
+}
+
+/**
+ * Gets the primary edge of this quadedge and its <tt>sym</tt>.
+ * The primary edge is the one for which the origin
+ * and destination coordinates are ordered
+ * according to the standard {@link Coordinate} ordering
+ *
+ * @return the primary quadedge
+ */
+func (qe *QuadEdge) GetPrimary() *QuadEdge {
+	if qe.Orig().GetCoordinate().CompareTo(qe.Dest().GetCoordinate()) <= 0 {
+		return qe
+	}
+	return qe.Sym()
+}
+
+/**
+ * Sets the external data value for this edge.
+ *
+ * @param data an object containing external data
+ */
+func (qe *QuadEdge) SetData(data any) {
+	qe.data = data
+}
+
+/**
+ * Gets the external data value for this edge.
+ *
+ * @return the data object
+ */
+func (qe *QuadEdge) GetData() any {
+	return qe.data
+}
+
+/**
+ * Marks this quadedge as being deleted.
+ * This does not free the memory used by
+ * this quadedge quartet, but indicates
+ * that this edge no longer participates
+ * in a subdivision.
+ */
+func (qe *QuadEdge) Delete() {
+	qe.isLive = false
+	qe.Rot().isLive = false
+	qe.Sym().isLive = false
+	qe.InvRot().isLive = false
+}
+
+/**
+ * Tests whether this edge has been deleted.
+ *
+ * @return true if this edge has not been deleted.
+ */
+func (qe *QuadEdge) IsLive() bool {
+	return qe.isLive
+}
+
+/***************************************************************************
+ * QuadEdge Algebra
+ ***************************************************************************
+ */
+
+/**
+ * Gets the dual of this edge, directed from its right to its left.
+ *
+ * @return the rotated edge
+ */
+func (qe *QuadEdge) Rot() *QuadEdge {
+	return qe.rot
+}
+
+/**
+ * Gets the dual of this edge, directed from its left to its right.
+ *
+ * @return the inverse rotated edge.
+ */
+func (qe *QuadEdge) InvRot() *QuadEdge {
+	return qe.rot.Sym()
+}
+
+/**
+ * Gets the edge from the destination to the origin of this edge.
+ *
+ * @return the sym of the edge
+ */
+func (qe *QuadEdge) Sym() *QuadEdge {
+	return qe.rot.rot
+}
+
+/**
+ * Gets the next CCW edge around the origin of this edge.
+ *
+ * @return the next linked edge.
+ */
+func (qe *QuadEdge) ONext() *QuadEdge {
+	return qe.next
+}
+
+/**
+ * Gets the next CW edge around (from) the origin of this edge.
+ *
+ * @return the previous edge.
+ */
+func (qe *QuadEdge) OPrev() *QuadEdge {
+	return qe.rot.next.rot
+}
+
+/**
+ * Gets the next CCW edge around (into) the destination of this edge.
+ *
+ * @return the next destination edge.
+ */
+func (qe *QuadEdge) DNext() *QuadEdge {
+	return qe.Sym().ONext().Sym()
+}
+
+/**
+ * Gets the next CW edge around (into) the destination of this edge.
+ *
+ * @return the previous destination edge.
+ */
+func (qe *QuadEdge) DPrev() *QuadEdge {
+	return qe.InvRot().ONext().InvRot()
+}
+
+/**
+ * Gets the CCW edge around the left face following this edge.
+ *
+ * @return the next left face edge.
+ */
+func (qe *QuadEdge) LNext() *QuadEdge {
+	return qe.InvRot().ONext().Rot()
+}
+
+/**
+ * Gets the CCW edge around the left face before this edge.
+ *
+ * @return the previous left face edge.
+ */
+func (qe *QuadEdge) LPrev() *QuadEdge {
+	return qe.next.Sym()
+}
+
+/**
+ * Gets the edge around the right face ccw following this edge.
+ *
+ * @return the next right face edge.
+ */
+func (qe *QuadEdge) RNext() *QuadEdge {
+	return qe.rot.next.InvRot()
+}
+
+/**
+ * Gets the edge around the right face ccw before this edge.
+ *
+ * @return the previous right face edge.
+ */
+func (qe *QuadEdge) RPrev() *QuadEdge {
+	return qe.Sym().ONext()
+}
+
+/***********************************************************************************************
+ * Data Access
+ **********************************************************************************************/
+
+/**
+ * Sets the vertex for this edge's origin
+ *
+ * @param o the origin vertex
+ */
+func (qe *QuadEdge) SetOrig(o *Vertex) {
+	qe.vertex = o
+}
+
+/**
+ * Sets the vertex for this edge's destination
+ *
+ * @param d the destination vertex
+ */
+func (qe *QuadEdge) SetDest(d *Vertex) {
+	qe.Sym().SetOrig(d)
+}
+
+/**
+ * Gets the vertex for the edge's origin
+ *
+ * @return the origin vertex
+ */
+func (qe *QuadEdge) Orig() *Vertex {
+	return qe.vertex
+}
+
+/**
+ * Gets the vertex for the edge's destination
+ *
+ * @return the destination vertex
+ */
+func (qe *QuadEdge) Dest() *Vertex {
+	return qe.Sym().Orig()
+}
+
+/**
+ * Gets the length of the geometry of this quadedge.
+ *
+ * @return the length of the quadedge
+ */
+func (qe *QuadEdge) GetLength() float64 {
+	return qe.Orig().GetCoordinate().Distance(qe.Dest().GetCoordinate())
+}
+
+/**
+ * Tests if this quadedge and another have the same line segment geometry,
+ * regardless of orientation.
+ *
+ * @param qe2 a quadedge
+ * @return true if the quadedges are based on the same line segment regardless of orientation
+ */
+func (qe *QuadEdge) EqualsNonOriented(qe2 *QuadEdge) bool {
+	if qe.EqualsOriented(qe2) {
+		return true
+	}
+	if qe.EqualsOriented(qe2.Sym()) {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests if this quadedge and another have the same line segment geometry
+ * with the same orientation.
+ *
+ * @param qe2 a quadedge
+ * @return true if the quadedges are based on the same line segment
+ */
+func (qe *QuadEdge) EqualsOriented(qe2 *QuadEdge) bool {
+	if qe.Orig().GetCoordinate().Equals2D(qe2.Orig().GetCoordinate()) &&
+		qe.Dest().GetCoordinate().Equals2D(qe2.Dest().GetCoordinate()) {
+		return true
+	}
+	return false
+}
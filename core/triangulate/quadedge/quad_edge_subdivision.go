@@ -0,0 +1,666 @@
+package geos
+
+import (
+	"errors"
+	"slices"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+const quadEdgeSubdivision_EDGE_COINCIDENCE_TOL_FACTOR = 1000.0
+
+/**
+ * A function which is called for each triangle
+ * of a {@link QuadEdgeSubdivision}.
+ * The triangle is provided as the three quadedges
+ * which form its boundary, in counter-clockwise order.
+ */
+type TriangleVisitor func(triEdges []*QuadEdge)
+
+/**
+ * A class that contains the {@link QuadEdge}s representing a planar subdivision that models a
+ * triangulation.
+ * The subdivision is constructed using the
+ * quadedge algebra defined in the class {@link QuadEdge}.
+ * All metric calculations
+ * are done in the {@link Vertex} class.
+ * In addition to a triangulation, subdivisions
+ * support extraction of Voronoi diagrams.
+ * This is easily accomplished, since the Voronoi diagram is the dual
+ * of the Delaunay triangulation.
+ * <p>
+ * Subdivisions can be provided with a tolerance value. Inserted vertices which
+ * are closer than this value to vertices already in the subdivision will be
+ * ignored. Using a suitable tolerance value can prevent robustness failures
+ * from happening during Delaunay triangulation.
+ * <p>
+ * Subdivisions maintain a <b>frame</b> triangle around the client-created
+ * edges. The frame is used to provide a bounded "container" for all edges
+ * within a TIN. Normally the frame edges, frame connecting edges, and frame
+ * triangles are not included in client processing.
+ */
+type QuadEdgeSubdivision struct {
+	quadEdges                []*QuadEdge
+	startingEdge             *QuadEdge
+	tolerance                float64
+	edgeCoincidenceTolerance float64
+	frameVertex              [3]*Vertex
+	frameEnv                 *geom.Envelope
+	lastEdge                 *QuadEdge
+}
+
+/**
+ * Creates a new instance of a quad-edge subdivision based on a frame triangle
+ * that encloses a supplied bounding box. A new super-bounding box that
+ * contains the triangle is computed and stored.
+ *
+ * @param env
+ *          the bounding box to surround
+ * @param tolerance
+ *          the tolerance value for determining if two sites are equal
+ */
+func NewQuadEdgeSubdivision(env *geom.Envelope, tolerance float64) *QuadEdgeSubdivision {
+	subdiv := new(QuadEdgeSubdivision)
+	subdiv.quadEdges = make([]*QuadEdge, 0)
+	subdiv.tolerance = tolerance
+	subdiv.edgeCoincidenceTolerance = tolerance / quadEdgeSubdivision_EDGE_COINCIDENCE_TOL_FACTOR
+	subdiv.createFrame(env)
+	subdiv.startingEdge = subdiv.initSubdiv()
+	subdiv.lastEdge = subdiv.startingEdge
+	return subdiv
+}
+
+func (subdiv *QuadEdgeSubdivision) createFrame(env *geom.Envelope) {
+	deltaX := env.GetWidth()
+	deltaY := env.GetHeight()
+	offset := 0.0
+	if deltaX > deltaY {
+		offset = deltaX * 10.0
+	} else {
+		offset = deltaY * 10.0
+	}
+
+	subdiv.frameVertex[0] = NewVertex((env.GetMaxX()+env.GetMinX())/2.0, env.GetMaxY()+offset)
+	subdiv.frameVertex[1] = NewVertex(env.GetMinX()-offset, env.GetMinY()-offset)
+	subdiv.frameVertex[2] = NewVertex(env.GetMaxX()+offset, env.GetMinY()-offset)
+
+	subdiv.frameEnv = geom.NewEnvelopeFromCoordinates(subdiv.frameVertex[0].GetCoordinate(), subdiv.frameVertex[1].GetCoordinate())
+	subdiv.frameEnv.ExpandToIncludeCoordinate(subdiv.frameVertex[2].GetCoordinate())
+}
+
+func (subdiv *QuadEdgeSubdivision) initSubdiv() *QuadEdge {
+	// build initial subdivision from frame
+	ea := subdiv.MakeEdge(subdiv.frameVertex[0], subdiv.frameVertex[1])
+	eb := subdiv.MakeEdge(subdiv.frameVertex[1], subdiv.frameVertex[2])
+	QuadEdgeSplice(ea.Sym(), eb)
+	ec := subdiv.MakeEdge(subdiv.frameVertex[2], subdiv.frameVertex[0])
+	QuadEdgeSplice(eb.Sym(), ec)
+	QuadEdgeSplice(ec.Sym(), ea)
+	return ea
+}
+
+/**
+ * Gets the vertex-equality tolerance value
+ * used in this subdivision
+ *
+ * @return the tolerance value
+ */
+func (subdiv *QuadEdgeSubdivision) GetTolerance() float64 {
+	return subdiv.tolerance
+}
+
+/**
+ * Gets the envelope of the Subdivision (including the frame).
+ *
+ * @return the envelope
+ */
+func (subdiv *QuadEdgeSubdivision) GetEnvelope() *geom.Envelope {
+	return subdiv.frameEnv.Copy()
+}
+
+/**
+ * Gets the collection of base {@link QuadEdge}s (one for every pair of
+ * vertices which is connected).
+ *
+ * @return the base quadedges
+ */
+func (subdiv *QuadEdgeSubdivision) GetEdges() []*QuadEdge {
+	return subdiv.quadEdges
+}
+
+/**
+ * Creates a new quadedge, recording it in the edges list.
+ *
+ * @param o the origin vertex
+ * @param d the destination vertex
+ * @return a new quadedge
+ */
+func (subdiv *QuadEdgeSubdivision) MakeEdge(o *Vertex, d *Vertex) *QuadEdge {
+	q := QuadEdgeMakeEdge(o, d)
+	subdiv.quadEdges = append(subdiv.quadEdges, q)
+	return q
+}
+
+/**
+ * Creates a new QuadEdge connecting the destination of a to the origin of b,
+ * in such a way that all three have the same left face after the connection
+ * is complete. The quadedge is recorded in the edges list.
+ *
+ * @param a an edge
+ * @param b an edge
+ * @return a quadedge
+ */
+func (subdiv *QuadEdgeSubdivision) Connect(a *QuadEdge, b *QuadEdge) *QuadEdge {
+	q := QuadEdgeConnect(a, b)
+	subdiv.quadEdges = append(subdiv.quadEdges, q)
+	return q
+}
+
+/**
+ * Deletes a quadedge from the subdivision. Linked quadedges are updated to
+ * reflect the deletion.
+ *
+ * @param e the quadedge to delete
+ */
+func (subdiv *QuadEdgeSubdivision) Delete(e *QuadEdge) {
+	QuadEdgeSplice(e, e.OPrev())
+	QuadEdgeSplice(e.Sym(), e.Sym().OPrev())
+
+	eSym := e.Sym()
+	eRot := e.Rot()
+	eRotSym := e.Rot().Sym()
+
+	subdiv.quadEdges = slices.DeleteFunc(subdiv.quadEdges, func(q *QuadEdge) bool {
+		return q == e || q == eSym || q == eRot || q == eRotSym
+	})
+	e.Delete()
+}
+
+/**
+ * Locates an edge of a triangle which contains a location
+ * specified by a Vertex v.
+ * The edge returned has the
+ * property that either v is on e, or e is an edge of a triangle containing v.
+ * The search starts from startEdge amd proceeds on the general direction of v.
+ * <p>
+ * This locate algorithm relies on the subdivision being Delaunay. For
+ * non-Delaunay subdivisions, this may loop for ever.
+ * An error is returned if the location cannot be found
+ * within a number of steps equal to the number of edges.
+ *
+ * @param v the location to search for
+ * @param startEdge an edge of the subdivision to start searching at
+ * @return a QuadEdge which contains v, or is on the edge of a triangle containing v
+ */
+func (subdiv *QuadEdgeSubdivision) LocateFromEdge(v *Vertex, startEdge *QuadEdge) (*QuadEdge, error) {
+	iter := 0
+	maxIter := len(subdiv.quadEdges)
+
+	e := startEdge
+
+	for {
+		iter++
+
+		/**
+		 * So far it has always been the case that failure to locate indicates an
+		 * invalid subdivision. So just fail completely. (An alternative would be
+		 * to perform an exhaustive search for the containing triangle, but this
+		 * would mask errors in the subdivision topology)
+		 *
+		 * This can also happen if two vertices are located very close together,
+		 * since the orientation predicates may experience precision failures.
+		 */
+		if iter > maxIter {
+			return nil, errors.New("locate failed to converge (at edge: " +
+				e.Orig().GetCoordinate().ToString() + " - " + e.Dest().GetCoordinate().ToString() +
+				").  Possible causes include invalid Subdivision topology or very close sites")
+		}
+
+		if v.Equals(e.Orig()) || v.Equals(e.Dest()) {
+			break
+		} else if v.RightOf(e) {
+			e = e.Sym()
+		} else if !v.RightOf(e.ONext()) {
+			e = e.ONext()
+		} else if !v.RightOf(e.DPrev()) {
+			e = e.DPrev()
+		} else {
+			// on edge or in triangle containing edge
+			break
+		}
+	}
+	return e, nil
+}
+
+/**
+ * Finds a quadedge of a triangle containing a location
+ * specified by a {@link Vertex}, if one exists.
+ * The search starts from the edge found by the previous locate,
+ * which makes locating a sequence of nearby sites efficient.
+ *
+ * @param v the vertex to locate
+ * @return a quadedge on the edge of a triangle which touches or contains the location
+ */
+func (subdiv *QuadEdgeSubdivision) Locate(v *Vertex) (*QuadEdge, error) {
+	if !subdiv.lastEdge.IsLive() {
+		subdiv.lastEdge = subdiv.quadEdges[0]
+	}
+	e, err := subdiv.LocateFromEdge(v, subdiv.lastEdge)
+	if err != nil {
+		return nil, err
+	}
+	subdiv.lastEdge = e
+	return e, nil
+}
+
+/**
+ * Finds a quadedge of a triangle containing a location
+ * specified by a {@link Coordinate}, if one exists.
+ *
+ * @param p the Coordinate to locate
+ * @return a quadedge on the edge of a triangle which touches or contains the location
+ */
+func (subdiv *QuadEdgeSubdivision) LocateCoordinate(p *geom.Coordinate) (*QuadEdge, error) {
+	return subdiv.Locate(NewVertexFromCoordinate(p))
+}
+
+/**
+ * Inserts a new site into the Subdivision, connecting it to the vertices of
+ * the containing triangle (or quadrilateral, if the split point falls on an
+ * existing edge).
+ * <p>
+ * This method does NOT maintain the Delaunay condition. If desired, this must
+ * be checked and enforced by the caller.
+ * <p>
+ * This method does NOT check if the inserted vertex falls on an edge. This
+ * must be checked by the caller, since this situation may cause erroneous
+ * triangulation
+ *
+ * @param v
+ *          the vertex to insert
+ * @return a new quad edge terminating in v
+ */
+func (subdiv *QuadEdgeSubdivision) InsertSite(v *Vertex) (*QuadEdge, error) {
+	e, err := subdiv.Locate(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.EqualsTolerance(e.Orig(), subdiv.tolerance) || v.EqualsTolerance(e.Dest(), subdiv.tolerance) {
+		return e, nil // point already in subdivision.
+	}
+
+	// Connect the new point to the vertices of the containing
+	// triangle (or quadrilateral, if the new point fell on an
+	// existing edge.)
+	base := subdiv.MakeEdge(e.Orig(), v)
+	QuadEdgeSplice(base, e)
+	startEdge := base
+	for {
+		base = subdiv.Connect(e, base.Sym())
+		e = base.OPrev()
+		if e.LNext() == startEdge {
+			break
+		}
+	}
+	return startEdge, nil
+}
+
+/**
+ * Tests whether a QuadEdge is an edge incident on a frame triangle vertex.
+ *
+ * @param e
+ *          the edge to test
+ * @return true if the edge is connected to the frame triangle
+ */
+func (subdiv *QuadEdgeSubdivision) IsFrameEdge(e *QuadEdge) bool {
+	if subdiv.IsFrameVertex(e.Orig()) || subdiv.IsFrameVertex(e.Dest()) {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests whether a QuadEdge is an edge on the border of the frame facets and
+ * the internal facets. E.g. an edge which does not itself touch a frame
+ * vertex, but which touches an edge which does.
+ *
+ * @param e
+ *          the edge to test
+ * @return true if the edge is on the border of the frame
+ */
+func (subdiv *QuadEdgeSubdivision) IsFrameBorderEdge(e *QuadEdge) bool {
+	// check other vertex of triangle to left of edge
+	vLeftTriOther := e.LNext().Dest()
+	if subdiv.IsFrameVertex(vLeftTriOther) {
+		return true
+	}
+	// check other vertex of triangle to right of edge
+	vRightTriOther := e.Sym().LNext().Dest()
+	if subdiv.IsFrameVertex(vRightTriOther) {
+		return true
+	}
+	return false
+}
+
+/**
+ * Tests whether a vertex is a vertex of the outer triangle.
+ *
+ * @param v
+ *          the vertex to test
+ * @return true if the vertex is an outer triangle vertex
+ */
+func (subdiv *QuadEdgeSubdivision) IsFrameVertex(v *Vertex) bool {
+	for _, fv := range subdiv.frameVertex {
+		if v.Equals(fv) {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * Tests whether a {@link Coordinate} lies on a {@link QuadEdge}, up to a
+ * tolerance determined by the subdivision tolerance.
+ *
+ * @param e
+ *          a QuadEdge
+ * @param p
+ *          a point
+ * @return true if the vertex lies on the edge
+ */
+func (subdiv *QuadEdgeSubdivision) IsOnEdge(e *QuadEdge, p *geom.Coordinate) bool {
+	dist := algorithm.DistancePointToSegment(p, e.Orig().GetCoordinate(), e.Dest().GetCoordinate())
+	// heuristic (hack?)
+	return dist < subdiv.edgeCoincidenceTolerance
+}
+
+/**
+ * Tests whether a {@link Vertex} is the start or end vertex of a
+ * {@link QuadEdge}, up to the subdivision tolerance distance.
+ *
+ * @param e
+ * @param v
+ * @return true if the vertex is a endpoint of the edge
+ */
+func (subdiv *QuadEdgeSubdivision) IsVertexOfEdge(e *QuadEdge, v *Vertex) bool {
+	if v.EqualsTolerance(e.Orig(), subdiv.tolerance) || v.EqualsTolerance(e.Dest(), subdiv.tolerance) {
+		return true
+	}
+	return false
+}
+
+/**
+ * Gets all primary quadedges in the subdivision.
+ * A primary edge is a {@link QuadEdge}
+ * which occupies the 0'th position in its array of associated quadedges.
+ * These provide the unique geometric edges of the triangulation.
+ *
+ * @param includeFrame true if the frame edges are to be included
+ * @return a list of QuadEdges
+ */
+func (subdiv *QuadEdgeSubdivision) GetPrimaryEdges(includeFrame bool) []*QuadEdge {
+	edges := make([]*QuadEdge, 0)
+	edgeStack := []*QuadEdge{subdiv.startingEdge}
+	visitedEdges := make(map[*QuadEdge]bool)
+
+	for len(edgeStack) > 0 {
+		edge := edgeStack[len(edgeStack)-1]
+		edgeStack = edgeStack[:len(edgeStack)-1]
+		if visitedEdges[edge] {
+			continue
+		}
+		priQE := edge.GetPrimary()
+		if includeFrame || !subdiv.IsFrameEdge(priQE) {
+			edges = append(edges, priQE)
+		}
+		edgeStack = append(edgeStack, edge.ONext(), edge.Sym().ONext())
+
+		visitedEdges[edge] = true
+		visitedEdges[edge.Sym()] = true
+	}
+	return edges
+}
+
+/**
+ * Visits all triangles in the subdivision.
+ * The triangle edges are provided in counter-clockwise order,
+ * each edge having the triangle on its left.
+ *
+ * @param triVisitor the function to call for each triangle
+ * @param includeFrame true if the frame triangles should be included
+ */
+func (subdiv *QuadEdgeSubdivision) VisitTriangles(triVisitor TriangleVisitor, includeFrame bool) {
+	// visited flag is used to record visited edges of triangles
+	edgeStack := []*QuadEdge{subdiv.startingEdge}
+	visitedEdges := make(map[*QuadEdge]bool)
+
+	for len(edgeStack) > 0 {
+		edge := edgeStack[len(edgeStack)-1]
+		edgeStack = edgeStack[:len(edgeStack)-1]
+		if visitedEdges[edge] {
+			continue
+		}
+		triEdges := make([]*QuadEdge, 0, 3)
+		isFrame := false
+		curr := edge
+		for {
+			triEdges = append(triEdges, curr)
+			if subdiv.IsFrameEdge(curr) {
+				isFrame = true
+			}
+			// push sym edges to visit next
+			sym := curr.Sym()
+			if !visitedEdges[sym] {
+				edgeStack = append(edgeStack, sym)
+			}
+			// mark this edge as visited
+			visitedEdges[curr] = true
+
+			curr = curr.LNext()
+			if curr == edge {
+				break
+			}
+		}
+		if isFrame && !includeFrame {
+			continue
+		}
+		triVisitor(triEdges)
+	}
+}
+
+/**
+ * Gets the coordinates for each triangle in the subdivision as an array.
+ * Each triangle is returned as a closed ring of 4 coordinates,
+ * oriented counter-clockwise.
+ *
+ * @param includeFrame
+ *          true if the frame triangles should be included
+ * @return a list of Coordinate[4] representing each triangle
+ */
+func (subdiv *QuadEdgeSubdivision) GetTriangleCoordinates(includeFrame bool) [][]geom.Coordinate {
+	triCoords := make([][]geom.Coordinate, 0)
+	subdiv.VisitTriangles(func(triEdges []*QuadEdge) {
+		if len(triEdges) != 3 {
+			return
+		}
+		pts := make([]geom.Coordinate, 0, 4)
+		for _, e := range triEdges {
+			pts = append(pts, *e.Orig().GetCoordinate())
+		}
+		pts = append(pts, pts[0])
+		triCoords = append(triCoords, pts)
+	}, includeFrame)
+	return triCoords
+}
+
+/**
+ * Gets the unique {@link Vertex}es in the subdivision,
+ * including the frame vertices if desired.
+ *
+ * @param includeFrame
+ *          true if the frame vertices should be included
+ * @return the vertices in the subdivision
+ */
+func (subdiv *QuadEdgeSubdivision) GetVertices(includeFrame bool) []*Vertex {
+	vertices := make([]*Vertex, 0)
+	isAdded := make(map[*Vertex]bool)
+	for _, qe := range subdiv.quadEdges {
+		for _, v := range []*Vertex{qe.Orig(), qe.Dest()} {
+			if isAdded[v] {
+				continue
+			}
+			if includeFrame || !subdiv.IsFrameVertex(v) {
+				vertices = append(vertices, v)
+				isAdded[v] = true
+			}
+		}
+	}
+	return vertices
+}
+
+/**
+ * Gets the vertices for each triangle in the subdivision.
+ * The vertices of each triangle are in counter-clockwise order.
+ *
+ * @param includeFrame
+ *          true if the frame triangles should be included
+ * @return a list of Vertex[3] representing each triangle
+ */
+func (subdiv *QuadEdgeSubdivision) GetTriangleVertices(includeFrame bool) [][]*Vertex {
+	triVerts := make([][]*Vertex, 0)
+	subdiv.VisitTriangles(func(triEdges []*QuadEdge) {
+		if len(triEdges) != 3 {
+			return
+		}
+		triVerts = append(triVerts, []*Vertex{triEdges[0].Orig(), triEdges[1].Orig(), triEdges[2].Orig()})
+	}, includeFrame)
+	return triVerts
+}
+
+/**
+ * Gets the coordinates of the edges of the triangulation,
+ * excluding the frame edges.
+ * Each edge is returned as a line of 2 coordinates.
+ *
+ * @return a list of Coordinate[2] representing each edge
+ */
+func (subdiv *QuadEdgeSubdivision) GetEdgeCoordinates() [][]geom.Coordinate {
+	quadEdges := subdiv.GetPrimaryEdges(false)
+	edges := make([][]geom.Coordinate, 0, len(quadEdges))
+	for _, qe := range quadEdges {
+		edges = append(edges, []geom.Coordinate{*qe.Orig().GetCoordinate(), *qe.Dest().GetCoordinate()})
+	}
+	return edges
+}
+
+/**
+ * Gets a collection of {@link QuadEdge}s whose origin
+ * vertices are pairwise unique.
+ * This can be used to iterate over the vertices of the subdivision,
+ * for instance to compute the Voronoi cell of each.
+ *
+ * @param includeFrame
+ *          true if the frame vertices should be included
+ * @return a list of QuadEdges with unique origin vertices
+ */
+func (subdiv *QuadEdgeSubdivision) GetVertexUniqueEdges(includeFrame bool) []*QuadEdge {
+	edges := make([]*QuadEdge, 0)
+	visitedVertices := make(map[*Vertex]bool)
+	for _, qe := range subdiv.quadEdges {
+		for _, e := range []*QuadEdge{qe, qe.Sym()} {
+			v := e.Orig()
+			if visitedVertices[v] {
+				continue
+			}
+			visitedVertices[v] = true
+			if includeFrame || !subdiv.IsFrameVertex(v) {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return edges
+}
+
+/**
+ * Gets the cells in the Voronoi diagram for this triangulation.
+ * The cells are returned with the coordinate of the
+ * originating site.
+ * <p>
+ * The cells of sites on the boundary of the triangulation
+ * extend to the circumcentres of the frame triangles,
+ * so they will usually need to be clipped to a suitable extent.
+ *
+ * @return a list of the Voronoi cells
+ */
+func (subdiv *QuadEdgeSubdivision) GetVoronoiCellPolygons() []*VoronoiCell {
+	/*
+	 * Compute circumcentres of triangles as vertices for dual edges.
+	 * Precomputing the circumcentres is more efficient,
+	 * and more importantly ensures that the computed centres
+	 * are consistent across the Voronoi cells.
+	 */
+	subdiv.VisitTriangles(func(triEdges []*QuadEdge) {
+		a := triEdges[0].Orig().GetCoordinate()
+		b := triEdges[1].Orig().GetCoordinate()
+		c := triEdges[2].Orig().GetCoordinate()
+		ccVertex := NewVertexFromCoordinate(quadEdgeSubdivisionCircumcentre(a, b, c))
+		for _, e := range triEdges {
+			e.Rot().SetOrig(ccVertex)
+		}
+	}, true)
+
+	cells := make([]*VoronoiCell, 0)
+	for _, qe := range subdiv.GetVertexUniqueEdges(false) {
+		cells = append(cells, subdiv.GetVoronoiCellPolygon(qe))
+	}
+	return cells
+}
+
+/**
+ * Gets the Voronoi cell around a site specified
+ * by the origin of a QuadEdge.
+ * The circumcentres of the triangles must already have been computed
+ * (as is done by {@link #GetVoronoiCellPolygons}).
+ *
+ * @param qe a quadedge originating at the cell site
+ * @return the Voronoi cell of the site
+ */
+func (subdiv *QuadEdgeSubdivision) GetVoronoiCellPolygon(qe *QuadEdge) *VoronoiCell {
+	coordList := geom.DefaultCoordinateList()
+	startQE := qe
+	for {
+		// use previously computed circumcentre
+		coordList.AddCoordinateRepeated(qe.Rot().Orig().GetCoordinate(), false)
+		// move to next triangle CW around vertex
+		qe = qe.OPrev()
+		if qe == startQE {
+			break
+		}
+	}
+	coordList.CloseRing()
+	if len(coordList.Coordinates) < 4 {
+		coordList.AddCoordinateRepeated(&coordList.Coordinates[len(coordList.Coordinates)-1], true)
+	}
+	return NewVoronoiCell(coordList.ToCoordinateArray(), startQE.Orig().GetCoordinate())
+}
+
+/**
+ * Computes the circumcentre of a triangle.
+ * The vertices are translated so that c is at the origin,
+ * which reduces the magnitude of the values involved.
+ */
+func quadEdgeSubdivisionCircumcentre(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate) *geom.Coordinate {
+	ax := a.X - c.X
+	ay := a.Y - c.Y
+	bx := b.X - c.X
+	by := b.Y - c.Y
+
+	asqr := ax*ax + ay*ay
+	bsqr := bx*bx + by*by
+	denom := 2 * (ax*by - ay*bx)
+	numx := ay*bsqr - asqr*by
+	numy := ax*bsqr - asqr*bx
+	return geom.NewCoordinateXY(c.X-numx/denom, c.Y+numy/denom)
+}
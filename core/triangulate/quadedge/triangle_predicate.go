@@ -0,0 +1,41 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Tests if a point is inside the circle defined by
+ * the triangle with vertices a, b, c (oriented counter-clockwise).
+ * This computation uses the determinant of the points
+ * translated so that the test point is at the origin,
+ * which reduces the magnitude of the values involved
+ * and so improves the robustness of the result.
+ * <p>
+ * This is the predicate used for constructing
+ * Delaunay triangulations.
+ *
+ * @param a a vertex of the triangle
+ * @param b a vertex of the triangle
+ * @param c a vertex of the triangle
+ * @param p the point to test
+ * @return true if this point is inside the circle defined by the points a, b, c
+ */
+func TrianglePredicateIsInCircleRobust(a *geom.Coordinate, b *geom.Coordinate, c *geom.Coordinate, p *geom.Coordinate) bool {
+	adx := a.X - p.X
+	ady := a.Y - p.Y
+	bdx := b.X - p.X
+	bdy := b.Y - p.Y
+	cdx := c.X - p.X
+	cdy := c.Y - p.Y
+
+	abdet := adx*bdy - bdx*ady
+	bcdet := bdx*cdy - cdx*bdy
+	cadet := cdx*ady - adx*cdy
+	alift := adx*adx + ady*ady
+	blift := bdx*bdx + bdy*bdy
+	clift := cdx*cdx + cdy*cdy
+
+	disc := alift*bcdet + blift*cadet + clift*abdet
+	return disc > 0
+}
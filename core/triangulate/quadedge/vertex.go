@@ -0,0 +1,133 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Models a site (node) in a {@link QuadEdgeSubdivision}.
+ * The sites can be points on a line string representing a
+ * linear site.
+ * <p>
+ * The vertex can be considered as a vector with a norm, length, inner product, cross
+ * product, etc. Additionally, point relations (e.g., is a point to the left of a line, the circle
+ * defined by this point and two others, etc.) are also defined in this class.
+ * <p>
+ * It is common to want to attach user-defined data to
+ * the vertices of a subdivision.
+ * One way to do this is to store it in the <code>data</code> field.
+ */
+type Vertex struct {
+	p    geom.Coordinate
+	data any
+}
+
+/**
+ * Creates a vertex at a given X and Y location.
+ */
+func NewVertex(x float64, y float64) *Vertex {
+	v := new(Vertex)
+	v.p = *geom.NewCoordinateXY(x, y)
+	return v
+}
+
+/**
+ * Creates a vertex at a given location,
+ * copying all ordinates of the coordinate.
+ */
+func NewVertexFromCoordinate(c *geom.Coordinate) *Vertex {
+	v := new(Vertex)
+	v.p = *c
+	return v
+}
+
+func (v *Vertex) GetX() float64 {
+	return v.p.X
+}
+
+func (v *Vertex) GetY() float64 {
+	return v.p.Y
+}
+
+func (v *Vertex) GetZ() float64 {
+	return v.p.Z
+}
+
+func (v *Vertex) SetZ(z float64) {
+	v.p.Z = z
+}
+
+/**
+ * Gets the location of the vertex.
+ */
+func (v *Vertex) GetCoordinate() *geom.Coordinate {
+	return &v.p
+}
+
+/**
+ * Gets the user data attached to the vertex.
+ */
+func (v *Vertex) GetData() any {
+	return v.data
+}
+
+/**
+ * Attaches user data to the vertex.
+ */
+func (v *Vertex) SetData(data any) {
+	v.data = data
+}
+
+/**
+ * Tests whether this vertex has the same location as another, in 2D.
+ */
+func (v *Vertex) Equals(x *Vertex) bool {
+	return v.p.X == x.GetX() && v.p.Y == x.GetY()
+}
+
+/**
+ * Tests whether this vertex is within a distance tolerance of another.
+ */
+func (v *Vertex) EqualsTolerance(x *Vertex, tolerance float64) bool {
+	return v.p.Distance(x.GetCoordinate()) < tolerance
+}
+
+/**
+ * Tests whether the triangle formed by this vertex and two
+ * other vertices is in CCW orientation.
+ *
+ * @param b a vertex
+ * @param c a vertex
+ * @return true if the triangle is oriented CCW
+ */
+func (v *Vertex) IsCCW(b *Vertex, c *Vertex) bool {
+	// is equal to the signed area of the triangle
+	return (b.p.X-v.p.X)*(c.p.Y-v.p.Y)-(b.p.Y-v.p.Y)*(c.p.X-v.p.X) > 0
+}
+
+/**
+ * Tests whether this vertex lies strictly to the right of an edge.
+ */
+func (v *Vertex) RightOf(e *QuadEdge) bool {
+	return v.IsCCW(e.Dest(), e.Orig())
+}
+
+/**
+ * Tests whether this vertex lies strictly to the left of an edge.
+ */
+func (v *Vertex) LeftOf(e *QuadEdge) bool {
+	return v.IsCCW(e.Orig(), e.Dest())
+}
+
+/**
+ * Tests if the vertex is inside the circle defined by
+ * the triangle with vertices a, b, c (oriented counter-clockwise).
+ *
+ * @param a a vertex of the triangle
+ * @param b a vertex of the triangle
+ * @param c a vertex of the triangle
+ * @return true if this vertex is in the circumcircle of (a,b,c)
+ */
+func (v *Vertex) IsInCircle(a *Vertex, b *Vertex, c *Vertex) bool {
+	return TrianglePredicateIsInCircleRobust(&a.p, &b.p, &c.p, &v.p)
+}
@@ -0,0 +1,47 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A cell of a Voronoi diagram, given as a closed ring.
+ * The cell records the coordinate of the site which originated it
+ * (the equivalent of the user data of a cell polygon),
+ * so that cells can be related back to the input sites.
+ */
+type VoronoiCell struct {
+	ring []geom.Coordinate
+	site geom.Coordinate
+}
+
+/**
+ * Creates a Voronoi cell.
+ *
+ * @param ring the closed ring of the cell
+ * @param site the coordinate of the site of the cell
+ */
+func NewVoronoiCell(ring []geom.Coordinate, site *geom.Coordinate) *VoronoiCell {
+	cell := new(VoronoiCell)
+	cell.ring = ring
+	cell.site = *site
+	return cell
+}
+
+/**
+ * Gets the ring of the cell.
+ *
+ * @return the closed ring of the cell
+ */
+func (cell *VoronoiCell) GetRing() []geom.Coordinate {
+	return cell.ring
+}
+
+/**
+ * Gets the coordinate of the site which originated the cell.
+ *
+ * @return the site coordinate
+ */
+func (cell *VoronoiCell) GetSite() *geom.Coordinate {
+	return &cell.site
+}
@@ -0,0 +1,141 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Models a constraint segment in a triangulation.
+ * A constraint segment is an oriented straight line segment between a start point
+ * and an end point.
+ */
+type Segment struct {
+	p0   geom.Coordinate
+	p1   geom.Coordinate
+	data any
+}
+
+/**
+ * Creates a new instance for the given points.
+ *
+ * @param p0 the start point
+ * @param p1 the end point
+ */
+func NewSegment(p0 *geom.Coordinate, p1 *geom.Coordinate) *Segment {
+	return NewSegmentWithData(p0, p1, nil)
+}
+
+/**
+ * Creates a new instance for the given points, with associated external data.
+ *
+ * @param p0 the start point
+ * @param p1 the end point
+ * @param data an external data object
+ */
+func NewSegmentWithData(p0 *geom.Coordinate, p1 *geom.Coordinate, data any) *Segment {
+	seg := new(Segment)
+	seg.p0 = *p0
+	seg.p1 = *p1
+	seg.data = data
+	return seg
+}
+
+/**
+ * Gets the start coordinate of the segment
+ *
+ * @return a Coordinate
+ */
+func (seg *Segment) GetStart() *geom.Coordinate {
+	return &seg.p0
+}
+
+/**
+ * Gets the end coordinate of the segment
+ *
+ * @return a Coordinate
+ */
+func (seg *Segment) GetEnd() *geom.Coordinate {
+	return &seg.p1
+}
+
+/**
+ * Gets the length of the segment.
+ *
+ * @return the length of the segment
+ */
+func (seg *Segment) GetLength() float64 {
+	return seg.p0.Distance(&seg.p1)
+}
+
+/**
+ * Gets the external data associated with this segment
+ *
+ * @return a data object
+ */
+func (seg *Segment) GetData() any {
+	return seg.data
+}
+
+/**
+ * Sets the external data to be associated with this segment
+ *
+ * @param data a data object
+ */
+func (seg *Segment) SetData(data any) {
+	seg.data = data
+}
+
+/**
+ * Determines whether two segments are topologically equal.
+ * I.e. equal up to orientation.
+ *
+ * @param s a segment
+ * @return true if the segments are topologically equal
+ */
+func (seg *Segment) EqualsTopologically(s *Segment) bool {
+	if seg.p0.Equals2D(&s.p0) && seg.p1.Equals2D(&s.p1) {
+		return true
+	}
+	return seg.p0.Equals2D(&s.p1) && seg.p1.Equals2D(&s.p0)
+}
+
+/**
+ * Computes the projection of a point onto the line containing the segment.
+ *
+ * @param p the point to project
+ * @return the projected point
+ */
+func (seg *Segment) Project(p *geom.Coordinate) *geom.Coordinate {
+	dx := seg.p1.X - seg.p0.X
+	dy := seg.p1.Y - seg.p0.Y
+	len2 := dx*dx + dy*dy
+	if len2 == 0 {
+		return geom.NewCoordinateXY(seg.p0.X, seg.p0.Y)
+	}
+	r := ((p.X-seg.p0.X)*dx + (p.Y-seg.p0.Y)*dy) / len2
+	return geom.NewCoordinateXY(seg.p0.X+r*dx, seg.p0.Y+r*dy)
+}
+
+/**
+ * Computes the point at a given fraction along the segment
+ * from the start point.
+ * The Z value is interpolated if present.
+ *
+ * @param fraction the fraction of the segment length
+ * @return the point at the fraction along the segment
+ */
+func (seg *Segment) PointAlong(fraction float64) *geom.Coordinate {
+	return geom.NewCoordinateXYZ(
+		seg.p0.X+fraction*(seg.p1.X-seg.p0.X),
+		seg.p0.Y+fraction*(seg.p1.Y-seg.p0.Y),
+		seg.p0.Z+fraction*(seg.p1.Z-seg.p0.Z))
+}
+
+/**
+ * Gets a string representation of this segment.
+ *
+ * @return a string
+ */
+func (seg *Segment) ToString() string {
+	return seg.p0.ToString() + " - " + seg.p1.ToString()
+}
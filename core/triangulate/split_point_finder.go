@@ -0,0 +1,122 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A function which computes the split point
+ * for a constraint segment which is encroached upon
+ * by a site of the triangulation.
+ * Split points must not lie on the segment endpoints,
+ * and should be chosen so that the subsegments
+ * are not encroached upon by the same site.
+ *
+ * @param seg the encroached segment
+ * @param encroachPt the encroaching point
+ * @return the point at which to split the encroached segment
+ */
+type ConstraintSplitPointFinder func(seg *Segment, encroachPt *geom.Coordinate) *geom.Coordinate
+
+/**
+ * A simple split point finder which returns the midpoint of the split segment.
+ * This is a default strategy only.
+ * Usually a more sophisticated strategy is required to prevent repeated splitting.
+ * Other points which could be used are:
+ * <ul>
+ * <li>The projection of the encroaching point on the segment
+ * <li>A point on the segment which will produce two segments which will not be further encroached
+ * <li>The point on the segment which is the same distance from an endpoint as the encroaching point
+ * </ul>
+ *
+ * @param seg the encroached segment
+ * @param encroachPt the encroaching point
+ * @return the midpoint of the segment
+ */
+func MidpointSplitPointFinderFindSplitPoint(seg *Segment, encroachPt *geom.Coordinate) *geom.Coordinate {
+	return seg.PointAlong(0.5)
+}
+
+/**
+ * A strategy for finding constraint split points which attempts to maximise the length of the split
+ * segments while preventing further encroachment. (This is not always possible for narrow angles).
+ *
+ * @param seg the encroached segment
+ * @param encroachPt the encroaching point
+ * @return the point at which to split the encroached segment
+ */
+func NonEncroachingSplitPointFinderFindSplitPoint(seg *Segment, encroachPt *geom.Coordinate) *geom.Coordinate {
+	segLen := seg.GetLength()
+	midPtLen := segLen / 2
+	splitSeg := newSplitSegment(seg)
+
+	projPt := NonEncroachingSplitPointFinderProjectedSplitPoint(seg, encroachPt)
+	/**
+	 * Compute the largest diameter (length) that will produce a split segment which is not
+	 * still encroached upon by the encroaching point (The length is reduced slightly by a
+	 * safety factor)
+	 */
+	nonEncroachDiam := projPt.Distance(encroachPt) * 2 * 0.8
+	maxSplitLen := min(nonEncroachDiam, midPtLen)
+	splitSeg.setMinimumLength(maxSplitLen)
+
+	splitSeg.splitAt(projPt)
+	return splitSeg.getSplitPoint()
+}
+
+/**
+ * Computes a split point which is the projection of the encroaching point on the segment
+ *
+ * @param seg the encroached segment
+ * @param encroachPt the encroaching point
+ * @return a split point on the segment
+ */
+func NonEncroachingSplitPointFinderProjectedSplitPoint(seg *Segment, encroachPt *geom.Coordinate) *geom.Coordinate {
+	return seg.Project(encroachPt)
+}
+
+/**
+ * Models a constraint segment which can be split in two in various ways,
+ * according to certain geometric constraints.
+ */
+type splitSegment struct {
+	seg        *Segment
+	segLen     float64
+	splitPt    *geom.Coordinate
+	minimumLen float64
+}
+
+func newSplitSegment(seg *Segment) *splitSegment {
+	ss := new(splitSegment)
+	ss.seg = seg
+	ss.segLen = seg.GetLength()
+	return ss
+}
+
+func (ss *splitSegment) setMinimumLength(minLen float64) {
+	ss.minimumLen = minLen
+}
+
+func (ss *splitSegment) getSplitPoint() *geom.Coordinate {
+	return ss.splitPt
+}
+
+/**
+ * Splits the segment at a point,
+ * unless it is closer than the minimum length to an endpoint,
+ * in which case the point at the minimum length from that endpoint is used.
+ */
+func (ss *splitSegment) splitAt(pt *geom.Coordinate) {
+	// check that given pt doesn't violate min length
+	minFrac := ss.minimumLen / ss.segLen
+	if pt.Distance(ss.seg.GetStart()) < ss.minimumLen {
+		ss.splitPt = ss.seg.PointAlong(minFrac)
+		return
+	}
+	if pt.Distance(ss.seg.GetEnd()) < ss.minimumLen {
+		ss.splitPt = ss.seg.PointAlong(1 - minFrac)
+		return
+	}
+	// passes minimum distance check - use provided point as split pt
+	ss.splitPt = pt
+}
@@ -0,0 +1,567 @@
+package geos
+
+import (
+	"errors"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * A memory-efficient representation of a triangle in a triangulation.
+ * Contains three vertices, and links to adjacent Tris for each edge.
+ * Tris are constructed independently, and if needed linked
+ * into a triangulation using {@link TriangulationBuilderBuild}.
+ * <p>
+ * An edge of a Tri in a triangulation is called a boundary edge
+ * if it has no adjacent triangle.
+ * The set of Tris containing boundary edges are called the triangulation border.
+ */
+type Tri struct {
+	p0 geom.Coordinate
+	p1 geom.Coordinate
+	p2 geom.Coordinate
+
+	/**
+	 * triN is the adjacent triangle across the edge pN - pNNext.
+	 * pNNext is the next vertex CW from pN.
+	 */
+	tri0 *Tri
+	tri1 *Tri
+	tri2 *Tri
+}
+
+/**
+ * Creates a triangle with the given vertices.
+ * The vertices should be oriented clockwise.
+ *
+ * @param p0 the first triangle vertex
+ * @param p1 the second triangle vertex
+ * @param p2 the third triangle vertex
+ * @return the created triangle
+ */
+func NewTri(p0 *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate) *Tri {
+	tri := new(Tri)
+	tri.p0 = *p0
+	tri.p1 = *p1
+	tri.p2 = *p2
+	return tri
+}
+
+/**
+ * Creates a triangle from an array with three vertex coordinates.
+ * The vertices should be oriented clockwise.
+ *
+ * @param pts the array of vertex coordinates
+ * @return the created triangle
+ */
+func NewTriFromCoordinates(pts []geom.Coordinate) *Tri {
+	return NewTri(&pts[0], &pts[1], &pts[2])
+}
+
+/**
+ * Computes the area of a set of Tris.
+ *
+ * @param triList a set of Tris
+ * @return the total area of the triangles
+ */
+func TriArea(triList []*Tri) float64 {
+	area := 0.0
+	for _, tri := range triList {
+		area += tri.GetArea()
+	}
+	return area
+}
+
+/**
+ * Validates a list of Tris, by checking that the adjacency links
+ * of each triangle are consistent.
+ *
+ * @param triList the tris to validate
+ * @return an error if a tri is invalid
+ */
+func TriValidate(triList []*Tri) error {
+	for _, tri := range triList {
+		if err := tri.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * Gets the coordinates of the triangles in a list,
+ * each as a closed ring.
+ *
+ * @param tris the triangles
+ * @return the triangle rings
+ */
+func TriToRings(tris []*Tri) [][]geom.Coordinate {
+	rings := make([][]geom.Coordinate, 0, len(tris))
+	for _, tri := range tris {
+		rings = append(rings, tri.ToRing())
+	}
+	return rings
+}
+
+/**
+ * Sets the adjacent triangles.
+ * The vertices of the adjacent triangles are
+ * assumed to match the appropriate vertices in this triangle.
+ *
+ * @param tri0 the triangle adjacent to edge 0
+ * @param tri1 the triangle adjacent to edge 1
+ * @param tri2 the triangle adjacent to edge 2
+ */
+func (tri *Tri) SetAdjacentTris(tri0 *Tri, tri1 *Tri, tri2 *Tri) {
+	tri.tri0 = tri0
+	tri.tri1 = tri1
+	tri.tri2 = tri2
+}
+
+/**
+ * Sets the triangle adjacent to the edge originating
+ * at a given vertex.
+ * The vertices of the adjacent triangles are
+ * assumed to match the appropriate vertices in this triangle.
+ *
+ * @param pt the edge start point
+ * @param tri the adjacent triangle
+ */
+func (tri *Tri) SetAdjacent(pt *geom.Coordinate, adj *Tri) {
+	index := tri.GetIndex(pt)
+	tri.SetTri(index, adj)
+	// TODO: validate that tri is adjacent at the edge specified
+}
+
+/**
+ * Sets the triangle adjacent to an edge.
+ * The vertices of the adjacent triangle are
+ * assumed to match the appropriate vertices in this triangle.
+ *
+ * @param edgeIndex the edge triangle is adjacent to
+ * @param tri the triangle which is adjacent
+ */
+func (tri *Tri) SetTri(edgeIndex int, adj *Tri) {
+	switch edgeIndex {
+	case 0:
+		tri.tri0 = adj
+	case 1:
+		tri.tri1 = adj
+	case 2:
+		tri.tri2 = adj
+	}
+}
+
+func (tri *Tri) setCoordinates(p0 geom.Coordinate, p1 geom.Coordinate, p2 geom.Coordinate) {
+	tri.p0 = p0
+	tri.p1 = p1
+	tri.p2 = p2
+}
+
+/**
+ * Interchanges the vertices of this triangle and a neighbor
+ * so that their common edge
+ * becomes the the other diagonal of the quadrilateral they form.
+ * Neighbour triangles are updated accordingly.
+ *
+ * @param index the index of the adjacent tri to flip with
+ */
+func (tri *Tri) Flip(index int) {
+	adj := tri.GetAdjacent(index)
+	index1 := adj.GetIndexOfTri(tri)
+
+	adj0 := *tri.GetCoordinate(index)
+	adj1 := *tri.GetCoordinate(TriNext(index))
+	opp0 := *tri.GetCoordinate(TriOppVertex(index))
+	opp1 := *adj.GetCoordinate(TriOppVertex(index1))
+
+	tri.flip(adj, index, index1, adj0, adj1, opp0, opp1)
+}
+
+func (tri *Tri) flip(adj *Tri, index0 int, index1 int, adj0 geom.Coordinate, adj1 geom.Coordinate, opp0 geom.Coordinate, opp1 geom.Coordinate) {
+	tri.setCoordinates(opp1, opp0, adj0)
+	adj.setCoordinates(opp0, opp1, adj1)
+	/**
+	 *  Order: 0: opp0-adj0 edge, 1: opp0-adj1 edge,
+	 *  2: opp1-adj0 edge, 3: opp1-adj1 edge
+	 */
+	adjacent := tri.getAdjacentTris(adj, index0, index1)
+	tri.SetAdjacentTris(adj, adjacent[0], adjacent[2])
+	//--- update the adjacent triangles with new adjacency
+	if adjacent[2] != nil {
+		adjacent[2].replace(adj, tri)
+	}
+	adj.SetAdjacentTris(tri, adjacent[3], adjacent[1])
+	if adjacent[1] != nil {
+		adjacent[1].replace(tri, adj)
+	}
+}
+
+/**
+ * Replaces an adjacent triangle with a different one.
+ */
+func (tri *Tri) replace(triOld *Tri, triNew *Tri) {
+	if tri.tri0 != nil && tri.tri0 == triOld {
+		tri.tri0 = triNew
+	} else if tri.tri1 != nil && tri.tri1 == triOld {
+		tri.tri1 = triNew
+	} else if tri.tri2 != nil && tri.tri2 == triOld {
+		tri.tri2 = triNew
+	}
+}
+
+func (tri *Tri) getAdjacentTris(triAdj *Tri, index int, indexAdj int) []*Tri {
+	adj := make([]*Tri, 4)
+	adj[0] = tri.GetAdjacent(TriPrev(index))
+	adj[1] = tri.GetAdjacent(TriNext(index))
+	adj[2] = triAdj.GetAdjacent(TriNext(indexAdj))
+	adj[3] = triAdj.GetAdjacent(TriPrev(indexAdj))
+	return adj
+}
+
+/**
+ * Removes this tri from the triangulation containing it.
+ * All links between the tri and adjacent ones are nulled.
+ */
+func (tri *Tri) Remove() {
+	tri.remove(0)
+	tri.remove(1)
+	tri.remove(2)
+}
+
+func (tri *Tri) remove(index int) {
+	adj := tri.GetAdjacent(index)
+	if adj == nil {
+		return
+	}
+	adj.SetTri(adj.GetIndexOfTri(tri), nil)
+	tri.SetTri(index, nil)
+}
+
+/**
+ * Validates that the tri is correct.
+ * Currently just checks that orientation is CW.
+ * The adjacent tris must share the corresponding edge.
+ *
+ * @return an error if the tri is invalid
+ */
+func (tri *Tri) Validate() error {
+	if triIsCCW(&tri.p0, &tri.p1, &tri.p2) {
+		return errors.New("tri is not oriented correctly")
+	}
+	if err := tri.validateAdjacent(0); err != nil {
+		return err
+	}
+	if err := tri.validateAdjacent(1); err != nil {
+		return err
+	}
+	return tri.validateAdjacent(2)
+}
+
+func (tri *Tri) validateAdjacent(index int) error {
+	adj := tri.GetAdjacent(index)
+	if adj == nil {
+		return nil
+	}
+	e0 := tri.GetCoordinate(index)
+	e1 := tri.GetCoordinate(TriNext(index))
+	adj0 := adj.GetIndex(e0)
+	adj1 := adj.GetIndex(e1)
+	if adj0 < 0 || adj1 < 0 || TriNext(adj1) != adj0 {
+		return errors.New("tri does not share the edge with its adjacent tri")
+	}
+	return nil
+}
+
+/**
+ * Gets the coordinate for a vertex.
+ * This is the start vertex of the edge.
+ *
+ * @param index the vertex (edge) index
+ * @return the vertex coordinate
+ */
+func (tri *Tri) GetCoordinate(index int) *geom.Coordinate {
+	switch index {
+	case 0:
+		return &tri.p0
+	case 1:
+		return &tri.p1
+	}
+	return &tri.p2
+}
+
+/**
+ * Gets the index of the triangle vertex which has a given coordinate (if any).
+ * This is also the index of the edge which originates at the vertex.
+ *
+ * @param p the coordinate to find
+ * @return the vertex index, or -1 if it is not in the triangle
+ */
+func (tri *Tri) GetIndex(p *geom.Coordinate) int {
+	if tri.p0.Equals2D(p) {
+		return 0
+	}
+	if tri.p1.Equals2D(p) {
+		return 1
+	}
+	if tri.p2.Equals2D(p) {
+		return 2
+	}
+	return -1
+}
+
+/**
+ * Gets the edge index which a triangle is adjacent to (if any),
+ * based on the adjacent triangle link.
+ *
+ * @param adj the tri to find
+ * @return the index of the edge adjacent to the triangle, or -1 if not found
+ */
+func (tri *Tri) GetIndexOfTri(adj *Tri) int {
+	if tri.tri0 == adj {
+		return 0
+	}
+	if tri.tri1 == adj {
+		return 1
+	}
+	if tri.tri2 == adj {
+		return 2
+	}
+	return -1
+}
+
+/**
+ * Gets the triangle adjacent to an edge.
+ *
+ * @param index the edge index
+ * @return the adjacent triangle (may be nil)
+ */
+func (tri *Tri) GetAdjacent(index int) *Tri {
+	switch index {
+	case 0:
+		return tri.tri0
+	case 1:
+		return tri.tri1
+	}
+	return tri.tri2
+}
+
+/**
+ * Tests if this tri has any adjacent tris.
+ *
+ * @return true if there is at least one adjacent tri
+ */
+func (tri *Tri) HasAnyAdjacent() bool {
+	return tri.HasAdjacent(0) || tri.HasAdjacent(1) || tri.HasAdjacent(2)
+}
+
+/**
+ * Tests if there is an adjacent triangle to an edge.
+ *
+ * @param index the edge index
+ * @return true if there is a triangle adjacent to edge
+ */
+func (tri *Tri) HasAdjacent(index int) bool {
+	return tri.GetAdjacent(index) != nil
+}
+
+/**
+ * Tests if a triangle is adjacent to some edge of this triangle.
+ *
+ * @param adj the triangle to test
+ * @return true if the triangle is adjacent
+ */
+func (tri *Tri) IsAdjacent(adj *Tri) bool {
+	return tri.GetIndexOfTri(adj) >= 0
+}
+
+/**
+ * Computes the number of triangle adjacent to this triangle.
+ * This is a number from 0 to 3.
+ *
+ * @return the number of adjacent triangles
+ */
+func (tri *Tri) NumAdjacent() int {
+	num := 0
+	if tri.tri0 != nil {
+		num++
+	}
+	if tri.tri1 != nil {
+		num++
+	}
+	if tri.tri2 != nil {
+		num++
+	}
+	return num
+}
+
+/**
+ * Tests if a tri vertex is interior.
+ * A vertex of a triangle is interior if it
+ * is fully surrounded by other triangles.
+ *
+ * @param index the vertex index
+ * @return true if the vertex is interior
+ */
+func (tri *Tri) IsInteriorVertex(index int) bool {
+	curr := tri
+	currIndex := index
+	for {
+		adj := curr.GetAdjacent(currIndex)
+		if adj == nil {
+			return false
+		}
+		adjIndex := adj.GetIndexOfTri(curr)
+		if adjIndex < 0 {
+			//-- the adjacency links are inconsistent
+			return false
+		}
+		curr = adj
+		currIndex = TriNext(adjIndex)
+		if curr == tri {
+			break
+		}
+	}
+	return true
+}
+
+/**
+ * Tests if a tri contains a boundary edge,
+ * and thus on the border of the triangulation containing it.
+ *
+ * @return true if the tri is on the border of the triangulation
+ */
+func (tri *Tri) IsBorder() bool {
+	return tri.IsBoundary(0) || tri.IsBoundary(1) || tri.IsBoundary(2)
+}
+
+/**
+ * Tests if a tri edge is a boundary edge,
+ * i.e. has no adjacent triangle.
+ *
+ * @param index the edge index
+ * @return true if the edge is a boundary
+ */
+func (tri *Tri) IsBoundary(index int) bool {
+	return !tri.HasAdjacent(index)
+}
+
+/**
+ * Computes the vertex or edge index which is the next one
+ * (clockwise) around the triangle.
+ *
+ * @param index the index
+ * @return the next index value
+ */
+func TriNext(index int) int {
+	switch index {
+	case 0:
+		return 1
+	case 1:
+		return 2
+	case 2:
+		return 0
+	}
+	return -1
+}
+
+/**
+ * Computes the vertex or edge index which is the previous one
+ * (counter-clockwise) around the triangle.
+ *
+ * @param index the index
+ * @return the previous index value
+ */
+func TriPrev(index int) int {
+	switch index {
+	case 0:
+		return 2
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	return -1
+}
+
+/**
+ * Gets the index of the vertex opposite an edge.
+ *
+ * @param edgeIndex the edge index
+ * @return the index of the opposite vertex
+ */
+func TriOppVertex(edgeIndex int) int {
+	return TriPrev(edgeIndex)
+}
+
+/**
+ * Gets the index of the edge opposite a vertex.
+ *
+ * @param vertexIndex the index of the vertex
+ * @return the index of the opposite edge
+ */
+func TriOppEdge(vertexIndex int) int {
+	return TriNext(vertexIndex)
+}
+
+/**
+ * Computes a coordinate for the midpoint of a triangle edge.
+ *
+ * @param edgeIndex the edge index
+ * @return the midpoint of the triangle edge
+ */
+func (tri *Tri) Midpoint(edgeIndex int) *geom.Coordinate {
+	p0 := tri.GetCoordinate(edgeIndex)
+	p1 := tri.GetCoordinate(TriNext(edgeIndex))
+	midX := (p0.X + p1.X) / 2
+	midY := (p0.Y + p1.Y) / 2
+	return geom.NewCoordinateXY(midX, midY)
+}
+
+/**
+ * Gets the area of the triangle.
+ *
+ * @return the area of the triangle
+ */
+func (tri *Tri) GetArea() float64 {
+	area := ((tri.p1.X-tri.p0.X)*(tri.p2.Y-tri.p0.Y) - (tri.p2.X-tri.p0.X)*(tri.p1.Y-tri.p0.Y)) / 2
+	if area < 0 {
+		return -area
+	}
+	return area
+}
+
+/**
+ * Gets the perimeter length of the triangle.
+ *
+ * @return the perimeter length
+ */
+func (tri *Tri) GetLength() float64 {
+	return tri.p0.Distance(&tri.p1) + tri.p1.Distance(&tri.p2) + tri.p2.Distance(&tri.p0)
+}
+
+/**
+ * Gets the length of an edge of the triangle.
+ *
+ * @param edgeIndex the edge index
+ * @return the edge length
+ */
+func (tri *Tri) GetEdgeLength(edgeIndex int) float64 {
+	return tri.GetCoordinate(edgeIndex).Distance(tri.GetCoordinate(TriNext(edgeIndex)))
+}
+
+/**
+ * Gets the coordinates of the triangle as a closed ring.
+ *
+ * @return the triangle ring
+ */
+func (tri *Tri) ToRing() []geom.Coordinate {
+	return []geom.Coordinate{tri.p0, tri.p1, tri.p2, tri.p0}
+}
+
+/**
+ * Tests whether a triangle is oriented counter-clockwise.
+ */
+func triIsCCW(p0 *geom.Coordinate, p1 *geom.Coordinate, p2 *geom.Coordinate) bool {
+	return (p1.X-p0.X)*(p2.Y-p0.Y)-(p1.Y-p0.Y)*(p2.X-p0.X) > 0
+}
@@ -0,0 +1,65 @@
+package geos
+
+import (
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+/**
+ * Represents an edge in a {@link Tri},
+ * to be used as a key for looking up Tris
+ * while building a triangulation.
+ * The edge value is normalized to allow lookup
+ * of adjacent triangles.
+ */
+type triEdgeKey struct {
+	x0, y0, x1, y1 float64
+}
+
+func newTriEdgeKey(a *geom.Coordinate, b *geom.Coordinate) triEdgeKey {
+	if a.CompareTo(b) > 0 {
+		a, b = b, a
+	}
+	return triEdgeKey{a.X, a.Y, b.X, b.Y}
+}
+
+/**
+ * Builds a triangulation from a set of {@link Tri}s
+ * by populating the links to adjacent triangles.
+ * Tris which share an edge (with the same vertices)
+ * are linked as neighbours.
+ *
+ * @param triList the list of Tris
+ */
+func TriangulationBuilderBuild(triList []*Tri) {
+	triMap := make(map[triEdgeKey]*Tri)
+	for _, tri := range triList {
+		triangulationBuilderAdd(triMap, tri)
+	}
+}
+
+func triangulationBuilderAdd(triMap map[triEdgeKey]*Tri, tri *Tri) {
+	p0 := tri.GetCoordinate(0)
+	p1 := tri.GetCoordinate(1)
+	p2 := tri.GetCoordinate(2)
+
+	// get adjacent triangles, if any
+	n0 := triMap[newTriEdgeKey(p0, p1)]
+	n1 := triMap[newTriEdgeKey(p1, p2)]
+	n2 := triMap[newTriEdgeKey(p2, p0)]
+
+	tri.SetAdjacentTris(n0, n1, n2)
+	triangulationBuilderAddAdjacent(triMap, tri, n0, p0, p1)
+	triangulationBuilderAddAdjacent(triMap, tri, n1, p1, p2)
+	triangulationBuilderAddAdjacent(triMap, tri, n2, p2, p0)
+}
+
+func triangulationBuilderAddAdjacent(triMap map[triEdgeKey]*Tri, tri *Tri, adj *Tri, p0 *geom.Coordinate, p1 *geom.Coordinate) {
+	/**
+	 * If adjacent is nil, this tri is first one to be recorded for edge
+	 */
+	if adj == nil {
+		triMap[newTriEdgeKey(p0, p1)] = tri
+		return
+	}
+	adj.SetAdjacent(p1, tri)
+}
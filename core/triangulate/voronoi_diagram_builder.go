@@ -0,0 +1,201 @@
+package geos
+
+import (
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * A utility class which creates Voronoi Diagrams
+ * from collections of points.
+ * The diagram is returned as a list of cells,
+ * each carrying the coordinate of the site which originated it.
+ * <p>
+ * The diagram is clipped to the clip envelope if one is set,
+ * or otherwise to an envelope surrounding the sites
+ * (expanded by the larger of the width and height of the sites extent).
+ */
+type VoronoiDiagramBuilder struct {
+	siteCoords []geom.Coordinate
+	tolerance  float64
+	subdiv     *quadedge.QuadEdgeSubdivision
+	clipEnv    *geom.Envelope
+	diagramEnv *geom.Envelope
+}
+
+/**
+ * Creates a new Voronoi diagram builder.
+ */
+func NewVoronoiDiagramBuilder() *VoronoiDiagramBuilder {
+	builder := new(VoronoiDiagramBuilder)
+	return builder
+}
+
+/**
+ * Sets the sites (point or vertices) which will be diagrammed.
+ * Repeated points are removed.
+ *
+ * @param coords the points to diagram
+ */
+func (builder *VoronoiDiagramBuilder) SetSites(coords []geom.Coordinate) {
+	// remove any duplicate points (they will cause the triangulation to fail)
+	builder.siteCoords = DelaunayTriangulationBuilderExtractUniqueCoordinates(coords)
+	builder.subdiv = nil
+}
+
+/**
+ * Sets the envelope to clip the diagram to.
+ * The diagram is clipped to exactly this envelope,
+ * which may be smaller or larger than the extent of the sites.
+ * Cells lying entirely outside it are dropped.
+ *
+ * @param clipEnv the clip envelope
+ */
+func (builder *VoronoiDiagramBuilder) SetClipEnvelope(clipEnv *geom.Envelope) {
+	builder.clipEnv = clipEnv
+	builder.subdiv = nil
+}
+
+/**
+ * Sets the snapping tolerance which will be used
+ * to improved the robustness of the triangulation computation.
+ * A tolerance of 0.0 specifies that no snapping will take place.
+ *
+ * @param tolerance the tolerance distance to use
+ */
+func (builder *VoronoiDiagramBuilder) SetTolerance(tolerance float64) {
+	builder.tolerance = tolerance
+	builder.subdiv = nil
+}
+
+func (builder *VoronoiDiagramBuilder) create() error {
+	if builder.subdiv != nil {
+		return nil
+	}
+	diagramEnv := DelaunayTriangulationBuilderEnvelope(builder.siteCoords)
+	//-- add a buffer around the final envelope
+	expandBy := max(diagramEnv.GetWidth(), diagramEnv.GetHeight())
+	diagramEnv.ExpandBy(expandBy)
+
+	//-- the subdivision frame must cover the clip envelope, so the cells extend over it
+	frameEnv := geom.NewEnvelopeFromEnvelope(diagramEnv)
+	if builder.clipEnv != nil {
+		frameEnv.ExpandToIncludeEnvelope(builder.clipEnv)
+		diagramEnv = geom.NewEnvelopeFromEnvelope(builder.clipEnv)
+	}
+
+	vertices := DelaunayTriangulationBuilderToVertices(builder.siteCoords)
+	subdiv := quadedge.NewQuadEdgeSubdivision(frameEnv, builder.tolerance)
+	triangulator := NewIncrementalDelaunayTriangulator(subdiv)
+	if err := triangulator.InsertSites(vertices); err != nil {
+		return err
+	}
+	builder.subdiv = subdiv
+	builder.diagramEnv = diagramEnv
+	return nil
+}
+
+/**
+ * Gets the {@link quadedge.QuadEdgeSubdivision} which models the computed diagram.
+ * An error is returned if the triangulation cannot be computed.
+ *
+ * @return the subdivision containing the triangulation
+ */
+func (builder *VoronoiDiagramBuilder) GetSubdivision() (*quadedge.QuadEdgeSubdivision, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	return builder.subdiv, nil
+}
+
+/**
+ * Gets the cells of the computed diagram,
+ * clipped to the diagram envelope.
+ * Each cell is a clockwise ring, and carries the coordinate
+ * of the site it contains.
+ * An error is returned if the triangulation cannot be computed.
+ *
+ * @return the Voronoi cells of the sites
+ */
+func (builder *VoronoiDiagramBuilder) GetDiagram() ([]*quadedge.VoronoiCell, error) {
+	if err := builder.create(); err != nil {
+		return nil, err
+	}
+	cells := builder.subdiv.GetVoronoiCellPolygons()
+	return voronoiDiagramBuilderClipCells(cells, builder.diagramEnv), nil
+}
+
+/**
+ * Clips the cells to an envelope.
+ * Cells which do not intersect the envelope
+ * (or whose clipped area is zero) are dropped.
+ */
+func voronoiDiagramBuilderClipCells(cells []*quadedge.VoronoiCell, clipEnv *geom.Envelope) []*quadedge.VoronoiCell {
+	clipped := make([]*quadedge.VoronoiCell, 0, len(cells))
+	for _, cell := range cells {
+		ring := cell.GetRing()
+		cellEnv := geom.NewEnvelopeFromCoordinateArray(ring)
+		if !clipEnv.CoversEnvelope(cellEnv) {
+			if !clipEnv.IntersectsEnvelope(cellEnv) {
+				continue
+			}
+			ring = voronoiDiagramBuilderClipConvexRing(ring, clipEnv)
+		}
+		if len(ring) < 4 || algorithm.AreaOfRing(ring) == 0 {
+			continue
+		}
+		if algorithm.OrientationIsCCW(ring) {
+			geom.ReverseCoordinates(ring)
+		}
+		clipped = append(clipped, quadedge.NewVoronoiCell(ring, cell.GetSite()))
+	}
+	return clipped
+}
+
+/**
+ * Clips a convex ring to an envelope,
+ * by clipping it against each side of the envelope in turn
+ * (the Sutherland-Hodgman algorithm).
+ * The result is exact (up to rounding of the computed intersection points),
+ * since the ring is convex.
+ */
+func voronoiDiagramBuilderClipConvexRing(ring []geom.Coordinate, env *geom.Envelope) []geom.Coordinate {
+	pts := ring[:len(ring)-1]
+	pts = voronoiDiagramBuilderClipSide(pts, func(p *geom.Coordinate) float64 { return p.X - env.GetMinX() })
+	pts = voronoiDiagramBuilderClipSide(pts, func(p *geom.Coordinate) float64 { return env.GetMaxX() - p.X })
+	pts = voronoiDiagramBuilderClipSide(pts, func(p *geom.Coordinate) float64 { return p.Y - env.GetMinY() })
+	pts = voronoiDiagramBuilderClipSide(pts, func(p *geom.Coordinate) float64 { return env.GetMaxY() - p.Y })
+
+	coordList := geom.DefaultCoordinateList()
+	for i := range pts {
+		//-- ensure computed intersection points lie exactly on the envelope
+		pts[i].X = min(max(pts[i].X, env.GetMinX()), env.GetMaxX())
+		pts[i].Y = min(max(pts[i].Y, env.GetMinY()), env.GetMaxY())
+		coordList.AddCoordinateRepeated(&pts[i], false)
+	}
+	coordList.CloseRing()
+	return coordList.ToCoordinateArray()
+}
+
+/**
+ * Clips a sequence of ring vertices (without the closing point)
+ * to the half-plane where the signed distance function is non-negative.
+ */
+func voronoiDiagramBuilderClipSide(pts []geom.Coordinate, dist func(p *geom.Coordinate) float64) []geom.Coordinate {
+	result := make([]geom.Coordinate, 0, len(pts)+1)
+	for i := range pts {
+		p0 := &pts[i]
+		p1 := &pts[(i+1)%len(pts)]
+		d0 := dist(p0)
+		d1 := dist(p1)
+		if d0 >= 0 {
+			result = append(result, *p0)
+		}
+		if (d0 < 0 && d1 > 0) || (d0 > 0 && d1 < 0) {
+			frac := d0 / (d0 - d1)
+			result = append(result, *geom.NewCoordinateXY(p0.X+frac*(p1.X-p0.X), p0.Y+frac*(p1.Y-p0.Y)))
+		}
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	hull "github.com/UltimateThread/geos-go/core/algorithm/hull"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+/**
+ * Creates the points of a 0..10 grid, excluding those
+ * inside the given block.
+ */
+func concave_hull_grid(minX float64, maxX float64, minY float64, maxY float64) []geom.Coordinate {
+	pts := make([]geom.Coordinate, 0)
+	for x := 0.0; x <= 10; x++ {
+		for y := 0.0; y <= 10; y++ {
+			if x >= minX && x <= maxX && y >= minY && y <= maxY {
+				continue
+			}
+			pts = append(pts, *geom.NewCoordinateXY(x, y))
+		}
+	}
+	return pts
+}
+
+func check_concave_hull(t *testing.T, poly [][]geom.Coordinate, pts []geom.Coordinate, numHoles int, area float64) {
+	assert.Equal(t, numHoles+1, len(poly))
+	assert.True(t, valid.NewIsValidOpPolygon(poly).IsValid())
+	assert.False(t, algorithm.OrientationIsCCW(poly[0]))
+	assert.InDelta(t, area, polygons_area([][][]geom.Coordinate{poly}), 1e-9)
+	for i := range pts {
+		assert.NotEqual(t, 2, locate_in_polygon(&pts[i], poly))
+	}
+}
+
+func TestConcaveHullByLength(t *testing.T) {
+	pts := concave_hull_grid(3, 7, 3, 10)
+
+	//-- the notch corners are cut by diagonals shorter than the target length
+	poly, err := hull.ConcaveHullByLength(pts, 1.5, false)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, pts, 0, 53)
+
+	//-- a large length produces the convex hull
+	poly, err = hull.ConcaveHullByLength(pts, 100, false)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, pts, 0, 100)
+}
+
+func TestConcaveHullByLengthRatio(t *testing.T) {
+	pts := concave_hull_grid(3, 7, 3, 10)
+
+	poly, err := hull.ConcaveHullByLengthRatio(pts, 1, false)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, pts, 0, 100)
+
+	poly, err = hull.ConcaveHullByLengthRatio(pts, 0, false)
+	assert.Nil(t, err)
+	assert.True(t, polygons_area([][][]geom.Coordinate{poly}) <= 53)
+	check_concave_hull(t, poly, pts, 0, polygons_area([][][]geom.Coordinate{poly}))
+
+	_, err = hull.ConcaveHullByLengthRatio(pts, 1.5, false)
+	assert.NotNil(t, err)
+	_, err = hull.ConcaveHullByLength(pts, -1, false)
+	assert.NotNil(t, err)
+}
+
+func TestConcaveHullHoles(t *testing.T) {
+	pts := concave_hull_grid(3, 7, 3, 7)
+
+	poly, err := hull.ConcaveHullByLength(pts, 1.5, true)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, pts, 1, 66)
+	assert.True(t, algorithm.OrientationIsCCW(poly[1]))
+
+	poly, err = hull.ConcaveHullByLength(pts, 1.5, false)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, pts, 0, 100)
+}
+
+func TestConcaveHullDegenerate(t *testing.T) {
+	poly, err := hull.ConcaveHullByLength(nil, 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(poly))
+
+	poly, err = hull.ConcaveHullByLength(coords(0, 0, 1, 1, 2, 2, 1, 1), 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(poly))
+	check_coords(t, poly[0], 0, 0, 2, 2)
+
+	poly, err = hull.ConcaveHullByLength(coords(0, 0, 4, 0, 0, 3), 1, false)
+	assert.Nil(t, err)
+	check_concave_hull(t, poly, coords(0, 0, 4, 0, 0, 3), 0, 6)
+}
+
+func TestConcaveHullUniformGridEdgeLength(t *testing.T) {
+	pts := concave_hull_grid(20, 20, 20, 20)
+	assert.InDelta(t, 10/11.0, hull.ConcaveHullUniformGridEdgeLength(pts), 1e-9)
+}
+
+func TestConcaveHullOfPolygons(t *testing.T) {
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)},
+		{coords(20, 0, 20, 10, 30, 10, 30, 0, 20, 0)},
+	}
+
+	//-- a large length fills the gap
+	result, err := hull.ConcaveHullOfPolygonsByLength(polys, 100, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 1, len(result[0]))
+	assert.True(t, valid.NewIsValidOpPolygon(result[0]).IsValid())
+	assert.InDelta(t, 300, polygons_area(result), 1e-9)
+
+	//-- a small length leaves the input polygons
+	result, err = hull.ConcaveHullOfPolygonsByLength(polys, 5, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(result))
+	assert.InDelta(t, 200, polygons_area(result), 1e-9)
+
+	result, err = hull.ConcaveHullOfPolygonsByLengthRatio(polys, 1, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.InDelta(t, 300, polygons_area(result), 1e-9)
+
+	fill, err := hull.ConcaveHullOfPolygonsFillByLength(polys, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(fill))
+	assert.InDelta(t, 100, polygons_area(fill), 1e-9)
+}
+
+func TestConcaveHullOfPolygonsTight(t *testing.T) {
+	//-- an L-shape and a square in its corner gap
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 10, 2, 10, 2, 2, 10, 2, 10, 0, 0, 0)},
+		{coords(4, 4, 4, 6, 6, 6, 6, 4, 4, 4)},
+	}
+	loose, err := hull.ConcaveHullOfPolygonsByLength(polys, 100, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(loose))
+	assert.InDelta(t, 68, polygons_area(loose), 1e-9)
+
+	tight, err := hull.ConcaveHullOfPolygonsByLength(polys, 100, true, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tight))
+	assert.True(t, polygons_area(tight) <= polygons_area(loose))
+	for _, poly := range tight {
+		assert.True(t, valid.NewIsValidOpPolygon(poly).IsValid())
+	}
+}
+
+func TestConcaveHullOfPolygonsHoles(t *testing.T) {
+	//-- four squares around a gap
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 4, 4, 4, 4, 0, 0, 0)},
+		{coords(0, 10, 0, 14, 4, 14, 4, 10, 0, 10)},
+		{coords(10, 10, 10, 14, 14, 14, 14, 10, 10, 10)},
+		{coords(10, 0, 10, 4, 14, 4, 14, 0, 10, 0)},
+	}
+	result, err := hull.ConcaveHullOfPolygonsByLength(polys, 7, false, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 2, len(result[0]))
+	assert.True(t, valid.NewIsValidOpPolygon(result[0]).IsValid())
+
+	result, err = hull.ConcaveHullOfPolygonsByLength(polys, 7, false, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 1, len(result[0]))
+	assert.InDelta(t, 196, polygons_area(result), 1e-9)
+}
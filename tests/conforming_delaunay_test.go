@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	triangulate "github.com/UltimateThread/geos-go/core/triangulate"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+)
+
+/**
+ * Checks that each constraint segment is the union of triangulation edges.
+ */
+func check_conforming_edges(t *testing.T, edges [][]geom.Coordinate, constraints [][]geom.Coordinate) {
+	for _, line := range constraints {
+		for i := 0; i < len(line)-1; i++ {
+			p0 := &line[i]
+			p1 := &line[i+1]
+			edgeLen := 0.0
+			for _, e := range edges {
+				if algorithm.DistancePointToSegment(&e[0], p0, p1) < 1e-9 &&
+					algorithm.DistancePointToSegment(&e[1], p0, p1) < 1e-9 {
+					edgeLen += e[0].Distance(&e[1])
+				}
+			}
+			assert.InDelta(t, p0.Distance(p1), edgeLen, 1e-9)
+		}
+	}
+}
+
+func TestConformingDelaunayConstraintSplit(t *testing.T) {
+	//-- the sites near the constraint force it to be split
+	sites := coords(5, 1, 5, -1, 0, 10, 10, 10, 0, -10, 10, -10)
+	constraints := [][]geom.Coordinate{coords(0, 0, 10, 0)}
+
+	builder := triangulate.NewConformingDelaunayTriangulationBuilder()
+	builder.SetSites(sites)
+	builder.SetConstraints(constraints)
+	edges, err := builder.GetEdges()
+	assert.Nil(t, err)
+	check_conforming_edges(t, edges, constraints)
+
+	subdiv, err := builder.GetSubdivision()
+	assert.Nil(t, err)
+	assert.Greater(t, len(subdiv.GetVertices(false)), 8)
+}
+
+func TestConformingDelaunayTriangles(t *testing.T) {
+	sites := coords(0, 0, 10, 0, 10, 10, 0, 10, 4, 6)
+	constraints := [][]geom.Coordinate{coords(0, 0, 10, 10), coords(5, 0, 5, 10)}
+
+	builder := triangulate.NewConformingDelaunayTriangulationBuilder()
+	builder.SetSites(sites)
+	builder.SetConstraints(constraints)
+	tris, err := builder.GetTriangles()
+	assert.Nil(t, err)
+	area := 0.0
+	for _, tri := range tris {
+		assert.False(t, algorithm.OrientationIsCCW(tri))
+		area += algorithm.AreaOfRing(tri)
+	}
+	assert.InDelta(t, 100, area, 1e-9)
+
+	edges, err := builder.GetEdges()
+	assert.Nil(t, err)
+	check_conforming_edges(t, edges, constraints)
+}
+
+func TestConformingDelaunayParallelConstraints(t *testing.T) {
+	constraints := [][]geom.Coordinate{
+		coords(64.18716208549536, 0, 125.50878763278658, 0),
+		coords(20.284376043157064, 5, 124.99259311571605, 5),
+	}
+
+	builder := triangulate.NewConformingDelaunayTriangulationBuilder()
+	builder.SetConstraints(constraints)
+	edges, err := builder.GetEdges()
+	if !assert.Nil(t, err) {
+		return
+	}
+	check_conforming_edges(t, edges, constraints)
+}
+
+func TestConformingDelaunayInterpolatesZ(t *testing.T) {
+	sites := coords(5, 0.5, 5, -0.5)
+	constraints := [][]geom.Coordinate{{*geom.NewCoordinateXYZ(0, 0, 0), *geom.NewCoordinateXYZ(10, 0, 10)}}
+
+	builder := triangulate.NewConformingDelaunayTriangulationBuilder()
+	builder.SetSites(sites)
+	builder.SetConstraints(constraints)
+	edges, err := builder.GetEdges()
+	assert.Nil(t, err)
+	check_conforming_edges(t, edges, constraints)
+	for _, e := range edges {
+		for _, p := range e {
+			if p.Y == 0 {
+				assert.InDelta(t, p.X, p.Z, 1e-9)
+			}
+		}
+	}
+}
+
+func TestConformingDelaunayNoConstraints(t *testing.T) {
+	builder := triangulate.NewConformingDelaunayTriangulationBuilder()
+	builder.SetSites(coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5))
+	tris, err := builder.GetTriangles()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(tris))
+}
+
+func TestPolygonTriangulatorWithHole(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(3, 3, 7, 3, 7, 7, 3, 7, 3, 3),
+	}
+	tris, err := polygon.PolygonTriangulatorTriangulate([][][]geom.Coordinate{poly})
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(tris))
+	area := 0.0
+	for _, tri := range tris {
+		area += algorithm.AreaOfRing(tri)
+		centroid := geom.NewCoordinateXY((tri[0].X+tri[1].X+tri[2].X)/3, (tri[0].Y+tri[1].Y+tri[2].Y)/3)
+		assert.Equal(t, 0, locate_in_polygon(centroid, poly))
+	}
+	assert.InDelta(t, 84, area, 1e-9)
+}
+
+func TestPolygonTriangulatorMatchesConstrainedDelaunay(t *testing.T) {
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 10, 2, 10, 2, 2, 8, 2, 8, 10, 10, 10, 10, 0, 0, 0)},
+		{coords(20, 0, 20, 5, 25, 5, 25, 0, 20, 0)},
+		{
+			coords(30, 0, 31, 4, 34, 6, 38, 6, 41, 4, 42, 0, 41, -4, 38, -6, 34, -6, 31, -4, 30, 0),
+			coords(35, -1, 37, -1, 37, 1, 35, 1, 35, -1),
+		},
+	}
+	tris, err := polygon.PolygonTriangulatorTriangulate(polys)
+	assert.Nil(t, err)
+	cdtTris, err := polygon.ConstrainedDelaunayTriangulatorTriangulate(polys)
+	assert.Nil(t, err)
+	assert.Equal(t, len(cdtTris), len(tris))
+
+	//-- both triangulations lie in the polygons and cover their area
+	check_triangles_in_polygons(t, tris, polys)
+	check_triangles_in_polygons(t, cdtTris, polys)
+
+	//-- the constrained triangulation is Delaunay within each polygon
+	for _, poly := range polys {
+		triList, err := polygon.ConstrainedDelaunayTriangulatorTriangulatePolygon(poly)
+		assert.Nil(t, err)
+		check_tris_delaunay(t, triList)
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Checks that a linked triangulation is Delaunay with respect to its boundary:
+ * for every pair of adjacent tris forming a convex quadrilateral,
+ * the vertex of each tri opposite the common edge
+ * is not inside the circumcircle of the other tri.
+ */
+func check_tris_delaunay(t *testing.T, triList []*tri.Tri) {
+	assert.Nil(t, tri.TriValidate(triList))
+	for _, tr := range triList {
+		for i := 0; i < 3; i++ {
+			adj := tr.GetAdjacent(i)
+			if adj == nil {
+				continue
+			}
+			adj0 := tr.GetCoordinate(i)
+			adj1 := tr.GetCoordinate(tri.TriNext(i))
+			opp0 := tr.GetCoordinate(tri.TriOppVertex(i))
+			opp1 := adj.GetCoordinate(tri.TriOppVertex(adj.GetIndexOfTri(tr)))
+			isConvex := algorithm.OrientationIndex(opp0, adj0, opp1) == algorithm.OrientationIndex(opp1, adj1, opp0)
+			if !isConvex {
+				continue
+			}
+			//-- the tris are clockwise, so reverse them for the circle test
+			assert.False(t, quadedge.TrianglePredicateIsInCircleRobust(adj0, opp0, adj1, opp1),
+				"%v is in the circumcircle of %v", opp1, tr.ToRing())
+		}
+	}
+}
+
+func TestTriDelaunayImproverFlips(t *testing.T) {
+	//-- a thin quadrilateral triangulated along its long diagonal
+	tris := []*tri.Tri{
+		tri.NewTriFromCoordinates(coords(0, 0, 5, 1, 10, 0)),
+		tri.NewTriFromCoordinates(coords(0, 0, 10, 0, 5, -1)),
+	}
+	tri.TriangulationBuilderBuild(tris)
+	polygon.TriDelaunayImproverImprove(tris)
+
+	check_tris_delaunay(t, tris)
+	assert.Equal(t, 10.0, tri.TriArea(tris))
+	for _, tr := range tris {
+		assert.True(t, tr.GetIndex(geom.NewCoordinateXY(5, 1)) >= 0)
+		assert.True(t, tr.GetIndex(geom.NewCoordinateXY(5, -1)) >= 0)
+	}
+}
+
+func TestTriDelaunayImproverNonConvex(t *testing.T) {
+	//-- the quadrilateral is concave at (10,0), so the diagonal can not be flipped
+	tris := []*tri.Tri{
+		tri.NewTriFromCoordinates(coords(0, 0, 12, 1, 10, 0)),
+		tri.NewTriFromCoordinates(coords(0, 0, 10, 0, 12, -1)),
+	}
+	tri.TriangulationBuilderBuild(tris)
+	polygon.TriDelaunayImproverImprove(tris)
+
+	check_coords(t, tris[0].ToRing(), 0, 0, 12, 1, 10, 0, 0, 0)
+	check_coords(t, tris[1].ToRing(), 0, 0, 10, 0, 12, -1, 0, 0)
+}
+
+func TestTriDelaunayImproverFan(t *testing.T) {
+	//-- ear clipping a convex polygon produces a poor fan triangulation
+	shell := coords(0, 0, 1, 4, 4, 6, 8, 6, 11, 4, 12, 0, 11, -4, 8, -6, 4, -6, 1, -4, 0, 0)
+	tris, err := polygon.PolygonEarClipperTriangulate(shell)
+	assert.Nil(t, err)
+	tri.TriangulationBuilderBuild(tris)
+	polygon.TriDelaunayImproverImprove(tris)
+
+	check_tris_delaunay(t, tris)
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{shell})
+}
+
+func TestConstrainedDelaunayTriangulatePolygon(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 1, 4, 4, 6, 8, 6, 11, 4, 12, 0, 11, -4, 8, -6, 4, -6, 1, -4, 0, 0),
+		coords(5, -1, 7, -1, 7, 1, 5, 1, 5, -1),
+	}
+	tris, err := polygon.ConstrainedDelaunayTriangulatorTriangulatePolygon(poly)
+	assert.Nil(t, err)
+	assert.Equal(t, 14, len(tris))
+	check_tris_delaunay(t, tris)
+	check_triangles_in_polygon(t, tri.TriToRings(tris), poly)
+}
+
+func TestConstrainedDelaunayTriangulator(t *testing.T) {
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 10, 2, 10, 2, 2, 8, 2, 8, 10, 10, 10, 10, 0, 0, 0)},
+		{
+			coords(20, 0, 20, 10, 30, 10, 30, 0, 20, 0),
+			coords(20, 5, 23, 7, 23, 3, 20, 5),
+		},
+		{},
+	}
+	cdt := polygon.NewConstrainedDelaunayTriangulator(polys)
+	tris, err := cdt.GetResult()
+	assert.Nil(t, err)
+	//-- the result is cached
+	again, err := cdt.GetResult()
+	assert.Nil(t, err)
+	assert.Equal(t, len(tris), len(again))
+	for i := range tris {
+		for j := range tris[i] {
+			assert.True(t, tris[i][j].Equals2D(&again[i][j]))
+		}
+	}
+
+	check_triangles_in_polygons(t, tris, polys[:2])
+}
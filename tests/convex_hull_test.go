@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+func TestConvexHullFewPoints(t *testing.T) {
+	assert.Equal(t, 0, len(algorithm.ConvexHullOfPoints(nil)))
+	check_coords(t, algorithm.ConvexHullOfPoints(coords(1, 1, 1, 1)), 1, 1)
+	check_coords(t, algorithm.ConvexHullOfPoints(coords(1, 1, 2, 2, 1, 1)), 1, 1, 2, 2)
+}
+
+func TestConvexHullCollinear(t *testing.T) {
+	check_coords(t, algorithm.ConvexHullOfPoints(coords(0, 0, 1, 1, 2, 2, 1, 1, 3, 3)), 0, 0, 3, 3)
+	check_coords(t, algorithm.ConvexHullOfPoints(coords(5, 0, 1, 0, 3, 0, 0, 0)), 0, 0, 5, 0)
+}
+
+func TestConvexHullSquare(t *testing.T) {
+	pts := coords(0, 0, 10, 0, 5, 5, 10, 10, 0, 10, 2, 8, 5, 0, 10, 5)
+	check_coords(t, algorithm.ConvexHullOfPoints(pts), 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+}
+
+func TestConvexHullGeometries(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 5, 5),
+		coords(10, 0, 5, 4),
+	}
+	check_coords(t, algorithm.NewConvexHullFromLines(lines).GetConvexHull(), 0, 0, 5, 5, 10, 0, 0, 0)
+
+	polys := [][][]geom.Coordinate{
+		{coords(0, 0, 0, 2, 2, 2, 2, 0, 0, 0)},
+		{coords(4, 0, 4, 2, 6, 2, 6, 0, 4, 0)},
+	}
+	check_coords(t, algorithm.NewConvexHullFromPolygons(polys).GetConvexHull(), 0, 0, 0, 2, 6, 2, 6, 0, 0, 0)
+}
+
+func TestConvexHullRandomPoints(t *testing.T) {
+	rnd := rand.New(rand.NewSource(13))
+	for n := 3; n < 500; n += 37 {
+		pts := make([]geom.Coordinate, n)
+		for i := range pts {
+			pts[i] = *geom.NewCoordinateXY(float64(rnd.Intn(100)), float64(rnd.Intn(100)))
+		}
+		hull := algorithm.ConvexHullOfPoints(pts)
+		assert.True(t, len(hull) >= 4)
+		assert.True(t, hull[0].Equals2D(&hull[len(hull)-1]))
+		assert.False(t, algorithm.OrientationIsCCW(hull))
+
+		//-- strictly convex, turning right at every vertex
+		m := len(hull) - 1
+		for i := 0; i < m; i++ {
+			assert.Equal(t, constants.ORIENTATION_CLOCKWISE, algorithm.OrientationIndex(&hull[i], &hull[(i+1)%m], &hull[(i+2)%m]))
+		}
+		for i := range pts {
+			assert.NotEqual(t, constants.LOCATION_EXTERIOR, algorithm.PointLocationLocateInRing(&pts[i], hull))
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	triangulate "github.com/UltimateThread/geos-go/core/triangulate"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+func delaunay_triangles(t *testing.T, pts []geom.Coordinate, tolerance float64) [][]geom.Coordinate {
+	builder := triangulate.NewDelaunayTriangulationBuilder()
+	builder.SetSites(pts)
+	builder.SetTolerance(tolerance)
+	tris, err := builder.GetTriangles()
+	assert.Nil(t, err)
+	return tris
+}
+
+func TestDelaunaySquareWithCentre(t *testing.T) {
+	pts := coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5)
+	builder := triangulate.NewDelaunayTriangulationBuilder()
+	builder.SetSites(pts)
+
+	tris, err := builder.GetTriangles()
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(tris))
+	area := 0.0
+	for _, tri := range tris {
+		assert.Equal(t, 4, len(tri))
+		assert.False(t, algorithm.OrientationIsCCW(tri))
+		area += algorithm.AreaOfRing(tri)
+	}
+	assert.InDelta(t, 100, area, 1e-9)
+
+	edges, err := builder.GetEdges()
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(edges))
+
+	subdiv, err := builder.GetSubdivision()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(subdiv.GetVertices(false)))
+	assert.Equal(t, 8, len(subdiv.GetVertices(true)))
+	assert.Equal(t, 4, len(subdiv.GetTriangleVertices(false)))
+}
+
+func TestDelaunayKeepsZ(t *testing.T) {
+	pts := []geom.Coordinate{
+		*geom.NewCoordinateXYZ(0, 0, 1),
+		*geom.NewCoordinateXYZ(10, 0, 2),
+		*geom.NewCoordinateXYZ(0, 10, 3),
+	}
+	tris := delaunay_triangles(t, pts, 0)
+	assert.Equal(t, 1, len(tris))
+	for _, p := range tris[0] {
+		assert.Equal(t, p.X/10+2*p.Y/10+1, p.Z)
+	}
+}
+
+func TestDelaunayRepeatedPoints(t *testing.T) {
+	pts := coords(0, 0, 10, 0, 0, 10, 10, 0, 0, 0)
+	tris := delaunay_triangles(t, pts, 0)
+	assert.Equal(t, 1, len(tris))
+	check_coords_unordered(t, tris[0][:3], 0, 0, 10, 0, 0, 10)
+}
+
+func TestDelaunayTolerance(t *testing.T) {
+	pts := coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5, 5.001, 5)
+	assert.Equal(t, 6, len(delaunay_triangles(t, pts, 0)))
+	assert.Equal(t, 4, len(delaunay_triangles(t, pts, 0.01)))
+}
+
+func TestDelaunayCollinear(t *testing.T) {
+	builder := triangulate.NewDelaunayTriangulationBuilder()
+	builder.SetSites(coords(0, 0, 5, 0, 10, 0))
+	tris, err := builder.GetTriangles()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tris))
+	edges, err := builder.GetEdges()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(edges))
+}
+
+func TestDelaunayEmptyCircumcircles(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+	pts := make([]geom.Coordinate, 0, 200)
+	for i := 0; i < 200; i++ {
+		pts = append(pts, *geom.NewCoordinateXY(math.Round(r.Float64()*1000), math.Round(r.Float64()*1000)))
+	}
+	builder := triangulate.NewDelaunayTriangulationBuilder()
+	builder.SetSites(pts)
+	subdiv, err := builder.GetSubdivision()
+	assert.Nil(t, err)
+
+	vertices := subdiv.GetVertices(false)
+	triVerts := subdiv.GetTriangleVertices(false)
+	area := 0.0
+	for _, tv := range triVerts {
+		area += math.Abs(algorithm.AreaOfRingSigned([]geom.Coordinate{
+			*tv[0].GetCoordinate(), *tv[1].GetCoordinate(), *tv[2].GetCoordinate(), *tv[0].GetCoordinate(),
+		}))
+		for _, v := range vertices {
+			if v == tv[0] || v == tv[1] || v == tv[2] {
+				continue
+			}
+			assert.False(t, quadedge.TrianglePredicateIsInCircleRobust(
+				tv[0].GetCoordinate(), tv[1].GetCoordinate(), tv[2].GetCoordinate(), v.GetCoordinate()))
+		}
+	}
+	//-- the triangulation covers the convex hull
+	hull := algorithm.ConvexHullOfPoints(pts)
+	assert.InDelta(t, algorithm.AreaOfRing(hull), area, 1e-6)
+}
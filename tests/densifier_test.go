@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	densify "github.com/UltimateThread/geos-go/core/densify"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+func TestDensifyLine(t *testing.T) {
+	result, err := densify.Densify(coords(0, 0, 10, 0, 10, 1), 4)
+	assert.Nil(t, err)
+	check_coords_tolerance(t, result, []float64{0, 0, 10.0 / 3, 0, 20.0 / 3, 0, 10, 0, 10, 1}, 1e-12)
+}
+
+func TestDensifyRingStaysClosed(t *testing.T) {
+	ring := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	result, err := densify.Densify(ring, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 9, len(result))
+	assert.True(t, geom.IsRing(result))
+	for i := 1; i < len(result); i++ {
+		assert.True(t, result[i].Distance(&result[i-1]) <= 5)
+	}
+}
+
+func TestDensifyInvalidTolerance(t *testing.T) {
+	_, err := densify.Densify(coords(0, 0, 10, 0), 0)
+	assert.NotNil(t, err)
+	_, err = densify.Densify(coords(0, 0, 10, 0), -1)
+	assert.NotNil(t, err)
+}
+
+func TestDensifyEmpty(t *testing.T) {
+	result, err := densify.Densify(coords(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(result))
+}
+
+func TestDensifyInterpolatesZM(t *testing.T) {
+	line := []geom.Coordinate{
+		*geom.NewCoordinateXYZM(0, 0, 10, 100),
+		*geom.NewCoordinateXYZM(4, 0, 20, 200),
+		*geom.NewCoordinateXY(8, 0),
+	}
+	result, err := densify.Densify(line, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(result))
+	assert.Equal(t, 15.0, result[1].Z)
+	assert.Equal(t, 150.0, result[1].M)
+	// no interpolation if an endpoint has no value
+	assert.True(t, math.IsNaN(result[3].Z))
+	assert.True(t, math.IsNaN(result[3].M))
+}
+
+func TestDensifyFraction(t *testing.T) {
+	line := []geom.Coordinate{
+		*geom.NewCoordinateXYM(0, 0, 0),
+		*geom.NewCoordinateXYM(10, 0, 1),
+		*geom.NewCoordinateXYM(10, 2, 2),
+	}
+	result, err := densify.DensifyFraction(line, 0.25)
+	assert.Nil(t, err)
+	check_coords_tolerance(t, result, []float64{0, 0, 2.5, 0, 5, 0, 7.5, 0, 10, 0, 10, 0.5, 10, 1, 10, 1.5, 10, 2}, 1e-12)
+	assert.Equal(t, 0.25, result[1].M)
+	assert.Equal(t, 1.75, result[7].M)
+
+	_, err = densify.DensifyFraction(line, 0)
+	assert.NotNil(t, err)
+	_, err = densify.DensifyFraction(line, 1.5)
+	assert.NotNil(t, err)
+}
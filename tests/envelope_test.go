@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+func TestEnvelopeNull(t *testing.T) {
+	env := geom.DefaultEnvelope()
+	assert.True(t, env.IsNull())
+	assert.Equal(t, 0.0, env.GetWidth())
+	assert.Equal(t, 0.0, env.GetArea())
+	assert.Nil(t, env.Centre())
+	assert.False(t, env.IntersectsXY(0, 0))
+}
+
+func TestEnvelopeOrdersExtent(t *testing.T) {
+	env := geom.NewEnvelope(10, 0, 20, 5)
+	assert.Equal(t, 0.0, env.GetMinX())
+	assert.Equal(t, 10.0, env.GetMaxX())
+	assert.Equal(t, 5.0, env.GetMinY())
+	assert.Equal(t, 20.0, env.GetMaxY())
+	assert.Equal(t, 150.0, env.GetArea())
+}
+
+func TestEnvelopeExpandToInclude(t *testing.T) {
+	env := geom.DefaultEnvelope()
+	env.ExpandToIncludeCoordinate(geom.NewCoordinateXY(1, 1))
+	assert.False(t, env.IsNull())
+	assert.Equal(t, 0.0, env.GetWidth())
+
+	env.ExpandToIncludeXY(3, -2)
+	assert.True(t, env.Equals(geom.NewEnvelope(1, 3, -2, 1)))
+
+	env.ExpandToIncludeEnvelope(geom.NewEnvelope(-5, -4, 0, 0))
+	assert.True(t, env.Equals(geom.NewEnvelope(-5, 3, -2, 1)))
+}
+
+func TestEnvelopeIntersects(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	assert.True(t, env.IntersectsEnvelope(geom.NewEnvelope(10, 20, 10, 20)))
+	assert.False(t, env.IntersectsEnvelope(geom.NewEnvelope(11, 20, 0, 10)))
+	assert.True(t, env.IntersectsCoordinate(geom.NewCoordinateXY(10, 0)))
+	assert.False(t, env.IntersectsEnvelope(geom.DefaultEnvelope()))
+
+	p1 := geom.NewCoordinateXY(0, 0)
+	p2 := geom.NewCoordinateXY(10, 10)
+	assert.True(t, geom.EnvelopeIntersectsPoint(p1, p2, geom.NewCoordinateXY(5, 5)))
+	assert.False(t, geom.EnvelopeIntersectsPoint(p1, p2, geom.NewCoordinateXY(5, 11)))
+}
+
+func TestEnvelopeIntersection(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	intEnv := env.Intersection(geom.NewEnvelope(5, 15, -5, 5))
+	assert.True(t, intEnv.Equals(geom.NewEnvelope(5, 10, 0, 5)))
+	assert.True(t, env.Intersection(geom.NewEnvelope(20, 30, 20, 30)).IsNull())
+}
+
+func TestEnvelopeCovers(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	assert.True(t, env.CoversEnvelope(geom.NewEnvelope(0, 10, 0, 10)))
+	assert.True(t, env.CoversEnvelope(geom.NewEnvelope(2, 3, 2, 3)))
+	assert.False(t, env.CoversEnvelope(geom.NewEnvelope(2, 11, 2, 3)))
+	assert.True(t, env.CoversCoordinate(geom.NewCoordinateXY(10, 10)))
+}
+
+func TestEnvelopeDistance(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	assert.Equal(t, 0.0, env.Distance(geom.NewEnvelope(5, 15, 5, 15)))
+	assert.Equal(t, 5.0, env.Distance(geom.NewEnvelope(15, 20, 0, 10)))
+	assert.Equal(t, 5.0, env.Distance(geom.NewEnvelope(13, 20, 14, 20)))
+}
+
+func TestEnvelopeExpandBy(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	env.ExpandBy(1)
+	assert.True(t, env.Equals(geom.NewEnvelope(-1, 11, -1, 11)))
+	env.ExpandBy(-7)
+	assert.True(t, env.IsNull())
+}
@@ -0,0 +1,251 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	geomutil "github.com/UltimateThread/geos-go/core/geom/util"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+func polygons_area(polys [][][]geom.Coordinate) float64 {
+	area := 0.0
+	for _, poly := range polys {
+		area += algorithm.AreaOfRing(poly[0])
+		for _, hole := range poly[1:] {
+			area -= algorithm.AreaOfRing(hole)
+		}
+	}
+	return area
+}
+
+func check_fixed_polygons(t *testing.T, result *geomutil.GeometryFixerResult, numPolys int, area float64) {
+	polys := result.GetPolygons()
+	assert.Equal(t, numPolys, len(polys))
+	assert.InDelta(t, area, polygons_area(polys), 1e-9)
+	op := valid.NewIsValidOpMultiPolygon(polys)
+	assert.True(t, op.IsValid(), "fixed result is invalid: %v", op.GetValidationError())
+}
+
+func TestGeometryFixerValidPolygon(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 96)
+	assert.Equal(t, 2, len(result.GetPolygons()[0]))
+	assert.Equal(t, 5, len(result.GetPolygons()[0][0]))
+}
+
+func TestGeometryFixerLargeValidPolygon(t *testing.T) {
+	//-- a circle with 50000 vertices, and a comb whose teeth all cross the same horizontal lines
+	n := 50000
+	circle := make([]geom.Coordinate, 0, n+1)
+	for i := 0; i < n; i++ {
+		angle := -2 * math.Pi * float64(i) / float64(n)
+		circle = append(circle, *geom.NewCoordinateXY(100*math.Cos(angle), 100*math.Sin(angle)))
+	}
+	circle = append(circle, circle[0])
+	comb := make([]geom.Coordinate, 0, n+2)
+	for i := 0; i < n/2; i++ {
+		comb = append(comb, *geom.NewCoordinateXY(float64(i), 0), *geom.NewCoordinateXY(float64(i)+0.5, 10))
+	}
+	comb = append(comb, *geom.NewCoordinateXY(float64(n/2), -5), comb[0])
+
+	fixer := geomutil.NewGeometryFixer()
+	for _, ring := range [][]geom.Coordinate{circle, comb} {
+		start := time.Now()
+		result, err := fixer.FixPolygon([][]geom.Coordinate{ring})
+		assert.Less(t, time.Since(start), 10*time.Second)
+		if !assert.Nil(t, err) {
+			return
+		}
+		check_fixed_polygons(t, result, 1, algorithm.AreaOfRing(ring))
+		assert.Equal(t, len(ring), len(result.GetPolygons()[0][0]))
+	}
+}
+
+func TestGeometryFixerBowtie(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 10, 10, 0, 0, 10, 0, 0),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 2, 50)
+}
+
+func TestGeometryFixerSpike(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 15, 15, 10, 10, 0, 10, 0, 0),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 100)
+	assert.Equal(t, 5, len(result.GetPolygons()[0][0]))
+}
+
+func TestGeometryFixerSelfTouchingRing(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 5, 10, 7, 5, 3, 5, 5, 10, 0, 10, 0, 0),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 90)
+	assert.Equal(t, 2, len(result.GetPolygons()[0]))
+}
+
+func TestGeometryFixerHoleOverlappingShell(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(5, 5, 15, 5, 15, 15, 5, 15, 5, 5),
+		coords(20, 20, 30, 20, 30, 30, 20, 20),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 75)
+}
+
+func TestGeometryFixerOverlappingMultiPolygon(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixMultiPolygon([][][]geom.Coordinate{
+		{coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)},
+		{coords(5, 5, 5, 15, 15, 15, 15, 5, 5, 5)},
+		{coords(20, 0, 20, 1, 21, 1, 21, 0, 20, 0)},
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 2, 176)
+}
+
+func TestGeometryFixerInvalidCoordinates(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 0, 10, math.NaN(), 5, 10, 10, 10, 0, math.Inf(1), 0, 0, 0),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 100)
+
+	assert.True(t, fixer.FixPoint(geom.NewCoordinateXY(math.NaN(), 1)).IsEmpty())
+	assert.Equal(t, 1, len(fixer.FixMultiPoint(coords(1, 1, math.NaN(), 2)).GetPoints()))
+
+	lineResult := fixer.FixLineString(coords(0, 0, 1, 1, 1, 1, math.NaN(), 3, 2, 2))
+	assert.Equal(t, 1, len(lineResult.GetLines()))
+	check_coords(t, lineResult.GetLines()[0], 0, 0, 1, 1, 2, 2)
+}
+
+func TestGeometryFixerCollapsed(t *testing.T) {
+	flat := [][]geom.Coordinate{coords(0, 0, 10, 0, 5, 0, 0, 0)}
+	line := coords(1, 1, 1, 1)
+
+	fixer := geomutil.NewGeometryFixer()
+	flatResult, err := fixer.FixPolygon(flat)
+	assert.Nil(t, err)
+	assert.True(t, flatResult.IsEmpty())
+	assert.True(t, fixer.FixLineString(line).IsEmpty())
+	assert.True(t, fixer.FixLinearRing(coords(0, 0, 1, 1, 0, 0)).IsEmpty())
+
+	fixer.SetKeepCollapsed(true)
+	polyResult, err := fixer.FixPolygon(flat)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(polyResult.GetPolygons()))
+	assert.Equal(t, 1, len(polyResult.GetLines()))
+	check_coords(t, polyResult.GetLines()[0], 0, 0, 10, 0, 5, 0, 0, 0)
+
+	lineResult := fixer.FixLineString(line)
+	check_coords(t, lineResult.GetPoints(), 1, 1)
+
+	ringResult := fixer.FixLinearRing(coords(0, 0, 1, 1, 0, 0))
+	check_coords(t, ringResult.GetLines()[0], 0, 0, 1, 1, 0, 0)
+}
+
+func TestGeometryFixerSelfOverlappingRing(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0, 5, 0, 5, 5, 0, 5, 0, 0),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 1, 100)
+
+	touchingHoles, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(2, 2, 5, 2, 5, 5, 2, 5, 2, 2),
+		coords(5, 5, 8, 5, 8, 8, 5, 8, 5, 5),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, touchingHoles, 1, 82)
+	assert.Equal(t, 3, len(touchingHoles.GetPolygons()[0]))
+}
+
+func TestGeometryFixerCrossingAtIntersectionPoint(t *testing.T) {
+	// several segments cross near (2.27, 3.64), leaving a small triangle with winding -1
+	fixer := geomutil.NewGeometryFixer()
+	result, err := fixer.FixPolygon([][]geom.Coordinate{
+		coords(1, 3, 3, 4, 4, 1, 1, 0, 1, 3, 4, 1, 4, 0, 5, 0, 5, 0, 2, 4, 5, 5, 1, 3),
+	})
+	assert.Nil(t, err)
+	check_fixed_polygons(t, result, 3, 6.9)
+}
+
+func TestGeometryFixerRandomRingsPreserveArea(t *testing.T) {
+	fixer := geomutil.NewGeometryFixer()
+	r := rand.New(rand.NewSource(38))
+	for i := 0; i < 500; i++ {
+		n := 4 + r.Intn(8)
+		ords := make([]float64, 0, 2*n+2)
+		for k := 0; k < n; k++ {
+			ords = append(ords, float64(r.Intn(6)), float64(r.Intn(6)))
+		}
+		ords = append(ords, ords[0], ords[1])
+		ring := coords(ords...)
+
+		result, err := fixer.FixPolygon([][]geom.Coordinate{ring})
+		if !assert.Nil(t, err) {
+			continue
+		}
+		polys := result.GetPolygons()
+		op := valid.NewIsValidOpMultiPolygon(polys)
+		assert.True(t, op.IsValid(), "fixed result of %v is invalid: %v", ords, op.GetValidationError())
+		//-- the result covers exactly the points with a non-zero winding number
+		for x := 0.013; x < 5; x += 0.1 {
+			for y := 0.017; y < 5; y += 0.1 {
+				pt := geom.NewCoordinateXY(x, y)
+				isInResult := false
+				for _, poly := range polys {
+					if locate_in_polygon(pt, poly) == 0 {
+						isInResult = true
+					}
+				}
+				if !assert.Equal(t, ring_winding_number(pt, ring) != 0, isInResult, "point %v for ring %v", pt, ords) {
+					return
+				}
+			}
+		}
+	}
+}
+
+/**
+ * Computes the winding number of a ring around a point not lying on it.
+ */
+func ring_winding_number(pt *geom.Coordinate, ring []geom.Coordinate) int {
+	winding := 0
+	for i := 0; i < len(ring)-1; i++ {
+		p0 := &ring[i]
+		p1 := &ring[i+1]
+		if p0.Y <= pt.Y {
+			if p1.Y > pt.Y && algorithm.OrientationIndex(p0, p1, pt) == constants.ORIENTATION_LEFT {
+				winding++
+			}
+		} else if p1.Y <= pt.Y && algorithm.OrientationIndex(p0, p1, pt) == constants.ORIENTATION_RIGHT {
+			winding--
+		}
+	}
+	return winding
+}
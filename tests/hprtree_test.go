@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	index "github.com/UltimateThread/geos-go/core/index"
+	hprtree "github.com/UltimateThread/geos-go/core/index/hprtree"
+	quadtree "github.com/UltimateThread/geos-go/core/index/quadtree"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+	fractal "github.com/UltimateThread/geos-go/core/shape/fractal"
+)
+
+func hprtree_grid_envelopes(n int) []*geom.Envelope {
+	envs := make([]*geom.Envelope, 0, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x := float64(i) * 10
+			y := float64(j) * 10
+			envs = append(envs, geom.NewEnvelope(x, x+float64(i%3+1), y, y+float64(j%4+1)))
+		}
+	}
+	return envs
+}
+
+func check_index_query(t *testing.T, tree index.SpatialIndex[int], envs []*geom.Envelope, searchEnv *geom.Envelope) {
+	expected := make([]int, 0)
+	for i, env := range envs {
+		if env.IntersectsEnvelope(searchEnv) {
+			expected = append(expected, i)
+		}
+	}
+	actual := make([]int, 0)
+	for _, item := range tree.Query(searchEnv) {
+		if envs[item].IntersectsEnvelope(searchEnv) {
+			actual = append(actual, item)
+		}
+	}
+	sort.Ints(actual)
+	assert.Equal(t, expected, actual)
+}
+
+func TestHPRtreeEmpty(t *testing.T) {
+	tree := hprtree.NewHPRtree[int]()
+	assert.Empty(t, tree.Query(geom.NewEnvelope(0, 10, 0, 10)))
+	assert.Equal(t, 0, tree.Size())
+}
+
+func TestHPRtreeFewItems(t *testing.T) {
+	tree := hprtree.NewHPRtree[int]()
+	envs := hprtree_grid_envelopes(3)
+	for i, env := range envs {
+		tree.Insert(env, i)
+	}
+	check_index_query(t, tree, envs, geom.NewEnvelope(5, 15, 5, 15))
+}
+
+func TestHPRtreeQuery(t *testing.T) {
+	tree := hprtree.NewHPRtreeWithCapacity[int](4)
+	envs := hprtree_grid_envelopes(40)
+	for i, env := range envs {
+		tree.Insert(env, i)
+	}
+	assert.Equal(t, 1600, tree.Size())
+	check_index_query(t, tree, envs, geom.NewEnvelope(55, 155, 102, 230))
+	check_index_query(t, tree, envs, geom.NewEnvelope(0, 1000, 0, 1000))
+	check_index_query(t, tree, envs, geom.NewEnvelope(391, 392, 391, 392))
+	check_index_query(t, tree, envs, geom.NewEnvelope(1000, 1100, 0, 1000))
+	assert.NotNil(t, tree.Insert(envs[0], 0))
+}
+
+func TestHPRtreeQueryVisitorStops(t *testing.T) {
+	tree := hprtree.NewHPRtree[int]()
+	envs := hprtree_grid_envelopes(20)
+	for i, env := range envs {
+		tree.Insert(env, i)
+	}
+	count := 0
+	tree.QueryVisitor(geom.NewEnvelope(0, 1000, 0, 1000), func(item int) bool {
+		count++
+		return count < 10
+	})
+	assert.Equal(t, 10, count)
+}
+
+func TestHPRtreeSpatialIndexImplementations(t *testing.T) {
+	envs := hprtree_grid_envelopes(25)
+	indexes := []index.SpatialIndex[int]{
+		hprtree.NewHPRtree[int](),
+		strtree.NewSTRtree[int](),
+		quadtree.NewQuadtree[int](),
+	}
+	for _, tree := range indexes {
+		for i, env := range envs {
+			assert.Nil(t, tree.Insert(env, i))
+		}
+		check_index_query(t, tree, envs, geom.NewEnvelope(33, 121, 47, 88))
+	}
+}
+
+func TestHilbertCodeIsContinuous(t *testing.T) {
+	for level := 1; level <= 4; level++ {
+		side := fractal.HilbertCodeMaxOrdinate(level) + 1
+		pts := make([][2]int, fractal.HilbertCodeSize(level))
+		seen := make([]bool, len(pts))
+		for x := 0; x < side; x++ {
+			for y := 0; y < side; y++ {
+				code := fractal.HilbertCodeEncode(level, x, y)
+				assert.False(t, seen[code])
+				seen[code] = true
+				pts[code] = [2]int{x, y}
+			}
+		}
+		for i := 1; i < len(pts); i++ {
+			dist := abs_int(pts[i][0]-pts[i-1][0]) + abs_int(pts[i][1]-pts[i-1][1])
+			assert.Equal(t, 1, dist)
+		}
+	}
+}
+
+func abs_int(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
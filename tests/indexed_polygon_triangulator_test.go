@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+)
+
+/**
+ * Checks that the indexed triangles are clockwise, lie in the polygon
+ * and cover its area.
+ */
+func check_indexed_triangles(t *testing.T, tris [][3]int, vertices []geom.Coordinate, poly [][]geom.Coordinate) {
+	area := 0.0
+	for _, tri := range tris {
+		ring := []geom.Coordinate{vertices[tri[0]], vertices[tri[1]], vertices[tri[2]], vertices[tri[0]]}
+		assert.False(t, algorithm.OrientationIsCCW(ring))
+		area += algorithm.AreaOfRing(ring)
+		centroid := geom.NewCoordinateXY((ring[0].X+ring[1].X+ring[2].X)/3, (ring[0].Y+ring[1].Y+ring[2].Y)/3)
+		assert.Equal(t, 0, locate_in_polygon(centroid, poly))
+	}
+	assert.InDelta(t, polygons_area([][][]geom.Coordinate{poly}), area, 1e-9)
+}
+
+func TestIndexedTriangulatorSquare(t *testing.T) {
+	poly := [][]geom.Coordinate{coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)}
+	triangulator := polygon.NewIndexedPolygonTriangulator(poly)
+	tris, err := triangulator.GetResult()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tris))
+	vertices, err := triangulator.GetVertices()
+	assert.Nil(t, err)
+	check_coords(t, vertices, 0, 0, 0, 10, 10, 10, 10, 0)
+	check_indexed_triangles(t, tris, vertices, poly)
+}
+
+func TestIndexedTriangulatorWithHoles(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 20, 10, 20, 0, 0, 0),
+		coords(2, 2, 6, 2, 6, 6, 2, 6, 2, 2),
+		coords(12, 2, 18, 2, 15, 8, 12, 2),
+	}
+	triangulator := polygon.NewIndexedPolygonTriangulator(poly)
+	tris, err := triangulator.GetResult()
+	assert.Nil(t, err)
+	vertices, err := triangulator.GetVertices()
+	assert.Nil(t, err)
+	//-- no vertices are added, and every vertex is used
+	assert.Equal(t, 11, len(vertices))
+	assert.Equal(t, 11+2*2-2, len(tris))
+	used := make([]bool, len(vertices))
+	for _, tri := range tris {
+		for _, i := range tri {
+			used[i] = true
+		}
+	}
+	for _, u := range used {
+		assert.True(t, u)
+	}
+	check_indexed_triangles(t, tris, vertices, poly)
+}
+
+func TestIndexedTriangulatorTouchingHole(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(5, 0, 3, 3, 7, 3, 5, 0),
+	}
+	triangulator := polygon.NewIndexedPolygonTriangulator(poly)
+	tris, err := triangulator.GetResult()
+	assert.Nil(t, err)
+	vertices, err := triangulator.GetVertices()
+	assert.Nil(t, err)
+	//-- the touch point is already a hole vertex
+	assert.Equal(t, 7, len(vertices))
+	check_indexed_triangles(t, tris, vertices, poly)
+}
+
+func TestIndexedTriangulatorSkipFlatCorners(t *testing.T) {
+	poly := [][]geom.Coordinate{coords(0, 0, 0, 5, 0, 10, 10, 10, 10, 0, 0, 0)}
+	tris, err := polygon.IndexedPolygonTriangulatorTriangulate(poly)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(tris))
+
+	triangulator := polygon.NewIndexedPolygonTriangulator(poly)
+	triangulator.SetSkipFlatCorners(true)
+	tris, err = triangulator.GetResult()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tris))
+	vertices, err := triangulator.GetVertices()
+	assert.Nil(t, err)
+	check_indexed_triangles(t, tris, vertices, poly)
+}
+
+func TestIndexedTriangulatorEmpty(t *testing.T) {
+	tris, err := polygon.IndexedPolygonTriangulatorTriangulate([][]geom.Coordinate{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tris))
+}
@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	locate "github.com/UltimateThread/geos-go/core/algorithm/locate"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	intervalrtree "github.com/UltimateThread/geos-go/core/index/intervalrtree"
+)
+
+func TestIntervalRTreeEmpty(t *testing.T) {
+	tree := intervalrtree.NewSortedPackedIntervalRTree[int]()
+	assert.Empty(t, tree.Query(0, 10))
+	assert.Nil(t, tree.GetRoot())
+}
+
+func TestIntervalRTreeQuery(t *testing.T) {
+	tree := intervalrtree.NewSortedPackedIntervalRTree[int]()
+	intervals := [][2]float64{{0, 1}, {2, 5}, {3, 3}, {-4, -1}, {4.5, 9}, {10, 11}, {0.5, 2.5}}
+	for i, interval := range intervals {
+		assert.Nil(t, tree.Insert(interval[0], interval[1], i))
+	}
+
+	result := tree.Query(2.5, 3)
+	sort.Ints(result)
+	assert.Equal(t, []int{1, 2, 6}, result)
+
+	result = tree.Query(1, 1)
+	sort.Ints(result)
+	assert.Equal(t, []int{0, 6}, result)
+
+	assert.Empty(t, tree.Query(9.5, 9.9))
+	assert.NotNil(t, tree.Insert(0, 1, 99))
+
+	root := tree.GetRoot()
+	assert.Equal(t, -4.0, root.GetMin())
+	assert.Equal(t, 11.0, root.GetMax())
+	assert.False(t, root.IsLeaf())
+}
+
+func TestIntervalRTreeMeasureIntervals(t *testing.T) {
+	// segments of a track, indexed by their M (time) interval
+	track := []geom.Coordinate{
+		*geom.NewCoordinateXYM(0, 0, 0),
+		*geom.NewCoordinateXYM(1, 0, 10),
+		*geom.NewCoordinateXYM(2, 1, 25),
+		*geom.NewCoordinateXYM(3, 1, 30),
+		*geom.NewCoordinateXYM(4, 2, 60),
+	}
+	tree := intervalrtree.NewSortedPackedIntervalRTree[int]()
+	for i := 1; i < len(track); i++ {
+		tree.Insert(math.Min(track[i-1].M, track[i].M), math.Max(track[i-1].M, track[i].M), i-1)
+	}
+	result := tree.Query(12, 28)
+	sort.Ints(result)
+	assert.Equal(t, []int{1, 2}, result)
+
+	count := 0
+	tree.QueryVisitor(0, 100, func(seg int) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestIndexedPointInAreaLocator(t *testing.T) {
+	shell := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	hole := coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2)
+	other := coords(20, 0, 20, 5, 25, 5, 20, 0)
+	locator := locate.NewIndexedPointInAreaLocator([][]geom.Coordinate{shell, hole, other})
+
+	assert.Equal(t, constants.LOCATION_INTERIOR, locator.Locate(geom.NewCoordinateXY(5, 5)))
+	assert.Equal(t, constants.LOCATION_EXTERIOR, locator.Locate(geom.NewCoordinateXY(3, 3)))
+	assert.Equal(t, constants.LOCATION_BOUNDARY, locator.Locate(geom.NewCoordinateXY(4, 3)))
+	assert.Equal(t, constants.LOCATION_BOUNDARY, locator.Locate(geom.NewCoordinateXY(0, 10)))
+	assert.Equal(t, constants.LOCATION_EXTERIOR, locator.Locate(geom.NewCoordinateXY(15, 5)))
+	assert.Equal(t, constants.LOCATION_INTERIOR, locator.Locate(geom.NewCoordinateXY(21, 4)))
+	assert.Equal(t, constants.LOCATION_EXTERIOR, locator.Locate(geom.NewCoordinateXY(-1, 10)))
+}
+
+func TestIndexedPointInAreaLocatorConcurrent(t *testing.T) {
+	// run with -race to check that the lazy index creation is thread-safe
+	shell := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	hole := coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2)
+	locator := locate.NewIndexedPointInAreaLocator([][]geom.Coordinate{shell, hole})
+
+	var wg sync.WaitGroup
+	locs := make([]int, 8)
+	for i := range locs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			locs[i] = locator.Locate(geom.NewCoordinateXY(3, float64(i)+0.5))
+		}(i)
+	}
+	wg.Wait()
+	for i, loc := range locs {
+		expected := constants.LOCATION_INTERIOR
+		if i == 2 || i == 3 {
+			expected = constants.LOCATION_EXTERIOR
+		}
+		assert.Equal(t, expected, loc, "point %d", i)
+	}
+}
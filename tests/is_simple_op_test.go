@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+func check_non_simple(t *testing.T, op *valid.IsSimpleOp, x float64, y float64) {
+	assert.False(t, op.IsSimple())
+	pt := op.GetNonSimpleLocation()
+	if assert.NotNil(t, pt) {
+		assert.True(t, pt.Equals2D(geom.NewCoordinateXY(x, y)), "location %v", pt)
+	}
+}
+
+func TestIsSimpleOpLineString(t *testing.T) {
+	assert.True(t, valid.NewIsSimpleOpLineString(coords(0, 0, 10, 0, 10, 10, 20, 10)).IsSimple())
+	assert.Nil(t, valid.NewIsSimpleOpLineString(coords(0, 0, 10, 0)).GetNonSimpleLocation())
+
+	check_non_simple(t, valid.NewIsSimpleOpLineString(coords(0, 0, 10, 10, 10, 0, 0, 10)), 5, 5)
+	//-- endpoint touching the line interior
+	check_non_simple(t, valid.NewIsSimpleOpLineString(coords(0, 0, 10, 0, 10, 10, 5, 0)), 5, 0)
+	//-- collinear overlap
+	assert.False(t, valid.NewIsSimpleOpLineString(coords(0, 0, 10, 0, 5, 0)).IsSimple())
+}
+
+func TestIsSimpleOpRepeatedPoints(t *testing.T) {
+	assert.True(t, valid.NewIsSimpleOpLineString(coords(0, 0, 5, 0, 5, 0, 10, 0, 10, 10)).IsSimple())
+	assert.True(t, valid.NewIsSimpleOpLineString(coords(1, 1, 1, 1)).IsSimple())
+}
+
+func TestIsSimpleOpClosedLine(t *testing.T) {
+	ring := coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)
+	assert.True(t, valid.NewIsSimpleOpLineString(ring).IsSimple())
+	assert.True(t, valid.NewIsSimpleOpLinearRing(ring).IsSimple())
+
+	check_non_simple(t, valid.NewIsSimpleOpLinearRing(coords(0, 0, 10, 0, 5, 5, 10, 10, 0, 10, 5, 5, 0, 0)), 5, 5)
+}
+
+func TestIsSimpleOpMultiLineStringBoundaryNodeRule(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 10, 0),
+		coords(10, 0, 20, 0),
+	}
+	check_non_simple(t, valid.NewIsSimpleOpMultiLineString(lines), 10, 0)
+	assert.True(t, valid.NewIsSimpleOpMultiLineStringBoundaryNodeRule(lines, algorithm.BoundaryNodeRuleEndPoint).IsSimple())
+
+	crossing := [][]geom.Coordinate{
+		coords(0, 0, 10, 0),
+		coords(5, -5, 5, 5),
+	}
+	check_non_simple(t, valid.NewIsSimpleOpMultiLineStringBoundaryNodeRule(crossing, algorithm.BoundaryNodeRuleEndPoint), 5, 0)
+
+	rings := [][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 0),
+		coords(0, 0, -10, 0, -10, -10, 0, 0),
+	}
+	assert.False(t, valid.NewIsSimpleOpMultiLineString(rings).IsSimple())
+	assert.True(t, valid.NewIsSimpleOpMultiLineStringBoundaryNodeRule(rings, algorithm.BoundaryNodeRuleEndPoint).IsSimple())
+}
+
+func TestIsSimpleOpBoundaryNodeRules(t *testing.T) {
+	assert.True(t, algorithm.BoundaryNodeRuleMod2(1))
+	assert.False(t, algorithm.BoundaryNodeRuleMod2(2))
+	assert.True(t, algorithm.BoundaryNodeRuleEndPoint(2))
+	assert.False(t, algorithm.BoundaryNodeRuleMultiValentEndPoint(1))
+	assert.True(t, algorithm.BoundaryNodeRuleMultiValentEndPoint(2))
+	assert.True(t, algorithm.BoundaryNodeRuleMonoValentEndPoint(1))
+	assert.False(t, algorithm.BoundaryNodeRuleMonoValentEndPoint(2))
+}
+
+func TestIsSimpleOpFindAllLocations(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 30, 0),
+		coords(10, -5, 10, 5),
+		coords(20, -5, 20, 5),
+	}
+	op := valid.NewIsSimpleOpMultiLineString(lines)
+	op.SetFindAllLocations(true)
+	assert.False(t, op.IsSimple())
+	check_coords_unordered(t, op.GetNonSimpleLocations(), 10, 0, 20, 0)
+
+	single := valid.NewIsSimpleOpMultiLineString(lines)
+	assert.False(t, single.IsSimple())
+	assert.Equal(t, 1, len(single.GetNonSimpleLocations()))
+}
+
+func TestIsSimpleOpMultiPoint(t *testing.T) {
+	assert.True(t, valid.NewIsSimpleOpMultiPoint(coords(0, 0, 1, 1, 2, 2)).IsSimple())
+	check_non_simple(t, valid.NewIsSimpleOpMultiPoint(coords(0, 0, 1, 1, 0, 0)), 0, 0)
+
+	op := valid.NewIsSimpleOpMultiPoint(coords(0, 0, 1, 1, 0, 0, 1, 1))
+	op.SetFindAllLocations(true)
+	assert.Equal(t, 2, len(op.GetNonSimpleLocations()))
+}
+
+func TestIsSimpleOpPolygon(t *testing.T) {
+	//-- a hole touching the shell does not make the polygon non-simple
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(0, 0, 5, 2, 2, 5, 0, 0),
+	}
+	assert.True(t, valid.NewIsSimpleOpPolygon(poly).IsSimple())
+	check_non_simple(t, valid.NewIsSimpleOpPolygon([][]geom.Coordinate{coords(0, 0, 10, 10, 10, 0, 0, 10, 0, 0)}), 5, 5)
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+func check_invalid(t *testing.T, op *valid.IsValidOp, errorType int, x float64, y float64) {
+	assert.False(t, op.IsValid())
+	err := op.GetValidationError()
+	if assert.NotNil(t, err) {
+		assert.Equal(t, errorType, err.GetErrorType())
+		pt := err.GetCoordinate()
+		assert.True(t, pt.Equals2D(geom.NewCoordinateXY(x, y)), "location %v", pt)
+	}
+}
+
+func TestIsValidOpPolygonWithHole(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2),
+	})
+	assert.True(t, op.IsValid())
+	assert.Nil(t, op.GetValidationError())
+}
+
+func TestIsValidOpBowtie(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 10, 10, 0, 0, 10, 0, 0),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_SELF_INTERSECTION, 5, 5)
+}
+
+func TestIsValidOpRingSelfTouch(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 5, 5, 10, 10, 0, 10, 5, 5, 0, 0),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_RING_SELF_INTERSECTION, 5, 5)
+
+	ring := valid.NewIsValidOpLinearRing(coords(0, 0, 10, 0, 5, 5, 10, 10, 0, 10, 5, 5, 0, 0))
+	check_invalid(t, ring, constants.TOPOLOGYVALIDATIONERROR_RING_SELF_INTERSECTION, 5, 5)
+}
+
+func TestIsValidOpHoleOutsideShell(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(20, 20, 30, 20, 30, 30, 20, 20),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_HOLE_OUTSIDE_SHELL, 20, 20)
+}
+
+func TestIsValidOpNestedHoles(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(1, 1, 9, 1, 9, 9, 1, 9, 1, 1),
+		coords(3, 3, 6, 3, 6, 6, 3, 6, 3, 3),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_NESTED_HOLES, 3, 3)
+}
+
+func TestIsValidOpNestedShells(t *testing.T) {
+	op := valid.NewIsValidOpMultiPolygon([][][]geom.Coordinate{
+		{coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)},
+		{coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2)},
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_NESTED_SHELLS, 2, 2)
+}
+
+func TestIsValidOpShellInHole(t *testing.T) {
+	op := valid.NewIsValidOpMultiPolygon([][][]geom.Coordinate{
+		{
+			coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+			coords(1, 1, 9, 1, 9, 9, 1, 9, 1, 1),
+		},
+		{coords(2, 2, 4, 2, 4, 4, 2, 4, 2, 2)},
+	})
+	assert.True(t, op.IsValid())
+}
+
+func TestIsValidOpDisconnectedInterior(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(0, 5, 5, 0, 10, 5, 5, 10, 0, 5),
+	})
+	assert.False(t, op.IsValid())
+	assert.Equal(t, constants.TOPOLOGYVALIDATIONERROR_DISCONNECTED_INTERIOR, op.GetValidationError().GetErrorType())
+}
+
+func TestIsValidOpHoleTouchingShellOnce(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(0, 5, 5, 2, 5, 8, 0, 5),
+	})
+	assert.True(t, op.IsValid())
+}
+
+func TestIsValidOpInvalidCoordinate(t *testing.T) {
+	nan := geom.NewCoordinateXY(math.NaN(), 1)
+	assert.False(t, nan.IsValid())
+
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, math.Inf(1), 10, 0, 10, 0, 0),
+	})
+	assert.False(t, op.IsValid())
+	assert.Equal(t, constants.TOPOLOGYVALIDATIONERROR_INVALID_COORDINATE, op.GetValidationError().GetErrorType())
+
+	pt := valid.NewIsValidOpPoint(nan)
+	assert.False(t, pt.IsValid())
+	assert.True(t, valid.NewIsValidOpMultiPoint(coords(1, 1, 2, 2)).IsValid())
+}
+
+func TestIsValidOpRingNotClosed(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_RING_NOT_CLOSED, 0, 0)
+}
+
+func TestIsValidOpTooFewPoints(t *testing.T) {
+	op := valid.NewIsValidOpPolygon([][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 0, 0, 0),
+	})
+	check_invalid(t, op, constants.TOPOLOGYVALIDATIONERROR_TOO_FEW_POINTS, 0, 0)
+
+	line := valid.NewIsValidOpLineString(coords(1, 1, 1, 1))
+	check_invalid(t, line, constants.TOPOLOGYVALIDATIONERROR_TOO_FEW_POINTS, 1, 1)
+	assert.True(t, valid.NewIsValidOpLineString(coords(1, 1, 2, 2)).IsValid())
+	assert.True(t, valid.NewIsValidOpLineString(nil).IsValid())
+}
+
+func TestIsValidOpErrorMessage(t *testing.T) {
+	err := valid.NewTopologyValidationError(constants.TOPOLOGYVALIDATIONERROR_SELF_INTERSECTION, geom.NewCoordinateXY(5, 5))
+	assert.Equal(t, "Self-intersection", err.GetMessage())
+	assert.Contains(t, err.ToString(), "Self-intersection at or near point")
+	assert.Equal(t, err.ToString(), err.Error())
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	kdtree "github.com/UltimateThread/geos-go/core/index/kdtree"
+)
+
+func TestKdTreeSinglePoint(t *testing.T) {
+	index := kdtree.NewKdTree(0.001)
+	node1 := index.Insert(geom.NewCoordinateXY(1, 1))
+	node2 := index.Insert(geom.NewCoordinateXY(1, 1))
+
+	assert.Same(t, node1, node2)
+	assert.Equal(t, 2, node1.GetCount())
+	assert.True(t, node1.IsRepeated())
+	assert.Equal(t, 1, index.Size())
+}
+
+func TestKdTreeSnapToExisting(t *testing.T) {
+	index := kdtree.NewKdTree(1)
+	node1 := index.InsertWithData(geom.NewCoordinateXY(0, 0), "a")
+	node2 := index.Insert(geom.NewCoordinateXY(0.5, 0.5))
+	node3 := index.Insert(geom.NewCoordinateXY(1.5, 0))
+
+	assert.Same(t, node1, node2)
+	assert.NotSame(t, node1, node3)
+	assert.Equal(t, "a", node2.GetData())
+	assert.Equal(t, 2, index.Size())
+}
+
+func TestKdTreeSnapToNearest(t *testing.T) {
+	index := kdtree.NewKdTree(2)
+	index.Insert(geom.NewCoordinateXY(0, 0))
+	far := index.Insert(geom.NewCoordinateXY(3, 0))
+	node := index.Insert(geom.NewCoordinateXY(2, 0))
+
+	assert.Same(t, far, node)
+	assert.Equal(t, 2, far.GetCount())
+}
+
+func TestKdTreeQuery(t *testing.T) {
+	index := kdtree.DefaultKdTree()
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			index.Insert(geom.NewCoordinateXY(float64(i), float64(j)))
+		}
+	}
+	assert.Equal(t, 100, index.Size())
+
+	result := index.Query(geom.NewEnvelope(2, 4, 5.5, 7))
+	pts := kdtree.KdTreeToCoordinates(result, false)
+	check_coords_unordered(t, pts, 2, 6, 2, 7, 3, 6, 3, 7, 4, 6, 4, 7)
+
+	assert.NotNil(t, index.QueryPoint(geom.NewCoordinateXY(3, 3)))
+	assert.Nil(t, index.QueryPoint(geom.NewCoordinateXY(3, 3.5)))
+}
+
+func TestKdTreeRepeatedToCoordinates(t *testing.T) {
+	index := kdtree.DefaultKdTree()
+	index.Insert(geom.NewCoordinateXY(1, 1))
+	index.Insert(geom.NewCoordinateXY(1, 1))
+	index.Insert(geom.NewCoordinateXY(2, 2))
+
+	nodes := index.Query(geom.NewEnvelope(0, 5, 0, 5))
+	assert.Equal(t, 2, len(kdtree.KdTreeToCoordinates(nodes, false)))
+	assert.Equal(t, 3, len(kdtree.KdTreeToCoordinates(nodes, true)))
+}
+
+func TestKdTreeRemoveDuplicates(t *testing.T) {
+	pts := coords(0, 0, 5, 5, 0.1, 0, 10, 0, 5.05, 5, 0, 0)
+	check_coords(t, kdtree.KdTreeRemoveDuplicates(pts, 0.2), 0, 0, 5, 5, 10, 0)
+	check_coords(t, kdtree.KdTreeRemoveDuplicates(pts, 0), 0, 0, 5, 5, 0.1, 0, 10, 0, 5.05, 5)
+}
+
+func TestKdTreeSnapCoordinates(t *testing.T) {
+	pts := []geom.Coordinate{*geom.NewCoordinateXYZ(0, 0, 1), *geom.NewCoordinateXYZ(5, 5, 2), *geom.NewCoordinateXYZ(0.1, 0, 3)}
+	snapped := kdtree.KdTreeSnapCoordinates(pts, 0.2)
+	check_coords(t, snapped, 0, 0, 5, 5, 0, 0)
+	assert.Equal(t, 3.0, snapped[2].Z)
+}
+
+func check_coords_unordered(t *testing.T, pts []geom.Coordinate, ords ...float64) {
+	expected := coords(ords...)
+	assert.Equal(t, len(expected), len(pts))
+	for i := range expected {
+		found := false
+		for j := range pts {
+			if pts[j].Equals2D(&expected[i]) {
+				found = true
+			}
+		}
+		assert.True(t, found, "missing %v", expected[i].ToString())
+	}
+}
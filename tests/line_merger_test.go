@@ -0,0 +1,262 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	linemerge "github.com/UltimateThread/geos-go/core/operation/linemerge"
+)
+
+func merge_lines(isDirected bool, lines ...[]geom.Coordinate) [][]geom.Coordinate {
+	merger := linemerge.NewLineMergerWithDirected(isDirected)
+	merger.Add(lines)
+	return merger.GetMergedLineStrings()
+}
+
+/**
+ * Checks that each expected line occurs in the result, in either direction.
+ */
+func check_lines_unordered(t *testing.T, actual [][]geom.Coordinate, expected ...[]geom.Coordinate) {
+	assert.Equal(t, len(expected), len(actual))
+	for _, exp := range expected {
+		found := false
+		for _, line := range actual {
+			if lines_equal_2d(line, exp, false) || lines_equal_2d(line, exp, true) {
+				found = true
+			}
+		}
+		assert.True(t, found, "missing line %v", exp)
+	}
+}
+
+func lines_equal_2d(a []geom.Coordinate, b []geom.Coordinate, isReversed bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		j := i
+		if isReversed {
+			j = len(b) - 1 - i
+		}
+		if !a[i].Equals2D(&b[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+func check_sequence(t *testing.T, lines [][]geom.Coordinate, expected ...[]geom.Coordinate) {
+	sequencer := linemerge.NewLineSequencer()
+	sequencer.Add(lines)
+	if expected == nil {
+		assert.False(t, sequencer.IsSequenceable())
+		assert.Nil(t, sequencer.GetSequencedLineStrings())
+		return
+	}
+	assert.True(t, sequencer.IsSequenceable())
+	result := sequencer.GetSequencedLineStrings()
+	assert.Equal(t, len(expected), len(result))
+	for i := range expected {
+		assert.True(t, lines_equal_2d(result[i], expected[i], false), "line %d is %v", i, result[i])
+	}
+	assert.True(t, linemerge.LineSequencerIsSequenced(result))
+}
+
+func TestLineMergerChain(t *testing.T) {
+	merged := merge_lines(false,
+		coords(120, 120, 180, 140),
+		coords(200, 180, 180, 140),
+		coords(200, 180, 240, 180))
+	assert.Equal(t, 1, len(merged))
+	check_coords(t, merged[0], 120, 120, 180, 140, 200, 180, 240, 180)
+}
+
+func TestLineMergerLoops(t *testing.T) {
+	merged := merge_lines(false,
+		coords(120, 300, 80, 340),
+		coords(120, 300, 140, 320, 160, 320),
+		coords(40, 320, 20, 340, 0, 320),
+		coords(0, 320, 20, 300, 40, 320),
+		coords(40, 320, 60, 320, 80, 340),
+		coords(160, 320, 180, 340, 200, 320),
+		coords(200, 320, 180, 300, 160, 320))
+	check_lines_unordered(t, merged,
+		coords(160, 320, 180, 340, 200, 320, 180, 300, 160, 320),
+		coords(40, 320, 20, 340, 0, 320, 20, 300, 40, 320),
+		coords(40, 320, 60, 320, 80, 340, 120, 300, 140, 320, 160, 320))
+}
+
+func TestLineMergerIsolatedLoop(t *testing.T) {
+	merged := merge_lines(false,
+		coords(0, 0, 10, 0),
+		coords(10, 0, 10, 10),
+		coords(10, 10, 0, 0))
+	assert.Equal(t, 1, len(merged))
+	assert.Equal(t, 4, len(merged[0]))
+	assert.True(t, geom.IsRing(merged[0]))
+}
+
+func TestLineMergerJunction(t *testing.T) {
+	merged := merge_lines(false,
+		coords(0, 0, 10, 0),
+		coords(10, 0, 20, 0),
+		coords(10, 0, 10, 10),
+		coords(10, 10, 10, 20))
+	check_lines_unordered(t, merged,
+		coords(0, 0, 10, 0),
+		coords(10, 0, 20, 0),
+		coords(10, 0, 10, 10, 10, 20))
+}
+
+func TestLineMergerDirected(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 10, 0),
+		coords(10, 0, 20, 0),
+		coords(30, 0, 20, 0),
+	}
+	assert.Equal(t, 1, len(merge_lines(false, lines...)))
+
+	merged := merge_lines(true, lines...)
+	assert.Equal(t, 2, len(merged))
+	check_lines_unordered(t, merged, coords(0, 0, 10, 0, 20, 0), coords(30, 0, 20, 0))
+	for _, line := range merged {
+		//-- directed lines keep the direction of their parts
+		assert.True(t, lines_equal_2d(line, coords(0, 0, 10, 0, 20, 0), false) ||
+			lines_equal_2d(line, coords(30, 0, 20, 0), false))
+	}
+}
+
+func TestLineMergerKeepsZ(t *testing.T) {
+	merged := merge_lines(false,
+		[]geom.Coordinate{*geom.NewCoordinateXYZ(0, 0, 1), *geom.NewCoordinateXYZ(10, 0, 2)},
+		[]geom.Coordinate{*geom.NewCoordinateXYZ(20, 0, 3), *geom.NewCoordinateXYZ(10, 0, 2)})
+	assert.Equal(t, 1, len(merged))
+	assert.Equal(t, 3, len(merged[0]))
+	for _, p := range merged[0] {
+		assert.Equal(t, p.X/10+1, p.Z)
+	}
+}
+
+func TestLineMergerIncremental(t *testing.T) {
+	merger := linemerge.NewLineMerger()
+	merger.AddLine(coords(0, 0, 10, 0))
+	merger.AddLine(coords(20, 0, 30, 0))
+	assert.Equal(t, 2, len(merger.GetMergedLineStrings()))
+	merger.AddLine(coords(10, 0, 20, 0))
+	merged := merger.GetMergedLineStrings()
+	assert.Equal(t, 1, len(merged))
+	check_coords(t, merged[0], 0, 0, 10, 0, 20, 0, 30, 0)
+}
+
+func TestLineSequencerSimple(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 20, 0, 30),
+		coords(0, 10, 0, 20),
+	},
+		coords(0, 0, 0, 10),
+		coords(0, 10, 0, 20),
+		coords(0, 20, 0, 30))
+}
+
+func TestLineSequencerSimpleLoop(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 10, 0, 0),
+	},
+		coords(0, 0, 0, 10),
+		coords(0, 10, 0, 0))
+}
+
+func TestLineSequencerSimpleBigLoop(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 20, 0, 30),
+		coords(0, 30, 0, 0),
+		coords(0, 10, 0, 20),
+	},
+		coords(0, 0, 0, 10),
+		coords(0, 10, 0, 20),
+		coords(0, 20, 0, 30),
+		coords(0, 30, 0, 0))
+}
+
+func TestLineSequencerTwoSimpleLoops(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 10, 0, 0),
+		coords(0, 0, 0, 20),
+		coords(0, 20, 0, 0),
+	},
+		coords(0, 10, 0, 0),
+		coords(0, 0, 0, 20),
+		coords(0, 20, 0, 0),
+		coords(0, 0, 0, 10))
+}
+
+func TestLineSequencerWide8WithTail(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(10, 0, 10, 10),
+		coords(0, 0, 10, 0),
+		coords(0, 10, 10, 10),
+		coords(0, 10, 0, 20),
+		coords(10, 10, 10, 20),
+		coords(0, 20, 10, 20),
+		coords(10, 20, 30, 30),
+	})
+}
+
+func TestLineSequencerLoopWithTail(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 10, 10, 10),
+		coords(10, 10, 10, 20, 0, 10),
+	},
+		coords(0, 0, 0, 10),
+		coords(0, 10, 10, 10),
+		coords(10, 10, 10, 20, 0, 10))
+}
+
+func TestLineSequencerMultipleGraphsWithRing(t *testing.T) {
+	check_sequence(t, [][]geom.Coordinate{
+		coords(0, 0, 0, 10),
+		coords(0, 10, 10, 10, 10, 20, 0, 10),
+		coords(0, 10, 0, 20),
+		coords(0, 100, 0, 110),
+		coords(0, 110, 10, 110, 10, 120, 0, 110),
+		coords(0, 110, 0, 120),
+	},
+		coords(0, 0, 0, 10),
+		coords(0, 10, 10, 10, 10, 20, 0, 10),
+		coords(0, 10, 0, 20),
+		coords(0, 100, 0, 110),
+		coords(0, 110, 10, 110, 10, 120, 0, 110),
+		coords(0, 110, 0, 120))
+}
+
+func TestLineSequencerReversesLines(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(20, 0, 30, 0),
+		coords(10, 0, 0, 0),
+		coords(20, 0, 10, 0),
+	}
+	assert.False(t, linemerge.LineSequencerIsSequenced(lines))
+	result := linemerge.LineSequencerSequence(lines)
+	assert.Equal(t, 3, len(result))
+	for i := 0; i < len(result)-1; i++ {
+		assert.True(t, result[i][len(result[i])-1].Equals2D(&result[i+1][0]))
+	}
+}
+
+func TestLineSequencerIsSequenced(t *testing.T) {
+	assert.True(t, linemerge.LineSequencerIsSequenced([][]geom.Coordinate{coords(0, 0, 0, 10)}))
+	assert.True(t, linemerge.LineSequencerIsSequenced([][]geom.Coordinate{
+		coords(0, 0, 0, 1), coords(0, 2, 0, 3), coords(0, 3, 0, 4),
+	}))
+	assert.False(t, linemerge.LineSequencerIsSequenced([][]geom.Coordinate{
+		coords(0, 0, 0, 1), coords(0, 2, 0, 3), coords(0, 1, 0, 4),
+	}))
+}
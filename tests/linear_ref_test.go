@@ -0,0 +1,260 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	linearref "github.com/UltimateThread/geos-go/core/linearref"
+)
+
+func check_point(t *testing.T, pt *geom.Coordinate, x float64, y float64) {
+	assert.NotNil(t, pt)
+	if pt == nil {
+		return
+	}
+	assert.InDelta(t, x, pt.X, 1e-9)
+	assert.InDelta(t, y, pt.Y, 1e-9)
+}
+
+func check_location(t *testing.T, loc *linearref.LinearLocation, componentIndex int, segmentIndex int, segmentFraction float64) {
+	assert.Equal(t, componentIndex, loc.GetComponentIndex())
+	assert.Equal(t, segmentIndex, loc.GetSegmentIndex())
+	assert.InDelta(t, segmentFraction, loc.GetSegmentFraction(), 1e-9)
+}
+
+func TestLengthIndexedLineExtractPoint(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	check_point(t, indexedLine.ExtractPoint(5), 5, 0)
+	check_point(t, indexedLine.ExtractPoint(15), 10, 5)
+	//-- negative indices are measured from the end
+	check_point(t, indexedLine.ExtractPoint(-1), 10, 9)
+	//-- out of range indices are clamped
+	check_point(t, indexedLine.ExtractPoint(-30), 0, 0)
+	check_point(t, indexedLine.ExtractPoint(30), 10, 10)
+}
+
+func TestLengthIndexedLineExtractPointOffset(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	pt, err := indexedLine.ExtractPointOffset(5, 1)
+	assert.Nil(t, err)
+	check_point(t, pt, 5, 1)
+	pt, err = indexedLine.ExtractPointOffset(5, -1)
+	assert.Nil(t, err)
+	check_point(t, pt, 5, -1)
+	//-- the end point is offset from the last segment
+	pt, err = indexedLine.ExtractPointOffset(20, 1)
+	assert.Nil(t, err)
+	check_point(t, pt, 9, 10)
+
+	diagonal := linearref.NewLengthIndexedLine(coords(0, 0, 10, 10, 20, 20))
+	pt, err = diagonal.ExtractPointOffset(0, 1)
+	assert.Nil(t, err)
+	check_point(t, pt, -math.Sqrt2/2, math.Sqrt2/2)
+}
+
+func TestLengthIndexedLineExtractPointOffsetZeroLength(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 0, 0))
+	_, err := indexedLine.ExtractPointOffset(0, 1)
+	assert.NotNil(t, err)
+}
+
+func TestLengthIndexedLineExtractPointInterpolatesZM(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine([]geom.Coordinate{
+		*geom.NewCoordinateXYZM(0, 0, 0, 100),
+		*geom.NewCoordinateXYZM(10, 0, 10, 200),
+	})
+	pt := indexedLine.ExtractPoint(2.5)
+	check_point(t, pt, 2.5, 0)
+	assert.InDelta(t, 2.5, pt.Z, 1e-9)
+	assert.InDelta(t, 125, pt.M, 1e-9)
+
+	assert.True(t, math.IsNaN(linearref.NewLengthIndexedLine(coords(0, 0, 10, 0)).ExtractPoint(5).Z))
+}
+
+func TestLengthIndexedLineExtractLine(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	result := indexedLine.ExtractLine(5, 15)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 5, 0, 10, 0, 10, 5)
+
+	result = indexedLine.ExtractLine(-15, -5)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 5, 0, 10, 0, 10, 5)
+
+	//-- a reversed interval produces a reversed line
+	result = indexedLine.ExtractLine(15, 5)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 10, 5, 10, 0, 5, 0)
+
+	result = indexedLine.ExtractLine(0, 20)
+	check_coords(t, result[0], 0, 0, 10, 0, 10, 10)
+}
+
+func TestLengthIndexedLineExtractLineZeroLength(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	result := indexedLine.ExtractLine(5, 5)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 5, 0, 5, 0)
+
+	result = indexedLine.ExtractLine(10, 10)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 10, 0, 10, 0)
+}
+
+func TestLengthIndexedLineExtractLineMulti(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLineFromLines([][]geom.Coordinate{
+		coords(0, 0, 10, 0),
+		coords(20, 0, 25, 0, 30, 0),
+	})
+	assert.Equal(t, 20.0, indexedLine.GetEndIndex())
+
+	result := indexedLine.ExtractLine(5, 15)
+	assert.Equal(t, 2, len(result))
+	check_coords(t, result[0], 5, 0, 10, 0)
+	check_coords(t, result[1], 20, 0, 25, 0)
+
+	//-- a component endpoint is resolved to the end of the first component
+	check_point(t, indexedLine.ExtractPoint(10), 10, 0)
+	//-- an interval starting at a component endpoint starts in the next component
+	result = indexedLine.ExtractLine(10, 20)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 20, 0, 25, 0, 30, 0)
+}
+
+func TestLengthIndexedLineProject(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	assert.InDelta(t, 5.0, indexedLine.Project(geom.NewCoordinateXY(5, 3)), 1e-9)
+	assert.InDelta(t, 15.0, indexedLine.Project(geom.NewCoordinateXY(12, 5)), 1e-9)
+	assert.InDelta(t, 0.0, indexedLine.Project(geom.NewCoordinateXY(-5, -5)), 1e-9)
+	assert.InDelta(t, 20.0, indexedLine.Project(geom.NewCoordinateXY(10, 50)), 1e-9)
+	assert.InDelta(t, 15.0, indexedLine.IndexOf(geom.NewCoordinateXY(10, 5)), 1e-9)
+}
+
+func TestLengthIndexedLineIndexOfAfterRing(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0))
+	pt := geom.NewCoordinateXY(0, 0)
+	assert.Equal(t, 0.0, indexedLine.IndexOf(pt))
+	assert.Equal(t, 40.0, indexedLine.IndexOfAfter(pt, 0.1))
+	//-- no index after the end of the line
+	assert.Equal(t, 40.0, indexedLine.IndexOfAfter(geom.NewCoordinateXY(5, 0), 45))
+}
+
+func TestLengthIndexedLineIndicesOf(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	indices := indexedLine.IndicesOf([][]geom.Coordinate{coords(5, 0, 10, 0, 10, 5)})
+	assert.InDelta(t, 5.0, indices[0], 1e-9)
+	assert.InDelta(t, 15.0, indices[1], 1e-9)
+
+	ring := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0))
+	indices = ring.IndicesOf([][]geom.Coordinate{coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)})
+	assert.Equal(t, [2]float64{0, 40}, indices)
+}
+
+func TestLengthIndexedLineClampIndex(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	assert.Equal(t, 0.0, indexedLine.GetStartIndex())
+	assert.Equal(t, 20.0, indexedLine.GetEndIndex())
+	assert.Equal(t, 15.0, indexedLine.ClampIndex(-5))
+	assert.Equal(t, 20.0, indexedLine.ClampIndex(25))
+	assert.Equal(t, 0.0, indexedLine.ClampIndex(-25))
+	assert.True(t, indexedLine.IsValidIndex(20))
+	assert.False(t, indexedLine.IsValidIndex(-5))
+	assert.False(t, indexedLine.IsValidIndex(21))
+}
+
+func TestLinearLocationNormalize(t *testing.T) {
+	check_location(t, linearref.NewLinearLocationWithSegment(0, 1.0), 0, 1, 0)
+	check_location(t, linearref.NewLinearLocationWithSegment(2, 1.5), 0, 3, 0)
+	check_location(t, linearref.NewLinearLocationWithSegment(-1, 0.5), 0, 0, 0)
+	check_location(t, linearref.NewLinearLocationWithComponent(1, 2, -0.5), 1, 2, 0)
+
+	a := linearref.NewLinearLocationWithComponent(0, 1, 0.5)
+	b := linearref.NewLinearLocationWithComponent(1, 0, 0)
+	assert.Equal(t, -1, a.CompareTo(b))
+	assert.Equal(t, 1, b.CompareTo(a))
+	assert.Equal(t, 0, a.CompareTo(a.Copy()))
+	assert.True(t, a.IsOnSameSegment(linearref.NewLinearLocationWithSegment(2, 0)))
+	assert.False(t, a.IsOnSameSegment(b))
+}
+
+func TestLocationIndexedLineIndexOf(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	indexedLine := linearref.NewLocationIndexedLine(line)
+	loc := indexedLine.IndexOf(geom.NewCoordinateXY(10, 5))
+	check_location(t, loc, 0, 1, 0.5)
+	check_point(t, indexedLine.ExtractPoint(loc), 10, 5)
+	assert.InDelta(t, 15.0, linearref.LengthLocationMapGetLength([][]geom.Coordinate{line}, loc), 1e-9)
+
+	check_location(t, indexedLine.Project(geom.NewCoordinateXY(12, 5)), 0, 1, 0.5)
+	check_location(t, indexedLine.GetStartIndex(), 0, 0, 0)
+	check_location(t, indexedLine.GetEndIndex(), 0, 2, 0)
+	check_point(t, indexedLine.ExtractPoint(indexedLine.GetEndIndex()), 10, 10)
+}
+
+func TestLocationIndexedLineIndexOfAfter(t *testing.T) {
+	indexedLine := linearref.NewLocationIndexedLine(coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0))
+	pt := geom.NewCoordinateXY(0, 0)
+	start := indexedLine.IndexOf(pt)
+	check_location(t, start, 0, 0, 0)
+	check_location(t, indexedLine.IndexOfAfter(pt, start), 0, 4, 0)
+}
+
+func TestLocationIndexedLineExtractLine(t *testing.T) {
+	indexedLine := linearref.NewLocationIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	start := linearref.NewLinearLocationWithSegment(0, 0.5)
+	end := linearref.NewLinearLocationWithSegment(1, 0.5)
+	result := indexedLine.ExtractLine(start, end)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 5, 0, 10, 0, 10, 5)
+
+	indices := indexedLine.IndicesOf(result)
+	assert.Equal(t, 0, indices[0].CompareTo(start))
+	assert.Equal(t, 0, indices[1].CompareTo(end))
+
+	result = indexedLine.ExtractLine(end, start)
+	check_coords(t, result[0], 10, 5, 10, 0, 5, 0)
+}
+
+func TestLocationIndexedLineMulti(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 10, 0),
+		coords(20, 0, 25, 0, 30, 0),
+	}
+	indexedLine := linearref.NewLocationIndexedLineFromLines(lines)
+	loc := indexedLine.Project(geom.NewCoordinateXY(27, 1))
+	check_location(t, loc, 1, 1, 0.4)
+	assert.InDelta(t, 17.0, linearref.LengthLocationMapGetLength(lines, loc), 1e-9)
+	//-- the end of the first component maps to its length
+	assert.InDelta(t, 10.0, linearref.LengthLocationMapGetLength(lines, linearref.NewLinearLocationWithComponent(0, 1, 0)), 1e-9)
+	check_location(t, linearref.LengthLocationMapGetLocation(lines, 17), 1, 1, 0.4)
+	check_location(t, linearref.LengthLocationMapGetLocationResolveLower(lines, 10, false), 1, 0, 0)
+}
+
+func TestLocationIndexedLineClampIndex(t *testing.T) {
+	indexedLine := linearref.NewLocationIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	assert.True(t, indexedLine.IsValidIndex(linearref.NewLinearLocationWithSegment(2, 0)))
+	assert.False(t, indexedLine.IsValidIndex(linearref.NewLinearLocationWithSegment(3, 0)))
+	assert.False(t, indexedLine.IsValidIndex(linearref.NewLinearLocationWithComponent(1, 0, 0)))
+	check_location(t, indexedLine.ClampIndex(linearref.NewLinearLocationWithSegment(5, 0.5)), 0, 2, 0)
+	check_location(t, indexedLine.ClampIndex(linearref.NewLinearLocationWithComponent(3, 0, 0)), 0, 2, 0)
+}
+
+func TestLocationIndexedLineExtractPointOffset(t *testing.T) {
+	indexedLine := linearref.NewLocationIndexedLine(coords(0, 0, 10, 0, 10, 10))
+	pt, err := indexedLine.ExtractPointOffset(linearref.NewLinearLocationWithSegment(1, 0.5), 2)
+	assert.Nil(t, err)
+	check_point(t, pt, 8, 5)
+	pt, err = indexedLine.ExtractPointOffset(indexedLine.GetEndIndex(), -1)
+	assert.Nil(t, err)
+	check_point(t, pt, 11, 10)
+}
+
+func TestLinearRefEmptyLine(t *testing.T) {
+	indexedLine := linearref.NewLengthIndexedLineFromLines([][]geom.Coordinate{})
+	assert.Nil(t, indexedLine.ExtractPoint(5))
+	assert.Equal(t, 0, len(indexedLine.ExtractLine(0, 5)))
+	assert.Equal(t, 0.0, indexedLine.Project(geom.NewCoordinateXY(1, 1)))
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	linearref "github.com/UltimateThread/geos-go/core/linearref"
+)
+
+/**
+ * Creates a measured line from a list of X, Y, M triples.
+ */
+func coords_m(ords ...float64) []geom.Coordinate {
+	pts := make([]geom.Coordinate, 0, len(ords)/3)
+	for i := 0; i < len(ords); i += 3 {
+		pts = append(pts, *geom.NewCoordinateXYM(ords[i], ords[i+1], ords[i+2]))
+	}
+	return pts
+}
+
+func check_measures(t *testing.T, pts []geom.Coordinate, measures ...float64) {
+	assert.Equal(t, len(measures), len(pts))
+	for i := 0; i < len(pts) && i < len(measures); i++ {
+		assert.InDelta(t, measures[i], pts[i].M, 1e-9, "point %d", i)
+	}
+}
+
+func TestMeasureIndexedLineLocateAlong(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 10, 0, 10, 10, 10, 30))
+	pts := indexedLine.LocateAlong(5)
+	assert.Equal(t, 1, len(pts))
+	check_point(t, &pts[0], 5, 0)
+	assert.InDelta(t, 5.0, pts[0].M, 1e-9)
+
+	pts = indexedLine.LocateAlong(20)
+	check_coords(t, pts, 10, 5)
+	//-- vertices are located once
+	check_coords(t, indexedLine.LocateAlong(10), 10, 0)
+	check_coords(t, indexedLine.LocateAlong(30), 10, 10)
+	check_coords(t, indexedLine.LocateAlong(0), 0, 0)
+	assert.Equal(t, 0, len(indexedLine.LocateAlong(31)))
+}
+
+func TestMeasureIndexedLineLocateAlongNonMonotonic(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 10, 0, 10, 20, 0, 0))
+	assert.False(t, indexedLine.IsMonotonic(false))
+	check_coords(t, indexedLine.LocateAlong(5), 5, 0, 15, 0)
+	check_coords(t, indexedLine.LocateAlong(10), 10, 0)
+
+	locs := indexedLine.LocationsOf(5)
+	assert.Equal(t, 2, len(locs))
+	check_location(t, locs[0], 0, 0, 0.5)
+	check_location(t, locs[1], 0, 1, 0.5)
+}
+
+func TestMeasureIndexedLineLocateAlongMulti(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLineFromLines([][]geom.Coordinate{
+		coords_m(0, 0, 0, 10, 0, 10),
+		coords_m(20, 0, 10, 30, 0, 20),
+	})
+	assert.True(t, indexedLine.IsMonotonic(false))
+	assert.False(t, indexedLine.IsMonotonic(true))
+	check_coords(t, indexedLine.LocateAlong(10), 10, 0, 20, 0)
+	check_coords(t, indexedLine.LocateAlong(15), 25, 0)
+}
+
+func TestMeasureIndexedLineLocateAlongOffset(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 10, 0, 10, 10, 10, 30))
+	pts, err := indexedLine.LocateAlongOffset(5, 2)
+	assert.Nil(t, err)
+	check_coords(t, pts, 5, 2)
+	assert.InDelta(t, 5.0, pts[0].M, 1e-9)
+
+	pts, err = indexedLine.LocateAlongOffset(30, -1)
+	assert.Nil(t, err)
+	check_coords(t, pts, 11, 10)
+}
+
+func TestMeasureIndexedLineLocateBetween(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 10, 0, 10, 10, 10, 30))
+	sections := indexedLine.LocateBetween(5, 20)
+	assert.Equal(t, 1, len(sections))
+	check_coords(t, sections[0], 5, 0, 10, 0, 10, 5)
+	check_measures(t, sections[0], 5, 10, 20)
+
+	//-- the order of the measures does not matter
+	sections = indexedLine.LocateBetween(20, 5)
+	assert.Equal(t, 1, len(sections))
+	check_coords(t, sections[0], 5, 0, 10, 0, 10, 5)
+
+	sections = indexedLine.LocateBetween(-10, 50)
+	assert.Equal(t, 1, len(sections))
+	check_coords(t, sections[0], 0, 0, 10, 0, 10, 10)
+
+	assert.Equal(t, 0, len(indexedLine.LocateBetween(40, 50)))
+}
+
+func TestMeasureIndexedLineLocateBetweenNonMonotonic(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 10, 0, 10, 20, 0, 0, 30, 0, 10))
+	sections := indexedLine.LocateBetween(0, 5)
+	assert.Equal(t, 2, len(sections))
+	check_coords(t, sections[0], 0, 0, 5, 0)
+	check_coords(t, sections[1], 15, 0, 20, 0, 25, 0)
+
+	//-- a range touching only a vertex gives a zero-length section
+	sections = indexedLine.LocateBetween(10, 12)
+	assert.Equal(t, 2, len(sections))
+	check_coords(t, sections[0], 10, 0, 10, 0)
+	check_coords(t, sections[1], 30, 0, 30, 0)
+}
+
+func TestMeasureIndexedLineLocateBetweenMissingMeasures(t *testing.T) {
+	line := coords_m(0, 0, 0, 10, 0, 10, 20, 0, 20, 30, 0, 30)
+	line[2].M = math.NaN()
+	indexedLine := linearref.NewMeasureIndexedLine(line)
+	assert.False(t, indexedLine.IsMonotonic(false))
+	sections := indexedLine.LocateBetween(0, 30)
+	assert.Equal(t, 1, len(sections))
+	check_coords(t, sections[0], 0, 0, 10, 0)
+}
+
+func TestMeasureIndexedLineProject(t *testing.T) {
+	indexedLine := linearref.NewMeasureIndexedLine(coords_m(0, 0, 100, 10, 0, 110, 10, 10, 130))
+	assert.InDelta(t, 104.0, indexedLine.Project(geom.NewCoordinateXY(4, 3)), 1e-9)
+	assert.InDelta(t, 120.0, indexedLine.Project(geom.NewCoordinateXY(12, 5)), 1e-9)
+	assert.InDelta(t, 130.0, indexedLine.Project(geom.NewCoordinateXY(20, 20)), 1e-9)
+	assert.True(t, math.IsNaN(linearref.NewMeasureIndexedLine(coords(0, 0, 10, 0)).Project(geom.NewCoordinateXY(5, 0))))
+}
+
+func TestMeasureIndexedLineIsMonotonic(t *testing.T) {
+	assert.True(t, linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 1, 0, 1, 2, 0, 2)).IsMonotonic(true))
+	assert.True(t, linearref.NewMeasureIndexedLine(coords_m(0, 0, 2, 1, 0, 1, 2, 0, 0)).IsMonotonic(true))
+	assert.True(t, linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 1, 0, 1, 2, 0, 1)).IsMonotonic(false))
+	assert.False(t, linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 1, 0, 1, 2, 0, 1)).IsMonotonic(true))
+	assert.False(t, linearref.NewMeasureIndexedLine(coords_m(0, 0, 0, 1, 0, 2, 2, 0, 1)).IsMonotonic(false))
+	assert.False(t, linearref.NewMeasureIndexedLine(coords(0, 0, 1, 0)).IsMonotonic(false))
+}
+
+func TestMeasureIndexedLineAddMeasure(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 30)
+	measured := linearref.MeasureIndexedLineAddMeasure(line, 100, 200)
+	check_coords(t, measured, 0, 0, 10, 0, 10, 30)
+	check_measures(t, measured, 100, 125, 200)
+	//-- the input is not modified
+	assert.True(t, math.IsNaN(line[1].M))
+
+	check_measures(t, linearref.MeasureIndexedLineAddMeasure(coords(5, 5, 5, 5), 1, 2), 1, 1)
+}
+
+func TestMeasureIndexedLineInterpolateMeasures(t *testing.T) {
+	line := coords_m(0, 0, 0, 0, 0, 0, 10, 0, 0, 30, 0, 30, 40, 0, 0, 50, 0, 0)
+	line[0].M = math.NaN()
+	line[2].M = math.NaN()
+	line[4].M = math.NaN()
+	line[5].M = math.NaN()
+	result, err := linearref.MeasureIndexedLineInterpolateMeasures(line)
+	assert.Nil(t, err)
+	check_measures(t, result, 0, 0, 10, 30, 30, 30)
+	assert.True(t, math.IsNaN(line[2].M))
+
+	_, err = linearref.MeasureIndexedLineInterpolateMeasures(coords(0, 0, 10, 0))
+	assert.NotNil(t, err)
+}
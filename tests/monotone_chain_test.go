@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	chain "github.com/UltimateThread/geos-go/core/index/chain"
+)
+
+func monotone_chain_ranges(chains []*chain.MonotoneChain) [][2]int {
+	ranges := make([][2]int, len(chains))
+	for i, mc := range chains {
+		ranges[i] = [2]int{mc.GetStartIndex(), mc.GetEndIndex()}
+	}
+	return ranges
+}
+
+func TestMonotoneChainBuilderChains(t *testing.T) {
+	pts := coords(0, 0, 1, 1, 2, 3, 3, 2, 4, 0, 4, 0, 5, -1, 4, -2, 3, -3)
+	chains := chain.MonotoneChainBuilderGetChains(pts)
+	assert.Equal(t, [][2]int{{0, 2}, {2, 6}, {6, 8}}, monotone_chain_ranges(chains))
+
+	env := chains[1].GetEnvelope()
+	assert.Equal(t, 2.0, env.GetMinX())
+	assert.Equal(t, 5.0, env.GetMaxX())
+	assert.Equal(t, -1.0, env.GetMinY())
+	assert.Equal(t, 3.0, env.GetMaxY())
+	check_coords(t, chains[2].GetCoordinates(), 5, -1, 4, -2, 3, -3)
+}
+
+func TestMonotoneChainBuilderRepeatedStart(t *testing.T) {
+	pts := coords(0, 0, 0, 0, 1, 1, 0, 2)
+	chains := chain.MonotoneChainBuilderGetChainsWithContext(pts, "ctx")
+	assert.Equal(t, [][2]int{{0, 2}, {2, 3}}, monotone_chain_ranges(chains))
+	assert.Equal(t, "ctx", chains[0].GetContext())
+
+	single := chain.MonotoneChainBuilderGetChains(coords(1, 1))
+	assert.Equal(t, [][2]int{{0, 0}}, monotone_chain_ranges(single))
+	assert.Empty(t, chain.MonotoneChainBuilderGetChains(coords()))
+}
+
+func TestMonotoneChainSelect(t *testing.T) {
+	pts := make([]geom.Coordinate, 0)
+	for i := 0; i <= 100; i++ {
+		pts = append(pts, *geom.NewCoordinateXY(float64(i), float64(i%10)))
+	}
+	chains := chain.MonotoneChainBuilderGetChains(pts)
+	selected := make([]int, 0)
+	for _, mc := range chains {
+		mc.Select(geom.NewEnvelope(43.5, 45.5, 0, 10), func(mc *chain.MonotoneChain, startIndex int) {
+			selected = append(selected, startIndex)
+		})
+	}
+	assert.ElementsMatch(t, []int{43, 44, 45}, selected)
+}
+
+func TestMonotoneChainOverlaps(t *testing.T) {
+	line1 := make([]geom.Coordinate, 0)
+	line2 := make([]geom.Coordinate, 0)
+	for i := 0; i <= 60; i++ {
+		x := float64(i) / 2
+		line1 = append(line1, *geom.NewCoordinateXY(x, math.Sin(x)))
+		line2 = append(line2, *geom.NewCoordinateXY(x+0.1, math.Cos(x)))
+	}
+
+	expected := 0
+	for i := 0; i < len(line1)-1; i++ {
+		for j := 0; j < len(line2)-1; j++ {
+			if geom.EnvelopeIntersectsSegments(&line1[i], &line1[i+1], &line2[j], &line2[j+1]) {
+				expected++
+			}
+		}
+	}
+
+	chains1 := chain.MonotoneChainBuilderGetChains(line1)
+	chains2 := chain.MonotoneChainBuilderGetChains(line2)
+	count := 0
+	for _, mc1 := range chains1 {
+		for _, mc2 := range chains2 {
+			mc1.ComputeOverlaps(mc2, func(mc1 *chain.MonotoneChain, start1 int, mc2 *chain.MonotoneChain, start2 int) {
+				p0, p1 := mc1.GetLineSegment(start1)
+				q0, q1 := mc2.GetLineSegment(start2)
+				assert.True(t, geom.EnvelopeIntersectsSegments(p0, p1, q0, q1))
+				count++
+			})
+		}
+	}
+	assert.Equal(t, expected, count)
+}
+
+func TestMonotoneChainOverlapTolerance(t *testing.T) {
+	mc1 := chain.NewMonotoneChain(coords(0, 0, 1, 1), 0, 1, nil)
+	mc2 := chain.NewMonotoneChain(coords(1.5, 0, 2, 1), 0, 1, nil)
+	count := 0
+	action := func(mc1 *chain.MonotoneChain, start1 int, mc2 *chain.MonotoneChain, start2 int) {
+		count++
+	}
+	mc1.ComputeOverlaps(mc2, action)
+	assert.Equal(t, 0, count)
+	mc1.ComputeOverlapsTolerance(mc2, 0.6, action)
+	assert.Equal(t, 1, count)
+}
+
+func TestQuadrant(t *testing.T) {
+	quad, err := geom.QuadrantOf(geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(-1, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, constants.QUADRANT_NW, quad)
+	_, err = geom.QuadrantOf(geom.NewCoordinateXY(1, 1), geom.NewCoordinateXY(1, 1))
+	assert.NotNil(t, err)
+	assert.True(t, geom.QuadrantIsOpposite(constants.QUADRANT_NE, constants.QUADRANT_SW))
+	assert.False(t, geom.QuadrantIsOpposite(constants.QUADRANT_NE, constants.QUADRANT_SE))
+}
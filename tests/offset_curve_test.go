@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	buffer "github.com/UltimateThread/geos-go/core/operation/buffer"
+)
+
+func TestOffsetCurveStraightLine(t *testing.T) {
+	line := coords(0, 0, 10, 0)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, 1, nil), [][]float64{{0, 1, 10, 1}})
+	check_offset_curve(t, buffer.GetOffsetCurve(line, -1, nil), [][]float64{{0, -1, 10, -1}})
+}
+
+func TestOffsetCurveZeroDistance(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, 0, nil), [][]float64{{0, 0, 10, 0, 10, 10}})
+}
+
+func TestOffsetCurveEmpty(t *testing.T) {
+	assert.Nil(t, buffer.GetOffsetCurve(coords(), 1, nil))
+	assert.Nil(t, buffer.GetOffsetCurve(coords(1, 1, 1, 1), 1, nil))
+}
+
+func TestOffsetCurveInsideCorner(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, 1, nil), [][]float64{{0, 1, 9, 1, 9, 10}})
+}
+
+func TestOffsetCurveMitreJoin(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	bufParams := buffer.NewBufferParameters(8, constants.BUFFER_CAP_FLAT, constants.BUFFER_JOIN_MITRE, 5)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, -1, bufParams), [][]float64{{0, -1, 11, -1, 11, 10}})
+}
+
+func TestOffsetCurveBevelJoin(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	bufParams := buffer.NewBufferParameters(8, constants.BUFFER_CAP_FLAT, constants.BUFFER_JOIN_BEVEL, 5)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, -1, bufParams), [][]float64{{0, -1, 10, -1, 11, 0, 11, 10}})
+}
+
+func TestOffsetCurveRoundJoin(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	curve := buffer.GetOffsetCurve(line, -1, nil)
+	assert.Equal(t, 1, len(curve))
+	section := curve[0]
+	// 8 quadrant segments around the corner
+	assert.Equal(t, 11, len(section))
+	corner := geom.NewCoordinateXY(10, 0)
+	for i := 1; i < len(section)-1; i++ {
+		assert.InDelta(t, 1.0, section[i].Distance(corner), 1e-9)
+	}
+}
+
+func TestOffsetCurveNarrowUTurnRemoved(t *testing.T) {
+	// the inside of the U is narrower than twice the offset distance
+	line := coords(0, 0, 10, 0, 10, 1, 0, 1)
+	assert.Equal(t, 0, len(buffer.GetOffsetCurve(line, 1, nil)))
+
+	raw := buffer.OffsetCurveRawOffset(line, 1, buffer.DefaultBufferParameters())
+	assert.Equal(t, 4, len(raw))
+}
+
+func TestOffsetCurveLoopRemoved(t *testing.T) {
+	// the offset of a narrow spike self-intersects
+	line := coords(0, 0, 10, 0, 10.2, 5, 10.4, 0, 20, 0)
+	curve := buffer.GetOffsetCurve(line, -1, nil)
+	assert.Equal(t, 1, len(curve))
+	section := curve[0]
+	for i := range section {
+		assert.True(t, section[i].Y < -0.97)
+	}
+	assert.True(t, section[0].Equals2D(geom.NewCoordinateXY(0, -1)))
+	assert.True(t, section[len(section)-1].Equals2D(geom.NewCoordinateXY(20, -1)))
+}
+
+func TestOffsetCurveDisjointSections(t *testing.T) {
+	// the line crosses itself, which splits the offset curve
+	line := coords(0, 0, 10, 0, 10, 1.5, 5, 1.5, 5, -5)
+	check_offset_curve(t, buffer.GetOffsetCurve(line, 1, nil), [][]float64{{0, 1, 4, 1}, {6, -1, 6, -5}})
+
+	joined := buffer.GetOffsetCurveJoined(line, 1)
+	check_offset_curve(t, [][]geom.Coordinate{joined}, [][]float64{{0, 1, 4, 1, 6, -1, 6, -5}})
+}
+
+func TestOffsetCurveRing(t *testing.T) {
+	ring := coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)
+	bufParams := buffer.NewBufferParameters(8, constants.BUFFER_CAP_FLAT, constants.BUFFER_JOIN_MITRE, 5)
+	check_offset_curve(t, buffer.GetOffsetCurve(ring, 1, bufParams), [][]float64{{1, 1, 9, 1, 9, 9, 1, 9, 1, 1}})
+	check_offset_curve(t, buffer.GetOffsetCurve(ring, -1, bufParams), [][]float64{{-1, -1, 11, -1, 11, 11, -1, 11, -1, -1}})
+}
+
+func coords(ords ...float64) []geom.Coordinate {
+	pts := make([]geom.Coordinate, 0, len(ords)/2)
+	for i := 0; i < len(ords); i += 2 {
+		pts = append(pts, *geom.NewCoordinateXY(ords[i], ords[i+1]))
+	}
+	return pts
+}
+
+func check_offset_curve(t *testing.T, curve [][]geom.Coordinate, expected [][]float64) {
+	assert.Equal(t, len(expected), len(curve))
+	for i := 0; i < len(curve) && i < len(expected); i++ {
+		check_coords_tolerance(t, curve[i], expected[i], 1e-5)
+	}
+}
+
+func check_coords_tolerance(t *testing.T, pts []geom.Coordinate, ords []float64, tolerance float64) {
+	assert.Equal(t, len(ords), len(pts)*2)
+	for i := 0; i < len(pts) && 2*i+1 < len(ords); i++ {
+		assert.InDelta(t, ords[2*i], pts[i].X, tolerance)
+		assert.InDelta(t, ords[2*i+1], pts[i].Y, tolerance)
+	}
+}
+
+func TestOffsetCurveSegmentCrossingLine(t *testing.T) {
+	// the raw curve segment after the last join crosses the first line segment,
+	// without either of its vertices being inside the buffer
+	line := coords(67, 83, 54, 49, 52, 78, 94, 94)
+	curve := buffer.GetOffsetCurve(line, -2.75, nil)
+	check_offset_curve(t, curve, [][]float64{
+		{62.556536, 79.078749, 55.885415, 61.631201, 54.883720, 76.155771, 62.556536, 79.078749},
+		{69.448771, 81.704362, 94.978987, 91.430159},
+	})
+	check_offset_curve_outside_buffer(t, line, 2.75, curve)
+}
+
+func TestOffsetCurveRandomOutsideBuffer(t *testing.T) {
+	r := rand.New(rand.NewSource(26))
+	for i := 0; i < 500; i++ {
+		n := 3 + r.Intn(4)
+		ords := make([]float64, 2*n)
+		for k := range ords {
+			ords[k] = math.Round(r.Float64() * 100)
+		}
+		line := coords(ords...)
+		distance := 0.5 + r.Float64()*10
+		if r.Intn(2) == 0 {
+			distance = -distance
+		}
+		check_offset_curve_outside_buffer(t, line, math.Abs(distance), buffer.GetOffsetCurve(line, distance, nil))
+	}
+}
+
+func TestOffsetCurveLongWavyLine(t *testing.T) {
+	n := 20000
+	line := make([]geom.Coordinate, 0, n)
+	for i := 0; i < n; i++ {
+		x := float64(i) * 0.1
+		line = append(line, *geom.NewCoordinateXY(x, math.Sin(x)))
+	}
+	//-- the offset below the line is broken into sections where it is removed inside the bends
+	for distance, numSections := range map[float64]int{0.5: 1, -3: 101} {
+		start := time.Now()
+		curve := buffer.GetOffsetCurve(line, distance, nil)
+		assert.Less(t, time.Since(start), 5*time.Second)
+		assert.Equal(t, numSections, len(curve))
+	}
+}
+
+/**
+ * Checks that no part of a round-joined offset curve lies inside the buffer,
+ * allowing for the fillet chords being slightly closer to the line.
+ */
+func check_offset_curve_outside_buffer(t *testing.T, line []geom.Coordinate, distance float64, curve [][]geom.Coordinate) {
+	minDist := 0.985 * distance
+	for _, section := range curve {
+		for i := 0; i < len(section)-1; i++ {
+			p0 := section[i]
+			p1 := section[i+1]
+			for k := 0; k <= 10; k++ {
+				f := float64(k) / 10
+				pt := geom.NewCoordinateXY(p0.X+f*(p1.X-p0.X), p0.Y+f*(p1.Y-p0.Y))
+				if !assert.True(t, algorithm.DistancePointToSegmentString(pt, line) >= minDist, "point %v of curve of %v", pt, line) {
+					return
+				}
+			}
+		}
+	}
+}
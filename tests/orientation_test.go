@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+func TestOrientationIndex(t *testing.T) {
+	p1 := geom.NewCoordinateXY(0, 0)
+	p2 := geom.NewCoordinateXY(10, 0)
+	assert.Equal(t, constants.ORIENTATION_COUNTERCLOCKWISE, algorithm.OrientationIndex(p1, p2, geom.NewCoordinateXY(5, 1)))
+	assert.Equal(t, constants.ORIENTATION_CLOCKWISE, algorithm.OrientationIndex(p1, p2, geom.NewCoordinateXY(5, -1)))
+	assert.Equal(t, constants.ORIENTATION_COLLINEAR, algorithm.OrientationIndex(p1, p2, geom.NewCoordinateXY(20, 0)))
+}
+
+func TestOrientationIndexRobust(t *testing.T) {
+	// a case which fails with plain floating-point arithmetic
+	p1 := geom.NewCoordinateXY(219.3649559090992, 140.84159161824724)
+	p2 := geom.NewCoordinateXY(168.9018919682399, -5.713787599646864)
+	q := geom.NewCoordinateXY(186.80814046338352, 46.28973405831556)
+	index := algorithm.OrientationIndex(p1, p2, q)
+	assert.Equal(t, index, algorithm.OrientationIndex(p2, q, p1))
+	assert.Equal(t, index, algorithm.OrientationIndex(q, p1, p2))
+	assert.Equal(t, -index, algorithm.OrientationIndex(p2, p1, q))
+}
+
+func TestOrientationIsCCW(t *testing.T) {
+	ccw := []geom.Coordinate{
+		*geom.NewCoordinateXY(0, 0),
+		*geom.NewCoordinateXY(10, 0),
+		*geom.NewCoordinateXY(10, 10),
+		*geom.NewCoordinateXY(0, 0),
+	}
+	assert.True(t, algorithm.OrientationIsCCW(ccw))
+
+	geom.ReverseCoordinates(ccw)
+	assert.False(t, algorithm.OrientationIsCCW(ccw))
+}
+
+func TestLineIntersectorProper(t *testing.T) {
+	li := algorithm.NewLineIntersector()
+	li.ComputeIntersection(
+		geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(10, 10),
+		geom.NewCoordinateXY(0, 10), geom.NewCoordinateXY(10, 0))
+	assert.True(t, li.HasIntersection())
+	assert.True(t, li.IsProper())
+	assert.Equal(t, constants.LINE_INTERSECTOR_POINT_INTERSECTION, li.GetIntersectionNum())
+	assert.True(t, li.GetIntersection(0).Equals2D(geom.NewCoordinateXY(5, 5)))
+}
+
+func TestLineIntersectorEndpoint(t *testing.T) {
+	li := algorithm.NewLineIntersector()
+	li.ComputeIntersection(
+		geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(10, 0),
+		geom.NewCoordinateXY(10, 0), geom.NewCoordinateXY(10, 10))
+	assert.True(t, li.HasIntersection())
+	assert.False(t, li.IsProper())
+	assert.True(t, li.GetIntersection(0).Equals2D(geom.NewCoordinateXY(10, 0)))
+}
+
+func TestLineIntersectorCollinear(t *testing.T) {
+	li := algorithm.NewLineIntersector()
+	li.ComputeIntersection(
+		geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(10, 0),
+		geom.NewCoordinateXY(5, 0), geom.NewCoordinateXY(20, 0))
+	assert.Equal(t, constants.LINE_INTERSECTOR_COLLINEAR_INTERSECTION, li.GetIntersectionNum())
+	assert.True(t, li.IsIntersection(geom.NewCoordinateXY(5, 0)))
+	assert.True(t, li.IsIntersection(geom.NewCoordinateXY(10, 0)))
+}
+
+func TestLineIntersectorDisjoint(t *testing.T) {
+	li := algorithm.NewLineIntersector()
+	li.ComputeIntersection(
+		geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(10, 0),
+		geom.NewCoordinateXY(0, 1), geom.NewCoordinateXY(10, 2))
+	assert.False(t, li.HasIntersection())
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	planargraph "github.com/UltimateThread/geos-go/core/planargraph"
+)
+
+func TestPlanarGraphAddEdge(t *testing.T) {
+	graph := planargraph.NewPlanarGraph[int]()
+	de := graph.AddEdge(coords(0, 0, 0, 0, 5, 5, 10, 0))
+	if !assert.NotNil(t, de) {
+		return
+	}
+	assert.True(t, de.GetEdgeDirection())
+	assert.False(t, de.GetSym().GetEdgeDirection())
+	assert.Equal(t, de, de.GetSym().GetSym())
+	assert.Equal(t, de.GetFromNode(), de.GetSym().GetToNode())
+	assert.True(t, de.GetDirectionPt().Equals2D(geom.NewCoordinateXY(5, 5)))
+	assert.True(t, de.GetSym().GetDirectionPt().Equals2D(geom.NewCoordinateXY(5, 5)))
+	//-- repeated points are removed from the edge line
+	check_coords(t, de.GetEdge().GetLine(), 0, 0, 5, 5, 10, 0)
+
+	//-- the data is updated by reference
+	*de.GetData() = 7
+	assert.Equal(t, 7, *de.GetData())
+	assert.Equal(t, 0, *de.GetSym().GetData())
+
+	//-- lines which collapse to a point are ignored
+	assert.Nil(t, graph.AddEdge(coords(1, 1, 1, 1)))
+	assert.Nil(t, graph.AddEdge(nil))
+	assert.Equal(t, 1, len(graph.GetEdges()))
+	assert.Equal(t, 2, len(graph.GetDirEdges()))
+	assert.Equal(t, 2, len(graph.GetNodes()))
+}
+
+func TestPlanarGraphOutEdgeOrder(t *testing.T) {
+	graph := planargraph.NewPlanarGraph[struct{}]()
+	graph.AddEdge(coords(0, 0, 0, -10))
+	graph.AddEdge(coords(0, 0, -10, 0))
+	graph.AddEdge(coords(0, 0, 10, 1))
+	graph.AddEdge(coords(0, 0, 10, 10))
+	graph.AddEdge(coords(0, 0, 1, 10))
+
+	nodes := graph.GetNodes()
+	assert.Equal(t, 6, len(nodes))
+	//-- the nodes are sorted in XY order
+	for i := 1; i < len(nodes); i++ {
+		assert.Equal(t, -1, nodes[i-1].GetCoordinate().CompareTo(nodes[i].GetCoordinate()))
+	}
+	var center *planargraph.Node[struct{}]
+	for _, node := range nodes {
+		if node.GetCoordinate().Equals2D(geom.NewCoordinateXY(0, 0)) {
+			center = node
+		}
+	}
+	if !assert.NotNil(t, center) {
+		return
+	}
+	assert.Equal(t, 5, center.GetDegree())
+	//-- the out edges are in CCW order from the positive X axis
+	expected := coords(10, 1, 10, 10, 1, 10, -10, 0, 0, -10)
+	for i, de := range center.GetOutEdges() {
+		assert.True(t, de.GetDirectionPt().Equals2D(&expected[i]), "edge %d is %v", i, de.GetDirectionPt())
+	}
+}
+
+func TestPlanarGraphConnectedSubgraphs(t *testing.T) {
+	graph := planargraph.NewPlanarGraph[struct{}]()
+	graph.AddEdge(coords(0, 0, 1, 0))
+	graph.AddEdge(coords(1, 0, 2, 0))
+	graph.AddEdge(coords(5, 5, 6, 6))
+	graph.AddEdge(coords(2, 0, 0, 0))
+
+	subgraphs := graph.GetConnectedSubgraphs()
+	assert.Equal(t, 2, len(subgraphs))
+	assert.Equal(t, 3, len(subgraphs[0]))
+	assert.Equal(t, 2, len(subgraphs[1]))
+	assert.True(t, subgraphs[1][0].GetCoordinate().Equals2D(geom.NewCoordinateXY(5, 5)))
+	for _, node := range graph.GetNodes() {
+		assert.True(t, node.IsVisited())
+	}
+}
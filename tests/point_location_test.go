@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+)
+
+func TestPointLocationLocateInRing(t *testing.T) {
+	ring := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	assert.Equal(t, constants.LOCATION_INTERIOR, algorithm.PointLocationLocateInRing(geom.NewCoordinateXY(5, 5), ring))
+	assert.Equal(t, constants.LOCATION_BOUNDARY, algorithm.PointLocationLocateInRing(geom.NewCoordinateXY(0, 5), ring))
+	assert.Equal(t, constants.LOCATION_BOUNDARY, algorithm.PointLocationLocateInRing(geom.NewCoordinateXY(10, 10), ring))
+	assert.Equal(t, constants.LOCATION_EXTERIOR, algorithm.PointLocationLocateInRing(geom.NewCoordinateXY(15, 5), ring))
+	assert.True(t, algorithm.PointLocationIsInRing(geom.NewCoordinateXY(5, 0), ring))
+}
+
+func TestPointLocationIsOnLine(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	assert.True(t, algorithm.PointLocationIsOnLine(geom.NewCoordinateXY(5, 0), line))
+	assert.True(t, algorithm.PointLocationIsOnLine(geom.NewCoordinateXY(10, 3), line))
+	assert.False(t, algorithm.PointLocationIsOnLine(geom.NewCoordinateXY(5, 1), line))
+}
+
+func TestAreaOfRing(t *testing.T) {
+	cw := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	assert.Equal(t, 100.0, algorithm.AreaOfRingSigned(cw))
+	geom.ReverseCoordinates(cw)
+	assert.Equal(t, -100.0, algorithm.AreaOfRingSigned(cw))
+	assert.Equal(t, 100.0, algorithm.AreaOfRing(cw))
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Checks that the triangles are clockwise, lie inside the polygon
+ * and cover its area.
+ * A triangle lies inside the polygon if its centroid is interior
+ * and none of its edges cross the polygon boundary.
+ */
+func check_triangles_in_polygon(t *testing.T, tris [][]geom.Coordinate, poly [][]geom.Coordinate) {
+	li := algorithm.NewLineIntersector()
+	area := 0.0
+	for _, tr := range tris {
+		assert.False(t, algorithm.OrientationIsCCW(tr))
+		assert.Greater(t, algorithm.AreaOfRing(tr), 0.0)
+		area += algorithm.AreaOfRing(tr)
+		centroid := geom.NewCoordinateXY((tr[0].X+tr[1].X+tr[2].X)/3, (tr[0].Y+tr[1].Y+tr[2].Y)/3)
+		assert.Equal(t, 0, locate_in_polygon(centroid, poly))
+		for i := 0; i < 3; i++ {
+			for _, ring := range poly {
+				for j := 0; j < len(ring)-1; j++ {
+					li.ComputeIntersection(&tr[i], &tr[i+1], &ring[j], &ring[j+1])
+					assert.False(t, li.IsProper())
+				}
+			}
+		}
+	}
+	assert.InDelta(t, polygons_area([][][]geom.Coordinate{poly}), area, 1e-9)
+}
+
+/**
+ * Checks the triangles of a set of disjoint polygons,
+ * assigning each triangle to the polygon whose shell contains it.
+ */
+func check_triangles_in_polygons(t *testing.T, tris [][]geom.Coordinate, polys [][][]geom.Coordinate) {
+	count := 0
+	for _, poly := range polys {
+		polyTris := make([][]geom.Coordinate, 0)
+		for _, tr := range tris {
+			centroid := geom.NewCoordinateXY((tr[0].X+tr[1].X+tr[2].X)/3, (tr[0].Y+tr[1].Y+tr[2].Y)/3)
+			if locate_in_polygon(centroid, poly[:1]) == 0 {
+				polyTris = append(polyTris, tr)
+			}
+		}
+		check_triangles_in_polygon(t, polyTris, poly)
+		count += len(polyTris)
+	}
+	assert.Equal(t, len(tris), count)
+}
+
+func TestPolygonEarClipperSquare(t *testing.T) {
+	shell := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	tris, err := polygon.PolygonEarClipperTriangulate(shell)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tris))
+	assert.Nil(t, tri.TriValidate(tris))
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{shell})
+}
+
+func TestPolygonEarClipperConcave(t *testing.T) {
+	//-- a comb with three teeth
+	shell := coords(0, 0, 0, 10, 2, 10, 2, 4, 4, 4, 4, 10, 6, 10, 6, 4, 8, 4, 8, 10, 10, 10, 10, 0, 0, 0)
+	tris, err := polygon.PolygonEarClipperTriangulate(shell)
+	assert.Nil(t, err)
+	assert.Equal(t, len(shell)-3, len(tris))
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{shell})
+}
+
+func TestPolygonEarClipperFlatCorners(t *testing.T) {
+	shell := coords(0, 0, 0, 5, 0, 10, 5, 10, 10, 10, 10, 0, 0, 0)
+	tris, err := polygon.PolygonEarClipperTriangulate(shell)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(tris))
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{shell})
+
+	clipper := polygon.NewPolygonEarClipper(shell)
+	clipper.SetSkipFlatCorners(true)
+	tris, err = clipper.Compute()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tris))
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{shell})
+}
+
+func TestPolygonEarClipperRepeatedPoints(t *testing.T) {
+	shell := coords(0, 0, 0, 10, 0, 10, 10, 10, 10, 0, 10, 0, 0, 0)
+	tris, err := polygon.PolygonEarClipperTriangulate(shell)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tris))
+	check_triangles_in_polygon(t, tri.TriToRings(tris), [][]geom.Coordinate{coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)})
+}
+
+func TestPolygonEarClipperDegenerate(t *testing.T) {
+	tris, err := polygon.PolygonEarClipperTriangulate(coords(0, 0, 10, 10, 0, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(tris))
+}
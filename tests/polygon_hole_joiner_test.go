@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygon "github.com/UltimateThread/geos-go/core/triangulate/polygon"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Checks that the joined ring is closed and clockwise, contains every
+ * vertex of the polygon, and can be triangulated into the polygon.
+ * The given nodes where rings touch must be vertices of both rings.
+ */
+func check_joined_ring(t *testing.T, poly [][]geom.Coordinate, nodes ...float64) []geom.Coordinate {
+	ring, err := polygon.PolygonHoleJoinerJoin(poly)
+	if !assert.Nil(t, err) {
+		return nil
+	}
+	assert.True(t, ring[0].Equals2D(&ring[len(ring)-1]))
+	assert.False(t, algorithm.OrientationIsCCW(ring))
+	//-- the shoelace area of the joined ring excludes the holes
+	assert.InDelta(t, polygons_area([][][]geom.Coordinate{poly}), algorithm.AreaOfRing(ring), 1e-9)
+
+	for _, r := range poly {
+		for i := range r {
+			assert.Greater(t, ring_count(ring, &r[i]), 0)
+		}
+	}
+	for _, node := range coords(nodes...) {
+		assert.GreaterOrEqual(t, ring_count(ring, &node), 2)
+	}
+
+	tris, err := polygon.PolygonEarClipperTriangulate(ring)
+	assert.Nil(t, err)
+	check_triangles_in_polygon(t, tri.TriToRings(tris), poly)
+	return ring
+}
+
+func ring_count(ring []geom.Coordinate, p *geom.Coordinate) int {
+	count := 0
+	for i := 0; i < len(ring)-1; i++ {
+		if ring[i].Equals2D(p) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPolygonHoleJoinerNoHoles(t *testing.T) {
+	//-- the shell is oriented clockwise
+	ring, err := polygon.PolygonHoleJoinerJoin([][]geom.Coordinate{coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0)})
+	assert.Nil(t, err)
+	check_coords(t, ring, 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+}
+
+func TestPolygonHoleJoinerOneHole(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(3, 3, 3, 7, 7, 7, 7, 3, 3, 3),
+	}
+	ring := check_joined_ring(t, poly)
+	//-- the hole is joined by an out-and-back line
+	assert.Equal(t, len(poly[0])+len(poly[1])+1, len(ring))
+}
+
+func TestPolygonHoleJoinerMultipleHoles(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 20, 20, 20, 20, 0, 0, 0),
+		coords(12, 12, 12, 16, 16, 16, 16, 12, 12, 12),
+		coords(2, 2, 2, 6, 6, 6, 6, 2, 2, 2),
+		coords(12, 2, 14, 6, 16, 2, 12, 2),
+	}
+	check_joined_ring(t, poly)
+}
+
+func TestPolygonHoleJoinerHoleTouchingShellVertex(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(0, 0, 3, 6, 6, 3, 0, 0),
+	}
+	check_joined_ring(t, poly)
+}
+
+func TestPolygonHoleJoinerHoleTouchingShellEdge(t *testing.T) {
+	//-- the hole vertex on the shell edge is added to the shell as a node
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(0, 5, 3, 7, 3, 3, 0, 5),
+	}
+	check_joined_ring(t, poly, 0, 5)
+}
+
+func TestPolygonHoleJoinerHolesTouching(t *testing.T) {
+	//-- the second hole touches the top edge of the first
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0),
+		coords(2, 2, 2, 4, 6, 4, 6, 2, 2, 2),
+		coords(4, 4, 3, 6, 5, 6, 4, 4),
+	}
+	check_joined_ring(t, poly, 4, 4)
+}
+
+func TestPolygonHoleJoinerCompute(t *testing.T) {
+	poly := [][]geom.Coordinate{
+		coords(0, 0, 10, 0, 10, 10, 0, 10, 0, 0),
+		coords(3, 3, 7, 3, 7, 7, 3, 7, 3, 3),
+	}
+	ring, err := polygon.NewPolygonHoleJoiner(poly).Compute()
+	assert.Nil(t, err)
+	assert.InDelta(t, 84, algorithm.AreaOfRing(ring), 1e-9)
+	assert.False(t, algorithm.OrientationIsCCW(ring))
+}
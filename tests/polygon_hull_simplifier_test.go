@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+	simplify "github.com/UltimateThread/geos-go/core/simplify"
+)
+
+// a C-shaped polygon opening to the right
+var polygonHullCShape = coords(0, 0, 0, 10, 10, 10, 10, 8, 2, 8, 2, 2, 10, 2, 10, 0, 0, 0)
+
+/**
+ * Locates a point in a polygon: 0 = interior, 1 = boundary, 2 = exterior.
+ */
+func locate_in_polygon(pt *geom.Coordinate, poly [][]geom.Coordinate) int {
+	loc := algorithm.PointLocationLocateInRing(pt, poly[0])
+	if loc != 0 {
+		return loc
+	}
+	for _, hole := range poly[1:] {
+		holeLoc := algorithm.PointLocationLocateInRing(pt, hole)
+		if holeLoc == 0 {
+			return 2
+		}
+		if holeLoc == 1 {
+			return 1
+		}
+	}
+	return 0
+}
+
+/**
+ * Checks that a hull is valid, has the same structure as the input,
+ * and contains (or is contained by) the input.
+ */
+func check_polygon_hull(t *testing.T, input [][][]geom.Coordinate, hull [][][]geom.Coordinate, isOuter bool) {
+	assert.True(t, valid.NewIsValidOpMultiPolygon(hull).IsValid())
+	assert.Equal(t, len(input), len(hull))
+	for i := range input {
+		assert.Equal(t, len(input[i]), len(hull[i]))
+		inner, outer := hull[i], input[i]
+		if isOuter {
+			inner, outer = input[i], hull[i]
+		}
+		for _, ring := range inner {
+			for j := range ring {
+				assert.NotEqual(t, 2, locate_in_polygon(&ring[j], outer))
+			}
+		}
+	}
+	if isOuter {
+		assert.GreaterOrEqual(t, polygons_area(hull), polygons_area(input))
+	} else {
+		assert.LessOrEqual(t, polygons_area(hull), polygons_area(input))
+	}
+}
+
+func TestPolygonHullOuterConvex(t *testing.T) {
+	input := [][][]geom.Coordinate{{polygonHullCShape}}
+	hull := simplify.PolygonHullSimplifierHull(input, true, 0)
+	check_coords(t, hull[0][0], 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	check_polygon_hull(t, input, hull, true)
+}
+
+func TestPolygonHullInner(t *testing.T) {
+	input := [][][]geom.Coordinate{{polygonHullCShape}}
+	hull := simplify.PolygonHullSimplifierHull(input, false, 0)
+	check_coords(t, hull[0][0], 2, 8, 0, 10, 10, 10, 2, 8)
+
+	for _, fraction := range []float64{0.3, 0.6} {
+		hull := simplify.PolygonHullSimplifierHull(input, false, fraction)
+		check_polygon_hull(t, input, hull, false)
+		assert.LessOrEqual(t, len(hull[0][0]), len(polygonHullCShape))
+	}
+}
+
+func TestPolygonHullVertexNumFraction(t *testing.T) {
+	input := [][][]geom.Coordinate{{polygonHullCShape}}
+	hull := simplify.PolygonHullSimplifierHull(input, true, 0.75)
+	//-- 8 vertices, so 6 are retained
+	assert.Equal(t, 7, len(hull[0][0]))
+	check_polygon_hull(t, input, hull, true)
+
+	hull = simplify.PolygonHullSimplifierHull(input, true, 1)
+	check_coords(t, hull[0][0], 0, 0, 0, 10, 10, 10, 10, 8, 2, 8, 2, 2, 10, 2, 10, 0, 0, 0)
+}
+
+func TestPolygonHullByAreaDelta(t *testing.T) {
+	// a square with a narrow notch and a wide bay
+	input := [][][]geom.Coordinate{{
+		coords(0, 0, 0, 20, 9, 20, 10, 12, 11, 20, 20, 20, 20, 0, 15, 0, 10, 8, 5, 0, 0, 0),
+	}}
+	area := polygons_area(input)
+	hull := simplify.PolygonHullSimplifierHullByAreaDelta(input, true, 0.05)
+	check_coords(t, hull[0][0], 0, 0, 0, 20, 20, 20, 20, 0, 15, 0, 10, 8, 5, 0, 0, 0)
+	assert.LessOrEqual(t, polygons_area(hull)-area, 0.05*area)
+	check_polygon_hull(t, input, hull, true)
+
+	hull = simplify.PolygonHullSimplifierHullByAreaDelta(input, true, 0)
+	check_coords(t, hull[0][0], 0, 0, 0, 20, 9, 20, 10, 12, 11, 20, 20, 20, 20, 0, 15, 0, 10, 8, 5, 0, 0, 0)
+}
+
+func TestPolygonHullWithHole(t *testing.T) {
+	input := [][][]geom.Coordinate{{
+		coords(0, 0, 0, 20, 20, 20, 20, 0, 0, 0),
+		coords(5, 5, 15, 5, 15, 15, 10, 8, 5, 15, 5, 5),
+	}}
+	//-- the outer hull shrinks the hole
+	hull := simplify.PolygonHullSimplifierHull(input, true, 0)
+	check_coords(t, hull[0][1], 5, 5, 10, 8, 5, 15, 5, 5)
+	check_polygon_hull(t, input, hull, true)
+
+	//-- the inner hull expands the hole
+	hull = simplify.PolygonHullSimplifierHull(input, false, 0)
+	check_coords(t, hull[0][1], 5, 5, 15, 5, 15, 15, 5, 15, 5, 5)
+	check_polygon_hull(t, input, hull, false)
+}
+
+func TestPolygonHullMultiPolygonNoOverlap(t *testing.T) {
+	// a bar lying in the opening of the C-shape blocks its concave corners
+	input := [][][]geom.Coordinate{
+		{polygonHullCShape},
+		{coords(4, 4, 4, 6, 12, 6, 12, 4, 4, 4)},
+	}
+	hull := simplify.PolygonHullSimplifierHull(input, true, 0)
+	check_coords(t, hull[0][0], 0, 0, 0, 10, 10, 10, 10, 8, 2, 8, 2, 2, 10, 2, 10, 0, 0, 0)
+	check_coords(t, hull[1][0], 4, 4, 4, 6, 12, 6, 12, 4, 4, 4)
+	check_polygon_hull(t, input, hull, true)
+}
+
+func TestPolygonHullOrientation(t *testing.T) {
+	// a counter-clockwise shell produces a clockwise result
+	input := [][][]geom.Coordinate{{
+		coords(0, 0, 10, 0, 10, 2, 2, 2, 2, 8, 10, 8, 10, 10, 0, 10, 0, 0),
+	}}
+	hull := simplify.PolygonHullSimplifierHull(input, true, 0)
+	check_coords(t, hull[0][0], 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+}
+
+func TestPolygonHullEmpty(t *testing.T) {
+	hull := simplify.PolygonHullSimplifierHull([][][]geom.Coordinate{{}}, true, 0.5)
+	assert.Equal(t, 1, len(hull))
+	assert.Equal(t, 0, len(hull[0]))
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	polygonize "github.com/UltimateThread/geos-go/core/operation/polygonize"
+	valid "github.com/UltimateThread/geos-go/core/operation/valid"
+)
+
+func polygonize_lines(extractOnlyPolygonal bool, lines ...[]geom.Coordinate) *polygonize.Polygonizer {
+	polygonizer := polygonize.NewPolygonizerWithOnlyPolygonal(extractOnlyPolygonal)
+	polygonizer.Add(lines)
+	return polygonizer
+}
+
+/**
+ * Checks that the polygons have shells oriented CW and holes CCW.
+ */
+func check_polygonizer_orientation(t *testing.T, polys [][][]geom.Coordinate) {
+	for _, poly := range polys {
+		assert.False(t, algorithm.OrientationIsCCW(poly[0]))
+		for _, hole := range poly[1:] {
+			assert.True(t, algorithm.OrientationIsCCW(hole))
+		}
+	}
+}
+
+func TestPolygonizerShellWithHole(t *testing.T) {
+	polygonizer := polygonize_lines(false,
+		coords(100, 180, 20, 20, 160, 20, 100, 180),
+		coords(100, 180, 80, 60, 120, 60, 100, 180))
+	polys := polygonizer.GetPolygons()
+	assert.Equal(t, 2, len(polys))
+	check_polygonizer_orientation(t, polys)
+
+	holeCount := 0
+	for _, poly := range polys {
+		holeCount += len(poly) - 1
+	}
+	assert.Equal(t, 1, holeCount)
+	assert.InDelta(t, 0.5*140*160, polygons_area(polys), 1e-9)
+	assert.Equal(t, 0, len(polygonizer.GetDangles()))
+	assert.Equal(t, 0, len(polygonizer.GetCutEdges()))
+	assert.Equal(t, 0, len(polygonizer.GetInvalidRingLines()))
+}
+
+func TestPolygonizerNodedGrid(t *testing.T) {
+	polygonizer := polygonize_lines(false,
+		coords(0, 0, 0, 10),
+		coords(0, 10, 10, 10),
+		coords(10, 10, 10, 0),
+		coords(10, 0, 0, 0),
+		coords(0, 10, 0, 20, 10, 20, 10, 10))
+	polys := polygonizer.GetPolygons()
+	assert.Equal(t, 2, len(polys))
+	check_polygonizer_orientation(t, polys)
+	assert.InDelta(t, 200, polygons_area(polys), 1e-9)
+	for _, poly := range polys {
+		assert.True(t, valid.NewIsValidOpPolygon(poly).IsValid())
+	}
+}
+
+func TestPolygonizerDanglesAndCutEdges(t *testing.T) {
+	polygonizer := polygonize_lines(false,
+		coords(10, 5, 10, 10, 0, 10, 0, 0, 10, 0, 10, 5),
+		coords(20, 5, 20, 0, 30, 0, 30, 10, 20, 10, 20, 5),
+		//-- cut edge joining the squares
+		coords(10, 5, 20, 5),
+		//-- a chain of dangles inside the first square
+		coords(10, 5, 5, 5),
+		coords(5, 5, 5, 2),
+		//-- a dangle inside the second square
+		coords(20, 5, 25, 5))
+	polys := polygonizer.GetPolygons()
+	assert.Equal(t, 2, len(polys))
+	assert.InDelta(t, 200, polygons_area(polys), 1e-9)
+
+	dangles := polygonizer.GetDangles()
+	assert.Equal(t, 3, len(dangles))
+	cutEdges := polygonizer.GetCutEdges()
+	assert.Equal(t, 1, len(cutEdges))
+	check_coords(t, cutEdges[0], 10, 5, 20, 5)
+}
+
+func TestPolygonizerInvalidRing(t *testing.T) {
+	polygonizer := polygonize_lines(false,
+		coords(0, 0, 10, 10, 10, 0, 0, 10, 0, 0))
+	assert.Equal(t, 0, len(polygonizer.GetPolygons()))
+	invalidRings := polygonizer.GetInvalidRingLines()
+	assert.Equal(t, 2, len(invalidRings))
+	for _, ring := range invalidRings {
+		assert.True(t, geom.IsRing(ring))
+	}
+}
+
+func TestPolygonizerNoCheckRingsValid(t *testing.T) {
+	polygonizer := polygonize_lines(false,
+		coords(0, 0, 10, 10, 10, 0, 0, 10, 0, 0))
+	polygonizer.SetCheckRingsValid(false)
+	assert.Equal(t, 0, len(polygonizer.GetInvalidRingLines()))
+}
+
+func TestPolygonizerOnlyPolygonalNested(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(100, 100, 100, 300, 300, 300, 300, 100, 100, 100),
+		coords(150, 150, 150, 250, 250, 250, 250, 150, 150, 150),
+	}
+	assert.Equal(t, 2, len(polygonize_lines(false, lines...).GetPolygons()))
+
+	polys := polygonize_lines(true, lines...).GetPolygons()
+	assert.Equal(t, 1, len(polys))
+	assert.Equal(t, 2, len(polys[0]))
+	check_polygonizer_orientation(t, polys)
+	assert.InDelta(t, 200*200-100*100, polygons_area(polys), 1e-9)
+}
+
+func TestPolygonizerOnlyPolygonalDoublyNested(t *testing.T) {
+	polys := polygonize_lines(true,
+		coords(0, 0, 0, 100, 100, 100, 100, 0, 0, 0),
+		coords(10, 10, 10, 90, 90, 90, 90, 10, 10, 10),
+		coords(20, 20, 20, 80, 80, 80, 80, 20, 20, 20)).GetPolygons()
+	assert.Equal(t, 2, len(polys))
+	assert.True(t, valid.NewIsValidOpMultiPolygon(polys).IsValid())
+	assert.InDelta(t, 100*100-80*80+60*60, polygons_area(polys), 1e-9)
+}
+
+func TestPolygonizerEmpty(t *testing.T) {
+	polygonizer := polygonize.NewPolygonizer()
+	assert.Equal(t, 0, len(polygonizer.GetPolygons()))
+	assert.Equal(t, 0, len(polygonizer.GetDangles()))
+	assert.Equal(t, 0, len(polygonizer.GetCutEdges()))
+	assert.Equal(t, 0, len(polygonizer.GetInvalidRingLines()))
+}
@@ -0,0 +1,260 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	triangulate "github.com/UltimateThread/geos-go/core/triangulate"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+/**
+ * Builds the Delaunay subdivision of a set of points
+ * using the incremental triangulator directly.
+ */
+func incremental_delaunay(t *testing.T, pts []geom.Coordinate, isForceConvex bool) *quadedge.QuadEdgeSubdivision {
+	env := geom.NewEnvelopeFromCoordinateArray(pts)
+	vertices := make([]*quadedge.Vertex, 0, len(pts))
+	for i := range pts {
+		vertices = append(vertices, quadedge.NewVertexFromCoordinate(&pts[i]))
+	}
+	subdiv := quadedge.NewQuadEdgeSubdivision(env, 0)
+	triangulator := triangulate.NewIncrementalDelaunayTriangulator(subdiv)
+	triangulator.ForceConvex(isForceConvex)
+	assert.Nil(t, triangulator.InsertSites(vertices))
+	return subdiv
+}
+
+func triangles_area(tris [][]geom.Coordinate) float64 {
+	area := 0.0
+	for _, tri := range tris {
+		area += algorithm.AreaOfRing(tri)
+	}
+	return area
+}
+
+func TestQuadEdgeMakeEdge(t *testing.T) {
+	o := quadedge.NewVertex(0, 0)
+	d := quadedge.NewVertex(10, 0)
+	e := quadedge.QuadEdgeMakeEdge(o, d)
+
+	assert.Equal(t, o, e.Orig())
+	assert.Equal(t, d, e.Dest())
+	assert.Equal(t, d, e.Sym().Orig())
+	assert.Equal(t, o, e.Sym().Dest())
+	assert.Equal(t, e, e.Sym().Sym())
+	assert.Equal(t, e.Sym(), e.Rot().Rot())
+	assert.Equal(t, e, e.Rot().Rot().Rot().Rot())
+	assert.Equal(t, e, e.Rot().InvRot())
+	//-- an isolated edge is the only edge around its origin and its left face
+	assert.Equal(t, e, e.ONext())
+	assert.Equal(t, e, e.OPrev())
+	assert.Equal(t, e.Sym(), e.LNext())
+	assert.Equal(t, 10.0, e.GetLength())
+	assert.True(t, e.IsLive())
+	assert.True(t, e.EqualsNonOriented(e.Sym()))
+	assert.False(t, e.EqualsOriented(e.Sym()))
+	assert.Equal(t, e, e.GetPrimary())
+	assert.Equal(t, e, e.Sym().GetPrimary())
+
+	e.SetData("data")
+	assert.Equal(t, "data", e.GetData())
+	e.Delete()
+	assert.False(t, e.IsLive())
+}
+
+func TestQuadEdgeConnectTriangle(t *testing.T) {
+	a := quadedge.NewVertex(0, 0)
+	b := quadedge.NewVertex(10, 0)
+	c := quadedge.NewVertex(0, 10)
+	ea := quadedge.QuadEdgeMakeEdge(a, b)
+	eb := quadedge.QuadEdgeMakeEdge(b, c)
+	quadedge.QuadEdgeSplice(ea.Sym(), eb)
+	ec := quadedge.QuadEdgeConnect(eb, ea)
+
+	assert.Equal(t, c, ec.Orig())
+	assert.Equal(t, a, ec.Dest())
+	//-- the three edges share the triangle as their left face
+	assert.Equal(t, eb, ea.LNext())
+	assert.Equal(t, ec, eb.LNext())
+	assert.Equal(t, ea, ec.LNext())
+	assert.Equal(t, ec, ea.LPrev())
+	//-- the edges around each vertex are linked
+	assert.Equal(t, ec.Sym(), ea.ONext())
+	assert.Equal(t, ea, ec.Sym().ONext())
+
+	//-- splicing the same edges again breaks the connection
+	quadedge.QuadEdgeSplice(ea.Sym(), eb)
+	assert.Equal(t, eb, eb.ONext())
+}
+
+func TestQuadEdgeSwap(t *testing.T) {
+	pts := coords(0, 0, 10, 0, 12, 8, 0, 6)
+	subdiv := incremental_delaunay(t, pts, true)
+
+	var diagonal *quadedge.QuadEdge
+	for _, e := range subdiv.GetPrimaryEdges(false) {
+		if !subdiv.IsFrameBorderEdge(e) {
+			diagonal = e
+		}
+	}
+	assert.NotNil(t, diagonal)
+	ends := []*geom.Coordinate{diagonal.Orig().GetCoordinate(), diagonal.Dest().GetCoordinate()}
+	left := diagonal.LNext().Dest()
+	right := diagonal.Sym().LNext().Dest()
+
+	quadedge.QuadEdgeSwap(diagonal)
+	//-- the edge now joins the vertices which were opposite it
+	assert.True(t, diagonal.Orig() == right || diagonal.Orig() == left)
+	assert.True(t, diagonal.Dest() == right || diagonal.Dest() == left)
+	assert.NotEqual(t, diagonal.Orig(), diagonal.Dest())
+	assert.True(t, diagonal.LNext().Dest().GetCoordinate().Equals2D(ends[0]) ||
+		diagonal.LNext().Dest().GetCoordinate().Equals2D(ends[1]))
+	assert.Equal(t, 2, len(subdiv.GetTriangleCoordinates(false)))
+	assert.InDelta(t, algorithm.AreaOfRing(append(pts, pts[0])), triangles_area(subdiv.GetTriangleCoordinates(false)), 1e-9)
+}
+
+func TestVertexPredicates(t *testing.T) {
+	a := quadedge.NewVertex(0, 0)
+	b := quadedge.NewVertex(10, 0)
+	c := quadedge.NewVertex(0, 10)
+	assert.True(t, a.IsCCW(b, c))
+	assert.False(t, a.IsCCW(c, b))
+	assert.False(t, a.IsCCW(b, quadedge.NewVertex(20, 0)))
+
+	e := quadedge.QuadEdgeMakeEdge(a, b)
+	assert.True(t, c.LeftOf(e))
+	assert.False(t, c.RightOf(e))
+	assert.True(t, quadedge.NewVertex(5, -1).RightOf(e))
+
+	assert.True(t, quadedge.NewVertex(5, 5).IsInCircle(a, b, c))
+	assert.False(t, quadedge.NewVertex(10, 10).IsInCircle(a, b, c))
+	assert.False(t, quadedge.NewVertex(20, 20).IsInCircle(a, b, c))
+
+	assert.True(t, a.Equals(quadedge.NewVertexFromCoordinate(geom.NewCoordinateXYZ(0, 0, 5))))
+	assert.False(t, a.Equals(quadedge.NewVertex(0, 0.001)))
+	assert.True(t, a.EqualsTolerance(quadedge.NewVertex(0, 0.001), 0.01))
+}
+
+func TestTrianglePredicateIsInCircle(t *testing.T) {
+	a := geom.NewCoordinateXY(0, 0)
+	b := geom.NewCoordinateXY(10, 0)
+	c := geom.NewCoordinateXY(10, 10)
+	assert.True(t, quadedge.TrianglePredicateIsInCircleRobust(a, b, c, geom.NewCoordinateXY(5, 5)))
+	assert.True(t, quadedge.TrianglePredicateIsInCircleRobust(a, b, c, geom.NewCoordinateXY(1, 9)))
+	//-- points on the circle are not inside it
+	assert.False(t, quadedge.TrianglePredicateIsInCircleRobust(a, b, c, geom.NewCoordinateXY(0, 10)))
+	assert.False(t, quadedge.TrianglePredicateIsInCircleRobust(a, b, c, geom.NewCoordinateXY(-1, 11)))
+	//-- a clockwise triangle reverses the result
+	assert.False(t, quadedge.TrianglePredicateIsInCircleRobust(a, c, b, geom.NewCoordinateXY(5, 5)))
+}
+
+func TestQuadEdgeSubdivisionFrame(t *testing.T) {
+	env := geom.NewEnvelope(0, 10, 0, 10)
+	subdiv := quadedge.NewQuadEdgeSubdivision(env, 0)
+	assert.Equal(t, 3, len(subdiv.GetVertices(true)))
+	assert.Equal(t, 0, len(subdiv.GetVertices(false)))
+	//-- the frame triangle and the outer face
+	assert.Equal(t, 2, len(subdiv.GetTriangleCoordinates(true)))
+	assert.Equal(t, 0, len(subdiv.GetTriangleCoordinates(false)))
+	assert.True(t, subdiv.GetEnvelope().ContainsEnvelope(env))
+	for _, v := range subdiv.GetVertices(true) {
+		assert.True(t, subdiv.IsFrameVertex(v))
+	}
+}
+
+func TestQuadEdgeSubdivisionInsertSite(t *testing.T) {
+	subdiv := quadedge.NewQuadEdgeSubdivision(geom.NewEnvelope(0, 10, 0, 10), 0.1)
+	v := quadedge.NewVertex(5, 5)
+	e, err := subdiv.InsertSite(v)
+	assert.Nil(t, err)
+	assert.Equal(t, v, e.Dest())
+	assert.False(t, subdiv.IsFrameVertex(v))
+	assert.True(t, subdiv.IsFrameEdge(e))
+	//-- the site splits the frame triangle
+	assert.Equal(t, 4, len(subdiv.GetVertices(true)))
+	assert.Equal(t, 4, len(subdiv.GetTriangleCoordinates(true)))
+	assert.Equal(t, 6, len(subdiv.GetPrimaryEdges(true)))
+	assert.Equal(t, 0, len(subdiv.GetPrimaryEdges(false)))
+
+	//-- a site within the tolerance is not inserted
+	e, err = subdiv.InsertSite(quadedge.NewVertex(5.05, 5))
+	assert.Nil(t, err)
+	assert.True(t, e.Orig() == v || e.Dest() == v)
+	assert.Equal(t, 4, len(subdiv.GetVertices(true)))
+	assert.True(t, subdiv.IsVertexOfEdge(e, quadedge.NewVertex(5.05, 5)))
+	assert.True(t, subdiv.IsOnEdge(e, e.Orig().GetCoordinate()))
+	assert.False(t, subdiv.IsOnEdge(e, geom.NewCoordinateXY(5.05, 5)))
+}
+
+func TestQuadEdgeSubdivisionLocate(t *testing.T) {
+	subdiv := incremental_delaunay(t, coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5), true)
+	r := rand.New(rand.NewSource(17))
+	for i := 0; i < 100; i++ {
+		p := geom.NewCoordinateXY(r.Float64()*10, r.Float64()*10)
+		e, err := subdiv.LocateCoordinate(p)
+		assert.Nil(t, err)
+		//-- the point is in the triangle to the left of the located edge
+		tri := []geom.Coordinate{*e.Orig().GetCoordinate(), *e.Dest().GetCoordinate(), *e.LNext().Dest().GetCoordinate()}
+		for j := 0; j < 3; j++ {
+			assert.NotEqual(t, -1, algorithm.OrientationIndex(&tri[j], &tri[(j+1)%3], p))
+		}
+	}
+	e, err := subdiv.LocateCoordinate(geom.NewCoordinateXY(5, 5))
+	assert.Nil(t, err)
+	centre := quadedge.NewVertex(5, 5)
+	assert.True(t, e.Orig().Equals(centre) || e.Dest().Equals(centre))
+}
+
+func TestQuadEdgeSubdivisionVoronoiCells(t *testing.T) {
+	subdiv := incremental_delaunay(t, coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5), true)
+	cells := subdiv.GetVoronoiCellPolygons()
+	assert.Equal(t, 5, len(cells))
+	edges := subdiv.GetVertexUniqueEdges(false)
+	assert.Equal(t, 5, len(edges))
+}
+
+func TestIncrementalDelaunayEmptyCircumcircles(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	pts := make([]geom.Coordinate, 0, 100)
+	for i := 0; i < 100; i++ {
+		pts = append(pts, *geom.NewCoordinateXY(math.Round(r.Float64()*100), math.Round(r.Float64()*100)))
+	}
+	subdiv := incremental_delaunay(t, pts, true)
+	vertices := subdiv.GetVertices(false)
+	for _, tv := range subdiv.GetTriangleVertices(false) {
+		assert.True(t, tv[0].IsCCW(tv[1], tv[2]))
+		for _, v := range vertices {
+			if v == tv[0] || v == tv[1] || v == tv[2] {
+				continue
+			}
+			assert.False(t, v.IsInCircle(tv[0], tv[1], tv[2]))
+		}
+	}
+	hull := algorithm.ConvexHullOfPoints(pts)
+	assert.InDelta(t, algorithm.AreaOfRing(hull), triangles_area(subdiv.GetTriangleCoordinates(false)), 1e-6)
+}
+
+func TestIncrementalDelaunayForceConvex(t *testing.T) {
+	//-- the frame is close enough to the sites to make the boundary concave
+	pts := coords(3, 0, 40, 1, 60, 1)
+	assert.Equal(t, 1, len(incremental_delaunay(t, pts, true).GetTriangleCoordinates(false)))
+	assert.Equal(t, 0, len(incremental_delaunay(t, pts, false).GetTriangleCoordinates(false)))
+}
+
+func TestIncrementalDelaunaySiteOnEdge(t *testing.T) {
+	//-- the centre site lies on the diagonal of the square
+	pts := coords(0, 0, 10, 0, 10, 10, 0, 10, 5, 5, 2, 2)
+	subdiv := incremental_delaunay(t, pts, true)
+	assert.Equal(t, 6, len(subdiv.GetVertices(false)))
+	tris := subdiv.GetTriangleCoordinates(false)
+	for _, tri := range tris {
+		assert.Greater(t, algorithm.AreaOfRing(tri), 0.0)
+	}
+	assert.InDelta(t, 100, triangles_area(tris), 1e-9)
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	quadtree "github.com/UltimateThread/geos-go/core/index/quadtree"
+)
+
+func quadtree_query_exact(tree *quadtree.Quadtree[int], envs []*geom.Envelope, searchEnv *geom.Envelope) []int {
+	result := make([]int, 0)
+	for _, item := range tree.Query(searchEnv) {
+		if envs[item].IntersectsEnvelope(searchEnv) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func TestQuadtreeEmpty(t *testing.T) {
+	tree := quadtree.NewQuadtree[int]()
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Size())
+	assert.Empty(t, tree.Query(geom.NewEnvelope(0, 10, 0, 10)))
+}
+
+func TestQuadtreeQueryEnvelopes(t *testing.T) {
+	tree := quadtree.NewQuadtree[int]()
+	envs := make([]*geom.Envelope, 0)
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			env := geom.NewEnvelope(float64(i)*10-100, float64(i)*10-95, float64(j)*10-100, float64(j)*10-95)
+			tree.Insert(env, len(envs))
+			envs = append(envs, env)
+		}
+	}
+	assert.Equal(t, 400, tree.Size())
+	assert.Equal(t, 400, len(tree.QueryAll()))
+
+	searchEnv := geom.NewEnvelope(-12, 7, 3, 14)
+	result := quadtree_query_exact(tree, envs, searchEnv)
+	assert.ElementsMatch(t, []int{190, 191, 210, 211}, result)
+	for i, env := range envs {
+		if env.IntersectsEnvelope(searchEnv) {
+			assert.Contains(t, result, i)
+		}
+	}
+}
+
+func TestQuadtreePoints(t *testing.T) {
+	tree := quadtree.NewQuadtree[int]()
+	pts := []geom.Coordinate{*geom.NewCoordinateXY(5, 5), *geom.NewCoordinateXY(5, 5), *geom.NewCoordinateXY(1000, 2000), *geom.NewCoordinateXY(5.000001, 5)}
+	envs := make([]*geom.Envelope, len(pts))
+	for i := range pts {
+		envs[i] = geom.NewEnvelopeFromCoordinate(&pts[i])
+		tree.Insert(envs[i], i)
+	}
+	assert.Equal(t, 4, tree.Size())
+	assert.ElementsMatch(t, []int{0, 1}, quadtree_query_exact(tree, envs, geom.NewEnvelope(4, 5, 4, 5)))
+	assert.ElementsMatch(t, []int{0, 1, 3}, quadtree_query_exact(tree, envs, geom.NewEnvelope(4, 6, 4, 6)))
+	assert.ElementsMatch(t, []int{2}, quadtree_query_exact(tree, envs, envs[2]))
+}
+
+func TestQuadtreeRemove(t *testing.T) {
+	tree := quadtree.NewQuadtree[int]()
+	envs := make([]*geom.Envelope, 0)
+	for i := 0; i < 50; i++ {
+		pt := geom.NewCoordinateXY(float64(i), float64(i%7))
+		env := geom.NewEnvelopeFromCoordinate(pt)
+		tree.Insert(env, i)
+		envs = append(envs, env)
+	}
+	for i := 0; i < 50; i += 2 {
+		assert.True(t, tree.Remove(envs[i], i))
+	}
+	assert.False(t, tree.Remove(envs[0], 0))
+	assert.Equal(t, 25, tree.Size())
+	for _, item := range tree.QueryAll() {
+		assert.Equal(t, 1, item%2)
+	}
+
+	for i := 1; i < 50; i += 2 {
+		assert.True(t, tree.Remove(envs[i], i))
+	}
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 1, tree.NodeCount())
+}
+
+func TestQuadtreeQueryVisitorStops(t *testing.T) {
+	tree := quadtree.NewQuadtree[int]()
+	for i := 0; i < 10; i++ {
+		tree.Insert(geom.NewEnvelope(float64(i), float64(i)+1, 0, 1), i)
+	}
+	count := 0
+	tree.QueryVisitor(geom.NewEnvelope(0, 10, 0, 1), func(item int) bool {
+		count++
+		return count < 3
+	})
+	assert.Equal(t, 3, count)
+}
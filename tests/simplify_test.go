@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	simplify "github.com/UltimateThread/geos-go/core/simplify"
+)
+
+func TestDouglasPeuckerLine(t *testing.T) {
+	line := coords(0, 5, 1, 5, 2, 5, 5, 5)
+	check_coords(t, simplify.DouglasPeuckerSimplifyLine(line, 10), 0, 5, 5, 5)
+
+	line = coords(0, 0, 5, 1, 10, 0, 15, 8, 20, 0)
+	check_coords(t, simplify.DouglasPeuckerSimplifyLine(line, 2), 0, 0, 10, 0, 15, 8, 20, 0)
+}
+
+func TestDouglasPeuckerFlattishPolygon(t *testing.T) {
+	polygon := [][]geom.Coordinate{
+		coords(20, 220, 40, 220, 60, 220, 80, 220, 100, 220, 120, 220, 140, 220, 140, 180, 100, 180, 60, 180, 20, 180, 20, 220),
+	}
+	result := simplify.DouglasPeuckerSimplifyPolygon(polygon, 10)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 20, 220, 140, 220, 140, 180, 20, 180, 20, 220)
+}
+
+func TestDouglasPeuckerRingEndpoint(t *testing.T) {
+	polygon := [][]geom.Coordinate{
+		coords(5, 0, 0, 0, 0, 10, 10, 10, 10, 0, 5, 0),
+	}
+	result := simplify.DouglasPeuckerSimplifyPolygon(polygon, 1)
+	check_coords(t, result[0], 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+}
+
+func TestDouglasPeuckerCollapsedHoleRemoved(t *testing.T) {
+	polygon := [][]geom.Coordinate{
+		coords(0, 0, 0, 100, 100, 100, 100, 0, 0, 0),
+		coords(50, 50, 51, 50, 51, 51, 50, 51, 50, 50),
+	}
+	result := simplify.DouglasPeuckerSimplifyPolygon(polygon, 5)
+	assert.Equal(t, 1, len(result))
+
+	// a shell which collapses gives an empty result
+	assert.Nil(t, simplify.DouglasPeuckerSimplifyPolygon(polygon[1:], 5))
+}
+
+func TestDouglasPeuckerCoordinateList(t *testing.T) {
+	coordList := geom.NewCoordinateList(coords(0, 0, 1, 0.1, 2, 0, 3, 0.1, 4, 0))
+	result := simplify.DouglasPeuckerSimplifyCoordinateList(coordList, 0.5)
+	check_coords(t, result.ToCoordinateArray(), 0, 0, 4, 0)
+	assert.Equal(t, 5, len(coordList.Coordinates))
+}
+
+func TestTopologyPreservingLineNotCrossed(t *testing.T) {
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 5, 5, 10, 0),
+		coords(4, -1, 4, 2),
+	}
+	result := simplify.TopologyPreservingSimplifyLines(lines, 10)
+	check_coords(t, result[0], 0, 0, 5, 5, 10, 0)
+	check_coords(t, result[1], 4, -1, 4, 2)
+
+	// without the other line the section is flattened
+	result = simplify.TopologyPreservingSimplifyLines(lines[:1], 10)
+	check_coords(t, result[0], 0, 0, 10, 0)
+}
+
+func TestTopologyPreservingNoJump(t *testing.T) {
+	// the small line lies inside the bend, and must not be jumped
+	lines := [][]geom.Coordinate{
+		coords(0, 0, 5, 5, 10, 0),
+		coords(5, 1, 5, 2),
+	}
+	result := simplify.TopologyPreservingSimplifyLines(lines, 10)
+	check_coords(t, result[0], 0, 0, 5, 5, 10, 0)
+}
+
+func TestTopologyPreservingHoleKeptInside(t *testing.T) {
+	polygons := [][][]geom.Coordinate{{
+		coords(0, 0, 0, 10, 5, 12, 10, 10, 10, 0, 0, 0),
+		coords(4.5, 10.5, 5.5, 10.5, 5.5, 11, 4.5, 11, 4.5, 10.5),
+	}}
+	result := simplify.TopologyPreservingSimplifyPolygons(polygons, 2.5)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 2, len(result[0]))
+	shell := result[0][0]
+	hole := result[0][1]
+	for i := range hole {
+		assert.True(t, algorithm.PointLocationIsInRing(&hole[i], shell))
+	}
+
+	// without the hole the bump is flattened
+	result = simplify.TopologyPreservingSimplifyPolygons([][][]geom.Coordinate{polygons[0][:1]}, 2.5)
+	for _, pt := range result[0][0] {
+		assert.True(t, pt.Y <= 10)
+	}
+}
+
+func TestTopologyPreservingRingMinimumSize(t *testing.T) {
+	ring := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	result := simplify.TopologyPreservingSimplifyLines([][]geom.Coordinate{ring}, 100)
+	assert.True(t, len(result[0]) >= 4)
+	assert.True(t, geom.IsRing(result[0]))
+}
+
+func check_coords(t *testing.T, pts []geom.Coordinate, ords ...float64) {
+	assert.Equal(t, len(ords)/2, len(pts))
+	if len(ords)/2 != len(pts) {
+		return
+	}
+	for i := range pts {
+		assert.True(t, pts[i].Equals2D(geom.NewCoordinateXY(ords[2*i], ords[2*i+1])), "point %d: %v", i, pts[i].ToString())
+	}
+}
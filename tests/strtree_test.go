@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	strtree "github.com/UltimateThread/geos-go/core/index/strtree"
+)
+
+func strtree_grid(n int) (*strtree.STRtree[int], []geom.Coordinate) {
+	tree := strtree.NewSTRtreeWithCapacity[int](4)
+	pts := make([]geom.Coordinate, 0, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			pt := geom.NewCoordinateXY(float64(i), float64(j))
+			tree.Insert(geom.NewEnvelopeFromCoordinate(pt), len(pts))
+			pts = append(pts, *pt)
+		}
+	}
+	return tree, pts
+}
+
+func strtree_point_distance(pts []geom.Coordinate, query geom.Coordinate) strtree.ItemDistance[int] {
+	return func(item1 int, item2 int) float64 {
+		p1 := query
+		if item1 >= 0 {
+			p1 = pts[item1]
+		}
+		p2 := query
+		if item2 >= 0 {
+			p2 = pts[item2]
+		}
+		return p1.Distance(&p2)
+	}
+}
+
+func TestSTRtreeEmpty(t *testing.T) {
+	tree := strtree.NewSTRtree[string]()
+	assert.True(t, tree.IsEmpty())
+	assert.Equal(t, 0, tree.Size())
+	assert.Equal(t, 0, tree.Depth())
+	assert.Empty(t, tree.Query(geom.NewEnvelope(0, 10, 0, 10)))
+	_, ok := tree.NearestNeighbour(geom.NewEnvelope(0, 0, 0, 0), "a", func(a, b string) float64 { return 0 })
+	assert.False(t, ok)
+}
+
+func TestSTRtreeQuery(t *testing.T) {
+	tree, _ := strtree_grid(10)
+	assert.Equal(t, 100, tree.Size())
+	assert.Greater(t, tree.Depth(), 1)
+
+	result := tree.Query(geom.NewEnvelope(2.5, 4.5, 6, 7))
+	sort.Ints(result)
+	assert.Equal(t, []int{36, 37, 46, 47}, result)
+}
+
+func TestSTRtreeQueryVisitorStops(t *testing.T) {
+	tree, _ := strtree_grid(10)
+	count := 0
+	tree.QueryVisitor(geom.NewEnvelope(0, 9, 0, 9), func(item int) bool {
+		count++
+		return count < 5
+	})
+	assert.Equal(t, 5, count)
+}
+
+func TestSTRtreeConcurrentQuery(t *testing.T) {
+	// run with -race to check that querying does not modify the tree
+	for _, isBuilt := range []bool{false, true} {
+		tree, _ := strtree_grid(20)
+		if isBuilt {
+			tree.Build()
+		}
+		var wg sync.WaitGroup
+		counts := make([]int, 8)
+		for i := range counts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for k := 0; k < 20; k++ {
+					x := float64((i + k) % 15)
+					counts[i] += len(tree.Query(geom.NewEnvelope(x, x+4.5, x, x+4.5)))
+				}
+			}(i)
+		}
+		wg.Wait()
+		for _, count := range counts {
+			assert.Equal(t, 20*25, count)
+		}
+	}
+}
+
+func TestSTRtreeInsertAfterBuild(t *testing.T) {
+	tree := strtree.NewSTRtree[int]()
+	assert.Nil(t, tree.Insert(geom.NewEnvelope(0, 1, 0, 1), 1))
+	tree.Build()
+	assert.NotNil(t, tree.Insert(geom.NewEnvelope(0, 1, 0, 1), 2))
+}
+
+func TestSTRtreeRemove(t *testing.T) {
+	tree, pts := strtree_grid(5)
+	env := geom.NewEnvelopeFromCoordinate(&pts[7])
+	assert.True(t, tree.Remove(env, 7))
+	assert.False(t, tree.Remove(env, 7))
+	assert.Equal(t, 24, tree.Size())
+
+	env = geom.NewEnvelopeFromCoordinate(&pts[12])
+	assert.True(t, tree.Remove(env, 12))
+	assert.Empty(t, tree.Query(env))
+	assert.Equal(t, 23, tree.Size())
+}
+
+func TestSTRtreeNearestNeighbour(t *testing.T) {
+	tree, pts := strtree_grid(10)
+	query := *geom.NewCoordinateXY(3.2, 6.9)
+	nearest, ok := tree.NearestNeighbour(geom.NewEnvelopeFromCoordinate(&query), -1, strtree_point_distance(pts, query))
+	assert.True(t, ok)
+	assert.Equal(t, 37, nearest)
+}
+
+func TestSTRtreeNearestNeighbourK(t *testing.T) {
+	tree, pts := strtree_grid(10)
+	query := *geom.NewCoordinateXY(3.2, 6.9)
+	nearest := tree.NearestNeighbourK(geom.NewEnvelopeFromCoordinate(&query), -1, strtree_point_distance(pts, query), 3)
+	assert.Equal(t, []int{37, 47, 36}, nearest)
+
+	all := tree.NearestNeighbourK(geom.NewEnvelopeFromCoordinate(&query), -1, strtree_point_distance(pts, query), 200)
+	assert.Equal(t, 100, len(all))
+	for i := 1; i < len(all); i++ {
+		assert.LessOrEqual(t, pts[all[i-1]].Distance(&query), pts[all[i]].Distance(&query))
+	}
+}
+
+func TestSTRtreeNearestNeighbourPair(t *testing.T) {
+	tree := strtree.NewSTRtree[int]()
+	pts := []geom.Coordinate{*geom.NewCoordinateXY(0, 0), *geom.NewCoordinateXY(10, 10), *geom.NewCoordinateXY(5, 0), *geom.NewCoordinateXY(10, 10.5)}
+	for i := range pts {
+		tree.Insert(geom.NewEnvelopeFromCoordinate(&pts[i]), i)
+	}
+	item1, item2, ok := tree.NearestNeighbourPair(strtree_point_distance(pts, geom.Coordinate{}))
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 3}, []int{min(item1, item2), max(item1, item2)})
+	assert.Equal(t, 0.5, math.Round(pts[item1].Distance(&pts[item2])*10)/10)
+}
@@ -0,0 +1,150 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	tri "github.com/UltimateThread/geos-go/core/triangulate/tri"
+)
+
+/**
+ * Creates the two clockwise triangles of the square (0,0)-(10,10),
+ * sharing the diagonal (0,0)-(10,10).
+ */
+func square_tris() []*tri.Tri {
+	return []*tri.Tri{
+		tri.NewTriFromCoordinates(coords(0, 0, 0, 10, 10, 10)),
+		tri.NewTriFromCoordinates(coords(0, 0, 10, 10, 10, 0)),
+	}
+}
+
+func TestTriIndexes(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, i, tri.TriPrev(tri.TriNext(i)))
+		assert.Equal(t, tri.TriPrev(i), tri.TriOppVertex(i))
+		assert.Equal(t, tri.TriNext(i), tri.TriOppEdge(i))
+	}
+	assert.Equal(t, 1, tri.TriNext(0))
+	assert.Equal(t, 0, tri.TriNext(2))
+	assert.Equal(t, 2, tri.TriPrev(0))
+	assert.Equal(t, -1, tri.TriNext(3))
+}
+
+func TestTriMeasures(t *testing.T) {
+	tr := tri.NewTri(geom.NewCoordinateXY(0, 0), geom.NewCoordinateXY(0, 3), geom.NewCoordinateXY(4, 0))
+	assert.Equal(t, 6.0, tr.GetArea())
+	assert.Equal(t, 12.0, tr.GetLength())
+	assert.Equal(t, 3.0, tr.GetEdgeLength(0))
+	assert.Equal(t, 5.0, tr.GetEdgeLength(1))
+	assert.Equal(t, 4.0, tr.GetEdgeLength(2))
+	assert.True(t, tr.Midpoint(1).Equals2D(geom.NewCoordinateXY(2, 1.5)))
+	assert.Equal(t, 1, tr.GetIndex(geom.NewCoordinateXY(0, 3)))
+	assert.Equal(t, -1, tr.GetIndex(geom.NewCoordinateXY(3, 0)))
+	assert.True(t, tr.GetCoordinate(2).Equals2D(geom.NewCoordinateXY(4, 0)))
+	check_coords(t, tr.ToRing(), 0, 0, 0, 3, 4, 0, 0, 0)
+	assert.Nil(t, tr.Validate())
+	//-- counter-clockwise tris are invalid
+	assert.NotNil(t, tri.NewTriFromCoordinates(coords(0, 0, 4, 0, 0, 3)).Validate())
+}
+
+func TestTriangulationBuilderBuild(t *testing.T) {
+	tris := square_tris()
+	for _, tr := range tris {
+		assert.False(t, tr.HasAnyAdjacent())
+	}
+	tri.TriangulationBuilderBuild(tris)
+
+	assert.Equal(t, tris[1], tris[0].GetAdjacent(2))
+	assert.Equal(t, tris[0], tris[1].GetAdjacent(0))
+	assert.Equal(t, 2, tris[0].GetIndexOfTri(tris[1]))
+	assert.Equal(t, -1, tris[0].GetIndexOfTri(tris[0]))
+	assert.True(t, tris[0].IsAdjacent(tris[1]))
+	assert.True(t, tris[0].HasAdjacent(2))
+	assert.False(t, tris[0].HasAdjacent(0))
+	for _, tr := range tris {
+		assert.Equal(t, 1, tr.NumAdjacent())
+		assert.True(t, tr.IsBorder())
+	}
+	assert.True(t, tris[0].IsBoundary(0))
+	assert.False(t, tris[0].IsBoundary(2))
+	assert.Nil(t, tri.TriValidate(tris))
+	assert.Equal(t, 100.0, tri.TriArea(tris))
+	assert.Equal(t, 2, len(tri.TriToRings(tris)))
+}
+
+func TestTriValidateAdjacent(t *testing.T) {
+	tris := square_tris()
+	other := tri.NewTriFromCoordinates(coords(20, 0, 20, 10, 30, 10))
+	tris[0].SetAdjacentTris(nil, nil, other)
+	assert.NotNil(t, tris[0].Validate())
+
+	tris[0].SetAdjacentTris(nil, nil, nil)
+	tris[0].SetAdjacent(geom.NewCoordinateXY(10, 10), tris[1])
+	tris[1].SetTri(0, tris[0])
+	assert.Nil(t, tri.TriValidate(tris))
+	assert.Equal(t, tris[1], tris[0].GetAdjacent(2))
+}
+
+func TestTriFlip(t *testing.T) {
+	tris := square_tris()
+	tri.TriangulationBuilderBuild(tris)
+	tris[0].Flip(2)
+
+	assert.Nil(t, tri.TriValidate(tris))
+	assert.Equal(t, 100.0, tri.TriArea(tris))
+	//-- the common edge is now the other diagonal
+	for _, tr := range tris {
+		assert.True(t, tr.GetIndex(geom.NewCoordinateXY(0, 10)) >= 0)
+		assert.True(t, tr.GetIndex(geom.NewCoordinateXY(10, 0)) >= 0)
+		assert.Equal(t, 1, tr.NumAdjacent())
+	}
+	assert.True(t, tris[0].IsAdjacent(tris[1]))
+}
+
+func TestTriFlipUpdatesNeighbours(t *testing.T) {
+	tris := square_tris()
+	below := tri.NewTriFromCoordinates(coords(0, 0, 10, 0, 5, -5))
+	tris = append(tris, below)
+	tri.TriangulationBuilderBuild(tris)
+	assert.Equal(t, tris[1], below.GetAdjacent(0))
+
+	tris[0].Flip(2)
+	assert.Nil(t, tri.TriValidate(tris))
+	//-- the tri below is now adjacent to the tri which took its edge
+	adj := below.GetAdjacent(0)
+	assert.NotNil(t, adj)
+	assert.True(t, adj.IsAdjacent(below))
+	assert.True(t, adj.GetIndex(geom.NewCoordinateXY(0, 0)) >= 0)
+	assert.True(t, adj.GetIndex(geom.NewCoordinateXY(10, 0)) >= 0)
+}
+
+func TestTriRemove(t *testing.T) {
+	tris := square_tris()
+	tri.TriangulationBuilderBuild(tris)
+	tris[0].Remove()
+	assert.Equal(t, 0, tris[0].NumAdjacent())
+	assert.Equal(t, 0, tris[1].NumAdjacent())
+}
+
+func TestTriInteriorVertex(t *testing.T) {
+	//-- a fan of four tris around the centre of a square
+	corners := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	centre := geom.NewCoordinateXY(5, 5)
+	tris := make([]*tri.Tri, 0, 4)
+	for i := 0; i < 4; i++ {
+		tris = append(tris, tri.NewTri(centre, &corners[i], &corners[i+1]))
+	}
+	tri.TriangulationBuilderBuild(tris)
+	assert.Nil(t, tri.TriValidate(tris))
+	for _, tr := range tris {
+		assert.Equal(t, 2, tr.NumAdjacent())
+		assert.True(t, tr.IsInteriorVertex(0))
+		assert.False(t, tr.IsInteriorVertex(1))
+		assert.False(t, tr.IsInteriorVertex(2))
+	}
+
+	tris[0].Remove()
+	assert.False(t, tris[1].IsInteriorVertex(0))
+}
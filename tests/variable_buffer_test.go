@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	constants "github.com/UltimateThread/geos-go/core/constants"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	buffer "github.com/UltimateThread/geos-go/core/operation/buffer"
+)
+
+func TestVariableBufferConstantDistance(t *testing.T) {
+	line := coords(0, 0, 10, 0)
+	result := buffer.VariableBufferStartEnd(line, 1, 1)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 1, len(result[0]))
+
+	shell := result[0][0]
+	assert.True(t, algorithm.AreaOfRingSigned(shell) > 0)
+	// a segment with round caps, approximated by 8 quadrant segments
+	assert.InDelta(t, 20+math.Pi, algorithm.AreaOfRing(shell), 0.1)
+	check_variable_buffer_distance(t, shell, line, 1)
+}
+
+func TestVariableBufferZeroStart(t *testing.T) {
+	line := coords(0, 0, 10, 0)
+	result := buffer.VariableBufferStartEnd(line, 0, 2)
+	assert.Equal(t, 1, len(result))
+	shell := result[0][0]
+
+	hasStart := false
+	for i := range shell {
+		if shell[i].Equals2D(geom.NewCoordinateXY(0, 0)) {
+			hasStart = true
+		}
+		assert.True(t, shell[i].X >= 0)
+	}
+	assert.True(t, hasStart)
+}
+
+func TestVariableBufferZeroDistance(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	assert.Nil(t, buffer.VariableBufferStartEnd(line, 0, 0))
+	assert.Nil(t, buffer.VariableBufferStartEnd(coords(), 1, 1))
+}
+
+func TestVariableBufferCorner(t *testing.T) {
+	line := coords(0, 0, 10, 0, 10, 10)
+	result := buffer.VariableBufferStartEnd(line, 1, 1)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 1, len(result[0]))
+	check_variable_buffer_distance(t, result[0][0], line, 1)
+}
+
+func TestVariableBufferRingHasHole(t *testing.T) {
+	line := coords(0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+	result := buffer.VariableBufferStartMidEnd(line, 1, 2, 1)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 2, len(result[0]))
+	hole := result[0][1]
+	assert.True(t, algorithm.AreaOfRingSigned(hole) < 0)
+	assert.True(t, algorithm.PointLocationIsInRing(geom.NewCoordinateXY(5, 5), hole))
+}
+
+func TestVariableBufferDistances(t *testing.T) {
+	line := coords(0, 0, 10, 0, 20, 0)
+	result, err := buffer.VariableBufferDistances(line, []float64{1, 3, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(result))
+	env := geom.NewEnvelopeFromCoordinateArray(result[0][0])
+	assert.InDelta(t, 3, env.GetMaxY(), 1e-9)
+	assert.InDelta(t, -3, env.GetMinY(), 1e-9)
+
+	_, err = buffer.VariableBufferDistances(line, []float64{1, 2})
+	assert.NotNil(t, err)
+}
+
+func TestVariableBufferMeasures(t *testing.T) {
+	line := []geom.Coordinate{
+		*geom.NewCoordinateXYM(0, 0, 1),
+		*geom.NewCoordinateXYM(10, 0, 2),
+		*geom.NewCoordinateXYM(20, 0, 1),
+	}
+	fromM := buffer.VariableBufferMeasures(line)
+	fromDist, _ := buffer.VariableBufferDistances(line, []float64{1, 2, 1})
+	assert.Equal(t, 1, len(fromM))
+	assert.Equal(t, len(fromDist[0][0]), len(fromM[0][0]))
+	for i := range fromM[0][0] {
+		assert.True(t, fromM[0][0][i].Equals2D(&fromDist[0][0][i]))
+	}
+}
+
+func TestVariableBufferZigzag(t *testing.T) {
+	line := coords(0, 0, 5, 3, 10, 0, 15, 3, 20, 0, 25, 3)
+	result := buffer.VariableBufferStartEnd(line, 0.5, 4)
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 1, len(result[0]))
+	shell := result[0][0]
+	for i := range line {
+		assert.Equal(t, constants.LOCATION_INTERIOR, algorithm.PointLocationLocateInRing(&line[i], shell))
+	}
+}
+
+func TestVariableBufferDisjointParts(t *testing.T) {
+	// the zero distance at the middle vertex splits the buffer
+	line := coords(0, 0, 10, 0, 20, 0)
+	result, _ := buffer.VariableBufferDistances(line, []float64{1, 0, 1})
+	assert.Equal(t, 2, len(result))
+}
+
+// Checks that the buffer boundary lies at (about) the given distance from the line
+func check_variable_buffer_distance(t *testing.T, ring []geom.Coordinate, line []geom.Coordinate, distance float64) {
+	// the maximum inset of an approximating chord with 8 quadrant segments
+	tol := distance * (1 - math.Cos(math.Pi/32))
+	for i := range ring {
+		dist := algorithm.DistancePointToSegmentString(&ring[i], line)
+		assert.True(t, dist <= distance+1e-9 && dist >= distance-tol, "distance %v at %v", dist, ring[i].ToString())
+	}
+}
@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	algorithm "github.com/UltimateThread/geos-go/core/algorithm"
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	triangulate "github.com/UltimateThread/geos-go/core/triangulate"
+	quadedge "github.com/UltimateThread/geos-go/core/triangulate/quadedge"
+)
+
+func voronoi_cells(t *testing.T, sites []geom.Coordinate, clipEnv *geom.Envelope) []*quadedge.VoronoiCell {
+	builder := triangulate.NewVoronoiDiagramBuilder()
+	builder.SetSites(sites)
+	if clipEnv != nil {
+		builder.SetClipEnvelope(clipEnv)
+	}
+	cells, err := builder.GetDiagram()
+	assert.Nil(t, err)
+	return cells
+}
+
+/**
+ * Checks that the cells cover the envelope,
+ * and that each cell is the region closest to its site.
+ */
+func check_voronoi_cells(t *testing.T, cells []*quadedge.VoronoiCell, sites []geom.Coordinate, env *geom.Envelope) {
+	area := 0.0
+	for _, cell := range cells {
+		ring := cell.GetRing()
+		assert.False(t, algorithm.OrientationIsCCW(ring))
+		assert.NotEqual(t, 2, algorithm.PointLocationLocateInRing(cell.GetSite(), ring))
+		area += algorithm.AreaOfRing(ring)
+
+		for i := 0; i < len(ring)-1; i++ {
+			assert.True(t, env.CoversCoordinate(&ring[i]))
+			//-- the cell vertices are no closer to any other site
+			siteDist := ring[i].Distance(cell.GetSite())
+			for j := range sites {
+				assert.GreaterOrEqual(t, ring[i].Distance(&sites[j]), siteDist-1e-6)
+			}
+		}
+	}
+	assert.InDelta(t, env.GetArea(), area, 1e-6*env.GetArea())
+}
+
+func TestVoronoiSquare(t *testing.T) {
+	sites := coords(0, 0, 10, 0, 0, 10, 10, 10)
+	cells := voronoi_cells(t, sites, nil)
+	assert.Equal(t, 4, len(cells))
+	for _, cell := range cells {
+		assert.InDelta(t, 225, algorithm.AreaOfRing(cell.GetRing()), 1e-9)
+	}
+	check_voronoi_cells(t, cells, sites, geom.NewEnvelope(-10, 20, -10, 20))
+}
+
+func TestVoronoiSiteAsUserData(t *testing.T) {
+	sites := coords(0, 0, 10, 0, 5, 8)
+	cells := voronoi_cells(t, sites, nil)
+	assert.Equal(t, 3, len(cells))
+	cellSites := make([]geom.Coordinate, 0, len(cells))
+	for _, cell := range cells {
+		cellSites = append(cellSites, *cell.GetSite())
+	}
+	check_coords_unordered(t, cellSites, 0, 0, 10, 0, 5, 8)
+}
+
+func TestVoronoiClipEnvelope(t *testing.T) {
+	sites := coords(0, 0, 10, 0, 5, 8, 4, 3)
+	clipEnv := geom.NewEnvelope(-100, 100, -50, 50)
+	cells := voronoi_cells(t, sites, clipEnv)
+	assert.Equal(t, 4, len(cells))
+	check_voronoi_cells(t, cells, sites, clipEnv)
+}
+
+func TestVoronoiClipEnvelopeTighter(t *testing.T) {
+	r := rand.New(rand.NewSource(45))
+	sites := make([]geom.Coordinate, 0, 50)
+	for i := 0; i < 50; i++ {
+		sites = append(sites, *geom.NewCoordinateXY(r.Float64()*100, r.Float64()*100))
+	}
+	// tighter than the default envelope, which extends the site extent on each side
+	clipEnv := geom.NewEnvelope(-10, 110, -10, 110)
+	cells := voronoi_cells(t, sites, clipEnv)
+	assert.Equal(t, 50, len(cells))
+	check_voronoi_cells(t, cells, sites, clipEnv)
+
+	// sites outside the clip envelope have no cell
+	cells = voronoi_cells(t, coords(0, 0, 10, 0, 0, 10, 10, 10), geom.NewEnvelope(0, 4, 0, 4))
+	assert.Equal(t, 1, len(cells))
+	check_voronoi_cells(t, cells, coords(0, 0), geom.NewEnvelope(0, 4, 0, 4))
+}
+
+func TestVoronoiCollinear(t *testing.T) {
+	sites := coords(0, 0, 5, 0, 10, 0)
+	cells := voronoi_cells(t, sites, nil)
+	assert.Equal(t, 3, len(cells))
+	check_voronoi_cells(t, cells, sites, geom.NewEnvelope(-10, 20, -10, 10))
+}
+
+func TestVoronoiRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(44))
+	sites := make([]geom.Coordinate, 0, 100)
+	for i := 0; i < 100; i++ {
+		sites = append(sites, *geom.NewCoordinateXY(math.Round(r.Float64()*1000), math.Round(r.Float64()*1000)))
+	}
+	uniqueSites := triangulate.DelaunayTriangulationBuilderExtractUniqueCoordinates(sites)
+	cells := voronoi_cells(t, sites, nil)
+	assert.Equal(t, len(uniqueSites), len(cells))
+
+	env := geom.NewEnvelopeFromCoordinateArray(uniqueSites)
+	env.ExpandBy(max(env.GetWidth(), env.GetHeight()))
+	check_voronoi_cells(t, cells, uniqueSites, env)
+}
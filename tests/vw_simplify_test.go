@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	geom "github.com/UltimateThread/geos-go/core/geom"
+	simplify "github.com/UltimateThread/geos-go/core/simplify"
+)
+
+func TestVWSimplifyLine(t *testing.T) {
+	line := coords(0, 5, 1, 5, 2, 5, 5, 5)
+	check_coords(t, simplify.VWSimplifyLine(line, 10), 0, 5, 5, 5)
+
+	line = coords(0, 0, 5, 1, 10, 0, 15, 8, 20, 0)
+	check_coords(t, simplify.VWSimplifyLine(line, 3), 0, 0, 10, 0, 15, 8, 20, 0)
+}
+
+func TestVWEffectiveAreas(t *testing.T) {
+	coordList := geom.NewCoordinateList(coords(0, 0, 5, 1, 10, 0, 15, 8, 20, 0))
+	areas := simplify.VWEffectiveAreas(coordList)
+	assert.Equal(t, []float64{math.Inf(1), 5, 40, 80, math.Inf(1)}, areas)
+}
+
+func TestVWEffectiveAreasMonotonic(t *testing.T) {
+	// the vertex at (2 -1) has a smaller area once (3 2) is removed
+	coordList := geom.NewCoordinateList(coords(0, 0, 1, 0, 2, -1, 3, 2, 4, 0))
+	areas := simplify.VWEffectiveAreas(coordList)
+	assert.Equal(t, []float64{math.Inf(1), 0.5, 2.5, 2.5, math.Inf(1)}, areas)
+}
+
+func TestVWSimplifyByEffectiveArea(t *testing.T) {
+	coordList := geom.NewCoordinateList(coords(0, 0, 2, 3, 3, 1, 5, 6, 6, 2, 8, 2.5, 9, 7, 11, 1, 12, 4, 14, 0))
+	areas := simplify.VWEffectiveAreas(coordList)
+	for _, tol := range []float64{0, 0.5, 1, 1.5, 2, 3, 5, 10} {
+		expected := simplify.VWSimplifyCoordinateList(coordList, tol)
+		actual := simplify.VWSimplifyByEffectiveArea(coordList, areas, tol)
+		assert.Equal(t, len(expected.Coordinates), len(actual.Coordinates), "tolerance %v", tol)
+		for i := range actual.Coordinates {
+			assert.True(t, expected.Coordinates[i].Equals2D(&actual.Coordinates[i]), "tolerance %v", tol)
+		}
+	}
+	assert.Nil(t, simplify.VWSimplifyByEffectiveArea(coordList, areas[1:], 1))
+}
+
+func TestVWSimplifyRingClosed(t *testing.T) {
+	coordList := geom.NewCoordinateList(coords(0, 0, 0, 10, 5, 10.1, 10, 10, 10, 0, 0, 0))
+	result := simplify.VWSimplifyCoordinateList(coordList, 1)
+	check_coords(t, result.ToCoordinateArray(), 0, 0, 0, 10, 10, 10, 10, 0, 0, 0)
+
+	areas := simplify.VWEffectiveAreas(coordList)
+	result = simplify.VWSimplifyByEffectiveArea(coordList, areas, 1)
+	assert.True(t, geom.IsRing(result.ToCoordinateArray()))
+}
+
+func TestVWSimplifyPolygon(t *testing.T) {
+	polygon := [][]geom.Coordinate{
+		coords(0, 0, 0, 100, 50, 101, 100, 100, 100, 0, 0, 0),
+		coords(50, 50, 51, 50, 51, 51, 50, 51, 50, 50),
+	}
+	result := simplify.VWSimplifyPolygon(polygon, 8)
+	assert.Equal(t, 1, len(result))
+	check_coords(t, result[0], 0, 0, 0, 100, 100, 100, 100, 0, 0, 0)
+
+	assert.Nil(t, simplify.VWSimplifyPolygon(polygon[1:], 5))
+}